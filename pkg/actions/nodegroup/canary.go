@@ -0,0 +1,92 @@
+package nodegroup
+
+import (
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+)
+
+const defaultCanaryInitialDesired = 1
+
+// canaryNodeGroup holds the capacity a nodegroup in canary mode is scaled to
+// once its initial nodes are ready
+type canaryNodeGroup struct {
+	ng              *api.NodeGroup
+	initialDesired  int
+	desiredCapacity int
+	minSize         int
+}
+
+// prepareCanaryNodeGroups lowers the capacity of the nodegroups in canary mode to their initial
+// desired capacity, returning the capacity to scale them to after validation
+func prepareCanaryNodeGroups(nodeGroups []*api.NodeGroup) []canaryNodeGroup {
+	var canaries []canaryNodeGroup
+	for _, ng := range nodeGroups {
+		if ng.Canary == nil {
+			continue
+		}
+
+		desiredCapacity := api.DefaultNodeCount
+		if ng.DesiredCapacity != nil {
+			desiredCapacity = *ng.DesiredCapacity
+		} else if ng.MinSize != nil {
+			desiredCapacity = *ng.MinSize
+		}
+		minSize := desiredCapacity
+		if ng.MinSize != nil {
+			minSize = *ng.MinSize
+		}
+
+		initialDesired := defaultCanaryInitialDesired
+		if ng.Canary.InitialDesired != nil {
+			initialDesired = *ng.Canary.InitialDesired
+		}
+		if initialDesired >= desiredCapacity {
+			logger.Warning("initial desired capacity of nodegroup %q is not lower than its desired capacity, creating it without canary", ng.Name)
+			continue
+		}
+
+		// the maximum size is otherwise derived from the desired capacity
+		if ng.MaxSize == nil {
+			ng.MaxSize = &desiredCapacity
+		}
+		ng.DesiredCapacity = &initialDesired
+		if minSize > initialDesired {
+			ng.MinSize = &initialDesired
+		}
+
+		canaries = append(canaries, canaryNodeGroup{
+			ng:              ng,
+			initialDesired:  initialDesired,
+			desiredCapacity: desiredCapacity,
+			minSize:         minSize,
+		})
+	}
+	return canaries
+}
+
+// scaleCanaryNodeGroups waits for the initial nodes of each canary nodegroup to become ready, and
+// scales the nodegroup to its desired capacity. Nodegroups failing validation are left at their initial capacity
+func (m *Manager) scaleCanaryNodeGroups(clientSet kubernetes.Interface, canaries []canaryNodeGroup) error {
+	for _, c := range canaries {
+		waitTimeout := m.ctl.Provider.WaitTimeout()
+		if c.ng.Canary.ValidationTimeout != nil {
+			waitTimeout = c.ng.Canary.ValidationTimeout.Duration
+		}
+
+		logger.Info("validating canary nodes of nodegroup %q", c.ng.Name)
+		if err := m.ctl.WaitForReadyNodes(clientSet, c.ng, c.initialDesired, waitTimeout); err != nil {
+			return errors.Wrapf(err, "validating canary nodes of nodegroup %q failed, the nodegroup was left at its initial capacity of %d node(s)", c.ng.Name, c.initialDesired)
+		}
+
+		c.ng.DesiredCapacity = &c.desiredCapacity
+		c.ng.MinSize = &c.minSize
+		logger.Info("canary nodes of nodegroup %q are ready, scaling to %d node(s)", c.ng.Name, c.desiredCapacity)
+		if _, err := m.stackManager.ScaleNodeGroup(c.ng, false); err != nil {
+			return errors.Wrapf(err, "scaling nodegroup %q", c.ng.Name)
+		}
+	}
+	return nil
+}
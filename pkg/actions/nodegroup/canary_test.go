@@ -0,0 +1,45 @@
+package nodegroup_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+var _ = Describe("Canary", func() {
+	var ng *api.NodeGroup
+
+	BeforeEach(func() {
+		ng = api.NewNodeGroup()
+		ng.Name = "canary"
+		ng.DesiredCapacity = aws.Int(4)
+		ng.MinSize = aws.Int(2)
+		ng.Canary = &api.CanaryConfig{}
+	})
+
+	It("creates canary nodegroups at their initial capacity", func() {
+		Expect(nodegroup.PrepareCanaryNodeGroups([]*api.NodeGroup{ng})).To(Equal(1))
+		Expect(*ng.DesiredCapacity).To(Equal(1))
+		Expect(*ng.MinSize).To(Equal(1))
+		Expect(*ng.MaxSize).To(Equal(4))
+	})
+
+	It("uses the configured initial capacity", func() {
+		ng.Canary.InitialDesired = aws.Int(2)
+		ng.MaxSize = aws.Int(6)
+		Expect(nodegroup.PrepareCanaryNodeGroups([]*api.NodeGroup{ng})).To(Equal(1))
+		Expect(*ng.DesiredCapacity).To(Equal(2))
+		Expect(*ng.MinSize).To(Equal(2))
+		Expect(*ng.MaxSize).To(Equal(6))
+	})
+
+	It("leaves nodegroups without canary untouched", func() {
+		ng.Canary = nil
+		Expect(nodegroup.PrepareCanaryNodeGroups([]*api.NodeGroup{ng})).To(Equal(0))
+		Expect(*ng.DesiredCapacity).To(Equal(4))
+		Expect(ng.MaxSize).To(BeNil())
+	})
+})
@@ -48,6 +48,7 @@ func (m *Manager) Create(options CreateOpts, nodegroupFilter filter.NodeGroupFil
 		return err
 	}
 
+	var canaries []canaryNodeGroup
 	var isOwnedCluster = true
 	if err := ctl.LoadClusterIntoSpecFromStack(cfg, m.stackManager); err != nil {
 		switch e := err.(type) {
@@ -104,6 +105,10 @@ func (m *Manager) Create(options CreateOpts, nodegroupFilter filter.NodeGroupFil
 		return err
 	}
 
+	if err := vpc.ValidatePodSubnetsForNodeGroups(cfg, ctl.Provider); err != nil {
+		return err
+	}
+
 	{
 		if err := nodegroupFilter.SetOnlyLocal(ctl.Provider.EKS(), m.stackManager, cfg); err != nil {
 			return err
@@ -111,6 +116,11 @@ func (m *Manager) Create(options CreateOpts, nodegroupFilter filter.NodeGroupFil
 
 		logFiltered := cmdutils.ApplyFilter(cfg, &nodegroupFilter)
 		logFiltered()
+
+		if err := vpc.SelectNodeGroupAZsByInstanceTypeOfferings(ctl.Provider.EC2(), cfg); err != nil {
+			return err
+		}
+
 		logMsg := func(resource string, count int) {
 			logger.Info("will create a CloudFormation stack for each of %d %s in cluster %q", count, resource, meta.Name)
 		}
@@ -132,6 +142,8 @@ func (m *Manager) Create(options CreateOpts, nodegroupFilter filter.NodeGroupFil
 			return cmdutils.PrintNodeGroupDryRunConfig(clusterConfigCopy, os.Stdout)
 		}
 
+		canaries = prepareCanaryNodeGroups(cfg.NodeGroups)
+
 		taskTree := &tasks.TaskTree{
 			Parallel: false,
 		}
@@ -187,6 +199,10 @@ func (m *Manager) Create(options CreateOpts, nodegroupFilter filter.NodeGroupFil
 		return err
 	}
 
+	if err := m.scaleCanaryNodeGroups(m.clientSet, canaries); err != nil {
+		return err
+	}
+
 	if err := ctl.ValidateExistingNodeGroupsForCompatibility(cfg, m.stackManager); err != nil {
 		logger.Critical("failed checking nodegroups", err.Error())
 	}
@@ -209,6 +225,12 @@ func (m *Manager) postNodeCreationTasks(clientSet kubernetes.Interface, options
 		return fmt.Errorf("failed to create nodegroups for cluster %q", m.cfg.Metadata.Name)
 	}
 
+	for _, ng := range m.cfg.NodeGroups {
+		if err := m.ctl.ConfigureCustomNetworking(m.cfg, clientSet, ng); err != nil {
+			return err
+		}
+	}
+
 	if options.UpdateAuthConfigMap {
 		for _, ng := range m.cfg.NodeGroups {
 			// authorise nodes to join
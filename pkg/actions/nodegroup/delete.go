@@ -5,6 +5,7 @@ import (
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/eks"
+	ssh "github.com/weaveworks/eksctl/pkg/ssh/client"
 	"github.com/weaveworks/eksctl/pkg/utils/tasks"
 
 	"github.com/kris-nova/logger"
@@ -52,6 +53,15 @@ func (m *Manager) Delete(nodeGroups []*api.NodeGroup, managedNodeGroups []*api.M
 	if errs := tasks.DoAllSync(); len(errs) > 0 {
 		return handleErrors(errs, "nodegroup(s)")
 	}
+
+	if !plan {
+		for _, n := range nodeGroups {
+			ssh.DeleteImportedSSHKeys(m.cfg.Metadata.Name, n.NodeGroupBase, m.ctl.Provider.EC2())
+		}
+		for _, n := range managedNodeGroups {
+			ssh.DeleteImportedSSHKeys(m.cfg.Metadata.Name, n.NodeGroupBase, m.ctl.Provider.EC2())
+		}
+	}
 	return nil
 }
 
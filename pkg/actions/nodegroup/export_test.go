@@ -1,5 +1,29 @@
 package nodegroup
 
+import (
+	"time"
+
+	"k8s.io/client-go/kubernetes"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
 func (m *Manager) SetWaiter(wait WaitFunc) {
 	m.wait = wait
 }
+
+func PrepareCanaryNodeGroups(nodeGroups []*api.NodeGroup) int {
+	return len(prepareCanaryNodeGroups(nodeGroups))
+}
+
+func WaitForNewNodesReady(clientSet kubernetes.Interface, nodeGroupName string, since time.Time, timeout, pollInterval time.Duration) error {
+	return waitForNewNodesReady(clientSet, nodeGroupName, since, timeout, pollInterval)
+}
+
+func RollbackTemplate(previousTemplate, currentTemplate string) (string, bool, error) {
+	return rollbackTemplate(previousTemplate, currentTemplate)
+}
+
+func (m *Manager) AllowNodeGroupReplacement(nodeGroupName string) (func(), error) {
+	return m.allowNodeGroupReplacement(nodeGroupName)
+}
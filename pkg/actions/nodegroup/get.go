@@ -53,18 +53,24 @@ func (m *Manager) GetAll() ([]*manager.NodeGroupSummary, error) {
 			}
 		}
 
+		remoteAccess := manager.MakeRemoteAccessInfo(describeOutput.Nodegroup.RemoteAccess)
+		launchTemplateID, launchTemplateVersion := launchTemplateOf(describeOutput.Nodegroup)
 		summaries = append(summaries, &manager.NodeGroupSummary{
-			Name:                 *describeOutput.Nodegroup.NodegroupName,
-			Cluster:              *describeOutput.Nodegroup.ClusterName,
-			Status:               *describeOutput.Nodegroup.Status,
-			MaxSize:              int(*describeOutput.Nodegroup.ScalingConfig.MaxSize),
-			MinSize:              int(*describeOutput.Nodegroup.ScalingConfig.MinSize),
-			DesiredCapacity:      int(*describeOutput.Nodegroup.ScalingConfig.DesiredSize),
-			InstanceType:         *describeOutput.Nodegroup.InstanceTypes[0],
-			ImageID:              *describeOutput.Nodegroup.AmiType,
-			CreationTime:         describeOutput.Nodegroup.CreatedAt,
-			NodeInstanceRoleARN:  *describeOutput.Nodegroup.NodeRole,
-			AutoScalingGroupName: strings.Join(asgs, ","),
+			Name:                  *describeOutput.Nodegroup.NodegroupName,
+			Cluster:               *describeOutput.Nodegroup.ClusterName,
+			Status:                *describeOutput.Nodegroup.Status,
+			MaxSize:               aws.Int(int(*describeOutput.Nodegroup.ScalingConfig.MaxSize)),
+			MinSize:               aws.Int(int(*describeOutput.Nodegroup.ScalingConfig.MinSize)),
+			DesiredCapacity:       aws.Int(int(*describeOutput.Nodegroup.ScalingConfig.DesiredSize)),
+			InstanceType:          *describeOutput.Nodegroup.InstanceTypes[0],
+			ImageID:               *describeOutput.Nodegroup.AmiType,
+			CreationTime:          describeOutput.Nodegroup.CreatedAt,
+			NodeInstanceRoleARN:   *describeOutput.Nodegroup.NodeRole,
+			AutoScalingGroupName:  strings.Join(asgs, ","),
+			RemoteAccess:          remoteAccess,
+			SSHKeyExists:          manager.SSHKeyExists(remoteAccess, m.ctl.Provider.EC2()),
+			LaunchTemplateID:      launchTemplateID,
+			LaunchTemplateVersion: launchTemplateVersion,
 		})
 	}
 
@@ -90,16 +96,29 @@ func (m *Manager) Get(name string) (*manager.NodeGroupSummary, error) {
 		return nil, err
 	}
 
+	remoteAccess := manager.MakeRemoteAccessInfo(describeOutput.Nodegroup.RemoteAccess)
+	launchTemplateID, launchTemplateVersion := launchTemplateOf(describeOutput.Nodegroup)
 	return &manager.NodeGroupSummary{
-		Name:                *describeOutput.Nodegroup.NodegroupName,
-		Cluster:             *describeOutput.Nodegroup.ClusterName,
-		Status:              *describeOutput.Nodegroup.Status,
-		MaxSize:             int(*describeOutput.Nodegroup.ScalingConfig.MaxSize),
-		MinSize:             int(*describeOutput.Nodegroup.ScalingConfig.MinSize),
-		DesiredCapacity:     int(*describeOutput.Nodegroup.ScalingConfig.DesiredSize),
-		InstanceType:        *describeOutput.Nodegroup.InstanceTypes[0],
-		ImageID:             *describeOutput.Nodegroup.AmiType,
-		CreationTime:        describeOutput.Nodegroup.CreatedAt,
-		NodeInstanceRoleARN: *describeOutput.Nodegroup.NodeRole,
+		Name:                  *describeOutput.Nodegroup.NodegroupName,
+		Cluster:               *describeOutput.Nodegroup.ClusterName,
+		Status:                *describeOutput.Nodegroup.Status,
+		MaxSize:               aws.Int(int(*describeOutput.Nodegroup.ScalingConfig.MaxSize)),
+		MinSize:               aws.Int(int(*describeOutput.Nodegroup.ScalingConfig.MinSize)),
+		DesiredCapacity:       aws.Int(int(*describeOutput.Nodegroup.ScalingConfig.DesiredSize)),
+		InstanceType:          *describeOutput.Nodegroup.InstanceTypes[0],
+		ImageID:               *describeOutput.Nodegroup.AmiType,
+		CreationTime:          describeOutput.Nodegroup.CreatedAt,
+		NodeInstanceRoleARN:   *describeOutput.Nodegroup.NodeRole,
+		RemoteAccess:          remoteAccess,
+		SSHKeyExists:          manager.SSHKeyExists(remoteAccess, m.ctl.Provider.EC2()),
+		LaunchTemplateID:      launchTemplateID,
+		LaunchTemplateVersion: launchTemplateVersion,
 	}, nil
 }
+
+func launchTemplateOf(ng *eks.Nodegroup) (string, string) {
+	if ng.LaunchTemplate == nil {
+		return "", ""
+	}
+	return aws.StringValue(ng.LaunchTemplate.Id), aws.StringValue(ng.LaunchTemplate.Version)
+}
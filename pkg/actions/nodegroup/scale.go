@@ -12,9 +12,16 @@ import (
 	"github.com/aws/aws-sdk-go/service/eks"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/ssh"
 )
 
-func (m *Manager) Scale(ng *api.NodeGroup) error {
+// Scale scales the nodegroup. In dry-run mode the CloudFormation changes scaling would make are logged
+// instead of being applied, which is only possible for nodegroups created by eksctl. Unless force is set,
+// the scaling of nodegroups created by eksctl is refused when their instance types are no longer offered
+// in all of their availability zones. The EC2 key pair set in the config of the nodegroup must exist, as new
+// nodes cannot be launched without it
+func (m *Manager) Scale(ng *api.NodeGroup, dryRun, force bool) error {
 	logger.Info("scaling nodegroup %q in cluster %s", ng.Name, m.cfg.Metadata.Name)
 	stackManager := m.ctl.NewStackManager(m.cfg)
 
@@ -23,8 +30,23 @@ func (m *Manager) Scale(ng *api.NodeGroup) error {
 		return err
 	}
 
+	if dryRun && !hasStacks {
+		return fmt.Errorf("dry-run is not supported for nodegroup %q as it was not created by eksctl", ng.Name)
+	}
+
+	if err := ssh.ValidateKeyPairs([]*api.NodeGroupBase{ng.NodeGroupBase}, m.ctl.Provider.EC2()); err != nil {
+		return err
+	}
+
+	if hasStacks && !force {
+		if err := stackManager.CheckNodeGroupInstanceTypeOfferings(ng); err != nil {
+			return fmt.Errorf("%v, use --force to scale the nodegroup anyway", err)
+		}
+	}
+
+	var changes []manager.StackChange
 	if hasStacks {
-		err = stackManager.ScaleNodeGroup(ng)
+		changes, err = stackManager.ScaleNodeGroup(ng, dryRun)
 	} else {
 		err = m.scale(ng)
 	}
@@ -33,9 +55,23 @@ func (m *Manager) Scale(ng *api.NodeGroup) error {
 		return fmt.Errorf("failed to scale nodegroup for cluster %q, error: %v", m.cfg.Metadata.Name, err)
 	}
 
+	if dryRun {
+		logPlannedChanges(ng.Name, changes)
+	}
 	return nil
 }
 
+func logPlannedChanges(ngName string, changes []manager.StackChange) {
+	if len(changes) == 0 {
+		logger.Info("(dry-run) scaling nodegroup %q would not change any resources", ngName)
+		return
+	}
+	logger.Info("(dry-run) scaling nodegroup %q would make the following changes:", ngName)
+	for _, change := range changes {
+		logger.Info("(dry-run) %s %s (%s)", change.Action, change.LogicalResourceID, change.ResourceType)
+	}
+}
+
 func (m *Manager) scale(ng *api.NodeGroup) error {
 	scalingConfig := &eks.NodegroupScalingConfig{}
 
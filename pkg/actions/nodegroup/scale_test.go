@@ -8,6 +8,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	awseks "github.com/aws/aws-sdk-go/service/eks"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -67,7 +68,7 @@ var _ = Describe("Scale", func() {
 				return nil
 			})
 
-			err := manager.Scale(ng)
+			err := manager.Scale(ng, false, false)
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(waitCallCount).To(Equal(1))
@@ -84,11 +85,28 @@ var _ = Describe("Scale", func() {
 					NodegroupName: &ngName,
 				}).Return(nil, fmt.Errorf("foo"))
 
-				err := manager.Scale(ng)
+				err := manager.Scale(ng, false, false)
 
 				Expect(err).To(MatchError(fmt.Sprintf("failed to scale nodegroup for cluster %q, error: foo", clusterName)))
 			})
 		})
+
+		It("fails when the EC2 key pair of the nodegroup does not exist", func() {
+			ng.SSH = &api.NodeGroupSSH{PublicKeyName: aws.String("deleted-key")}
+			p.MockEC2().On("DescribeKeyPairs", mock.Anything).Return(&ec2.DescribeKeyPairsOutput{}, nil)
+
+			err := manager.Scale(ng, false, false)
+
+			Expect(err).To(MatchError(`EC2 key pair "deleted-key" of nodegroup "my-ng" does not exist (path=ssh.publicKeyName)`))
+			Expect(p.MockEKS().AssertNotCalled(GinkgoT(), "UpdateNodegroupConfig", mock.Anything)).To(BeTrue())
+		})
+
+		It("does not support dry-run", func() {
+			err := manager.Scale(ng, true, false)
+
+			Expect(err).To(MatchError(fmt.Sprintf("dry-run is not supported for nodegroup %q as it was not created by eksctl", ngName)))
+			Expect(p.MockEKS().AssertNotCalled(GinkgoT(), "UpdateNodegroupConfig", mock.Anything)).To(BeTrue())
+		})
 	})
 
 })
@@ -2,6 +2,7 @@ package nodegroup
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 
@@ -11,12 +12,17 @@ import (
 	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/blang/semver"
 	"github.com/kris-nova/logger"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/managed"
 	"github.com/weaveworks/eksctl/pkg/utils/waiters"
 )
 
-func (m *Manager) Upgrade(nodeGroupName, version, launchTemplateVersion string, forceUpgrade bool) error {
+// Upgrade upgrades the nodegroup. When allowReplacement is set, the replacement protection of the nodegroup's
+// stack is lifted for the duration of the upgrade and restored afterwards. When readyTimeout is not zero, the
+// launch template version of a nodegroup with a stack is rolled back if its new nodes are not ready within
+// readyTimeout
+func (m *Manager) Upgrade(nodeGroupName, version, launchTemplateVersion string, forceUpgrade, allowReplacement bool, readyTimeout time.Duration) error {
 	stackCollection := manager.NewStackCollection(m.ctl.Provider, m.cfg)
 	hasStacks, err := m.hasStacks(nodeGroupName)
 	if err != nil {
@@ -30,18 +36,61 @@ func (m *Manager) Upgrade(nodeGroupName, version, launchTemplateVersion string,
 	}
 
 	if hasStacks {
+		if readyTimeout != 0 && launchTemplateVersion == "" {
+			return errors.New("rolling back upgrades whose nodes are not ready requires a launch template version to upgrade to, " +
+				"as EKS does not support downgrading the release version of a nodegroup")
+		}
+		if allowReplacement {
+			restore, err := m.allowNodeGroupReplacement(nodeGroupName)
+			if err != nil {
+				return err
+			}
+			defer restore()
+		}
 		managedService := managed.NewService(m.ctl.Provider.EKS(), m.ctl.Provider.SSM(), m.ctl.Provider.EC2(), stackCollection, m.cfg.Metadata.Name)
-		return managedService.UpgradeNodeGroup(managed.UpgradeOptions{
-			NodegroupName:         nodeGroupName,
-			KubernetesVersion:     version,
-			LaunchTemplateVersion: launchTemplateVersion,
-			ForceUpgrade:          forceUpgrade,
-		})
+		upgrade := func() error {
+			return managedService.UpgradeNodeGroup(managed.UpgradeOptions{
+				NodegroupName:         nodeGroupName,
+				KubernetesVersion:     version,
+				LaunchTemplateVersion: launchTemplateVersion,
+				ForceUpgrade:          forceUpgrade,
+			})
+		}
+		if readyTimeout == 0 {
+			return upgrade()
+		}
+		return m.upgradeWithHealthGate(nodeGroupName, readyTimeout, upgrade)
 	}
 
+	if readyTimeout != 0 {
+		return fmt.Errorf("nodegroup %q has no stack to roll back, rolling back upgrades whose nodes are not ready is only supported for nodegroups created by eksctl", nodeGroupName)
+	}
 	return m.upgradeAndWait(nodeGroupName, version, launchTemplateVersion, forceUpgrade)
 }
 
+// allowNodeGroupReplacement lifts the replacement protection of the nodegroup's stack, if any, and returns
+// a function restoring the stack policy the nodegroup had before
+func (m *Manager) allowNodeGroupReplacement(nodeGroupName string) (func(), error) {
+	ng := &api.NodeGroup{NodeGroupBase: &api.NodeGroupBase{Name: nodeGroupName}}
+	policy, err := m.stackManager.GetNodeGroupStackPolicy(ng)
+	if err != nil {
+		return nil, err
+	}
+	if !manager.IsNodeGroupProtectedByStackPolicy(policy) {
+		return func() {}, nil
+	}
+
+	logger.Info("allowing replacement of nodegroup %q for the upgrade", nodeGroupName)
+	if err := m.stackManager.SetNodeGroupStackPolicy(ng, true); err != nil {
+		return nil, err
+	}
+	return func() {
+		if err := m.stackManager.SetNodeGroupStackPolicyBody(ng, policy); err != nil {
+			logger.Warning("failed to restore the stack policy of nodegroup %q: %v", nodeGroupName, err)
+		}
+	}, nil
+}
+
 func (m *Manager) upgradeAndWait(nodeGroupName, version, launchTemplateVersion string, forceUpgrade bool) error {
 	input := &eks.UpdateNodegroupVersionInput{
 		ClusterName:   &m.cfg.Metadata.Name,
@@ -0,0 +1,135 @@
+package nodegroup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+)
+
+// newNodesReadyPollInterval is how often the nodes of an upgraded nodegroup are checked for readiness
+const newNodesReadyPollInterval = 15 * time.Second
+
+// upgradeWithHealthGate runs upgrade and waits up to readyTimeout for the nodes it created to become ready.
+// When they do not, the launch template version of the nodegroup is rolled back to the one it had before
+// the upgrade
+func (m *Manager) upgradeWithHealthGate(nodeGroupName string, readyTimeout time.Duration, upgrade func() error) error {
+	previousTemplate, err := m.stackManager.GetManagedNodeGroupTemplate(nodeGroupName)
+	if err != nil {
+		return errors.Wrapf(err, "fetching template of nodegroup %q", nodeGroupName)
+	}
+
+	upgradeStart := time.Now()
+	if err := upgrade(); err != nil {
+		return err
+	}
+
+	currentTemplate, err := m.stackManager.GetManagedNodeGroupTemplate(nodeGroupName)
+	if err != nil {
+		return errors.Wrapf(err, "fetching template of nodegroup %q", nodeGroupName)
+	}
+	if currentTemplate == previousTemplate {
+		logger.Info("nodegroup %q was not updated, skipping the readiness check of its nodes", nodeGroupName)
+		return nil
+	}
+
+	logger.Info("waiting up to %s for the new nodes of nodegroup %q to become ready", readyTimeout, nodeGroupName)
+	readyErr := waitForNewNodesReady(m.clientSet, nodeGroupName, upgradeStart, readyTimeout, newNodesReadyPollInterval)
+	if readyErr == nil {
+		return nil
+	}
+
+	template, ok, err := rollbackTemplate(previousTemplate, currentTemplate)
+	if err != nil {
+		return errors.Wrapf(err, "rolling back nodegroup %q after %v", nodeGroupName, readyErr)
+	}
+	if !ok {
+		return errors.Wrapf(readyErr, "nodegroup %q cannot be rolled back as its launch template version was not upgraded", nodeGroupName)
+	}
+
+	logger.Warning("%v, rolling back the launch template version of nodegroup %q", readyErr, nodeGroupName)
+	if err := m.stackManager.UpdateNodeGroupStack(nodeGroupName, template); err != nil {
+		return errors.Wrapf(err, "rolling back nodegroup %q after %v", nodeGroupName, readyErr)
+	}
+	return errors.Wrapf(readyErr, "rolled back nodegroup %q", nodeGroupName)
+}
+
+// rollbackTemplate returns the current template of a nodegroup with the launch template version it had in
+// previousTemplate, and whether that version differs. EKS rejects downgrades of the release version of a
+// nodegroup, so the release and Kubernetes versions of the upgrade are kept
+func rollbackTemplate(previousTemplate, currentTemplate string) (string, bool, error) {
+	path := builder.ManagedNodeGroupLaunchTemplateVersionPath
+	previousVersion, currentVersion := gjson.Get(previousTemplate, path), gjson.Get(currentTemplate, path)
+	if previousVersion.Raw == currentVersion.Raw {
+		return "", false, nil
+	}
+
+	var (
+		template string
+		err      error
+	)
+	if previousVersion.Exists() {
+		template, err = sjson.SetRaw(currentTemplate, path, previousVersion.Raw)
+	} else {
+		template, err = sjson.Delete(currentTemplate, path)
+	}
+	if err != nil {
+		return "", false, err
+	}
+	return template, true, nil
+}
+
+// waitForNewNodesReady waits until the nodegroup has nodes created after since and all of them are ready
+func waitForNewNodesReady(clientSet kubernetes.Interface, nodeGroupName string, since time.Time, timeout, pollInterval time.Duration) error {
+	listOptions := metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", api.EKSNodeGroupNameLabel, nodeGroupName),
+	}
+	deadline := time.Now().Add(timeout)
+	for {
+		nodes, err := clientSet.CoreV1().Nodes().List(context.TODO(), listOptions)
+		if err != nil {
+			return errors.Wrapf(err, "listing nodes of nodegroup %q", nodeGroupName)
+		}
+
+		newNodes, readyNodes := 0, 0
+		for _, node := range nodes.Items {
+			if node.CreationTimestamp.Time.Before(since) {
+				continue
+			}
+			newNodes++
+			if isNodeReady(&node) {
+				readyNodes++
+			}
+		}
+		if newNodes > 0 && readyNodes == newNodes {
+			logger.Info("all %d new node(s) of nodegroup %q are ready", newNodes, nodeGroupName)
+			return nil
+		}
+		logger.Debug("%d of %d new node(s) of nodegroup %q are ready", readyNodes, newNodes, nodeGroupName)
+
+		if !time.Now().Add(pollInterval).Before(deadline) {
+			return fmt.Errorf("timed out (after %s) waiting for the new nodes of nodegroup %q to become ready (%d of %d ready)",
+				timeout, nodeGroupName, readyNodes, newNodes)
+		}
+		time.Sleep(pollInterval)
+	}
+}
+
+func isNodeReady(node *corev1.Node) bool {
+	for _, c := range node.Status.Conditions {
+		if c.Type == corev1.NodeReady && c.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,89 @@
+package nodegroup_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+var _ = Describe("WaitForNewNodesReady", func() {
+	var (
+		clientSet    *fake.Clientset
+		upgradeStart time.Time
+	)
+
+	newNode := func(name, nodeGroup string, created time.Time, ready corev1.ConditionStatus) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Labels:            map[string]string{api.EKSNodeGroupNameLabel: nodeGroup},
+				CreationTimestamp: metav1.NewTime(created),
+			},
+			Status: corev1.NodeStatus{
+				Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: ready}},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		upgradeStart = time.Now()
+		clientSet = fake.NewSimpleClientset(
+			newNode("old-node", "ng-1", upgradeStart.Add(-time.Hour), corev1.ConditionFalse),
+			newNode("other-node", "ng-2", upgradeStart.Add(time.Minute), corev1.ConditionFalse),
+		)
+	})
+
+	It("succeeds once all new nodes of the nodegroup are ready", func() {
+		_, err := clientSet.CoreV1().Nodes().Create(context.TODO(), newNode("new-node", "ng-1", upgradeStart.Add(time.Minute), corev1.ConditionTrue), metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(nodegroup.WaitForNewNodesReady(clientSet, "ng-1", upgradeStart, time.Second, time.Millisecond)).To(Succeed())
+	})
+
+	It("times out when a new node is not ready", func() {
+		for _, node := range []*corev1.Node{
+			newNode("new-node-1", "ng-1", upgradeStart.Add(time.Minute), corev1.ConditionTrue),
+			newNode("new-node-2", "ng-1", upgradeStart.Add(time.Minute), corev1.ConditionFalse),
+		} {
+			_, err := clientSet.CoreV1().Nodes().Create(context.TODO(), node, metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		err := nodegroup.WaitForNewNodesReady(clientSet, "ng-1", upgradeStart, 10*time.Millisecond, time.Millisecond)
+		Expect(err).To(MatchError(ContainSubstring(`waiting for the new nodes of nodegroup "ng-1" to become ready (1 of 2 ready)`)))
+	})
+
+	It("times out when the nodegroup has no new nodes", func() {
+		err := nodegroup.WaitForNewNodesReady(clientSet, "ng-1", upgradeStart, 10*time.Millisecond, time.Millisecond)
+		Expect(err).To(MatchError(ContainSubstring("(0 of 0 ready)")))
+	})
+})
+
+var _ = Describe("RollbackTemplate", func() {
+	makeTemplate := func(releaseVersion, launchTemplateVersion string) string {
+		return `{"Resources": {"ManagedNodeGroup": {"Type": "AWS::EKS::Nodegroup", "Properties": {` +
+			`"ReleaseVersion": "` + releaseVersion + `", ` +
+			`"LaunchTemplate": {"Id": "lt-1", "Version": "` + launchTemplateVersion + `"}}}}}`
+	}
+
+	It("rolls back the launch template version and keeps the new release version", func() {
+		template, ok, err := nodegroup.RollbackTemplate(makeTemplate("1.19.6-20210301", "2"), makeTemplate("1.19.6-20210322", "3"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ok).To(BeTrue())
+		Expect(template).To(MatchJSON(makeTemplate("1.19.6-20210322", "2")))
+	})
+
+	It("has nothing to roll back when only the release version changed", func() {
+		_, ok, err := nodegroup.RollbackTemplate(makeTemplate("1.19.6-20210301", "2"), makeTemplate("1.19.6-20210322", "2"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ok).To(BeFalse())
+	})
+})
@@ -0,0 +1,75 @@
+package nodegroup_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Upgrade replacement protection", func() {
+	const stackName = "eksctl-my-cluster-nodegroup-my-ng"
+
+	var (
+		p       *mockprovider.MockProvider
+		manager *nodegroup.Manager
+	)
+
+	setStackPolicyBodies := func() []string {
+		var bodies []string
+		for _, call := range p.MockCloudFormation().Calls {
+			if call.Method == "SetStackPolicy" {
+				input := call.Arguments.Get(0).(*cfn.SetStackPolicyInput)
+				Expect(*input.StackName).To(Equal(stackName))
+				bodies = append(bodies, *input.StackPolicyBody)
+			}
+		}
+		return bodies
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "my-cluster"
+		manager = nodegroup.New(cfg, &eks.ClusterProvider{Provider: p}, nil)
+		p.MockCloudFormation().On("SetStackPolicy", mock.Anything).Return(&cfn.SetStackPolicyOutput{}, nil)
+	})
+
+	mockPolicy := func(policyBody string) {
+		p.MockCloudFormation().On("GetStackPolicy", &cfn.GetStackPolicyInput{
+			StackName: aws.String(stackName),
+		}).Return(&cfn.GetStackPolicyOutput{StackPolicyBody: aws.String(policyBody)}, nil)
+	}
+
+	It("restores the stack policy of protected nodegroups as it was", func() {
+		policyBody := `{"Statement": [` +
+			`{"Effect": "Allow", "Action": "Update:*", "Principal": "*", "Resource": "*"}, ` +
+			`{"Effect": "Deny", "Action": "Update:Delete", "Principal": "*", "Resource": "LogicalResourceId/NodeInstanceRole"}, ` +
+			`{"Effect": "Deny", "Action": "Update:Replace", "Principal": "*", "Resource": "LogicalResourceId/NodeGroup"}]}`
+		mockPolicy(policyBody)
+
+		restore, err := manager.AllowNodeGroupReplacement("my-ng")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(setStackPolicyBodies()).To(ConsistOf(MatchJSON(`{"Statement": [{"Effect": "Allow", "Action": "Update:*", "Principal": "*", "Resource": ["*"]}]}`)))
+
+		restore()
+		bodies := setStackPolicyBodies()
+		Expect(bodies).To(HaveLen(2))
+		Expect(bodies[1]).To(Equal(policyBody))
+	})
+
+	It("leaves the stack policy of unprotected nodegroups unchanged", func() {
+		mockPolicy(`{"Statement": [{"Effect": "Deny", "Action": "Update:Replace", "Principal": "*", "Resource": "LogicalResourceId/SG"}]}`)
+
+		restore, err := manager.AllowNodeGroupReplacement("my-ng")
+		Expect(err).NotTo(HaveOccurred())
+		restore()
+		Expect(setStackPolicyBodies()).To(BeEmpty())
+	})
+})
@@ -160,17 +160,31 @@ func (n *NeuronDevicePlugin) Deploy() error {
 // NewNvidiaDevicePlugin creates a new NvidiaDevicePlugin
 func NewNvidiaDevicePlugin(rawClient kubernetes.RawClientInterface, region string, planMode bool) DevicePlugin {
 	return &NvidiaDevicePlugin{
-		rawClient,
-		region,
-		planMode,
+		rawClient: rawClient,
+		region:    region,
+		planMode:  planMode,
+	}
+}
+
+// NewNvidiaDevicePluginWithMIGStrategy returns a MkDevicePlugin creating Nvidia device plugins that expose the
+// MIG devices of the nodes with the given strategy, "single" or "mixed"
+func NewNvidiaDevicePluginWithMIGStrategy(migStrategy string) MkDevicePlugin {
+	return func(rawClient kubernetes.RawClientInterface, region string, planMode bool) DevicePlugin {
+		return &NvidiaDevicePlugin{
+			rawClient:   rawClient,
+			region:      region,
+			planMode:    planMode,
+			migStrategy: migStrategy,
+		}
 	}
 }
 
 // A NvidiaDevicePlugin deploys the Nvidia Device Plugin to a cluster
 type NvidiaDevicePlugin struct {
-	rawClient kubernetes.RawClientInterface
-	region    string
-	planMode  bool
+	rawClient   kubernetes.RawClientInterface
+	region      string
+	planMode    bool
+	migStrategy string
 }
 
 func (n *NvidiaDevicePlugin) RawClient() kubernetes.RawClientInterface {
@@ -181,7 +195,12 @@ func (n *NvidiaDevicePlugin) PlanMode() bool {
 	return n.planMode
 }
 
+// SetImage keeps the image of the manifest, and sets the MIG strategy of the plugin when there is one
 func (n *NvidiaDevicePlugin) SetImage(t *v1.PodTemplateSpec) error {
+	if n.migStrategy != "" {
+		container := &t.Spec.Containers[0]
+		container.Args = append(container.Args, fmt.Sprintf("--mig-strategy=%s", n.migStrategy))
+	}
 	return nil
 }
 
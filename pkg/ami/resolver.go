@@ -56,6 +56,11 @@ func NewSSMResolver(api ssmiface.SSMAPI) Resolver {
 	return &SSMResolver{ssmAPI: api}
 }
 
+// NewSSMParameterResolver creates a new SSMParameterResolver for the SSM parameter parameterName
+func NewSSMParameterResolver(api ssmiface.SSMAPI, parameterName string) Resolver {
+	return &SSMParameterResolver{ssmAPI: api, parameterName: parameterName}
+}
+
 // UnsupportedQueryError represents an unsupported AMI query error
 type UnsupportedQueryError struct {
 	msg string
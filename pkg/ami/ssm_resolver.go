@@ -44,6 +44,32 @@ func (r *SSMResolver) Resolve(region, version, instanceType, imageFamily string)
 	return *output.Parameter.Value, nil
 }
 
+// SSMParameterResolver resolves the AMI to the value of a custom SSM
+// parameter, e.g. one an organisation registers its own AMIs in
+type SSMParameterResolver struct {
+	ssmAPI        ssmiface.SSMAPI
+	parameterName string
+}
+
+// Resolve will return the AMI stored in the SSM parameter, regardless of
+// the region, version, instance type and image family
+func (r *SSMParameterResolver) Resolve(region, version, instanceType, imageFamily string) (string, error) {
+	logger.Debug("resolving AMI using SSM parameter %s", r.parameterName)
+
+	output, err := r.ssmAPI.GetParameter(&ssm.GetParameterInput{
+		Name: aws.String(r.parameterName),
+	})
+	if err != nil {
+		return "", fmt.Errorf("error getting AMI from SSM parameter %s: %w", r.parameterName, err)
+	}
+
+	if output == nil || output.Parameter == nil || aws.StringValue(output.Parameter.Value) == "" {
+		return "", fmt.Errorf("SSM parameter %s does not hold an AMI", r.parameterName)
+	}
+
+	return *output.Parameter.Value, nil
+}
+
 // MakeSSMParameterName creates an SSM parameter name
 func MakeSSMParameterName(version, instanceType, imageFamily string) (string, error) {
 	if api.IsWindowsImage(imageFamily) {
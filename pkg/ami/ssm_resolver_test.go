@@ -278,6 +278,31 @@ var _ = Describe("AMI Auto Resolution", func() {
 	})
 })
 
+var _ = Describe("SSM parameter AMI resolution", func() {
+	const parameterName = "/org/hardened-ami/eks/image_id"
+
+	var p *mockprovider.MockProvider
+
+	BeforeEach(func() {
+		_, p = createProviders()
+	})
+
+	It("returns the AMI stored in the parameter", func() {
+		addMockGetParameter(p, parameterName, "ami-hardened")
+
+		resolvedAmi, err := NewSSMParameterResolver(p.MockSSM(), parameterName).Resolve("eu-west-1", "1.19", "t2.medium", "AmazonLinux2")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resolvedAmi).To(Equal("ami-hardened"))
+	})
+
+	It("fails when the parameter holds no AMI", func() {
+		addMockFailedGetParameter(p, parameterName)
+
+		_, err := NewSSMParameterResolver(p.MockSSM(), parameterName).Resolve("eu-west-1", "1.19", "t2.medium", "AmazonLinux2")
+		Expect(err).To(MatchError("SSM parameter /org/hardened-ami/eks/image_id does not hold an AMI"))
+	})
+})
+
 func addMockGetParameter(p *mockprovider.MockProvider, name string, amiID string) {
 	p.MockSSM().On("GetParameter",
 		mock.MatchedBy(func(input *ssm.GetParameterInput) bool {
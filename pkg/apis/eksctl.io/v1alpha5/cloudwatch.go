@@ -29,6 +29,11 @@ func SupportedCloudWatchClusterLogTypes() []string {
 	return []string{APILogging, AuditLogging, AuthenticatorLogging, ControllerManagerLogging, SchedulerLogging}
 }
 
+// SupportedLogRetentionInDays returns the retention periods in days CloudWatch supports for log groups
+func SupportedLogRetentionInDays() []int {
+	return []int{1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180, 365, 400, 545, 731, 1827, 3653}
+}
+
 // HasClusterCloudWatchLogging determines if cluster logging was enabled or not
 func (c *ClusterConfig) HasClusterCloudWatchLogging() bool {
 	return c.CloudWatch != nil && c.CloudWatch.ClusterLogging != nil && len(c.CloudWatch.ClusterLogging.EnableTypes) > 0
@@ -103,6 +103,7 @@ func SetNodeGroupDefaults(ng *NodeGroup, meta *ClusterMeta) {
 	}
 
 	setVolumeDefaults(ng.NodeGroupBase, nil)
+	setAdditionalVolumeDefaults(ng.AdditionalVolumes)
 
 	if ng.SecurityGroups.WithLocal == nil {
 		ng.SecurityGroups.WithLocal = Enabled()
@@ -191,6 +192,15 @@ func setVolumeDefaults(ng *NodeGroupBase, template *LaunchTemplate) {
 	}
 }
 
+// setAdditionalVolumeDefaults sets the IOPS of io1 volumes, which EC2 requires, as for the root volume
+func setAdditionalVolumeDefaults(volumes []VolumeMapping) {
+	for i := range volumes {
+		if volumes[i].VolumeType != nil && *volumes[i].VolumeType == NodeVolumeTypeIO1 && volumes[i].VolumeIOPS == nil {
+			volumes[i].VolumeIOPS = aws.Int(DefaultNodeVolumeIO1IOPS)
+		}
+	}
+}
+
 func setIAMDefaults(iamConfig *NodeGroupIAM) {
 	if iamConfig.WithAddonPolicies.ImageBuilder == nil {
 		iamConfig.WithAddonPolicies.ImageBuilder = Disabled()
@@ -1,6 +1,7 @@
 package v1alpha5
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -139,6 +140,25 @@ var _ = Describe("ClusterConfig validation", func() {
 		})
 	})
 
+	Context("Additional volumes", func() {
+		It("sets the IOPS of io1 volumes only when unset", func() {
+			testNodeGroup := NodeGroup{
+				NodeGroupBase: &NodeGroupBase{},
+				AdditionalVolumes: []VolumeMapping{
+					{DeviceName: "/dev/xvdb", VolumeType: aws.String(NodeVolumeTypeIO1)},
+					{DeviceName: "/dev/xvdc", VolumeType: aws.String(NodeVolumeTypeIO1), VolumeIOPS: aws.Int(500)},
+					{DeviceName: "/dev/xvdd", VolumeType: aws.String(NodeVolumeTypeGP2)},
+				},
+			}
+
+			SetNodeGroupDefaults(&testNodeGroup, &ClusterMeta{})
+
+			Expect(*testNodeGroup.AdditionalVolumes[0].VolumeIOPS).To(Equal(DefaultNodeVolumeIO1IOPS))
+			Expect(*testNodeGroup.AdditionalVolumes[1].VolumeIOPS).To(Equal(500))
+			Expect(testNodeGroup.AdditionalVolumes[2].VolumeIOPS).To(BeNil())
+		})
+	})
+
 	Context("Cluster NAT settings", func() {
 
 		It("Cluster NAT defaults to single NAT gateway mode", func() {
@@ -124,6 +124,53 @@ var _ = Describe("Managed Nodegroup Validation", func() {
 			},
 			errMsg: "cannot set instanceType when instanceSelector is specified",
 		}),
+		Entry("updateConfig with maxUnavailable", &nodeGroupCase{
+			ng: &ManagedNodeGroup{
+				NodeGroupBase: &NodeGroupBase{
+					ScalingConfig: &ScalingConfig{MinSize: aws.Int(3)},
+				},
+				UpdateConfig: &NodeGroupUpdateConfig{MaxUnavailable: aws.Int(2)},
+			},
+		}),
+		Entry("updateConfig with maxUnavailablePercentage", &nodeGroupCase{
+			ng: &ManagedNodeGroup{
+				NodeGroupBase: &NodeGroupBase{},
+				UpdateConfig:  &NodeGroupUpdateConfig{MaxUnavailablePercentage: aws.Int(50)},
+			},
+		}),
+		Entry("updateConfig with both fields", &nodeGroupCase{
+			ng: &ManagedNodeGroup{
+				NodeGroupBase: &NodeGroupBase{},
+				UpdateConfig: &NodeGroupUpdateConfig{
+					MaxUnavailable:           aws.Int(1),
+					MaxUnavailablePercentage: aws.Int(50),
+				},
+			},
+			errMsg: "only one of managedNodeGroups[0].updateConfig.maxUnavailable or managedNodeGroups[0].updateConfig.maxUnavailablePercentage can be set",
+		}),
+		Entry("updateConfig with no fields", &nodeGroupCase{
+			ng: &ManagedNodeGroup{
+				NodeGroupBase: &NodeGroupBase{},
+				UpdateConfig:  &NodeGroupUpdateConfig{},
+			},
+			errMsg: "one of managedNodeGroups[0].updateConfig.maxUnavailable or managedNodeGroups[0].updateConfig.maxUnavailablePercentage must be set",
+		}),
+		Entry("updateConfig with maxUnavailable greater than minSize", &nodeGroupCase{
+			ng: &ManagedNodeGroup{
+				NodeGroupBase: &NodeGroupBase{
+					ScalingConfig: &ScalingConfig{MinSize: aws.Int(1), MaxSize: aws.Int(4)},
+				},
+				UpdateConfig: &NodeGroupUpdateConfig{MaxUnavailable: aws.Int(2)},
+			},
+			errMsg: "managedNodeGroups[0].updateConfig.maxUnavailable (2) cannot be greater than minSize (1)",
+		}),
+		Entry("updateConfig with maxUnavailablePercentage out of range", &nodeGroupCase{
+			ng: &ManagedNodeGroup{
+				NodeGroupBase: &NodeGroupBase{},
+				UpdateConfig:  &NodeGroupUpdateConfig{MaxUnavailablePercentage: aws.Int(0)},
+			},
+			errMsg: "managedNodeGroups[0].updateConfig.maxUnavailablePercentage must be between 1 and 100",
+		}),
 	)
 
 	DescribeTable("User-supplied launch template with unsupported fields", func(ngBase *NodeGroupBase) {
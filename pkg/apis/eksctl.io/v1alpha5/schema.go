@@ -1,6 +1,6 @@
 // Code generated by go-bindata. DO NOT EDIT.
 // sources:
-// assets/schema.json (83.493kB)
+// assets/schema.json (113.631kB)
 
 package v1alpha5
 
@@ -69,7 +69,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _schemaJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x7f\x77\xdb\x36\xb2\xe8\xff\xfb\x29\x70\xd4\x3d\x77\x93\x73\x44\x2b\x49\x77\xbb\xdd\x6e\x9f\xcf\x53\x6c\x37\xd5\x4b\x6c\xeb\x5a\x4e\xfb\x5e\xa3\x9c\x0d\x44\x42\x12\xd6\x14\xc0\x05\x40\xdb\xea\x6d\xdf\x67\xbf\x07\x3f\xf8\x1b\xa4\x08\x92\x4e\xbc\xe7\xfa\xaf\xc4\x14\x39\x18\xcc\x0c\x06\x33\x83\x99\xc1\x7f\xfd\x01\x80\xd1\x1f\x19\x5a\x8f\xbe\x03\xa3\xaf\x26\x01\x5a\x63\x82\x05\xa6\x84\x4f\x4e\xc2\x98\x0b\xc4\x4e\x28\x59\xe3\xcd\x68\x2c\x5f\x14\xfb\x08\xc9\x17\xe9\xea\x9f\xc8\x17\xfa\xd9\x1f\xb9\xbf\x45\x3b\x28\x1f\x6f\x85\x88\xbe\x9b\x4c\xfe\xc9\x29\xf1\xf4\xd3\x23\xca\x36\x93\x80\xc1\xb5\xf0\x5e\xfc\x75\xa2\x9f\x7d\xa5\xbf\xcb\x0d\x35\xfa\x0e\x48\x3c\x00\x18\x4d\x7f\x59\xc4\x2b\x82\xc4\x39\x8c\x22\x4c\x36\xe9\x0f\x00\x8c\x60\x10\xa8\xb7\x61\x38\x67\x34\x42\x4c\x60\xc4\x73\xbf\xd7\x4e\x23\x01\xb9\x88\x90\x3f\x32\x2f\xff\x3e\x4e\xa0\x5a\x66\xa4\x9e\x07\x88\xfb\x0c\x47\x12\x84\x9a\x19\x0d\x03\x0e\xb8\x02\x04\x04\x05\xd3\x5f\xc0\x4e\xa3\xc8\x8f\xc0\x6c\x0d\xc4\x16\x81\x1b\xb4\x07\x98\x03\x48\xc0\xf4\x97\x31\x10\x5b\x28\x00\x0c\x39\x05\x2b\xe4\xd3\x1d\xe2\xea\x1d\x02\x77\x08\x50\xfd\xbe\x81\x46\xc5\x16\xb1\x3b\xcc\x11\x88\x39\x4a\x01\x09\x0a\x18\x5a\x23\x26\xff\x23\xb6\x38\x19\xfb\x28\xc3\xf0\xde\xc3\x44\xa0\x30\xc4\xff\xf4\xb6\x62\x17\x7a\x8f\x1f\xe3\x00\xad\x61\x1c\x0a\x89\xdd\x7f\xfd\xae\x59\x61\x18\x31\x2a\x30\x29\xc7\xf4\xa8\x86\xd5\xf0\xd7\xc2\xdf\x39\x46\x72\xc1\xa4\xe0\x8c\xf3\xbf\x95\x48\xe3\x43\x02\x56\x08\xd0\x1d\x16\x02\x05\x00\x57\x89\x51\xfc\xfc\x00\xa5\x5b\x80\x4b\xa1\xfd\x9e\x01\x1e\xf9\x38\x60\xe5\x59\xd8\x45\x78\x83\xc5\x36\x5e\x1d\xf9\x74\xf7\xdb\x1d\x82\xb7\xe8\x8e\xb2\x1b\xfe\x1b\xba\xe1\xbe\x08\x7f\x8b\x6e\x36\xbf\xc5\x02\x87\xfc\x37\x1c\x49\x7a\xcf\xe6\x17\x48\xd8\x47\xc4\xc1\x01\xaa\x65\x5f\x55\x16\x4a\xa4\x98\xcb\x50\x70\xc9\x02\x24\xf1\xfe\x50\x80\x3b\x2e\xf0\xa6\x3c\x4b\xf3\xe7\xc7\xf1\x81\xc5\xbc\x86\x21\x47\x45\xc1\x08\x02\x45\xe5\x54\x22\x18\xfa\x57\x8c\x19\x0a\x8a\x18\x48\x29\xad\x8e\x52\x2b\x3d\x42\x40\x7f\x3b\xa7\x21\xf6\xf7\xed\x38\x30\x23\x21\x26\xe8\x94\xfa\xf1\x0e\x11\xd1\x28\x5d\x7a\xe1\x41\x10\x29\xf0\x20\x30\xdf\xc8\x65\xa1\xc7\x75\x12\xae\xc3\xd0\xac\x9c\xce\xcf\x70\x7a\x75\xc1\xcb\xb3\xc4\x02\xed\xca\x0f\x1b\xc4\xa1\x00\x3c\xf7\x1e\x64\x0c\xee\x1b\xa9\x11\x62\x2e\xa4\xfa\x90\x48\x24\x6a\x64\x36\x3d\xd7\xf3\xc1\x52\xc7\x74\x22\x8b\x03\x58\x2b\x7d\x94\xbc\xb4\x5d\x0b\xb9\xef\x22\xc4\x76\x98\x73\x29\x13\xaf\x69\x4c\x02\xc8\x2a\x02\xe4\xa0\x88\xa6\x57\x17\x09\xf2\x39\xc0\x60\x65\x20\xab\x49\x70\x4e\x7d\x0c\x05\x72\x22\x8f\x13\x60\xeb\x44\x39\x62\xb7\xd8\x47\x53\xdf\xa7\x31\x11\x57\x34\x44\xd3\xab\x8b\x2e\x14\x13\x70\x53\x91\xbe\x83\x5b\x79\x27\x61\x2c\x6d\xe1\x36\x82\x5f\x6f\x11\xd8\x21\x01\x03\x28\xa0\x22\x42\x14\x85\x7b\xbd\x61\x21\xe0\x6b\x7b\xc7\x10\x47\x0a\xd8\x1d\x16\x5b\xe0\x43\x81\x36\x94\xe1\x5f\xa1\x84\x02\x20\x09\x00\x65\x1b\x48\xcc\x83\x23\x70\x06\xfd\x2d\x10\x70\x03\x7c\x4a\xe4\x67\x4a\x20\xa1\xda\x00\xe4\xcb\x90\x00\x1a\xe9\xd9\x82\x5b\x18\xc6\x68\x0c\x56\x54\x6c\xe5\x4b\x77\x5b\xec\x6f\xc1\x9e\xc6\x40\xe9\x1a\x74\xe4\xc4\xe4\x7f\xaf\xc9\x58\x36\xff\xb2\xa8\xdc\x22\xc6\x71\x41\xdb\x37\x4b\x99\xd3\x1e\xa5\x56\xbc\x65\xb0\x83\x32\xdf\xa4\x55\x6b\x7e\x1b\x1d\xd0\x18\xe5\xe5\xe1\xb4\x3d\x36\x9b\xa8\x92\xf7\x67\x6f\x17\x00\xca\x7d\x53\x32\x71\x8d\x37\x31\x53\xcc\x75\x34\x1d\x9b\x20\x15\xb6\xe8\xc4\x4f\x08\x69\x1c\xfc\x0c\x85\xbf\x6d\x63\xc0\x19\xf9\x7c\x47\x37\x9b\xa2\x9d\x0f\xea\x37\xe1\xca\x40\xc9\xd7\x1d\x45\xa2\x84\xc3\x20\x5c\xf0\x29\x11\x10\x13\x6e\x08\x06\x22\xc8\xe0\x0e\x09\xc4\x38\x60\x28\x84\xd2\x40\x14\x14\xe4\x68\xd5\x96\x29\xce\x80\x9b\x79\x54\x25\x7c\x2d\xab\x10\x81\xab\x10\x5d\xef\xa3\x8a\x92\x6e\x69\x46\x8c\x8b\xbf\x22\x12\xef\x0a\x8c\x48\xc8\x1d\xe1\xd2\xab\xf2\x61\x1c\x60\x61\x7b\x2c\xb6\x88\x08\xec\x43\x41\x59\xf5\x67\x49\x2c\x46\xc3\x10\xb1\x73\x48\xe0\x06\x59\x5e\x91\xbe\x68\x10\x87\x88\x8d\x0a\xbf\x7c\x1c\xc0\xe6\x51\xa4\x92\x2a\x31\xd4\x44\x96\x8c\xd1\x44\x04\xcf\x38\x42\xe0\x43\xc6\x06\x69\xd0\xf1\x8f\xcf\x26\x31\x87\x1b\x34\xf1\xe5\xf3\x3b\xf9\xdc\x33\xb2\xe9\x19\x10\x93\xaf\xcc\x03\xcd\x7d\x0f\xdd\xc3\x5d\x14\x22\xfe\xfc\xf9\x11\xf8\x09\x86\x38\x00\x88\x08\x26\x0d\x1f\xc8\xd0\x77\xe0\xd3\x52\x52\x73\x39\xfa\x34\x56\xff\x95\x34\xcc\xfe\xc8\x51\x2e\x79\x58\xa1\x57\xf2\x43\x4a\xa5\xe5\xe8\x93\xe3\xee\x74\x80\x08\xdf\x43\xb0\x65\x68\xfd\xbf\x96\xa3\xce\x93\x5f\x8e\x8e\x4b\x94\xfc\x7e\x02\x8f\xed\x14\xf9\xde\xa7\x01\x3a\xfe\x8f\x7f\xc5\x54\xfc\x1d\x46\x58\xff\xe7\xfb\x89\x7a\x3a\x2e\xfe\x2a\xa9\xd5\xf8\x7b\x8e\x80\x0d\xef\x55\x68\xda\xf0\x6e\x4a\xe6\xc2\x3b\x47\x5d\x15\x5b\x7e\xc5\x0e\xa9\xd5\x10\x6b\xd6\x3e\x89\xd1\x61\xf8\xe6\xaa\xdb\x5c\xc1\x5b\x35\x9c\x8e\x56\x1d\x74\x18\x13\xc3\x29\xbf\x17\xdf\x60\x52\x74\x64\x23\xfc\x93\xb1\x12\xda\xbb\x96\x72\xb7\x6c\xab\x27\x6b\x02\x56\xca\xe5\x6d\xa9\x87\xec\xde\x5f\x86\xb8\x83\x73\x62\xd5\xcb\x23\x1d\x65\x38\xc2\x74\x72\xfb\x12\x86\xd1\x16\xfe\x25\x8f\xda\x47\xfb\xf8\xb7\x10\x87\x70\x85\x43\x2c\xf6\xbf\x50\xd2\x75\xdf\xe8\x43\x02\xdf\x66\x8d\x34\x10\xbd\x6a\xc4\x34\x69\xf8\x45\x49\x8b\xf3\x38\x8a\x28\x13\x6d\x14\xf9\x73\x27\x2d\xba\x70\xd4\x94\x45\x95\x68\xd0\x92\x5a\xd1\x4e\xa5\x35\x64\x1b\x28\xd0\x9c\xd1\x35\x0e\x5b\xb3\xc9\x4e\xc1\x1f\x0a\xb0\x7a\x31\x6f\x83\x45\x3b\xae\xbd\xc1\xcd\xfe\xde\x0f\xef\xde\xff\x5f\xf0\xd3\x4b\x70\x7a\x36\xbf\x3a\x3b\x99\x5e\xcf\x2e\x2f\xc0\xc5\xe5\xf5\xec\xe4\xec\x08\x6c\x85\x88\xf8\x77\x93\x5c\x70\x6d\x92\x05\xd7\x26\x5a\xec\x27\x98\xf3\x18\xf1\xc9\xab\xbf\x7d\xf3\x35\x78\x83\x05\x40\xf7\x11\xe5\x88\x17\xcd\x61\xb0\xa6\x0c\xfc\x10\xc6\xf7\xe0\xf6\x65\xe2\x25\x21\xc8\x42\x8c\x18\xc0\x02\x99\x97\xe8\x1a\x6c\xb0\xa0\x11\x77\x12\x80\xc7\x39\x83\x3a\xae\xc9\xdf\xda\x32\xee\xb2\x4c\x89\xd2\xcc\x0f\x21\xfa\x4a\x21\x7a\x87\xc3\x50\xbe\x22\x30\x89\x91\xdc\x24\x56\x2a\x2a\x1d\x00\x4c\xc0\x3a\x16\x31\x43\x06\x67\x10\x85\x90\xf0\x31\x60\x28\x0a\xa1\xaf\x0c\x92\x2d\x52\x14\x29\x0e\x00\x57\xf4\xd6\x2d\xd8\xf2\x45\x11\xb5\x72\x02\xc3\x9d\x93\xd6\x9b\x4d\xcf\xeb\x02\xc6\xd2\xd2\x11\xfb\x39\xa3\xb7\x38\x40\xac\x9f\x86\x98\x95\xa0\xf5\xd2\x11\x6a\xb3\xee\xbd\xbb\x15\xad\x86\x83\x1b\xdb\x4d\xbc\x42\x8c\x20\x81\xf8\x05\x12\x72\x99\x55\xcc\x8d\x86\xe9\xbf\xad\xf9\xd8\x3a\xd2\x4e\xd9\x8c\xc1\x05\x0d\xd0\x1b\x46\xe3\xea\xba\x72\xa2\xfc\x79\x09\xda\x10\x91\x5d\xb5\x07\x4a\x88\x1b\x85\x1f\x50\x7b\x53\xba\x03\x2a\xfc\x31\xd9\x78\x24\x7d\xe3\xb9\x5a\x07\x1f\xcc\xcc\x40\xf6\x43\xfa\x11\xba\xe1\x9e\xf9\x59\x7d\xc7\x87\xd8\x2d\x2d\x98\x2c\x47\xc7\x65\xc4\xe5\x1e\xa9\xf0\xab\x7c\x5f\x45\x6a\x39\x3a\xae\x4e\xa2\x7e\x93\x4d\x4d\x4d\x97\x25\x79\x8e\x04\xac\x09\x5d\x0f\x23\x12\x83\xca\xc2\x0f\x94\x01\x4c\xd6\x94\xed\xb2\xb8\x62\xe2\xa5\x01\xe5\xf2\x5a\xb8\x6d\x13\x11\xb7\xbd\xf1\xd0\xa8\x2d\x65\xa1\x0d\x13\x23\x86\x6f\xa1\x40\x86\x3b\xed\x58\x39\x2f\x7e\xd3\x44\x40\x18\x86\xf4\x2e\xdb\x42\xa4\xd6\x87\x60\x1d\x87\xe1\xde\x33\x23\xa7\xde\x0f\x26\x26\xd4\x4a\xa8\x12\x3f\xb0\x85\x1c\xd0\x58\xa8\xe0\x3e\x90\x04\x93\x4a\x06\x40\xdf\x47\x9c\x8f\x15\x51\x12\x10\xfa\x99\x3a\x0d\xfe\x79\x01\x4c\xb8\x93\x03\xcc\x8d\x67\x1e\x80\x5b\x0c\xc1\x4f\xf3\x13\x80\x48\x10\x51\x4c\x84\x9b\xb1\xf2\x78\x67\x61\xe5\x29\x47\x3e\x43\x82\x9f\x11\x9f\xed\x93\x39\xb4\x60\xeb\xa2\xf2\x99\x15\xfa\x6d\xe4\x3b\xad\xf8\x9f\xe6\x27\x5d\xfd\xfd\x06\xc7\xd5\xa6\x87\x5a\x6c\x68\x25\x63\xa2\xd1\x24\x28\xcd\x79\x5c\x71\x86\xeb\x97\x91\x5d\xad\x35\x6e\x83\x0d\xde\x4b\xa3\x07\x6a\xf7\x0d\x1b\xa5\xa1\xe4\x91\x54\x4d\xdd\x41\x62\x2b\x10\x70\x2c\x55\x96\x59\x36\x63\x63\x1b\x6a\xf3\x0f\x05\xfa\xdc\xc6\x10\x0c\x4c\xe7\xb3\xd6\x51\x95\x3e\x80\x33\xb9\xf0\x94\x66\xf4\xcc\x71\x89\x67\xcc\xae\x4c\xf8\x0a\x02\xae\xde\x55\x36\x71\x12\x35\x28\xea\x8f\xec\x84\x67\x94\x46\x13\x0a\x2f\x18\xf0\x75\x76\x59\xb2\x2c\x3e\xda\x42\x3f\x67\xe9\x6a\x6f\x11\xd4\x36\x82\x38\x55\xba\xa4\xce\x8e\x5c\x51\x1a\x22\x58\xb3\xbe\xa3\x78\x15\x62\xdf\x15\x80\xd3\xba\x2e\x22\x59\x37\xf6\x20\x52\xa8\xcf\x7c\x12\xed\x0c\x23\xac\x14\x2b\x62\xa9\x0e\x4d\xd4\x6e\x6e\xc3\x75\x3c\x50\x72\x04\x6e\x63\xb1\x74\x54\x5a\x30\x37\x51\x0c\x34\x38\xbb\x47\x7e\x2c\xc1\xb5\x3b\xc1\x6e\xda\xa2\x19\x0d\x8d\xc7\xb6\xda\x83\x88\x06\x3a\xc7\x40\xe3\x2d\x37\xa2\xe9\x7c\xc6\x8f\xc0\xf5\x16\x73\xa0\x5e\xc5\x1c\xc0\x20\xd0\x91\x4b\xe9\xc1\x65\xe6\x3f\xb8\x7a\x3d\x3d\x51\x0e\x22\x8c\xc5\x36\x3d\x8d\x3d\x02\xca\xa4\x9e\xd3\x00\xa4\x68\x03\x89\xf7\xc7\x67\x89\xa7\x1f\x50\x9f\x1f\xc1\x3b\x7e\x04\x77\xf0\x57\x4a\x94\xcb\x8f\x6e\xf8\x24\x84\x02\x71\x31\x89\x39\x62\x9b\x18\x07\x68\x12\xd1\xc0\x43\x09\x10\x4f\xe2\x73\x24\x19\xe3\x66\x5f\x7d\xa6\x19\x67\x56\xda\x50\xd3\x5c\x8e\x8e\xab\x54\x3c\x1c\x05\x2b\x89\xcb\x7c\xd0\x5c\x0f\x6b\x1e\x86\xa4\x88\xa4\x94\xc1\x40\x12\x19\xa4\xf3\x51\x44\xfd\x64\xa4\xe2\xec\xed\x02\x98\x08\x1b\x58\x94\xa2\x8d\xe6\x6b\xcf\x84\xfb\x1c\x9d\xa6\x7e\x88\x55\x4c\xec\x32\x32\xcb\xd1\xb1\x05\xf7\x7a\x66\x14\x0f\xe1\xfb\xf9\x38\x99\xd6\x58\x14\xa0\x0e\xe1\xf2\x18\x3c\xe5\x7a\x50\x88\xaa\x03\x0a\x86\xe4\x1c\x31\xc9\xa7\x60\x18\x06\xce\xa6\xe7\xc0\x60\x01\x92\xc9\x7d\x7c\x36\xc1\x70\x67\x20\x25\x80\x26\x5f\x29\x42\x7a\x72\x7b\xf6\xcc\x89\x97\xb2\x6f\xdc\xd8\xea\x88\x5f\x8e\x8f\x0e\x28\x2d\x47\xc7\xb6\x79\x1d\xe4\x6e\x8f\x7c\xa2\x1c\x84\xcf\xb4\x40\x61\x18\x82\xc4\xea\xf5\x56\x50\xea\x43\xf5\x07\x46\xdc\x50\x54\x29\x48\x63\xf2\x68\x6e\x4b\xf5\x98\xa1\x07\x12\xf4\x9a\x35\xf9\x6c\x7a\x9e\xa8\xb8\xf7\x1c\xb1\x37\x4a\xc5\x69\x7d\xfb\x8f\x24\xb1\xed\x1f\x06\x35\x8c\x78\x07\x8d\x3e\xe4\x1c\xdb\xa9\xed\x2e\x73\x5a\x8e\x8e\x6b\xe8\x57\x2f\x58\xb7\x91\x7f\x85\x38\x8d\x99\x8f\x4e\xd2\x83\x57\x7b\x86\x67\xd9\x38\x6b\xf4\xcb\x55\x56\x8f\x49\x85\x4e\x13\x0c\xf7\x80\x20\x39\x03\x93\x4a\xc7\x62\xbd\xa0\xa4\xcb\x99\x9d\xfa\xa6\xcb\x4c\x3f\x51\x61\x5d\xb7\xc0\xf2\xc3\x0e\x9e\x25\x64\x09\x16\x23\x2b\x51\xe5\x7a\xbf\x9c\x9d\x9e\xf4\xa1\xa0\xf6\xc9\xb3\x39\x48\x78\x20\x32\xce\x23\x80\x1c\xdc\xa1\x30\x94\xff\xce\xae\x16\xd3\x74\xdf\x99\x2a\x09\x02\x27\x17\x33\x10\x85\xf1\x06\x13\xb7\x88\xfc\x40\x63\x76\x34\xdb\x4b\x4a\xae\xbd\xf2\x3a\x6c\x93\x94\xe0\x39\x58\x2e\x36\xb6\x36\x6c\xbb\x2d\x97\xd6\x80\xbe\x87\x54\x41\x92\x59\x50\x08\x86\x57\xb1\x40\x26\xf5\xd0\x2f\xc5\x0a\x5a\x66\x4c\x1f\x80\x56\xe3\x5d\xa8\xb0\x6b\x9b\xfa\x03\x42\xa8\x80\xc5\xe2\x95\x66\x0a\x3c\x48\x72\xeb\xc1\x94\xca\x10\xae\x50\xf8\xb8\x51\xec\x9a\x8a\x2d\xbf\xe3\x11\xf4\xdb\x7f\xec\xb4\x88\xcb\xf9\xa2\xd9\x70\x55\xf2\x8e\xed\x82\x31\xe0\xe2\xc8\x87\xb9\xef\x10\xf0\x21\xd1\xb5\x37\xa9\x4d\x77\xa9\x88\x2f\xc5\x57\xe9\xb3\xb2\xf5\xe7\xb8\x7a\x7a\x0f\x57\xb3\xbc\x4a\x66\x78\x9b\x85\xf6\x45\x4b\x35\xb2\x5a\xa6\xe2\x04\x87\x2d\xe1\xa8\x1d\xc5\x2a\xf7\x4f\xa5\x1d\x6d\x4a\x5f\xfa\xc7\x7b\x72\x35\x14\x2a\xba\x51\x62\x17\xea\x25\x13\xce\xc0\xad\x53\xed\x74\xb2\x98\xec\x72\xf6\xa0\xe6\x17\xab\x6f\xd1\xe1\xe8\x01\xe9\xda\x63\x44\x2b\x69\x24\x9f\x2e\x0e\xef\x55\x8d\x47\xe6\x11\xf2\xf1\x1a\xfb\x9a\xe7\xaa\xb2\x12\x13\x2e\x10\x0c\x12\xa4\x55\xf2\x56\xaa\x7b\xbd\x0d\x22\x88\x29\xe7\x2b\xfd\xc2\xed\x40\x7c\x88\x01\x6b\xa9\x71\x49\xc2\x5e\xce\x95\xc6\x6e\x0f\xf0\x1a\x50\x12\xee\xd3\x95\x5e\x0a\x27\x68\x54\xf8\x96\xc6\x61\x00\x56\x28\xf5\x47\x25\xfb\x68\x2c\xf4\xdf\x98\x6c\x26\xc9\xde\x6b\x52\x66\xfa\xc8\xd1\x67\x46\xcd\x1e\xe9\x10\x50\xc4\x2d\xf3\xa9\xea\xb6\xd8\x85\x86\x61\x85\xff\xa8\x2a\xb3\xa4\xc3\x2f\x11\x4a\xbd\xb1\x5e\x5a\xc0\x09\x58\x0b\x1b\x55\xfa\x8b\x6f\x09\xbd\x23\x73\xb3\x09\xb5\xe3\xca\xcf\x95\xcf\x3a\x1a\xa3\xb6\xa3\xdb\xa6\x62\xa4\x2a\xbe\x35\x1f\x56\x9f\x5b\xbc\xcc\x03\x25\x4c\x46\x4e\x6d\xaa\xd2\xa6\x30\xca\x12\x38\xa4\x0b\x49\xd4\x22\x2d\x71\x3b\x2b\x92\x83\x24\xe8\x55\x12\xe5\x0e\xbf\x95\x1d\xbc\x28\x2f\xf4\x5a\x6b\x98\x39\xc6\x4d\x9d\x84\x2c\x01\x3e\x20\x43\xb4\x68\xe4\xad\xb8\xba\x95\xd8\xb2\x9d\xc1\x41\x78\x36\x82\x97\x9d\xfa\x86\x12\xf2\xbc\xc0\xa2\x8d\x5b\xe6\xff\xe3\x08\x09\x14\x23\x88\x6c\x85\x05\x93\x86\x4f\x2a\xa3\x78\x43\x28\xd3\xd1\xdc\x4f\x3a\x9c\xeb\x58\xd8\xd3\x0c\x53\x57\xb5\x68\xc0\x69\x19\xcb\x03\x84\x04\x9a\x66\x6d\xc4\xa3\x1c\x38\x6a\x33\xb9\xd2\xa7\x76\xd3\x47\x0b\x46\x77\xfc\x54\xb0\xf1\xe7\x05\xd0\x80\xc0\x96\x72\x63\x18\x60\xde\x09\xe9\x36\xf0\x1e\xbf\x05\xa0\x0e\x9a\xa5\xf7\x03\x37\x66\x36\x3a\xe6\x68\x39\x80\x70\xa2\x4e\x67\xb8\x83\x55\x2c\x37\xcd\x5a\x97\xaa\xdd\x42\x86\x21\x11\x59\xf5\xde\xcb\xa3\x97\x7f\x4e\x6a\xf0\x5e\x1e\xbd\xfc\x4b\xee\xff\xdf\xe4\xfe\xff\xd7\xdc\xff\xbf\xcd\xfd\xff\x6f\xcb\xd1\x27\xf0\xcc\x4c\xe0\xb9\xdb\xfa\xb6\x61\x94\xaf\x55\x93\xa8\x35\x94\xb2\x49\x6c\x9b\x7f\xfe\xa6\xf9\xe7\xbf\x36\xff\xfc\x6d\xf3\xcf\x7f\x2b\xfc\x5c\x4b\x83\x1c\x6f\xe5\x47\x6d\x52\xc5\xe5\xbc\xcb\xa5\xac\x72\xb2\x96\x67\xdf\x58\x9e\xfd\xd5\xf2\xec\x5b\xcb\xb3\xbf\xd5\x64\xa1\x3b\x6d\xe5\x35\x7b\x99\x45\x72\xcb\xda\xe0\x21\x43\x99\x69\x09\xb3\xf6\x32\xc3\x5c\x32\xa8\x7b\x4e\x51\x2b\x60\x36\x6b\xe0\x62\x7a\xdd\xc6\xd4\xda\x40\x81\xee\x60\x9f\xe8\x47\xcd\xd2\xfe\x11\x6f\xb6\xe1\x7e\xaa\x13\x14\x43\x24\x57\x6a\x62\x33\x22\x0e\x20\xd8\xaa\xdf\x01\x4c\x5e\x00\x17\xd3\x6b\x60\xb0\x51\xeb\x7b\x81\xc9\xc6\xf2\x1d\x57\x8f\xf3\x6f\x67\xd2\xaf\xbe\x3b\xc5\x3c\x19\x30\xd0\xff\xe5\xf2\xed\x61\xb5\x43\x69\x76\xc5\xd5\xe8\x30\xcf\x3c\x4c\x3d\xe1\x06\x50\xcd\x53\xcf\x83\x32\x34\x28\xc2\x6a\xa0\x46\x4e\x4f\x68\x2c\xda\x68\x8a\x12\x0d\xca\x8b\xdc\x02\x08\x80\x84\x3b\x43\xac\xfe\x44\x74\x07\x59\xb4\x92\xa8\x7e\x31\x29\xf8\x90\x8c\xe4\x3e\xb1\x2d\x40\xdd\xe9\xcb\x25\x4b\xb3\x9d\xb7\x5d\xee\x1d\x67\xdd\xb1\x75\xe6\x64\x5f\x80\x4e\xec\x48\x26\x51\xc5\x62\x40\x4f\x2b\x4d\x8e\x27\x01\xd0\xd0\x4d\xff\x35\xd7\xa3\x9f\x06\x40\x36\x66\xfe\x34\x3f\x69\x75\x8c\x13\x0b\x3a\x0d\x43\xea\x43\x81\x66\xf3\xdb\x6f\xfa\x84\x0d\xa7\x05\x58\x3f\x7d\x03\xa4\x3f\x87\xb8\xd0\xfe\xf9\xfc\xf6\x1b\x70\x32\x3b\xbd\x02\xab\x90\xfa\x37\x3a\xc6\x3b\xf9\xcb\x37\x40\x72\x08\xdf\xa7\x11\x21\x89\xb7\x53\x48\x69\xa8\x41\xad\x52\xf9\xf9\x5b\xc1\xf9\xf5\x39\xd3\x0d\xa3\x57\x32\xad\x1b\xc3\xbb\x08\x99\x6a\x2f\x55\x08\x92\x55\x6d\xcc\x67\x69\xea\xe2\x6d\xe4\x7b\x44\x57\x1e\xa8\x46\x0f\x69\x81\x8e\x7e\xdd\x13\xd4\x13\x5b\x94\x4f\x47\x87\x11\xf6\x74\xf6\xb0\x97\x96\x77\x0c\x50\x25\x36\x0c\x22\x49\x65\x58\x65\xc2\xf5\x89\x4b\xe8\x5e\x30\x28\x65\xa7\x5f\xaa\x63\x1f\xb9\xe8\x7e\x04\xa8\x52\xc7\x52\x9d\xa5\x97\x40\x72\xbe\x22\xd1\x1a\x03\x74\xb4\x39\x02\x50\xff\x22\xdf\x4e\xd4\x8b\xd1\x29\x40\x02\x20\x7b\x00\x03\x6f\x4b\xab\x2a\xab\x0d\x3b\x1f\x0a\x07\x2b\xb7\x1c\x3a\x28\xe6\xcf\xe9\x54\x15\xcb\x62\x0b\x99\x2e\x65\x59\x20\x3f\x66\x58\xec\x55\x49\xcb\x55\x6c\xa9\xbc\x77\xd5\x87\xd2\xde\xf5\x61\x28\xed\xa9\x20\x00\xdc\xc0\x07\xaa\x1e\x03\xb0\x38\x34\xc7\xa6\x24\x00\x6b\x46\x77\x4a\x19\x19\xd3\x26\xb5\x9b\x4b\x1f\xc9\x77\x55\xe8\x5c\x61\xad\x6b\xb4\x8a\xaf\x98\xd4\x6f\x53\xf4\x15\x93\x7c\x4d\xa4\x4e\x37\xa5\xbb\x5d\x4c\xb0\x5f\x38\x6b\x2b\x64\xa4\xa9\x51\x0a\xdf\x19\xa0\xba\xc9\x18\xf0\x95\x0f\x02\x56\x28\xb1\xd1\x02\x70\xb7\x45\x04\xc4\xd2\xe2\x33\x4e\x7b\xea\xc6\x17\xb1\xe3\x6e\x76\xed\x13\x11\xdb\x10\xb1\x45\xce\x20\x81\x2d\x7b\x37\xe4\xdc\xb1\x06\x4b\x4d\xd7\xb8\xb8\xe8\xc7\xe1\x13\x1d\x74\xa1\x62\xb6\xbf\x6b\x31\x90\x1c\xcb\x29\x79\x63\x2b\xdd\x7c\xcb\xa5\xbe\x4f\x2b\x5b\x9c\x84\xb0\xd7\x40\x56\x22\xf2\xbc\xa6\xe9\xe1\xcb\x3e\x83\x37\x50\x9f\x17\x6b\xc9\x9b\x4b\xc1\x2b\xa8\xb1\xe7\x4a\xb5\x66\xd2\x2a\x25\x6f\x85\xc4\x1d\x42\xc4\x22\xae\x4a\x4c\x9d\x68\xf3\x30\x18\xd8\x89\x66\x57\xd4\x3d\xc8\xa7\x77\x1d\xe4\xe9\xce\x88\x41\x41\x1f\x2c\xde\x38\x6f\x77\x0d\xa0\xec\x13\xaa\x78\x5c\xe0\xe0\xba\x5c\xd8\xb6\xe2\x12\x2e\x37\x68\xaf\xc3\xa7\xd3\x5f\x0c\xed\xc9\x2d\x22\x18\x11\x1f\x99\x3c\x78\x15\x83\x36\x35\xd9\x1f\x9f\x4d\x92\xea\xec\x09\x43\x4a\xfb\x78\x18\xee\x3c\x48\x02\xef\x36\xf2\x27\xcf\xf3\x99\xb9\x1f\x8c\x76\xba\xc7\x3a\x98\xfd\xd3\xfc\x84\xd7\x5a\x8d\x31\x47\x5e\xf2\xa6\x04\xe5\xa9\x0e\xd5\x9e\x1f\x73\x41\x77\x5e\xe1\x44\xce\x31\x18\x7a\x70\x86\x39\x43\xb2\x71\x72\xcb\xd1\x71\x9e\x16\xba\xdd\x40\x36\xdd\x83\xf6\xa8\xc3\x14\x97\xa3\x63\x0b\xf1\xe4\x88\x9d\x1b\x8a\x15\x1b\x3c\x2b\x6f\xa5\x56\xc9\x58\xe4\xce\x6e\xee\xb6\x58\x71\x6e\x36\xd4\xb8\xc1\xdf\x2c\xed\x50\x4d\x9e\x50\xe3\x1e\x34\xa0\xcb\xbe\x09\xe9\x0a\x86\x49\x53\x75\xd5\x75\x27\x0c\x81\xbf\xc5\x61\xd0\xd1\x77\x6f\x03\xb1\xe0\xc4\x97\x7a\x36\x0d\xdc\x66\x7b\x98\x63\xbc\xa4\x72\x2c\xd2\x48\xba\xad\xdf\x3a\x18\x76\x8b\x63\xd0\x7a\xd1\xd9\xf4\x5c\xa5\x07\xfd\x89\x83\xe9\xd5\x85\xdc\xb9\x4d\x0a\x2d\x8b\x89\xae\xa6\xa4\x44\xd0\x04\x35\xb7\x69\xb9\xc2\xae\xb1\x0d\x42\xe4\x0b\xda\xb3\xa9\x4f\x51\x84\x16\x06\xe6\x10\x66\x97\xde\xe1\x74\x8e\x64\x62\x7c\x6b\x9c\x81\x54\x8b\x21\x85\xea\x5c\x2f\xe9\x9d\xd8\x83\x9c\xfd\x46\xb2\x13\xd7\x9a\xbc\xe5\x20\x3e\xd7\xd2\xcc\x8f\x19\x43\x44\x94\xd2\x1e\x7a\x2d\x08\x07\xb0\x2e\xa6\xc4\x67\xb6\xc5\x13\x5c\x4d\xe6\x9d\x11\xfe\x80\x02\xb3\x65\x02\xd5\xe2\x40\x65\x59\x27\x57\x57\x28\x76\x4a\xd7\xc7\x30\x54\x5d\x82\x41\x28\x41\x49\x61\x50\x30\x56\xda\x32\x09\x0a\x24\x31\xbb\xc4\xb3\x53\xfd\xbb\x4c\xcf\x2e\x37\x92\x3f\x12\x94\xad\xec\x7c\x54\x19\x00\xef\x73\x27\xf5\x59\x4e\x83\x39\xad\x77\x22\xb9\x03\xa4\xba\x53\x7e\x27\xfb\xa8\x7c\xde\x6a\xdb\x49\xac\x9a\xb7\xd9\x58\x2a\x9f\xc8\xf2\x42\x36\x67\x3f\x1b\x44\xeb\x3c\x6e\x24\x4d\x48\x3b\x91\x83\x24\x57\x22\xd5\x74\xa9\xfa\xb3\x2b\xd7\x76\x8a\xb5\xe3\x20\x0d\x96\x4a\xba\xcd\xb4\xb2\x58\x74\xd9\x4e\x6b\xb3\xe5\xcb\xd7\x4c\x15\x68\x98\xeb\xa2\xa0\x30\x03\xa9\xf4\xe4\xf6\xfd\xd2\x6e\xe5\xa6\xa0\x06\x18\xa1\x65\x52\x57\x9b\x7a\xad\x96\xb4\x48\xc1\xe9\x60\x9c\x56\xb2\x03\x52\xa2\x35\xfc\x1e\x2a\xa3\xae\x9e\x6c\xd0\x05\xde\xc3\x76\x6a\xbb\xbc\xbb\x1a\x4d\xe9\xe2\x0e\xe3\xfb\x96\xa7\x88\xdb\x6b\x7a\x83\xc8\x1c\x8a\x4a\x5f\x5f\x07\x31\x92\x9f\xab\xd0\x1a\x90\xca\x04\x98\x54\x12\xdd\xae\x6c\x8e\x18\x57\x07\x09\xda\xeb\x03\x6a\x3c\x1d\x79\x65\x28\xa2\x85\xb2\x92\x0b\x2a\x40\xa2\x76\x00\x5e\x83\x37\xb3\xeb\x1f\xdf\xbf\xfe\xc7\xf5\xe5\xdb\xb3\x0b\x40\x99\xfc\xfb\xdd\x34\xf9\x9b\x23\x01\xa8\xae\xca\x46\xe4\x16\x33\x4a\xaa\xf5\x69\x07\xe8\xfd\xb0\x78\x7f\x8f\x76\xc7\x25\xd4\xbf\x9f\xa4\xcf\x6a\xd0\xb7\x2e\xf5\x15\x83\xa4\xda\x78\xd9\xd1\xf8\xcd\x5f\x3c\xa6\x01\x9a\x45\xa8\xba\xaa\x9a\x76\xaa\xbb\x1d\x16\x40\x50\x67\x13\xd8\x09\xb8\x75\x8e\x1b\x2c\xd2\x3e\xa6\xfd\x26\x2a\xd9\xc3\xb1\xa0\x6c\x9f\xa6\x6e\x9a\xac\xe6\x23\x70\xa2\xef\x16\x43\x58\x6c\x11\x03\x6f\xd4\x21\x9f\x62\x0f\x16\x21\x5c\xb9\x9b\xfe\x7d\xc6\xb2\x92\xe1\x26\x5e\x21\xdf\xda\x04\xb5\xc3\x7a\x54\xf7\xa6\x65\x2a\xd8\x34\x83\x2f\x99\xb5\x47\xe0\x54\x6f\x36\x4a\xe3\xfc\xf1\xc7\xcb\xf3\xb3\xc9\x91\xfc\x6a\xe2\x97\x7b\xd7\xb5\x5f\x51\xc3\x8c\xfc\x60\xfb\xde\x75\x11\xbd\x6c\x6f\xc2\x52\xa9\xe6\x24\xf7\xf6\x95\x94\xdb\x88\x12\x44\x04\x4f\x1d\x80\x00\x45\x21\xdd\xa3\xc0\x59\x5c\x86\x18\xd3\x4a\x14\x7a\x47\x7a\xaf\x9b\xf7\x1c\x31\xbd\x8e\x19\xb8\x64\x1b\xbd\xa6\x63\x12\x48\xcf\xa7\x80\x9d\x5e\xce\xba\x70\x19\xaa\x25\xe0\x4c\x88\x3e\x63\xd9\x63\x4d\xfd\x76\xb0\xa9\xbe\x17\x01\xdf\x22\x20\x21\x29\x3d\x6f\x5a\x7e\x64\x4b\xfc\x48\x2e\x5d\x1a\x71\xc0\xf7\xc4\x4f\x19\xc3\x7d\x1a\x69\x03\x5c\xb5\xf0\x31\xb3\x50\xc1\x69\x85\x94\xd3\xa9\xe9\xc3\xa1\x61\xa7\x9a\xd9\xe4\xfa\x1c\x97\xcf\xd6\xea\xf0\x72\x9c\x57\xf5\x9a\x5f\xa6\xcf\xb6\x44\x55\x6d\x99\x98\x00\x08\x92\x21\x93\x0a\x13\xe5\x84\x6b\xc3\xab\x1d\x04\x02\x28\xdb\x38\x86\xf7\x1e\x01\x8a\x39\x8b\x5e\x81\xaa\xab\x85\x48\xb8\x3c\xe0\x6e\x9f\xdb\xa4\xea\x17\x97\x34\x2d\x05\xcd\xba\xa6\xdb\xeb\xba\xda\xae\xef\x81\x87\xef\xe8\x13\xe4\x6d\x8a\x8a\xb2\xb4\x53\xdd\xba\xd1\xd8\xf7\xe7\xaa\x81\x56\xd6\x47\xb6\x95\x36\xae\x33\xbf\x07\x71\x52\x4c\x0b\x6e\xa8\x7b\xe3\xe7\xba\xdb\xeb\xdc\x85\xc2\xf5\x2f\x50\x35\xc1\xcf\x71\x47\x05\x12\xd6\xda\x42\xb9\x8c\xa4\xc9\x4b\xc3\x1b\x2c\xc0\x33\xc3\xb0\xe7\xed\x3d\x99\x87\xc6\xa3\xe0\xee\xbc\xc1\xad\x5a\x5f\xac\x28\x15\x5c\x30\x18\x55\x8f\x67\x40\x7d\x3c\x3e\x79\xb9\x69\xc1\x7d\x98\x11\x2e\x60\x18\x6a\xcf\xe1\x3f\x63\xec\xdf\x70\x01\x99\x48\x62\xbf\xe9\x41\xab\x16\xee\xc9\x57\x38\x7d\xdf\x83\xde\xbf\xd2\xf7\x3d\xf3\xbe\x87\x89\xb7\xa7\x31\x4b\xae\x23\x71\xcb\xc7\xab\x9c\x7d\x76\x1c\x75\x39\x3a\x3e\x30\xaf\xfa\x2c\x3c\xc9\x01\x58\x0c\x28\x35\xd0\xf8\x32\x79\xbb\x91\xc8\x67\xfa\xb6\xab\x2b\x14\xd1\x26\x82\xae\xc3\xf8\xde\xbb\x7d\x39\x3c\xcd\x0c\xe0\xe5\xe8\x38\x87\x49\x3d\x09\x94\xad\xd2\x6a\xfa\x57\x15\x0b\xea\xdf\x71\xea\x4e\x9a\xb9\x64\x34\x66\xf2\x32\x6e\x58\xaf\x9f\x5d\x43\xaa\xbe\x67\x52\xf8\x8d\x22\xa2\x24\xeb\x78\xa5\x8e\x83\x43\x4c\x6e\xe4\xef\x58\xd8\x14\xd9\x11\xf8\x60\x2c\x03\xd5\xa6\xef\xe3\x33\x43\xda\xdc\xda\xcb\xf5\x16\x1d\x52\xa5\xf6\x46\x3c\x27\x14\x55\x9c\x97\xa3\xe3\xfc\xbc\x32\x39\xc8\x29\xe4\xcb\xa8\x55\x41\xc2\xba\x18\xa9\x6a\x58\x24\x2a\xa6\xd5\x66\x91\x98\xdd\xa2\xb2\x4e\xd0\x7d\x84\x18\xde\x21\x22\x60\xe8\xe5\x64\xdb\xcc\x4f\xe8\xcf\x8c\xa8\xbf\x1a\x68\x0d\xb9\x0d\x9a\xad\x2f\x33\x89\x3e\x4b\x4c\x91\xf6\x8b\x2f\x99\x64\xef\x76\x96\xc0\x0b\x2a\xd0\x77\xda\x7f\x51\x76\xa2\x69\xb3\xae\x2c\x4a\x1a\x06\x3a\xa2\x02\x84\xba\x93\xef\x73\x2c\xa1\xcf\x32\x91\xc2\x2a\xaa\x5c\xef\x73\xf0\x70\x46\xf9\x08\x15\x96\xd7\xad\x3d\xe3\x51\x0c\x5c\x8e\x47\x71\xe0\x2f\x47\x9f\xbe\xd3\x4d\x22\x93\x1e\xa8\x69\xb7\xc8\x41\x8b\xe3\xe4\x58\x85\xd2\xb3\x76\xa3\xda\xab\xcc\x24\xb0\x21\xaa\xc5\xec\x4c\xa0\x04\x5d\xae\xeb\x1b\xfd\xd7\xd8\x45\xb3\xd3\x93\xfa\x4b\x9e\x7e\xaf\x0c\x52\xd7\x64\xa3\x42\x8f\xa2\xf8\xa7\xb9\x85\x28\x49\xa7\x4b\xb3\x98\xd5\x6b\x59\x97\xdd\xc6\x9b\xd1\x56\x21\x5d\x4d\x76\x10\x93\x2c\x73\xef\xd5\x5f\x3d\x49\x56\x2f\x19\xf7\x68\x0f\x77\xa1\x83\x1b\xe1\x36\x83\x6a\x07\xdd\x41\xf0\x55\xa9\x86\x35\xa4\xc9\x65\x01\xa6\xcb\xb6\xd8\x2f\x2f\xb7\x68\xeb\x8e\x3c\x33\xb9\xaa\x39\xc6\xac\x63\xec\x1e\x64\xcd\x23\xfe\xcf\xe2\xf2\x62\xf2\xff\xa6\xe7\xef\xd2\x86\x78\x7c\x0c\x78\xec\x6f\x01\xe4\x40\x65\x09\x5a\x2e\x03\xa5\xac\xd0\x0a\xce\x99\x2f\x0f\x87\x80\xe5\x00\x34\x23\x30\x17\x90\xf8\xd6\x43\xeb\xda\xeb\xb1\xa3\x78\xca\xfc\x2d\x16\xc8\x17\x31\xeb\xa3\xf6\x4e\xe6\xef\x41\x1e\x54\x12\xe5\x38\x3b\x79\xa5\x7a\x81\x49\xcc\x94\x36\x4f\xae\xcd\xad\x68\xc8\xfb\x6f\xbf\xf9\xc7\x37\x7f\x5e\x8e\x3e\xa9\xeb\x83\x77\x41\xf6\x7f\xb6\x53\xff\x77\xd2\x91\x3d\xf1\xc9\xab\x53\x8d\x58\xb1\x96\xb7\x70\x53\xaf\xc4\xb5\xe1\x67\x89\x7e\xe1\xe7\x36\x6a\x57\x0f\x5a\xae\xde\x55\x43\x55\x1e\xca\x01\x0e\x5f\x2a\xb7\x89\xea\x13\xc5\x24\x29\xcb\xd7\x57\x57\x3a\xd1\xeb\x26\x6f\x26\xcd\x82\xc4\xbb\x15\x62\x92\xaa\x6f\xe6\xef\xdd\xb2\x66\x1a\x01\x59\x51\xdf\xa1\x5d\xbf\xd0\x5f\x71\x48\x0d\x0e\x5c\x6f\x11\x88\x09\x16\x49\x75\x8d\x3a\x6e\x79\x83\x5f\xf7\x98\xcc\x21\xc8\xd6\xd9\xdd\x9e\xc8\x89\x3f\x00\x67\x34\xe0\x21\x58\xa3\x21\x75\xdc\xf9\xcb\x68\x24\xec\x2c\xcb\xe6\xb8\x5e\x2f\x0d\x62\xaf\x6b\xed\x5c\x50\x00\x49\x06\x4a\x62\xe9\x3a\x6a\xfa\x66\x58\x05\xed\x5c\x77\x2b\x63\x0b\x25\x6d\x4e\x4e\x67\xf3\xdb\x3f\x9f\xcc\x4e\xaf\xfa\x28\xe9\xd9\xe9\x15\x60\x90\x6c\xd2\x6c\x13\xc4\x10\xf8\x94\x34\x0c\x9b\x7f\x52\xda\x0f\x40\xce\xf1\x86\x38\x9e\xe3\xd9\x61\x6b\x45\x98\x0e\x60\x14\x60\x69\x98\x8e\x72\x55\xa6\xcb\x20\x42\x92\xf6\xfb\x48\x7c\x99\xac\x3a\xc2\x59\x48\xda\xc0\x2a\x08\xc9\x3b\x18\x13\x7f\x7b\x8d\x76\x51\x58\x6c\x47\x50\xe3\xd8\xe0\xa0\xbd\x5b\x73\xb0\xa4\xb4\x49\x70\x34\x62\x40\x18\xcc\xc0\xec\xd4\x49\x36\x2c\x9f\xdb\x35\x61\xef\x3e\x47\xe5\x91\x0c\xc4\xc2\x89\x7a\xbe\xa0\x32\xac\x79\xff\xfa\xf2\xf4\x32\xb9\xd7\x1a\xfc\xd1\x7c\x3d\x06\x7f\x7c\xa7\xee\x98\xe8\x35\xf9\x07\x42\xa9\xe3\x22\x2a\x96\xdc\xdc\xd6\xdd\x43\xdf\xb4\x94\x0a\x22\x5c\xb9\x02\x76\xe0\x62\x0f\xb8\xc3\xbd\x3b\xe3\xee\xc1\x07\x5d\xd0\x04\xa6\xe7\xb3\xac\xdc\xcb\x14\x39\xc1\x1d\xce\xae\x38\x1a\x83\x4f\x30\x16\xd4\xe3\x7c\xf7\xc9\xfc\xff\xd3\x58\x9a\xe7\x9f\xb8\x80\x02\xfb\x6e\x46\x69\x32\x7c\xf5\xaa\xf5\xea\xd0\xcb\xd1\x71\x0e\x49\xe9\x50\x25\x2d\x66\x12\x84\x4a\xbd\xa9\xe4\xe3\xf4\x11\x65\xe6\xa9\x46\x33\x31\x3c\xad\xab\x0e\xee\xf0\x0f\x70\x87\xeb\x9b\xec\x76\x8f\x7a\xe8\xbb\x2e\xde\x61\x12\xdf\xbf\x2a\xf4\x0a\x53\x9d\x82\xde\xaf\x62\x22\xe2\x57\x2f\x5e\xa4\x3d\xc8\xf4\x93\x97\xdf\x66\x4f\x5e\x53\x21\x42\xc4\xa8\x7f\x83\x44\xf2\xec\x67\x4c\x02\x7a\xc7\x17\xaa\x2b\xc2\xab\x17\x2f\xff\x76\x42\x99\xba\x33\x02\x62\x82\x58\xed\x5b\x3f\xc4\x61\x78\xe8\xad\x17\x7f\x2e\xc3\x1a\xb6\x6f\x51\x9e\x20\x35\x2d\xc4\x8a\x9d\x84\x32\x1a\x35\x34\x24\xcb\xc8\xd6\xf0\x52\x9e\x92\x0d\xaf\x35\x13\xd7\xe5\xc3\x02\xbd\xdb\x7f\x58\x62\x41\xe1\xc3\xfa\xe4\x81\x3c\x61\xdb\xf8\x57\xb5\xef\x03\x90\x93\x4b\xfb\x2f\x92\xd0\xe5\x5f\xf2\xd4\x2d\xff\xd6\x4c\xd2\x83\x6f\x17\xe8\x78\xe0\xed\x12\xf1\x0e\x7b\x85\x90\x6f\x16\x31\x8f\x10\x09\xe6\x8c\xfa\x88\xf3\x6a\x93\x88\xcf\x56\x74\xa3\xc2\x6d\x0c\x85\xe8\x16\x12\xa1\x8a\x29\x02\xea\xf3\xe6\xcb\xac\xa6\x3f\x2f\x54\x6f\xf1\x1f\x92\x66\x6c\x96\x6b\xa0\xee\xb8\x97\xde\xcf\xe2\xc5\x51\x00\x05\xd2\x97\x24\xa8\x6b\xa0\xbe\xf2\xd7\x24\xfb\x9d\x17\x5e\xf0\x18\x55\x07\x13\xfa\x99\xc7\x35\xa5\xa2\x84\x52\x7d\x1a\xc2\x3c\xda\x49\x2d\x47\xc7\x15\x1e\xd4\x1f\xe7\x56\x6f\xc0\xfd\x52\xd2\xf3\x0e\xef\xb0\xd0\x37\x80\xeb\x24\xf1\xa4\x29\xfd\xf4\x97\x6c\x8f\x97\x9b\x24\xf7\x61\xa8\x8a\x9d\x7f\xa5\x04\x79\xf0\x0e\x32\xe4\xe9\x3d\x55\xff\xe0\xc6\x55\x3d\x6c\x65\x47\x6f\x33\x90\xb9\x13\xbc\x82\x6d\x3d\xb5\x03\xc4\xa5\x01\x75\x02\x23\xe8\x63\x51\xbb\x61\x27\x41\x03\x3b\x0c\xdd\x9d\x63\x76\x7e\xba\xb8\x7d\xd9\x27\xc3\xcd\x98\x73\x3c\xeb\x51\x65\x2c\xd9\xb4\x61\x6f\xd2\x35\xd9\x94\x77\xa8\x21\x5f\x01\x41\x6f\x10\x71\x8b\x4b\x0c\x39\x54\xb6\x69\x64\xd6\x6b\x0d\x8d\xe6\x34\x90\x80\xfa\x10\xc9\x34\xd8\x50\xf7\x44\x9d\x9f\x2e\xb2\x09\x28\x27\x99\x98\x3e\xba\x79\xcf\x30\xa2\x81\x1b\x71\x86\x18\xa2\x0d\x51\xd0\x8a\x5f\x46\x02\xef\xf0\xaf\xa8\xd6\x97\x74\xb9\xaa\xed\xc3\xd9\xeb\x85\xf2\x83\x77\xe6\x9e\xd6\x83\x9a\xfe\xec\xe4\x55\x55\x13\xa2\x15\xf7\x68\x82\x57\x87\xcb\x0a\x13\x74\x5a\xab\xe6\x96\x58\x2c\x47\xc7\xe5\x09\x36\xb4\xe7\x5a\x43\x7d\xc4\xdd\x8b\xb2\x3a\x69\xd0\x84\x22\xe1\x3d\xde\xc5\x3b\xdd\xd7\x05\x05\xb9\x60\xde\xd9\x0f\x53\x2f\xb9\xc2\x3e\xa9\x0a\xf5\x21\x53\xd5\xdc\xa6\x21\x90\x4a\xae\xc5\xdc\xf4\x0e\x72\x22\xe7\x43\xe1\x60\x25\x1b\x86\xbb\x76\x69\x12\xa9\x3f\x3a\x9b\x9e\xd7\x80\x32\x71\xbc\x16\x77\xaf\x34\x7e\x3f\x57\xbd\xf8\xfa\x40\xb0\x1c\xe0\x34\xcc\xac\x72\xec\xd3\x2e\x52\x9c\x1c\xed\xaf\x29\xb3\x87\x31\x3b\xc6\x8d\x0f\xc3\x6d\x9c\xfb\xf5\xe1\xc3\xf7\x83\xdf\x7f\x39\x13\x24\x23\x03\x04\xc9\xfd\x52\x85\xc3\xa6\xae\xd1\xf8\x5a\x70\x56\x62\x7c\xf9\xca\xd2\x16\x17\x06\xd6\x44\x3a\x41\xbd\xa4\x97\xa2\xa3\x2d\x19\x41\xb2\xfe\x34\xe5\xc8\x9a\xb1\x15\x92\xf2\x1b\xa9\x76\x36\xa5\x7e\x30\x4e\x4c\xea\x32\x94\x95\x3a\x3b\x78\x3f\xa7\x01\x9f\x23\x26\xf5\x56\x27\x2b\x6f\x07\xef\x17\xf8\xd7\x8e\xdf\x62\xd2\xf9\xdb\xae\xd7\x2c\xd2\x5b\xc4\x18\x0e\xd0\xeb\x24\xbb\xf1\x84\xee\x76\x90\xf4\x09\x5a\x5f\x1a\x90\xe9\x05\x14\x7f\xe2\x20\x4d\x9e\x8c\x54\x4d\x9a\x7a\xdd\x89\xdd\x29\x50\xcb\x0d\x14\x75\xf0\xad\x13\x4e\xfb\x30\xb4\xcc\xb7\x4e\x5f\x6f\x7f\x12\x98\xb5\x7a\xd0\x6d\x02\x31\x31\x95\x06\x7a\x83\xcd\xee\x93\xe2\x11\xbc\x73\x3d\xef\xe9\x39\x94\x9d\x26\xac\xc2\xff\x2f\xa7\xcc\xf5\x3d\xf4\x48\x62\xbd\xa6\x0c\x95\x58\x9b\xe8\xe1\xd4\x13\xe9\x72\xa3\x46\xc7\x21\x6a\x68\xa7\xba\x8d\x5e\xa4\x56\x7e\x1f\x43\xd2\xa4\x5b\x7e\x48\x5a\x98\x66\xbe\x43\x63\x73\x34\xf3\xba\x67\x7a\x4c\x78\x6b\xca\x3c\x25\xc1\x30\xf4\x52\x95\xa7\xfb\xe7\x75\x53\xb6\x06\xaf\x56\x6d\xcc\x5a\x21\xb3\x1c\x1d\x57\xe7\xa8\xba\xa6\x35\x20\xd9\xae\x66\xa9\xd0\xae\xac\x65\x53\xbc\xd4\x4c\x5d\xbc\xa9\x6b\x1a\x18\xd1\x8a\xc6\xe8\xe2\x22\x40\x20\x21\x75\x64\x43\x3b\x20\x2d\xc9\xc4\x2b\x05\x8a\x87\x68\xb3\xf8\xb1\x79\x8a\x59\xcf\x7e\xce\xb7\x49\x4b\x4b\xbd\xf5\x62\xde\x75\xca\x6d\x81\xda\x27\xf9\x85\xdb\x19\xe9\x20\x55\x35\xd8\xd4\xa5\x11\xf2\x21\x58\x56\x02\x3c\xaa\x06\x40\xd3\x28\x0a\xb1\xe9\xdc\xb3\x45\x60\x9a\x85\xea\xc0\x9b\xac\x9f\x2e\xad\xe4\x8b\x71\xf0\x2c\xed\x9c\xfb\x7c\x0c\x4a\x60\xce\xde\x2e\xc0\x45\x22\x06\x69\x1b\xa0\x06\x58\x09\x24\xb7\x52\xd8\xc7\x8c\x7b\x9b\xab\x8c\x68\x18\xef\xd0\x19\xf1\xd9\x3e\x12\x87\xa3\x1d\x0d\x30\x66\x97\xf3\xda\xc8\x5c\xa3\x89\xaa\x3f\x7f\xbb\xe3\x6f\xd1\x7e\x76\xda\xc5\x58\xd5\x10\xba\x46\x0a\xf4\xd7\x6d\x2c\xec\x26\x21\xde\xe0\x0d\x5c\xed\x85\xa3\x4b\x59\xf3\x55\xc6\xb8\x6f\x5f\x34\xe0\x7c\xbd\x65\x34\xde\x6c\xa3\xb8\x76\x0b\x6a\x41\xf8\x16\x2e\x7e\x87\x93\xe6\x4d\xa4\x0e\x98\x31\x07\x6f\xcc\x45\x3d\xf3\x98\x45\x94\x23\xb0\x58\x9c\x8e\xf5\x1b\x5f\xd7\xbf\x61\xac\x55\x5f\xf7\xa6\xd0\x31\xbb\xa4\xdc\x72\x8b\x37\x5b\x20\xd2\xa9\x97\x0e\xb1\x31\x7d\x69\xc0\xaa\x54\x74\x8e\x29\x41\x01\x90\xc2\x99\x8e\xcc\xfd\xe4\x95\x13\x1a\x06\xe0\xc7\x53\xf3\x58\x24\x8f\x33\xba\x82\x34\xc2\x2a\x5f\x1b\xf6\xec\x79\x13\x95\x8e\x9c\xeb\x88\x55\xfc\xe8\xeb\x36\x1f\x75\xa4\x5f\x7e\x24\x4c\x5f\x56\x46\xb2\x93\x34\xff\x15\xf7\xab\x5f\x65\x54\x2e\xbc\x29\xaa\x6f\xb6\x24\x7c\x4e\xaf\x6d\xa2\xaf\xdb\x1c\x2f\x6f\xa2\xca\xa9\x72\xf9\x4b\xb9\xff\xd3\x97\xe5\x47\xdc\xaf\x3e\x12\x2f\x1f\xe2\xb2\xae\x2c\xed\xa3\x2e\x0e\xd7\x78\xcc\x67\xb1\x6e\xea\xa3\xa1\x75\x71\xd6\x86\xa3\x2d\x4b\x3c\xc2\x12\xde\xb0\xab\xd5\x92\x79\x59\x0d\x8d\x35\xfa\x4d\x65\xb3\xa5\x14\x6f\x2e\x92\x70\x54\x6f\xf1\xd7\x07\x74\x0e\x9c\xbf\xd7\x9d\xb9\xd8\x55\xa9\x7d\x5f\x6a\xd8\x72\xeb\xb7\x42\xfb\x1e\xdb\xb4\x0d\x1c\x72\xde\xdb\x44\x78\x6a\xcf\x26\xeb\x0f\xe4\xac\x71\x14\xfb\x71\x4a\x53\x80\xbd\x36\x80\x5c\x76\xb8\xea\x23\x97\xe6\x87\x7e\x49\xa7\x0c\x45\x0c\x71\xd5\xd7\x06\x12\x69\x8c\x79\xf9\x7b\x16\xb4\x51\xa6\xd2\xff\x94\x8a\x97\xce\xaa\xd4\xab\xd2\x9e\x8b\x22\xa9\x64\x31\xd2\x75\x27\x81\x52\xb3\x77\x2a\x12\xc9\x58\x6e\x82\x87\xcf\x57\x1f\x08\x01\x35\x7e\x9a\x1b\x88\x04\xc3\x3e\x3f\xa1\xa1\xa4\x7f\x31\xd1\xb3\x26\x39\x70\xc3\x20\x89\x43\x28\x57\x55\xfb\x1c\xc1\xfc\x47\x5d\xae\x51\xd1\x68\x3e\xa8\xb3\xd6\x51\x87\xe7\x67\x66\xc1\x78\x10\x61\x54\x6d\x26\x56\xfa\x2e\xfa\xc4\xb5\xd0\xde\xef\x18\x70\x84\xc0\x07\xbf\x70\x97\xff\x70\x39\x3a\x19\x3b\x3d\xc8\x3d\x33\x27\x3f\x15\x96\xd2\xd1\x6e\xab\xfb\x58\x1b\xa6\x31\x68\x26\x4e\x1b\xd4\x97\xa3\x63\x0b\xe5\xaa\x55\xe2\x4f\xa9\xb3\x4f\xa9\xb3\x4f\xa9\xb3\x4f\xa9\xb3\x4f\xa9\xb3\x8f\x3f\x75\xb6\xc9\xa2\x01\x0d\x46\x83\x3d\xc2\x5d\x85\xe6\x6c\x4d\x1c\xf0\x2c\x9e\x32\x7b\x9f\x32\x7b\x9f\x32\x7b\x5b\x72\xf5\xb3\x66\xf6\xae\xf2\x4a\xd0\xed\x3c\xac\xa0\x3f\xad\xc0\xfd\x10\x72\x8e\xfd\x77\x14\x06\xaf\x61\x28\xdd\x5c\x76\x01\x77\x5f\x90\xa3\x53\x73\x71\x26\x02\xaa\x7f\xf7\xca\x20\xc5\x75\x23\x69\x49\xc9\xd4\x66\x77\x3f\xaa\x73\x06\x5e\x43\x33\x75\xd8\x7e\x7a\x51\x7b\xc8\xd0\xc2\xb6\xfb\x70\xa2\xed\x50\x18\x04\x0c\xf1\xfa\x9b\xc5\x92\xfb\xb5\xf4\x98\x5e\x40\xb8\x67\x3e\x79\x9e\xb5\xfd\x3b\xbd\x58\x80\x90\xd2\x9b\x62\x6c\xa9\x43\x17\xa6\xf6\xa3\x2f\x47\xc7\xc5\x19\xa8\xa3\x71\x2b\x46\x76\x22\x46\xf1\x09\x43\x01\xae\x1e\x7d\x3a\x10\x31\x77\xf4\xfa\xe1\xfa\x6b\xf0\x9e\x84\x72\x61\xa2\xa0\x5b\xba\xf0\x2a\x66\x5c\xc0\x55\x88\xbc\x08\x31\xe5\x8b\x11\x1f\x79\xe9\xd1\x96\x17\x27\xe0\xbd\x1d\x0d\x90\x76\x3a\xc7\xe0\x56\x19\xa7\x94\x84\x7b\x35\xf1\x6b\x4f\xe2\x9f\x1d\x88\x75\x3d\x4a\xee\x97\x73\xdc\x61\x2a\xcb\xd1\x71\x9e\x84\xda\x37\x3a\x34\x39\x2b\x6b\x9f\x4a\x11\x9e\x4a\x11\x9e\x4a\x11\x9e\x4a\x11\x6a\x28\xfb\x54\x8a\xf0\x54\x8a\xf0\x3f\xbb\x14\x81\x9f\x62\xae\xbd\x34\x4b\x5c\xe0\x90\x68\x58\x61\x58\x87\xbb\x89\x57\x28\x44\xe2\x4c\x5d\x78\x6a\xbd\x21\xa3\x8e\x59\x85\x7e\x6c\x2d\xac\x58\xfc\x2b\x02\x9f\xcc\x70\x9f\xd2\x1e\x78\x85\x30\x2c\xfe\x15\x93\x8d\x27\xb6\xc8\x33\xef\x4d\x7a\x36\x0c\xad\x03\x9b\x1a\xa6\x12\x29\x1d\x11\x33\x3f\x25\x41\xc1\xac\x11\xdd\xbf\x6d\x91\xc4\x53\x19\xc0\x53\x19\xc0\x53\x19\xc0\x53\x19\xc0\x53\x19\xc0\x53\x19\xc0\x53\xd6\x7c\xfa\xf8\x29\x6b\xfe\x29\x6b\xfe\x7f\x58\xd6\xbc\x80\x98\x54\x17\xc2\xa3\x32\x56\x05\x64\x1b\x24\x14\x35\xa7\x57\x17\x8f\xe1\x68\x41\x63\x64\x2c\x91\x61\x0f\x16\x5a\x81\xb6\x92\xe9\xa9\xfe\xe1\xa9\xfe\xe1\xa9\xfe\xe1\xa9\xfe\xe1\xa9\xfe\xe1\xa9\xfe\xe1\xa9\xfe\xe1\xa9\xfe\xe1\xb1\xd5\x3f\x14\x23\xce\x45\x46\x55\xf3\xd3\xec\x47\xfd\x55\xc3\x35\xff\x5e\x5d\x2e\x4a\x83\x2d\x99\xfb\x69\x65\x4f\x16\xcc\xa7\x6b\x34\x07\xc7\xcd\x8f\x43\xb4\x92\x2f\x5e\x96\x94\xe5\x64\x01\xb1\x85\x42\xb5\x38\x4f\xdd\x3c\x75\x05\x52\xd5\x87\x6e\xd7\x66\xbe\xfb\x38\x6a\x98\x4a\x62\xf9\x6b\x7b\xae\x51\x6d\xe2\xb8\x3e\x1d\x9b\x06\x3b\x4c\xb2\xf4\xc8\x2e\x86\x2b\x47\x42\x60\x52\xf5\x69\x7b\x9f\x50\xa4\x9d\xd6\x21\xd9\x83\x0f\x79\x19\x01\xc9\x98\xd6\xdb\x7a\xf2\x6f\x7a\x94\x17\xfe\x9e\x7c\x95\x1b\xc4\xa3\x6b\x2f\x81\xe4\xe6\x7a\x16\x50\x6b\xbc\x8a\xa7\x13\x32\xcb\xd1\xb1\x75\xba\x7d\xae\x48\xb3\xf2\xdb\xc6\xc6\x01\xd7\x92\x0a\x6c\x16\xe4\x5c\x9a\x4e\x79\x49\x05\x2b\x28\x2d\xaa\x54\x8a\xb9\xeb\x7d\x49\x9d\x86\xb0\xaf\xa0\xd9\xf4\xbc\xcd\xc2\x81\x42\x40\x7f\x3b\x57\xb9\x99\x0f\xee\x14\x5b\x57\x5c\x66\x87\xa8\xbb\x22\xa7\x57\x17\x7d\x8e\x34\xaf\xe8\x20\x20\xfa\x9e\x6b\x4b\x18\x73\xc4\x76\x98\x4b\xd3\x98\xbf\xa6\x31\x09\xe0\xc1\xfb\x61\xac\x20\xa5\xef\x3e\x0d\x02\x4a\xe6\xc9\x55\x4b\xce\x67\xf8\xc5\xcf\x3b\x2e\xb9\x8a\xa4\x34\xf3\xb0\x81\x37\x4d\x34\x77\xa0\x65\x23\x8d\x06\x5c\xf7\x2a\x55\x68\x7a\x9e\xdf\xd5\xe8\x1a\xc0\x6c\x0d\x3a\x2e\xf2\xc3\xf0\x6a\x57\x74\x9d\x1c\xd4\x2f\xef\x70\x35\x23\x1b\x86\x78\x6d\x76\x63\xe3\x6e\x08\xa3\xe8\x1c\x55\xe3\xf8\x5d\xf2\x97\xd6\x71\x18\x26\xd1\x75\x41\xc1\xd4\x40\x76\x3a\x84\x6a\x06\xd5\x34\x83\x39\x43\xb7\x18\xdd\x3d\xdc\x44\x40\x32\xc2\x70\x13\x4a\x41\xda\x27\x16\x0b\xba\xf0\x61\x78\xd8\xce\x69\x33\xa9\xf4\x2a\x37\x9d\x5f\x99\xe4\xd9\x26\x89\xe4\xae\xa7\x85\xad\xa1\x5a\xa7\xe6\x23\x26\xf4\x25\x1d\x83\xcc\x4d\x6e\xaa\xc6\x2f\x56\xc6\x67\x10\x00\x86\x7c\xca\x02\x45\xec\x2b\x1a\x0b\x04\xfe\xf2\x35\xc0\x04\x50\xa9\xf5\xd4\xd9\x07\x0d\x6f\xf5\x3d\x9f\xa7\x17\x8b\x17\x2f\x81\xbf\x85\x61\x88\xc8\x06\x1d\x81\x73\xca\x10\xc0\x24\x2b\x91\x34\x01\x95\xb5\x54\x4b\xe0\xc3\x16\x31\x94\xd9\x71\x72\x26\xa6\x4e\x99\x1d\x61\xaa\x72\xe4\x26\x85\x0d\x7e\x02\xfd\x1d\x9a\x04\x84\xbf\x78\x39\x61\x12\x95\xbf\x7c\x3d\xf9\x8a\x23\xe1\xc5\x91\x07\x3d\x0c\x77\x1e\xa3\x21\xea\x96\xa3\xf7\x39\x27\x5e\x35\x1b\x87\x9a\xfb\x72\x74\x2c\x89\x5a\x9f\x26\xa3\x4a\x56\x7f\x86\xc2\x3f\xa8\xa7\xac\x9f\xa3\xd5\x41\xdd\xd8\x56\xca\x08\xba\x03\x67\xaf\x17\xe0\x64\x31\x03\xcf\xce\x42\xc8\x05\xf6\xc1\xeb\x90\xfa\x37\x60\x21\x24\xf9\x52\x5b\x55\xfd\x0d\x37\x08\xcc\x88\x40\x6c\x0d\x7d\xf4\x1c\x04\x0c\xdf\x76\x5c\x68\x83\x0d\x6e\xa7\xd0\xba\xdb\xee\x81\xee\x05\x62\x04\x86\x0d\x05\x0a\x6d\x28\x0c\x03\x63\x19\x27\xf0\xbc\x80\x70\x10\x31\xfa\x4f\xe4\x8b\xf4\x02\x4a\xa5\x61\x74\xdd\x5f\x2a\xda\x4e\xb4\xec\x31\x8c\x75\xf6\x6b\x5e\x9b\xd7\xd8\x48\x35\xbc\x83\x1b\xf4\x3a\xc6\x61\xd0\x4f\xfd\xa9\x34\x52\x8d\xaf\xda\x5f\xce\x4e\xae\x32\xb9\xc8\x64\xe1\x0a\x6d\x30\x17\x6c\xff\xdc\x6c\x40\x47\xe0\x7a\x8b\x39\xc0\x1c\xc4\x1c\xad\xe3\x50\x01\x58\x49\x74\x30\xd9\x8c\xd5\x5f\xe6\x3e\xd5\x31\x80\xe0\x64\xa6\x72\xc9\xa5\xf2\x90\x8e\x3e\x41\x48\x2b\x97\x28\xe6\x5b\xa0\x66\xa2\xfe\x3c\x3b\xb9\x72\xe3\xc5\x23\xc3\xdd\xca\xa8\xfb\x2b\x58\x6b\xd0\x57\x39\xec\x64\x6b\x17\x64\xc0\xbe\xe9\xd7\xac\xb3\x9a\x6d\xd4\x62\xd3\xd5\x1b\x49\x25\xe5\x58\x92\xe9\x92\x62\x18\x5b\x8d\xcd\x32\x99\xec\xea\xfa\x21\x8c\x74\x69\x21\x77\xbe\x16\xd7\x0e\xa4\xc6\x1c\x3f\x90\x44\x5b\x77\x21\x5e\xa1\x8b\x4b\x85\x02\xb5\xd7\xe0\x9a\xa0\xfb\x15\x32\xc5\x62\x43\x24\x4d\x25\x40\x01\x33\x50\x55\xda\x54\x53\x42\x7f\xbe\xb2\x10\xf9\xaf\x26\x31\x47\x6c\xa3\x52\xfa\x13\x58\x5e\x02\xcb\x14\x26\xa9\x55\xa7\x7a\x70\x76\x2b\x45\xaa\x24\x52\x0d\x8a\xde\x72\x74\x6c\x23\x42\x9a\x57\xd5\x84\x78\xbb\xe4\xaa\xcf\xd7\xf6\xdd\x3a\xfc\x0e\xde\xcf\x19\xae\x17\x17\x5d\x64\x50\x3b\x31\x4a\x40\x80\x76\x90\x04\x20\x52\x50\xac\x63\x50\x72\xaa\xde\x79\x0d\x39\x6a\x5b\x6c\x55\x33\xa0\xfd\x68\x3b\x19\x60\x8e\x98\x8f\x88\x80\x1b\x34\x5d\xd1\x5b\xd4\x63\xbc\x82\x88\x5d\xa9\x2b\x41\x3f\xbc\xf0\x5e\xbe\x78\xf1\xd1\x49\x38\x1b\xbe\xcc\xe6\xf4\xf2\x85\x7d\x56\x52\xb6\xa6\x61\x48\x7d\x65\x0c\x2f\x04\x83\x02\x6d\x3a\x85\x88\x24\xa4\x44\x1d\xcd\x29\xad\xe6\x8c\x77\xa0\xc6\x4b\xef\x55\x37\x62\x58\x3e\xcc\x68\xf1\xaa\xeb\x86\x58\xd7\xfb\x2a\x95\xef\x43\xf2\xe8\x28\x4e\x8d\xd4\x3d\xcc\xc4\x71\x93\xe6\x1e\x70\xdb\xb3\x07\x8c\x3f\x14\xd5\x56\x9a\x09\x2b\x1f\x67\xc5\x97\x93\xf6\x9d\x8a\x9a\x06\xab\xa4\xb8\x96\x46\x59\x8e\x8e\x8b\xe8\x34\xf4\x13\x5a\xbc\x69\x17\xd5\x52\xa1\xc8\xd9\xe9\x97\x4b\xe1\xd2\x18\x20\x0e\x32\xd6\x81\xe4\x6c\x59\xe7\x56\xa5\xb9\xd0\xdd\xd2\x52\x3b\x0d\x60\x55\x0e\x77\x58\x6c\xdf\x51\x1f\x86\xbd\x9c\x09\x85\x0e\x80\x25\x1c\x80\x14\xfc\x50\x23\x92\x4f\x96\x05\x17\x54\x24\x17\xc1\x9a\x14\x93\x4a\xb7\x36\x37\x33\xe0\xe1\x11\xc8\x94\x94\x60\xb1\xbd\xa6\x53\x92\x72\xb1\x85\xac\x5f\xdd\xa1\x99\x8a\xea\xfa\x55\x9c\x0c\x57\xb0\x01\xdc\x51\xb2\x51\xc6\x68\x86\xab\x2e\xf0\xeb\x96\xbc\x3f\xfc\x80\x75\xb4\x72\xd2\xe9\xd9\x2a\xb6\x93\xd8\x2a\xc3\x83\xe8\x4e\x9f\x12\xc1\x68\xc8\x2b\x2b\xaa\x21\x97\xbc\xcd\x21\x6a\x5b\x98\x35\xca\x6f\xf1\x63\xbb\x90\x7e\x48\x7b\x05\xb2\x67\x6b\x20\x77\xac\x3b\xe9\x27\x4b\xf6\x29\x36\x2f\x16\x3f\x96\x74\x7b\xc4\xe8\x2d\x0e\x50\x60\xdc\xe9\x60\x0c\xa8\xd8\x22\x76\x87\x39\x02\x58\xc8\xa7\x78\x43\x28\x43\xc1\x11\xb8\x24\xe1\x1e\x50\x82\x00\x5d\x83\x79\xbc\x0a\xb1\xff\x16\xed\xe7\x50\x6c\xc7\xd9\x9f\x2a\xe7\x38\xfd\xeb\x02\xee\x50\x12\x40\x4c\x33\xf0\xdd\xca\x7a\x1e\xf1\x34\xec\xd1\x30\xe5\xc8\x2c\x78\xa5\x06\xd7\xdd\x73\xab\x84\x76\x3f\xc8\x79\x53\x22\xa8\x49\xdf\x8f\x39\x26\x1b\xb0\x58\x9c\x7f\x7c\x36\xc1\x52\x2e\x83\x58\x65\xc3\x7c\xc5\xf9\xd6\xd3\xb1\x12\xb7\x90\x72\xcd\xb8\xb9\xbd\xbf\x66\x98\xe5\xe8\xb8\x0e\xb7\xfa\x88\x6e\x94\xd0\xb7\x47\x12\xaa\xe6\x11\xb8\x41\x0a\xd1\x15\x92\x1b\x69\x96\x17\xaf\x51\x91\x98\xdd\xa0\xbd\xbf\x85\x98\x1c\x81\xbc\x40\x29\xf5\xa1\x97\xed\x2d\x0c\x63\x94\x97\x13\x27\xc2\x3d\x20\x1a\xcd\xa4\x6b\x71\x82\xdd\x92\x7c\x44\x0a\x39\x26\xaa\x52\xe0\x91\x90\xf2\x21\x51\x6a\x26\xab\x54\x07\x3d\xc8\x7a\xbd\x45\x20\x82\x62\x9b\x60\x2a\x71\x8c\x7a\x89\x88\x51\x7d\xe9\x54\x82\xdc\x9d\xfd\xcb\xd1\xff\x9f\x1c\x71\xbe\x9d\xe0\xe0\x1f\x8c\xc3\xa3\x28\x5e\x2d\x47\x79\x05\x28\x07\xe8\xc7\x94\xcf\x3b\x21\x9d\x1d\x5c\x99\x94\x7e\x7c\x78\x62\x76\xe7\x59\x95\xc4\x2c\xf2\x89\xa1\xb3\x07\x2e\xe6\xec\x6a\x30\xa9\x9d\xbf\x56\x2a\xad\x0a\xb4\x56\x35\x1c\xa6\x80\x75\xef\x1a\xd0\x77\x95\xf6\xa6\xb2\x49\xb3\xb2\xbb\xe2\xd6\xad\x32\x05\xfb\x64\x59\xb8\x40\x2f\xd8\x64\x97\xb3\xd3\x93\x59\x80\x88\xc0\x62\x3f\xd7\x06\x04\x6b\xd9\xeb\x36\x1f\xfa\xe3\x3c\x46\xec\xfd\xd5\xbb\x62\xf8\x1b\x23\x22\x66\xa7\x0e\xa1\xdf\xe4\x8b\x0e\x91\x21\x6d\x83\x9e\x84\x10\xd7\x9a\x1d\x87\x3f\xef\xd1\xa4\x23\xa5\x40\x87\x8f\xbb\x16\xe8\x27\xcc\x51\xb3\x7e\xec\x15\x69\x5f\xb6\x7a\xf2\x20\x82\x31\x47\x4c\xf2\xa1\xb3\x04\x25\x00\x1c\x65\xc8\x49\x2f\xba\xad\xbb\xda\xd9\xd5\x63\x5d\xb3\xa0\xea\x16\x4a\xbd\x2c\x96\x59\x3f\xa0\x36\x55\xba\x2e\x42\xbe\xca\x25\x23\x40\x6a\xb0\xc4\xf7\x61\xf9\xbb\x3d\xcf\xde\x2e\x00\x8c\xc5\xf6\x57\xd2\x21\xfa\xe7\x38\x40\x51\xa7\x46\x88\xc1\x62\xa3\x9e\x7a\x1f\x37\x25\xc3\x0f\x61\x7c\x3f\x65\xd5\x55\xf2\xf9\x8a\x35\xb3\xf8\x9b\xaf\xab\x21\x40\x88\x09\x02\x90\x6d\x54\xd2\x37\x4f\x6f\x4c\x95\xa8\x82\x00\xa2\x1d\x25\x4e\x26\x54\xb7\x11\xac\xab\x2d\xa3\xdb\x8f\x28\xdc\x25\x14\xff\x37\xa1\x9f\x44\x19\xa4\x52\xf2\x30\x14\x2c\x8e\x61\xa5\xa1\x84\x80\x45\xf2\xce\x39\x24\x78\x8d\x78\x7d\x77\xc8\x36\x5e\xfb\x89\x02\xa9\x42\x07\x2a\xb9\x40\xf1\x71\x97\x40\x4e\x0c\xe3\x37\x58\x80\x2b\x14\x51\x40\x89\x0e\x96\x87\xa1\x13\x15\xba\x8f\x62\xa5\x83\xaa\xc3\xea\xe1\xea\xc8\x81\x14\x0c\x39\xf2\x0d\x42\x11\x10\x0c\xfa\x37\x52\x7d\x48\xcc\xfe\xc4\x01\xdf\x13\x5f\xaa\x10\x95\x32\xf0\x77\x6d\xf3\x63\x0e\xa4\xca\xbc\x85\x21\x22\x42\x7e\x69\x6a\x92\x30\xd9\x00\xcf\xdb\x60\xe1\xc9\xaf\x3c\x01\x37\x6a\xa2\xfa\x11\xa1\x02\x71\x8f\xa1\xb5\xf4\x09\x25\x70\x27\xba\x7d\x51\x44\x6b\x2d\x1f\x1e\xc1\xfa\x53\xdb\x16\xe4\x3f\xd1\x71\x5b\x90\xc2\x02\x77\x5b\xc4\x54\xcb\x47\xc3\x76\x2d\x20\xfa\x46\x94\xd2\xca\x00\xe8\x68\x73\x04\xd6\xae\x94\x1c\x6a\xcc\x1a\xb3\x0e\x06\x97\xa4\xfe\x1e\x83\x56\xa1\x4f\xc2\x05\x8b\x7d\xa1\xd1\x10\x14\x48\xa0\x9e\x6a\x2b\xba\xa3\x81\xee\x8b\xa0\x5b\xf2\x29\xfc\x02\x14\x85\x74\xaf\x1c\x59\xc8\xb3\x77\xdd\xc2\x94\x0f\x30\x64\xbb\xcc\x83\x3b\x2c\xb6\x92\xc2\x7d\x09\x96\x78\x52\xdd\xf5\x73\x3d\x94\x8e\x16\x5f\x9d\x8e\xae\xa8\x2f\xeb\xa2\xb2\xd1\xc8\x26\x68\xd6\x8d\x35\x35\x48\xda\x6d\xbb\x83\x58\x78\xe6\x24\x41\x92\xb0\xe8\xc3\x26\xfd\x03\x19\x0a\xa1\xc8\x42\x2c\x34\x59\x52\xaa\x0d\x72\xaa\xd5\xb2\xd3\x9c\x74\x05\x4a\xdd\xc7\x50\x44\x39\x16\x94\xed\xa5\x56\x92\x5a\xab\x7d\xd9\xd2\xe7\xc7\xac\x60\x53\xce\x2d\xad\xc9\x1a\xee\x5c\xa2\x71\xe4\x54\xd8\xe3\x24\x93\x19\xf8\x41\x78\x9e\x75\x2d\xab\x76\x2c\x4b\x73\xb0\x5b\xf3\xa9\x1d\xb4\x22\x6d\x75\xd5\xb5\xd1\xe9\x6e\x56\xfb\x19\x09\x22\x8a\x89\x58\xe8\x3e\xc3\x1d\xad\xcf\x71\xf1\x57\x6b\x19\x7f\x92\x50\x58\x25\x49\x86\x56\x96\x14\x56\xfd\x31\xa4\x1b\x3e\x2a\x3c\xfc\x38\x80\xd1\x9b\x91\x3b\x77\x7c\x8f\x0c\x51\x92\xee\xcb\xa6\x38\x76\x17\x73\x01\x56\x08\x24\x0d\x5e\xa5\xa9\x9a\x74\xfa\x4a\xd2\x5a\x75\xdf\x08\x44\x04\x53\x30\x4d\x43\x8d\xe2\xc4\x93\x3b\x6f\x72\xd3\x4d\x1e\xc9\x49\x3a\x5f\x76\xf3\x19\xe6\x90\xef\xfd\x50\x9c\x4c\xc3\x85\x32\xb9\xf9\x35\xbc\x25\xa7\x5c\xba\x5c\xc6\xba\x49\xa2\xfe\x7d\x84\x93\x24\x7c\xb5\x8b\x2b\xdd\xb7\x06\x50\xe5\x2d\xef\x93\xd6\x6e\xdd\x7a\xee\x75\x85\xdb\x60\x1e\x38\x69\x34\x54\xad\xcf\x6f\x58\xe2\x83\x68\x3d\x55\xc1\x88\xea\x52\x8d\x0e\xcd\xfe\x10\x45\xbb\x41\x2f\x69\x45\x55\xe1\xd8\x46\x1d\xd2\x58\x44\xb1\xe8\x79\x60\x74\xa9\x80\x80\x00\x33\xd5\x0f\x61\x9f\x7a\xb2\xc9\x95\x29\x81\xfc\x9f\x44\x09\x08\x73\xe1\x23\x07\xcf\x36\xaa\xfd\x8b\x40\xe9\x6f\xc6\x2d\x76\x3b\xf4\x7d\xd0\xb1\x73\x42\x7a\x34\xf9\xfe\x5f\x31\xf6\x6f\xb8\x80\x4c\x78\x72\xd3\xf7\xa4\xb1\x56\x73\x38\xcc\x90\xee\x37\xd3\x83\xa8\x74\xad\xa6\xf1\x9f\x72\x50\xb0\x90\xa3\x26\xc8\x1e\x81\x13\x7d\x9a\x0f\xc1\x8a\x41\xe2\x6f\xc7\x40\x7a\x70\x94\x01\x6d\x72\x82\x2d\x74\x2c\x5a\xec\x3b\x96\x95\x06\xfa\xc4\xa6\x07\x05\x54\xe2\x02\x65\xe0\xfd\xd5\x3b\x50\x8f\xa1\xd3\x44\xbb\x80\x34\xd5\x18\xbc\xda\x9d\x07\x46\x91\x17\xa0\xdb\x21\xba\xea\x18\x62\xd9\x44\x68\x6c\x5d\xad\x43\xdb\xec\x01\x12\x10\x87\xaa\x59\x3b\x04\x99\xa4\x67\x8b\x87\x9a\x8d\x53\xbe\x51\xb6\x85\x61\x10\xe4\x43\x37\x99\x49\xdc\xc9\x48\x7f\x28\x54\x0a\x3a\xf2\x0a\x45\xb4\x8d\x82\xd4\x52\xdf\xf3\x34\x7d\x83\x85\x59\x3e\x20\x26\x01\x62\x49\x9b\x2b\x83\x77\x49\xcd\x63\xb9\xa1\xde\xe1\x30\xd4\x19\x3b\x72\x99\x49\xef\xe4\x3f\x54\xc4\x0c\x05\x63\x1d\x84\xd8\x41\xe7\xcd\x7a\x38\x54\xe0\x2e\xfa\xbb\x15\x1d\xbb\xfd\xb2\x83\xb8\x6f\x94\x4e\xc1\x30\xc8\x26\x08\x25\xfe\x99\x51\x45\xfe\x16\x92\x8d\x63\xe5\x86\x23\x68\xeb\xf4\xd6\x61\x7c\x3f\x40\xca\x45\xb6\x85\xe5\x19\xa3\x22\x32\x4d\x5c\xb9\x63\x92\x27\x64\x9c\x85\xa6\x26\xce\x42\x31\xe0\xd0\x56\x0a\x45\x50\x6c\xbf\x5c\x74\xff\x4a\xba\xf7\xf8\x56\xe7\x80\xe8\xeb\xab\x4c\xde\x68\xc9\x7f\xd7\xd3\x36\x3f\x5c\x46\x3c\x8b\x04\xa8\x19\xef\x28\x91\xef\x49\xb1\x58\x63\x12\x80\xb7\xf1\x0a\x31\x82\xa4\x41\x51\x88\x60\xc3\x28\x0a\xf7\x86\x28\x1f\x96\xaa\x79\x92\xc7\xf7\x5c\xa0\xdd\x72\x34\x06\xcb\xd1\x0a\x72\xb4\x1c\x39\xd6\x2d\x7c\xc9\x39\x68\x1f\x25\x37\x8f\x24\x97\x45\xff\x2b\xe7\xa3\xff\xf7\xb1\xb1\x99\xf6\x62\xf1\x63\xff\xe4\xa4\x79\x2e\x8f\x27\x31\x82\x4d\x9e\x4e\x72\xc0\x27\xd1\x8f\xc5\x16\x11\x81\x7d\x28\xdc\x0c\x84\x0e\xe0\xad\x53\x8e\x59\x1f\x85\x77\x6d\xf8\x2a\x47\x96\xa6\x8a\x41\xa8\xc2\x66\xc5\x52\xd3\x19\xa9\xb0\x13\x16\x56\xad\xb3\x3a\x78\xa8\xa1\xeb\x2d\xa9\x0d\x16\xff\x3b\x6b\xe1\xf4\x1d\x65\x9b\x89\x1c\xb1\xc6\xb2\x2a\x1d\x82\xf7\xdc\x59\x24\x88\x87\xd9\x58\xda\x43\xee\x68\x35\x4a\x29\x1b\x57\x6c\x95\x8a\xe2\xb5\xed\x55\x65\x1a\x56\xb6\xeb\xc6\xf5\x3b\xb4\xf5\x79\x30\x2e\x0b\xcb\x7a\x2e\xbd\x3c\x4f\xab\xb9\x87\x89\x06\x1f\x1e\xb5\x60\x53\x2e\x90\xcf\x90\xe0\xa6\x45\x62\xab\x4a\xdb\x1b\xb4\x9f\x5e\x5d\xb4\xcf\xb3\x32\xef\x3f\x48\x70\xb8\x0e\x97\xe1\x63\x24\x6f\xcf\x17\x00\xa5\x54\x4a\x33\x34\x06\x8a\x91\xd4\x41\x2f\xf0\xea\x67\x14\x86\x6f\x09\xbd\x73\xeb\x54\x34\x48\x3f\x1b\xd5\xc4\xa1\xd0\xab\xa1\xda\x74\xe6\x08\xa8\xbb\x7e\xb3\x07\x2d\x6f\xfb\x45\x37\x3c\xb9\xc9\x2c\x57\x57\x5c\x05\xaf\xca\x9e\xdd\x62\xad\xed\xd1\x6e\x57\x07\xed\x82\xea\x72\x74\x6c\x21\xc5\xf7\x13\xd8\x70\x1f\x77\xc3\xa9\x23\xbc\xe3\xf9\xc6\x99\x27\xba\x2c\x66\x70\xb6\xea\x2a\x02\x55\x8e\x70\xc7\xbd\x90\xc2\xc0\x4b\x6e\x51\xf5\xfc\x74\x4c\xc3\x6a\x89\x10\x48\x30\xea\xca\xe9\xc6\x71\x06\xe1\xb9\xcb\x9c\x7a\xc8\xc1\xc1\x89\x2c\x47\xc7\x55\x8a\x75\x16\x88\x81\xba\x39\x29\x72\xe5\x7b\x0a\xa5\xb4\x33\x4c\x2e\xfc\x56\xe4\x71\xa7\x56\x44\x5d\xd8\xd9\x80\xdf\x40\x0d\x92\x96\xa3\xe3\xca\x44\x3b\xb3\x66\xc8\x06\x3d\xc5\xae\x39\x0d\xdd\x72\x0c\xbb\x0a\xef\x17\xd9\x95\x6b\x38\x7a\x93\xfa\x50\x1e\xc7\x1b\x3e\xc9\x7f\x35\x59\x85\x74\x35\xd1\xc1\x11\x4d\x3c\x11\x0b\xca\x30\x0c\xb9\x94\xf1\xa3\x5d\xd0\x85\x85\x8e\xf3\x68\x6c\x97\xda\x0f\xfb\xe5\xe8\xb8\x42\xa4\xce\xac\xfe\xd2\x5d\x85\xdc\x18\x31\xc8\x20\x43\x1d\x83\xb9\x35\xe3\xa9\xdf\xff\x6a\x16\x5e\x8d\xaa\x1c\xc4\x54\x54\xc7\x5a\xaa\xcc\x56\xee\x2c\xd2\x31\xa2\x24\xeb\xca\xe7\xd2\x20\xe7\x30\xa4\x82\x09\x98\x2d\x82\xdf\xee\x10\xbc\x45\x77\x94\xdd\xf0\xdf\xf4\x1d\x74\xbf\x45\x37\x9b\xdf\x62\x81\x43\xfe\x1b\x8e\x08\x12\x47\xb3\xf9\x45\xb1\xcb\x73\x8d\x97\x59\x91\x45\x02\x66\xf3\xe4\xda\x6f\x80\x09\x38\x99\x9d\x5e\x01\x42\x85\x5b\x6e\xc4\x01\x30\x7f\x48\x24\xe6\xf7\x3f\xfc\xfe\x87\xff\x0e\x00\x00\xff\xff\x74\x92\xb6\x95\x25\x46\x01\x00")
+var _schemaJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\xff\x73\xdb\xb6\xf2\xe0\xef\xfe\x2b\x30\xea\x9b\x7b\xc9\x8c\x24\xd7\x79\x7d\x69\x9b\xeb\x79\x46\xb1\xdd\x54\x97\xc4\xd6\xc7\x72\xda\xbb\xc6\x99\x67\x88\x84\x25\x7c\x4c\x11\x7c\x00\x68\x47\x6d\xf3\xbf\xdf\x2c\xbe\x90\x20\x09\x7e\x93\x94\x2f\x6f\x2e\x93\xce\x54\x26\xc1\xc5\x62\x77\xb1\x58\x2c\x76\x17\x7f\x1e\x20\x34\xf8\x1b\x27\xb7\x83\x67\x68\xf0\xcd\x61\x48\x6e\x69\x4c\x25\x65\xb1\x38\x3c\x89\x52\x21\x09\x3f\x61\xf1\x2d\x5d\x0e\x86\xd0\x50\x6e\x12\x02\x0d\xd9\xe2\xbf\x49\x20\xf5\xb3\xbf\x89\x60\x45\xd6\x18\x1e\xaf\xa4\x4c\x9e\x1d\x1e\xfe\xb7\x60\xf1\x48\x3f\x1d\x33\xbe\x3c\x0c\x39\xbe\x95\xa3\x6f\xbf\x3f\xd4\xcf\xbe\xd1\xdf\x39\x5d\x0d\x9e\x21\xc0\x03\xa1\xc1\xe4\xf7\x79\xba\x88\x89\x7c\x8d\x93\x84\xc6\xcb\xec\x05\x42\x03\x1c\x86\x0a\x31\x1c\xcd\x38\x4b\x08\x97\x94\x08\xe7\x7d\xed\x30\x2c\xc8\x79\x42\x82\x81\x69\xfc\x61\x68\x7e\xf8\x46\x04\xff\x06\x21\x11\x01\xa7\x09\x74\xa8\x46\xc6\xa2\x50\x20\xa1\x70\x43\x92\xa1\xc9\xef\x68\xad\x51\x14\x63\x34\xbd\x45\x72\x45\xd0\x1d\xd9\x20\x2a\x10\x8e\xd1\xe4\xf7\x21\x92\x2b\x2c\x11\x8e\x04\x43\x0b\x12\xb0\x35\x11\xaa\x4d\x8c\xd7\x04\x31\xdd\xde\x40\x63\x72\x45\xf8\x03\x15\x04\xa5\x82\x64\x80\x24\x43\x9c\xdc\x12\x0e\x9d\xc9\x15\xb5\x7d\x8f\x73\x0c\xdf\x8f\x68\x2c\x49\x14\xd1\xff\x1e\xad\xe4\x3a\x1a\x7d\xf9\x18\x87\xe4\x16\xa7\x91\x1c\x3c\x43\x83\x3f\x3f\x0c\x0e\x1c\x46\x64\x7c\x57\x4c\x72\x98\x9e\xd4\xb0\x1a\xff\x51\xf8\xdb\x61\xa4\x90\x1c\x04\xc7\x76\xea\x63\x66\x80\x63\xb4\x20\x88\xad\xa9\x94\x24\x44\xb4\x4a\x8c\xe2\xe7\x2d\x94\xee\x00\x2e\x83\x96\x09\x1e\x42\x83\x80\x86\xbc\x3c\x0a\xbf\x08\x2f\xa9\x5c\xa5\x8b\x71\xc0\xd6\x7f\x3d\x10\x7c\x4f\x1e\x18\xbf\x13\x7f\x91\x3b\x11\xc8\xe8\xaf\xe4\x6e\xf9\x57\x2a\x69\x24\xfe\xa2\x09\xd0\x7b\x3a\x3b\x27\xd2\xdf\x23\x0d\x5b\xa8\x96\xbd\xfa\x70\x50\xfa\x7a\x90\x28\x71\xe4\x24\xbc\xe0\x21\x01\xbc\xdf\x9a\x37\x1a\xae\xd3\x0b\xfe\xc3\x21\x9f\x1e\xa5\xf9\xf3\xdd\xb0\x65\x32\xdf\xe2\x48\x90\xa2\x60\x84\x21\x8b\x1d\xac\x07\x9c\xfc\x3b\xa5\x9c\x84\x45\x0c\x60\x5e\x55\x7b\xa9\x95\x1e\x29\x71\xb0\x9a\xb1\x88\x06\x9b\x6e\x1c\x98\xc6\x11\x8d\xc9\x29\x0b\xd2\x35\x89\x65\xa3\x74\xe9\x89\x87\x51\xa2\xc0\xa3\xd0\x7c\x03\xd3\x42\xf7\xdb\x4b\xb8\xda\xa1\x65\xc0\x3e\x0c\xfd\x23\x9c\x5c\x9e\x17\xc7\x0f\x1c\x93\x64\x5d\x7e\xd8\x20\x0e\x05\xe0\x4e\x3b\xcc\x39\xde\x34\x52\x23\xa2\x42\x82\xc2\x03\x24\xac\x1a\x99\x4e\x5e\x6b\xea\x50\x22\x9c\x81\xf4\x21\x4b\x0f\xb0\x07\x9e\x21\x68\x79\x29\xd1\xa4\x6e\xf0\xee\x77\x09\xe1\x6b\x2a\x04\x2c\x2c\xcf\x59\x1a\x87\x98\x6f\x5a\xc0\x34\x11\x67\x72\x79\x6e\x91\x77\x00\xa3\x85\x81\xac\x06\x21\x04\x0b\x28\x96\xa4\x17\x79\x7a\x01\xf6\x0e\x54\x10\x7e\x4f\x03\x32\x09\x02\x96\xc6\xf2\x92\x45\x64\x72\x79\xde\x32\x54\x2f\x20\x89\x97\x15\xe9\x6b\x5d\xca\x1b\xa1\x17\xe0\xd7\x2f\xe1\x3e\x82\x5f\xad\x08\x5a\x13\x89\x43\x2c\xb1\xa2\x6e\x92\x44\x8a\x1a\xc0\x82\x40\xdb\x3b\x86\x38\x20\x60\x0f\x54\xae\x50\x80\x25\x59\x32\x4e\xff\xc0\x00\x05\xe1\x38\x44\x8c\x2f\x71\x6c\x1e\x8c\xd1\x19\x0e\x56\x48\xe2\x25\x0a\x58\x2c\xa8\x90\x02\x78\x8a\xd5\xe2\x0a\x8d\x71\x8c\x98\x62\x0c\x8e\xd0\x3d\x8e\x52\x32\x44\x0b\x26\x57\xd0\xe8\x61\x45\x83\x15\xda\xb0\x14\x29\x5d\x43\xc6\xbd\x98\xfc\x9f\x35\x18\xcf\xe2\x5f\x16\x95\x7b\xc2\x61\x02\x94\xa5\x65\x3f\x6b\x94\x9a\xf1\x9e\xce\x5a\x65\xbe\x49\xab\xd6\xbc\x73\x9f\xfb\x34\x86\xf3\x5a\x4d\x8f\xca\xc2\xd5\xb4\x3c\x0e\x0f\xfc\xb2\xad\x57\x0a\x10\xe4\xb3\x97\x73\x84\x61\xdd\x04\x89\xbc\xa5\xcb\x94\x2b\xe6\x66\xdd\xb6\x09\x56\x3b\xa4\xc2\x12\x7d\x82\x63\xcc\x37\x66\x9b\x90\xf3\xae\x76\xf5\x55\x96\x39\x8e\x4e\x89\x30\xeb\xb8\x97\xdb\xa0\xdf\x96\x84\x37\x4e\x67\xaa\xb1\x0c\x35\x24\x14\xe0\x04\x07\x54\x6e\xd4\xc3\x98\x85\x64\xc9\x59\x9a\x80\x85\x1b\x70\x82\xc1\xd4\x83\x09\x3d\x44\x0b\x72\xcb\x38\x41\x22\xc0\x11\x8d\x97\x88\xaa\xd5\x94\x4a\x51\x01\x34\x46\xa7\x5a\x6a\xd5\x32\x75\x73\x74\xd3\x6b\x7e\x7e\x5a\xec\x7e\x0a\x58\x48\x8e\x8f\x7e\x3a\x54\xff\xaf\x9b\x7b\x47\xd9\xe3\x6c\xd6\xc0\x5c\xc0\x11\x0d\x15\x67\xaf\xe8\x9a\xb0\x54\xb6\x4c\xc1\x0e\x3c\x91\x74\x4d\x10\x8e\x22\xf6\x40\x42\x74\xcb\xb8\x7a\x68\x38\xaf\x46\xaf\x48\xaa\xb7\x46\x88\x13\x1c\x96\x86\x63\x61\xb0\x54\xda\x95\x2c\x60\xeb\x35\x8e\xc3\x6d\x78\xf0\xa9\xb0\x21\xef\xf1\x3a\x89\x88\x28\xa8\x1e\xf8\x6f\x70\xf4\xed\x3a\xd7\x5c\x08\xbd\xdb\x52\x8b\x95\xe6\x4e\x23\x0f\xf7\xac\x55\x0a\x1a\x40\x11\x51\xcd\x2a\x90\x51\xec\xca\x73\x8c\x02\xa5\x10\xd0\x9a\x85\xb9\xc6\xed\xae\x73\xb6\xeb\xa7\xa4\x91\xf4\x0c\xb9\x24\x60\xc6\x60\xd3\x47\xbb\x62\x6a\xdb\x1e\x35\xc9\xbd\x15\x0b\x3b\xcb\x79\xde\x37\x48\x50\x84\xd3\x38\x58\x65\x73\x5f\x20\x1a\x4b\x36\x46\x53\x09\xbf\x84\xc4\x71\x40\x10\x2c\x74\x6a\x49\xc6\xf7\x98\x46\x78\x41\x23\x00\xf4\x07\x8b\x09\x5a\xa7\x42\xc2\x9e\x15\x08\xc4\x62\x92\xd9\xbc\x19\x3d\x7a\xcd\x8a\xcf\x8d\x6b\x86\x6a\x26\xf4\x99\xd8\x93\x38\x68\x33\xcc\x0b\x23\x25\x71\xba\xae\xce\x36\x96\x10\x77\x65\x87\x7f\x83\x98\xc5\x8e\xad\xeb\xcc\x0b\x1f\x37\xa9\x40\x37\x00\xe4\x66\x58\x4b\x10\x84\xe3\x0d\x82\x36\x7e\x3a\xae\xb1\x0c\x56\x30\x39\xe4\x8a\xac\x87\x88\x71\x74\x03\x18\xf4\x5e\x42\xb4\x5e\x87\x7e\x8c\x6a\xdf\x23\x46\x1a\x36\xa0\x65\x60\x3b\x9c\x39\x28\x71\xa8\x59\x2d\x85\x03\x3f\x27\x0f\x4a\xb4\xde\x4a\x07\x49\xcc\x97\x44\xc2\x2e\xd8\x3b\xae\xc5\x46\x2d\x8f\xd3\x53\x35\x26\x01\x2d\x6b\xa5\x3b\x47\xcd\x95\x4a\x31\x46\x17\x71\xb4\x41\x20\xbd\xfa\xf1\x1a\x19\xaf\x8e\x20\xf9\x8e\xa2\x8d\x5b\x9f\x1b\xcf\xa2\x0e\x34\xde\xdb\x88\xa5\xe1\x6f\xc0\xf9\x2e\x1a\xd0\x6c\x81\x5e\xb1\xe5\xb2\xe8\x7d\x45\xa8\xd5\x4d\x9c\x75\x64\xbf\xde\x72\x89\x2b\xe1\xb0\x17\x09\x0a\x58\x2c\x31\x8d\x85\x59\x5c\x50\x82\x39\x5e\x13\x49\xb8\x40\x9c\x44\xca\xf8\x92\x0c\x39\xb4\xea\xca\xf2\xde\x80\x9b\x79\x54\x25\x7c\x2d\xab\x48\x8c\x17\x11\xb9\xda\x24\x64\x4b\xe7\xce\xb0\xf8\xd6\xab\x48\x81\xdc\x09\x2d\x35\x85\x87\x69\x48\xa5\xef\xb1\x5c\x91\x58\xd2\x00\x4b\x56\xb4\xdc\xe1\x9f\x22\x16\x67\x51\x44\xf8\x6b\x1c\xe3\xb2\x71\x0f\xff\x06\x70\x42\x10\xa6\x11\xc9\x5c\x86\x86\xfb\xce\x5f\x1f\x86\xbe\xb5\xa1\xdd\x13\xa5\x48\x05\xd3\x26\xd2\x44\x06\xc6\x68\x22\xa2\x47\x82\x10\xf4\x36\x67\x03\xb8\xd9\xc4\xbb\x47\x87\xa9\xc0\x4b\x72\x18\xc0\xf3\x07\x78\x3e\x32\xb2\x39\x32\x20\x0e\xbf\x31\x0f\xb4\x58\x8d\xac\xf9\xf7\xf8\xf1\x18\xfd\x0a\xf6\x18\x22\xb1\xe4\xe0\xe5\xc2\x9c\x3c\x43\x37\xd7\x40\xcd\xeb\xc1\xcd\x50\xfd\x04\x1a\xe6\x7f\x38\x94\xb3\x0f\x2b\xf4\xb2\x2f\x32\x2a\x5d\x0f\x6e\x7a\xfa\x0c\x5a\x88\xf0\x13\x46\x2b\x4e\x6e\xff\xd7\xf5\x60\xeb\xc1\x5f\x0f\x8e\x4b\x94\xfc\xe9\x10\x1f\xfb\x29\xa2\x17\xa0\xff\xf1\xef\x94\xc9\xff\x89\x13\xaa\x7f\x64\xeb\x5c\xe1\x2d\x50\xab\xf1\xbd\x43\xc0\x86\x76\x15\x9a\x36\xb4\xcd\xc8\x5c\x68\x33\xde\x56\xb1\xb9\x33\x76\x9f\x5a\x8d\xf0\x66\xed\x63\xd8\x64\x59\xde\x57\xb7\xf5\x05\xef\xd5\x70\x15\xe7\x80\xdf\x8d\x6f\xdd\x59\x8e\x4c\x0f\xee\x68\x61\x97\x05\x53\xe8\x57\xe3\xbb\xa9\x50\xb1\x4e\x59\x2a\x1f\x46\x57\x3d\xe9\x5f\xe6\x26\x00\x22\x67\x7d\xb3\x1e\x3a\xf0\x34\x72\x11\x2f\x21\xd2\xa0\x99\x6b\x0c\x5c\x7d\xf6\x33\xa6\xec\xf0\xfe\x08\x47\xc9\x0a\xff\xb3\x66\x77\xe9\xf6\xef\x58\xea\xbf\x83\x61\xde\x91\x1e\x25\xec\x76\x21\x41\x90\x29\x86\x2d\x6d\x8b\x22\x6d\x4a\x02\x3b\x2f\x69\x71\x91\x26\x09\xe3\xb2\x8b\x22\x7f\xdc\x4b\x8b\xce\x7b\x6a\xca\xa2\x4a\x34\x68\x81\x56\xf4\x53\xe9\x16\xf3\x25\x96\x64\xc6\xd9\x2d\x8d\xc8\x6e\x62\xfb\x73\x01\x56\xde\xdf\x16\xcc\x5b\x52\xd9\x8d\x6b\x2f\xa8\x6c\xe4\xd3\xcf\xaf\xde\xfc\x1f\xf4\xeb\x11\x3a\x3d\x9b\x5d\x9e\x9d\x4c\xae\xa6\x17\xe7\xe8\xfc\xe2\x6a\x7a\x72\x36\x46\x10\x42\x20\x9e\x1d\x3a\x47\x9e\x87\xf9\x91\xe7\xa1\x16\xfb\x43\x2a\x44\x4a\xc4\xe1\x93\x1f\x9f\xfe\x03\xbd\xa0\x12\x91\xf7\x09\x13\x44\x78\x5c\x07\x3f\x47\xe9\x7b\x74\x7f\x64\x7d\xd7\x04\xf3\x88\x12\x8e\xa8\x24\xa6\x11\xbb\x45\x4b\x2a\x59\x22\x7a\x09\xc0\x97\x39\x82\x3a\xae\xb1\xa4\x2c\x2e\xf5\x8c\xbb\x48\x44\x23\xef\xda\x10\x7d\xa2\x10\x7d\xa0\x51\x04\x63\x91\x34\x4e\x09\x2c\x12\x0b\x15\x2b\x10\x82\xd7\xe6\x36\x95\x29\x27\x06\x67\x94\x44\x38\x16\x43\xc4\x49\x12\xe1\xc0\x6c\x4e\x15\x45\x8a\x1d\xe0\x05\xbb\x27\xbd\x58\xf4\x59\x11\xf5\x72\x82\xe2\x75\x2f\xad\x37\x9d\xbc\xf6\xb3\x94\x86\x60\xe9\xc8\xcd\x8c\xb3\x7b\x1a\x12\xbe\x9b\x86\x98\x96\xa0\xe5\x7d\x6e\xa1\x23\xd4\x62\x5d\xc2\xa6\xb4\x7e\x74\x58\xdd\xac\xda\x57\x94\x6d\x5f\xd8\xee\xd2\x05\xe1\x31\x91\x44\x9c\x13\x09\xd3\xac\x72\x16\xd1\x30\xfc\x97\x35\x1f\x7b\x7b\x5a\xab\x7d\x4b\x78\xce\x42\xf2\x02\xbc\x90\xbb\x51\xfe\x75\x09\x9a\x3b\xd2\x0f\x43\x1f\x09\xdb\x77\x39\xb0\x34\xbd\x3d\xb7\x9e\x36\x81\x94\x15\x9f\xad\x80\x0a\x7f\x1a\x2f\x47\x99\x2f\x4e\x3c\x56\x13\xf6\xad\x19\x59\xee\xa4\xcb\xf7\x3f\xe4\x4e\x8c\xcc\x6b\xf5\x9d\xd8\xc7\x6a\xe9\xc1\xe4\x7a\x70\x5c\x46\x1c\xd6\x48\x85\x5f\xe5\xfb\x2a\x52\xd7\x83\xe3\xea\x20\xea\x17\xd9\xcc\xd4\xec\x24\x25\x46\x22\x5f\x13\x89\xfd\xe0\xe2\xfd\x88\xc4\x5e\x65\xe1\x67\xc6\x11\x8d\x6f\x19\x5f\x1b\xdd\x14\x87\xc8\xee\xd2\x90\xda\xf2\x7a\xb8\xed\x13\x91\x5e\xec\x6e\xed\xb5\xa3\x2c\x74\x61\x62\xc2\xe9\x3d\x96\xc4\x70\xa7\x1b\x2b\x67\xc5\x6f\x9a\x08\xa8\xce\xaf\xf2\x25\x04\x96\x27\x8c\x6e\xd3\x28\xda\x8c\x4c\xcf\xd9\xee\x87\xc6\xe6\x00\x3c\x66\x6a\x0e\xa1\x15\x16\x88\xa5\x52\xc5\x72\x80\xbf\x58\x29\x19\x84\x83\x80\x08\x31\x54\x32\x6d\x41\xe8\x67\xb0\x4a\x4e\x7e\x9b\x23\x73\x08\x2d\xe0\xd8\x52\xef\x18\x43\x74\x4f\x31\xfa\x75\x76\x82\x48\x1c\x26\x8c\xc6\x52\xf4\x62\xc8\x97\x3b\x0a\x2f\x4f\x05\x09\x38\x91\xe2\x2c\x0e\xf8\xc6\x8e\xa1\x03\x5b\xe7\x95\xcf\xbc\xd0\xef\x93\xa0\x1b\x3c\x23\x1f\xbf\xce\x4e\x1c\x34\x0f\x4a\x00\x1b\xf7\xfb\x0d\x1b\x57\x9f\x1e\xea\xb0\xa0\x39\x4d\xc0\x98\x68\x34\x09\x9c\x97\x30\xe6\x61\x65\x33\xec\x3c\x49\xea\xa6\x84\xab\xd6\x9c\xa7\xeb\xd2\xc2\x25\x06\x0d\xbb\x97\xc6\x1d\xa8\x7f\x6f\xd8\x28\x0d\xce\xcb\x65\x61\xa3\x61\x4d\xdd\x8a\x57\x60\x1b\xdf\x0a\x46\x82\x82\x3b\xcb\x4c\x9b\xa1\xb1\x0d\xb5\x9d\x6a\xce\xea\x91\x21\x18\x9a\xcc\xa6\x19\x1e\xad\xb3\x71\x07\xc0\xb9\x5c\x8c\x94\x66\x1c\x99\x20\x96\x91\x31\xbb\x72\xe1\x2b\x08\xb8\x6a\x3b\x78\xe6\x78\x0d\x32\xa0\xa5\xb8\x9b\x41\xe6\x4d\x28\x34\x30\xe0\x4b\xde\x9c\x8a\x1b\xec\x9d\xcf\xf5\x73\x96\xcd\xf6\x0e\x4e\x6d\x23\x88\x13\xa5\x11\xcb\xf3\xd4\x2e\x7c\x0b\xc6\x22\x82\x6b\xe6\x77\x92\x2e\x22\x1a\xf4\x05\x70\x50\x02\xd4\x38\xaf\x8b\x48\xd6\xf5\xbd\x17\x29\xd4\xa7\xe2\x56\x3b\xe3\x84\xaa\xe5\x81\xf0\x4c\x87\x5a\xb5\xeb\x2c\xb8\x9d\x25\x71\x2b\xe0\x3e\x16\xc3\x46\xa5\x03\x73\xad\x62\x60\xe1\xd9\x7b\x12\xa4\x00\xae\x5b\x5c\xa1\x1d\x90\x8f\x42\x9c\x45\x66\xc7\xb6\xd8\xa0\x84\x41\xac\x02\xb3\x78\xc3\x42\x34\x99\x4d\xc5\x18\x5d\x41\x04\xbd\x6a\x0a\x21\xd9\x61\xa8\x3d\x97\xb0\xd5\xcc\xcd\x7f\x74\xf9\x7c\x72\xa2\x36\x88\xe0\x8c\xcf\x62\xe4\xc6\x48\x99\xd4\x33\x16\xa2\x0c\x6d\x04\x78\xbf\x7b\x64\x77\xfa\x21\x0b\xc4\x18\x3f\x88\x31\x5e\xe3\x3f\x58\xac\xb6\xfc\xe4\x4e\x1c\xc2\xc1\x92\x90\x87\xa9\x20\x7c\x99\xd2\x90\x1c\x26\x2c\x1c\x11\x0b\x64\x04\xf8\x8c\x41\x45\xf4\xb3\xaf\x3e\xd1\x88\x73\x2b\x6d\x5f\xc3\xbc\x1e\x1c\x57\xa9\x58\x6f\xdb\xd5\x88\xcb\xcc\x13\x4f\xb7\xbd\xf8\x78\xa3\x63\x6d\x80\x90\xc1\x00\x88\x8c\xb2\xf1\x28\xa2\xde\x18\xa9\x80\xf8\x38\xe3\x61\x43\xf3\x92\xb7\xd1\x7c\x3d\x32\xee\xbe\x9e\x9b\xa6\xdd\x10\xab\x98\xd8\x65\x64\xae\x07\xc7\x1e\xdc\xeb\x99\x51\x0c\x8d\xdc\x6d\x8f\x93\x6b\x8d\x79\x01\x6a\xde\x73\xa1\xef\x5e\x5b\x1e\x83\x27\xcc\x07\x85\x28\x08\xbd\x0a\x1f\x22\x60\xdb\x3a\x81\xb1\x86\x81\xd3\xc9\x6b\x64\xb0\x40\x76\x70\xef\x1e\x1d\x52\xbc\x36\x90\x2c\xa0\xc3\x6f\xd4\xbe\x75\x04\x11\x84\x23\x73\xe2\xa5\xbc\xb3\xfd\xd8\xda\x13\x3f\x87\x8f\x3d\x50\xba\x1e\x1c\xfb\xc6\xd5\xca\xdd\x6e\xda\xb8\x0d\xc2\x27\x9a\xa0\x38\x8a\x90\xb5\x7a\x47\x0b\x0c\xfa\x50\xfd\x41\x49\x1e\x50\xb9\xd8\x20\x63\xf2\x28\x6a\xbe\x05\xf5\x98\xa3\x87\x2c\x7a\xcd\x9a\x7c\x3a\x79\x6d\x55\xdc\x1b\x41\xf8\x0b\xa5\xe2\xf4\x0a\xf3\x2f\x9b\x6e\xf0\x2f\x83\x1a\x25\x62\x0b\x8d\xbe\xcf\x31\x76\x53\xdb\xdb\x8c\xe9\x7a\x70\x5c\x43\xbf\x7a\xc1\xba\x4f\x82\x4b\x22\x58\xca\x03\x72\x92\x1d\xbc\xfa\xf3\x6e\xca\xc6\x59\x93\x50\xe8\xcc\x0e\x22\x8a\x69\x1f\x1b\x14\x13\xe0\x8a\x49\x70\xe0\xa9\x9e\x50\xb0\xe5\xcc\x4f\x7d\xb3\x69\xa6\x9f\x28\xff\x73\x3f\xc7\xf2\xc7\xed\x3c\x0f\xd5\x95\x3c\x25\x5e\xa2\xc2\x7c\xbf\x98\x9e\x9e\xec\x42\x41\xbd\x27\xcf\xc7\x00\xf0\x50\x62\x36\x8f\x08\x0b\xf4\x40\xa2\x08\xfe\x3f\xbd\x9c\x4f\xb2\x75\x67\xa2\x24\x08\x9d\x9c\x4f\x51\x12\xa5\x4b\x1a\xf7\x22\xdc\xbe\xfa\xdc\xd2\x6c\x2f\x29\xb9\xee\xca\xcb\x69\x59\x63\x93\x94\xe0\xd5\xb4\x6a\x81\x9d\xb1\xb5\x8a\x99\xd5\xe0\x83\x8e\x53\x6b\x8f\x7b\x0f\x50\xb3\xc0\x2c\x2c\x25\xa7\x8b\x54\x12\x93\x10\x62\x96\xa9\x0c\xa3\x8e\x79\x6c\x2d\xd0\x6a\x76\x17\xca\xed\xda\x61\x87\x81\xe3\x98\x49\x5c\x4c\x29\x6e\xa6\x80\xdb\xa6\xba\x30\x39\x2f\x3f\x0c\x7d\x53\xcd\x9f\x72\xd4\x9a\xe8\x12\xe1\x05\x89\xbe\x6c\x14\xb7\x4d\x90\x83\xef\x44\x82\x83\xee\x1f\x1f\x94\x80\xf4\xca\xe2\xc9\xbb\xab\x92\x77\xe8\x17\x8c\x3d\x4e\x0e\x67\x63\x8c\x1e\x20\x92\x33\x86\x8d\x99\x63\xd3\x5d\x28\xe2\x83\xf8\x2a\x1d\x5a\xb6\xfe\x7a\xce\x9e\x9d\xbb\xab\x99\x5e\xf3\x82\x96\xe9\x34\xd1\xdc\x64\xa7\x4e\xee\xd4\x7d\x26\xd0\xe6\x19\xe6\xc5\x01\x16\xa1\x76\x53\x48\x5b\xf4\x92\x75\xf2\x61\xe8\xa7\xc8\xd7\x84\xdb\x6a\xc2\xad\x7e\x67\x17\xcb\x12\x71\x4a\x54\x68\x1a\x9e\x93\xd9\x0a\x1b\xf1\xbc\x5b\xeb\xde\xd8\x45\x26\x7a\x03\xf7\x0e\x75\xab\x93\x45\xbb\xca\x79\x21\x26\x1e\xcb\x61\x2f\x24\x6c\x4d\x0e\xd6\xee\xe8\x3d\xd2\x75\x87\x1e\xbd\xa4\x01\x21\x38\x6f\x5f\xab\x9a\xe8\x01\x35\x27\xe8\x2d\x0d\x34\xcf\x61\x45\x51\x29\x39\x04\x87\x16\xe9\x13\x38\x9a\xc8\x74\xef\x68\x49\x62\x08\xbe\x21\x61\xfe\x45\x2f\x72\xec\xa5\xc3\x5a\x6a\x40\x86\xc0\x2e\x5b\x03\x8d\xdd\x06\xea\x58\x30\x48\x8a\xb0\x33\xbd\xe4\x4e\xd0\xa8\x88\x15\x4b\xa3\x10\x0e\x30\xec\x7e\x14\xd8\x07\x69\x72\x36\x69\xeb\xd0\xae\xbd\xf1\xd2\xcb\xd5\xfe\x84\xfb\x64\xa8\x79\x49\x2c\x24\x96\xa9\xe8\x3b\xb7\x0d\x86\x06\xc1\xb9\x86\xe1\x85\xff\x45\xe5\xcb\xc3\x86\x1f\x10\xca\x76\x63\xbb\x70\xaf\x1f\xb0\x0e\x36\x2a\xec\x51\x5f\xc6\xec\x21\x9e\x99\x45\xa8\x1b\x57\x7e\xab\x7c\xb6\xe5\x8e\x32\x53\xf4\x4d\x76\x40\x23\xbe\x35\x1f\x0e\x6a\x17\x4e\xe7\x85\x6f\x51\xa8\xca\xa9\x4f\x55\x96\x9e\x29\x85\xf1\x11\x53\xd2\x71\xac\x0c\x90\x12\xb7\xf3\x3a\x0c\x10\x45\xb0\x4b\xa2\x7a\x7f\xf8\x9d\xec\x60\x33\x49\x3b\x58\xc3\xdc\x30\xc7\x7d\xd8\x30\x1f\xfb\x09\x99\x05\xbe\x47\x86\x68\x15\x66\xd7\x1a\x0f\xed\x7a\x32\xa0\x1d\x9e\x8f\xe0\xe5\x4d\x7d\x43\x61\x1f\x8b\x0e\xd0\x9a\x2c\x33\x0e\xba\xd4\xa8\xdd\xa9\x7c\x19\x2e\x81\x02\xd5\x30\x5f\x50\xc9\xc1\x53\x98\xc9\x28\x5d\xc6\x0c\x72\xfb\x17\x1b\x74\xa3\xdd\xb9\x3d\x13\x7b\x9a\x61\xea\x4c\x1a\x0d\x38\x4b\x63\xe9\xab\x6e\x3b\xb8\x04\x9a\x46\x6d\xc4\xa3\xec\x38\xea\x32\xb8\xd2\xa7\x5e\xec\x8c\x60\x6c\x8f\x1f\xc8\x2e\x2c\x51\x1a\x10\x5a\x31\x61\x0c\x03\x2a\xb6\x42\xba\x0b\x3c\xef\x48\xbe\x28\x0b\x40\x1d\xad\xc3\xee\x07\x2f\xcd\x68\xb4\x3b\xdf\x73\x00\xd1\x8b\x3a\x5b\xc3\xed\x20\xa8\x79\x3c\xcb\x9f\xbe\x51\x77\x90\x05\x9d\xbc\x77\x8f\x39\xc5\xb1\xcc\xb3\xf7\x8e\xc6\x47\xdf\xd9\x1c\xbc\xa3\xf1\xd1\x3f\x9d\xdf\x4f\x9d\xdf\xdf\x3b\xbf\x7f\x70\x7e\xff\x78\x3d\xb8\x41\x8f\xcc\x00\x1e\xf7\x9b\xdf\x3e\x8c\xdc\x5c\x35\x40\xad\x21\x95\x0d\xb0\x6d\x7e\xfd\xb4\xf9\xf5\xf7\xcd\xaf\x7f\x68\x7e\xfd\x63\xe1\x75\x2d\x0d\xcc\x63\x18\x2f\x90\xab\x4b\xa8\x38\x8c\xbb\xd0\x0e\x6a\x6d\x8c\x8f\x8a\x01\x4c\xfa\xd9\x53\xcf\xb3\xef\x3d\xcf\x7e\xf0\x3c\xfb\xb1\x26\x0a\xfd\xa0\x24\x7d\x8d\x4b\x79\xcd\x5a\xe6\x91\x5c\xe7\x91\xd2\x06\xce\xdf\x7b\x77\x65\x9a\x34\x3f\x81\xf4\xb6\x36\xb2\xca\x69\xab\x98\xa2\x4e\xc0\x7c\xd6\xc0\xf9\xe4\xaa\x8b\xa9\x05\x61\x0f\x0f\x78\xb3\xff\xa9\xfd\x0b\x5d\xae\xa2\xcd\x44\x07\x28\x46\x04\x66\xaa\xb5\x19\x21\x59\x15\xad\xd4\x7b\x5b\xed\x22\x22\xe8\x7c\x72\x85\x0c\x36\x2a\x9d\x77\x4e\xe3\xa5\xe7\x3b\xa1\x1e\xbb\xad\x73\xe9\x57\xdf\x9d\x52\x61\x3b\x0c\xf5\x4f\x01\xad\xf7\xab\x1d\x4a\xa3\x2b\xce\xc6\x1e\xe3\x74\x61\xea\x01\x37\x80\x6a\x1e\xba\x0b\xca\xd0\xa0\x08\xab\x81\x1a\x06\x0a\x8c\x5c\x63\xd1\x45\x53\x94\x68\x50\xf8\x04\x79\x01\x21\x34\x30\x98\xed\x63\xf6\x1b\x1a\xec\x67\xd2\x02\x57\x82\x62\x50\x70\x9b\x8c\x38\x9f\xf8\x26\xa0\x2e\x92\x2b\xba\x4c\x42\x13\x00\xd9\x6d\xb7\x5d\xae\xe8\x9b\x7d\xf1\xa1\x12\x39\xb9\x2b\xc0\x83\x12\xe0\x2e\x51\x9c\x83\x2a\x16\x7b\x61\x90\xde\x9a\x9a\x4e\x74\xb8\xbf\x8a\x0e\x35\x55\x71\x45\x67\xb6\xb5\x02\xf2\x31\x13\xa2\xd6\x3b\x30\x12\xa7\x92\x4d\xa2\x88\x41\x55\xc0\xe9\xec\xfe\x69\x9d\x5a\xed\xe2\x36\x9c\x14\x60\xfd\xfa\x14\xc1\x7e\x8e\x40\x35\x44\xd8\x9f\xcf\xee\x9f\xa2\x93\xe9\xe9\x25\x5a\x44\x2c\xb8\x53\x9e\x38\x74\xf8\xcf\xa7\xaa\xce\x09\x7d\x9f\x79\x84\x00\xef\x42\x27\x2d\xc4\xd9\x5b\xa7\x59\x9f\x1f\xca\xa5\x6b\x3b\xc9\xe4\xbe\x0a\xf4\x06\xf5\x31\xd3\x0d\xbd\x9f\x94\xbf\x6a\xe2\x13\x04\x09\xbd\xb5\x19\x37\x36\x6e\x14\x72\x4f\x66\xd3\x2c\x74\xf1\x3e\x09\x46\xb1\xce\x3c\x00\x37\xe9\x37\xb6\xf9\x48\x37\x1f\x49\x36\x92\x2b\xe2\x86\xa3\xe3\x84\x8e\x60\xd3\x4f\xf8\xc8\x46\x0f\xf7\x4c\x1b\x2a\x85\xbb\xed\x13\x11\x9b\x19\x56\x19\x70\x7d\xe0\x12\x79\x2f\x39\x06\xd9\xe9\x7a\x90\xb7\x7f\xb9\x28\x20\xd4\xeb\x08\x10\x66\x53\xae\xb3\xf4\xbc\xb3\xe7\x2b\x20\x30\x43\x44\xc6\xcb\x31\xc2\xfa\x0d\xb4\xb6\xea\xc5\xe8\x14\xa8\xa3\x04\xd5\xad\x70\x38\x5a\xb1\x5c\xd3\xf4\x61\xe7\xc7\xc2\xe1\xc0\x43\x9c\x3e\x75\xad\x9d\xaf\x94\x30\x91\xf9\x0a\x73\x9d\xca\x32\x27\x41\xca\xa9\xdc\xa8\xfc\xbb\xcb\xd4\x93\x79\xdf\x57\x1f\x82\xbd\x1b\xe0\x28\x02\x4a\x86\x48\x18\xf8\x68\x09\x1d\x20\x0e\x3d\x80\x20\x82\x4e\xbf\xe5\x6c\x6d\xaa\x45\x2a\xd3\x26\xb3\x9b\x4b\x1f\x41\x5b\x68\x26\x14\xd6\x3a\x47\xab\xd8\xc4\x84\x7e\x9b\xa4\xaf\x34\x76\x73\x22\xd5\x44\x87\x32\x8a\x69\x4c\x83\xc2\x59\x5b\x21\x22\x4d\x2d\x57\x85\xef\x0c\x50\xa6\x44\x0c\x02\x0f\x62\xa6\xca\xd1\x19\x1b\x2d\x44\x0f\x2b\x02\xb1\x0f\x30\xc3\xb4\x74\x67\xdb\xf8\x22\x76\xa2\x9f\x5d\xfb\x95\x88\x5d\x88\xd8\x21\x66\x30\xc6\xb2\xd7\x5a\x02\xdb\x31\x2f\x20\x37\xc7\xa5\x8f\x7e\xac\x9b\x90\x05\xe8\xbd\xb4\x9c\x4e\x54\xcc\xd7\x77\xc5\x17\x25\xf6\x8e\x92\x37\xb6\xd2\xdd\x0f\x02\x16\xb8\x2c\xb3\xa5\x97\x10\xee\xd4\xd1\x81\x67\x98\x03\xcb\xce\x17\x26\x31\xeb\x4f\x1f\x05\x0c\xa5\x9a\x48\xf0\x08\xdf\x61\x25\xf0\x26\x02\x70\x06\xf1\xa4\x05\x35\xf6\x58\x59\x39\xb9\xb4\xc2\xf4\x5d\x10\xf9\x40\x48\xec\x11\x57\x25\xa6\xbd\x68\xf3\x71\x30\xf0\x13\xcd\xaf\xa8\x77\x20\x1f\x20\x96\x70\x32\x52\x2b\x36\x09\x0b\xfa\x60\xfe\xa2\x17\x1d\x5a\x40\xf9\x07\x64\x96\xb4\x3e\xf3\xd2\xee\xd2\x9a\x86\x75\x47\x36\xda\xeb\x3f\xf9\xdd\xd0\x3e\xbe\x27\x31\x85\xa2\x87\x26\xeb\x41\x85\x35\x99\x9c\xec\x77\x8f\x0e\x6d\x76\xf6\x21\x27\x4a\x85\x8f\x28\x5e\x8f\x70\x1c\x8e\xee\x93\xe0\xf0\xb1\x1b\x99\xfb\xd6\x68\xa7\xf7\x54\x3b\xc7\x7f\x9d\x9d\x88\x5a\xab\x31\x15\x64\x64\x5b\x02\xa8\x91\xba\x37\x64\x14\xa4\x42\xb2\xf5\xa8\x70\x22\xd7\xd3\x19\xda\x3a\x42\xc7\x90\x6c\x1c\xdc\xf5\xe0\xd8\xa5\x05\xd8\x83\xee\x70\x5b\xed\xd1\x1e\x43\xbc\x1e\x1c\x7b\x88\x07\x3d\x8e\xf7\x53\x75\x53\xed\x56\x6a\x95\x8c\x47\xee\xfc\xe6\x6e\x87\x19\xd7\xcf\x86\x1a\x36\xec\x37\x9d\x77\xb0\x42\x39\x7f\x06\xf5\x7b\x1a\xcf\x1a\xb4\xc7\x2d\xfb\x32\x62\x0b\x1c\x19\x7b\x53\x69\x45\x08\x81\x0e\x56\x34\x0a\x33\x23\x74\x78\xd0\x4d\x4e\xbb\x43\x2c\x6c\xe2\x4d\x56\x96\xc9\xa0\xee\x78\x46\x5a\x21\x41\xdd\xa6\x7f\x3f\xc7\x78\x36\x73\x2c\xd1\x48\x8e\xb7\x39\xcf\xab\xc0\xc8\x40\x64\xf2\x0f\xe3\xf0\x04\xdb\x6f\x8f\x3e\x9c\x4e\xc3\x91\xfa\xdf\x05\x44\x48\x82\xc9\x60\x42\x68\x21\x5d\x44\xe5\x8f\x32\xa8\xed\x6b\x50\xeb\x37\xac\xbe\xb0\xbd\xc3\x15\x24\x22\x81\x64\x3b\x16\xf5\x29\x8a\xd0\xdc\xc0\xcc\x7b\x2c\xf4\xd9\xcb\xec\xd2\x2b\x9c\xe2\x5f\x66\x7c\x6b\x9c\x11\xa8\xc5\x88\x61\x95\x5b\x6b\x6b\x27\x96\x86\xdc\x87\x9c\xbb\xf5\x74\xe0\x19\xa8\x0d\x8a\xd9\x5e\x7c\xe0\xce\x8d\x20\xe5\x1c\xae\xe0\x29\x86\x3d\x54\x84\xb9\xcf\x50\x7b\x80\xf5\x8f\xcb\xa8\x91\x6e\x22\x53\x1a\xaf\xf3\xf2\xc3\xd0\x47\x97\xae\xb6\xb8\xc5\xd5\x44\xde\x19\xe1\x0f\x19\x32\x4b\x26\x58\x9a\x01\x51\x51\xd6\x66\x74\x9a\x9d\x24\xcc\x18\xaa\xae\x26\x83\x82\xd4\x36\x31\x28\x1c\x82\xa9\x6d\xf5\x64\xe6\xb3\xb3\x3b\x3b\x55\x68\xcc\xd4\xec\xea\x47\xf2\x2f\x04\xe5\x03\x0f\xe9\xbf\xac\x08\x80\x37\xce\x49\x7d\x1e\xd3\x60\x4e\xeb\x7b\x91\xbc\x07\xa4\xba\x53\xfe\x83\xd2\x60\x7a\x9d\xb7\xfa\x56\x12\xaf\xe6\xf5\xcc\xac\x86\x13\x59\xa3\x54\x2a\x0b\xf0\x36\x36\x88\xd6\x79\xc2\x48\x9a\x04\x3b\x11\x6a\x78\x91\xa2\xa6\xb3\xa2\x57\xa3\x5c\xdb\xf8\xb0\x53\x27\x0d\x96\x4a\xb6\xcc\x74\xb2\x58\x74\xda\x4e\x85\x6a\x75\x66\xcb\xe7\xcf\x99\x2a\xd0\xd0\xa9\xa2\xa0\x30\x33\x7a\x81\x71\xe1\xac\xfb\xa5\xd5\xaa\x9f\x82\xda\x43\x0f\x75\xb3\x68\xe8\xe3\x44\x89\xb2\x25\x9a\x75\xa4\x45\x06\x4e\x3b\xe3\xb4\x92\xdd\x23\x25\x3a\xc3\xdf\x41\x65\xd4\xe5\x93\x55\x44\x75\x97\x09\xbe\x83\xed\xd4\x75\x7a\x6f\x6b\x34\x19\x4a\x0d\xa0\x4e\x66\xc7\x53\xc4\xd5\x15\xbb\x23\xf1\x0c\xcb\xd5\x0e\x62\x04\x9f\x03\x6e\x18\x81\xcd\x8a\x4c\x28\x09\x6c\x99\x31\x9a\x11\x2e\x80\xd0\x50\xa4\x01\x3c\x6e\xaa\x3f\xed\x79\xe5\x24\x61\x85\x5b\xee\xce\x99\x44\x56\xed\x40\xaa\xc0\x8b\xe9\xd5\x2f\x6f\x9e\xff\xeb\xea\xe2\xe5\xd9\x39\x9c\x6c\xbc\x98\x5e\xbd\x9a\xd8\xbf\x05\xdc\xc0\xaa\x53\xc2\x49\x7c\x4f\x39\x8b\xab\xf9\x69\x2d\xf4\xfe\xb8\x78\xff\x44\xd6\xc7\x25\xd4\x7f\x3a\xcc\x9e\xd5\xa0\x9f\x61\x9f\x49\x3d\x42\x83\x05\xc7\x71\xb0\x0b\x83\xae\x4a\xd7\xc1\x6a\x80\x66\x12\x82\xb4\xd8\x72\xaa\xeb\xb5\xba\xb5\xaa\x17\x15\x7b\x03\xf7\x8e\x71\x49\x65\x56\xc7\x74\xb7\x81\x82\x58\x09\x2a\x19\xdf\x64\xa1\x9b\x26\xaa\x79\x8c\x4e\xf4\x9d\x1b\x84\x82\xb7\x07\x8a\xc0\xae\xd2\x85\x92\x2c\x2a\x23\xbc\xe8\xa7\xdc\x76\xed\xcb\x4b\x06\x38\x99\x35\xb1\x1e\xbb\xcf\x47\xe0\x46\x7e\xc2\x6a\x62\x48\xca\x66\x6d\xf1\xbe\xac\xbf\xfd\x72\xf1\xfa\xec\x70\x0c\x5f\x1d\x1a\x3c\xfa\xd0\x64\xbf\x3d\x7b\x29\x94\x2b\xfa\xdd\xc4\xc4\x41\x2f\x03\x09\x85\x12\x99\x2b\xb9\xf7\x4f\x40\x6e\x13\x16\x13\x88\x26\xb5\x1b\x80\x90\x24\x11\xdb\x90\xb0\x17\x69\xf6\xd5\xa7\x97\x28\xec\x21\xde\x79\xde\x40\x8d\x14\xa0\x04\xc8\xe8\x05\x5f\x2a\x0c\x51\x1a\x43\x89\x87\x22\x76\x8a\x0c\x26\x71\x19\x2b\x6d\xd8\x9b\x10\xbb\xf4\xe5\x25\x40\xb2\xdb\x0a\x36\xd1\xf7\x22\xd0\x7b\x82\x00\x92\x5a\x9f\x4c\xc9\x8f\x7c\x8a\x8f\x41\x61\x40\x45\x69\xb1\x89\x83\x8c\x31\x22\x60\x89\xb6\xf2\x61\x11\x11\x66\x14\xca\x39\x0d\xa0\x7a\x91\xe6\x23\xa2\xe1\xa7\x9a\x59\xe4\x76\x39\x2e\x87\x1b\xc9\x39\xdc\x8d\xea\xa8\x7a\x2d\x1b\xa6\xce\x36\xa0\x0a\x44\x84\x02\x2e\x18\xd9\x2e\x6d\x86\x89\xf2\x1b\x68\xef\x6e\x37\x08\x31\xdc\x7b\xda\x4f\x53\x7f\x09\x28\x3a\x16\xbd\x02\xe5\x17\xe3\x9c\xcb\x7b\x5c\xed\x73\xa0\x0d\x93\x0b\xac\x4d\xc9\xf2\xaa\xe9\x85\x23\x90\x5e\xd4\xfe\x08\xdd\x6f\xb9\x27\x70\x6d\x8a\x7c\x04\x46\x59\x3a\x0f\x72\x0c\xdd\xa7\x99\x86\x1e\xf8\xd7\xe7\xaa\x81\xe6\x3c\x29\x4d\xfd\x7c\xa6\x0d\xeb\xcc\xef\xbd\x6c\x52\x4c\x09\x6e\x70\xbc\x15\x28\x68\x62\x17\x0a\xd7\xbf\x60\xd0\x23\x2e\x77\x94\xb7\x02\xd6\xe8\x17\x54\x5e\x24\x60\xf2\xb2\xe8\x8e\x4a\xf4\xc8\x30\xcc\x39\xeb\x6b\x93\x81\x8f\x8d\x47\x61\xbb\x03\xb7\x56\x74\xd8\xed\x2c\x18\x93\x42\x72\x9c\x18\xa7\x47\xb7\xe3\x5b\xdb\xb8\x69\xc2\xbd\x9d\xc6\x42\xe2\x28\xd2\x3b\x87\xff\x4a\x69\x70\x27\x24\xe6\xd2\xfa\x7e\xb3\x83\x56\x2d\xdc\x87\xdf\xd0\xac\xfd\x08\x8f\xfe\x9d\xb5\x1f\x99\xf6\x23\x1a\x8f\x36\x2c\xe5\xf6\x3a\x92\x7e\xf1\x78\x95\xb3\xcf\x2d\x7b\x85\x62\x74\xcd\xe3\xaa\x8f\xc2\x83\xfd\x26\x2e\x3a\x94\x1a\x68\x7c\x61\x5b\x37\x12\xf9\x4c\x55\xa1\x42\x97\x24\x61\x4d\x04\xbd\x8d\xd2\xf7\xa3\xfb\xa3\xfd\xd3\xcc\x00\x86\x02\x8c\x39\x26\xf5\x24\x00\x81\xee\x36\xfc\xcb\x8a\x05\xf5\x9f\x38\xf4\x83\x12\x09\x1a\x35\x73\xc9\x68\xcc\xe5\x65\xd8\x30\x5f\x3f\xb9\x86\x54\x75\xcf\x40\xf8\x8d\x22\x82\x5b\x42\xec\xe6\x45\x1d\x30\x47\x34\xbe\xcb\xaf\x7a\x2e\x2b\xb2\x31\x7a\x6b\x2c\x03\x55\x7a\xf0\xdd\x23\x43\x5a\x67\xee\x39\xb5\x45\xf7\xa9\x52\x77\x46\xdc\x11\x8a\x2a\xce\xd7\x83\x63\x77\x5c\xb9\x1c\x18\xde\x0f\xcc\x6d\x34\x1d\x74\xf2\x6d\xd1\x53\xd5\x30\x49\x40\xf7\x77\x9a\x24\x66\xb5\xa8\xcc\x13\xf2\x3e\x21\x9c\x82\x93\x05\x47\x23\x47\xb6\xcd\xf8\xa4\xfe\xcc\x88\xfa\x93\x3d\xcd\xa1\x7e\x9d\xe6\xf3\xcb\x0c\x62\x97\x29\x06\x03\xf9\xfc\x53\xc6\x0c\xa4\xbf\x04\x9e\x33\x49\x9e\xe9\xfd\x8b\x32\xb7\x4d\x99\x75\x65\xd0\xb2\x08\xb6\x58\xf0\x05\x58\xc5\xe2\x93\x4c\xa1\x4f\x32\x90\xc2\x2c\xaa\x5c\xef\xd3\x7a\x38\x03\xd4\xa8\xb2\xbc\x6e\xee\x99\x1d\x45\xfe\xa4\xdf\x2e\xa3\x26\x1d\x8f\xd1\x30\xb8\x1e\xdc\x3c\x43\x50\x11\x31\xab\x81\x6a\x4f\x58\x79\xaf\x69\xd5\x96\x1c\x07\x7d\x15\x52\xcf\xba\xf5\xea\xcf\x32\x03\x60\xfb\xc8\x16\xf3\x33\x81\xc5\xe4\xe2\xb6\xd0\xb0\x83\xce\x83\xc1\xd4\x5f\xf2\xf4\xa1\xd2\x49\x5d\x91\x8d\x0a\x3d\x8a\xe2\x9f\xc5\x16\x12\x1b\x4e\x97\x45\x31\xab\x66\x79\x95\xdd\xc6\x9b\xd1\x16\x11\x5b\x1c\xae\x31\x8d\xf3\xb0\xc4\x27\xdf\x8f\x80\xac\x23\xdb\xef\x78\x83\xd7\xd1\xe3\x71\xff\x32\x21\x9d\x46\x50\xad\xa0\xbb\x17\x7c\x55\xa8\x61\x0d\x69\x9c\x28\xc0\x6c\xda\x16\xeb\xe5\xe5\x13\xac\x4e\xf7\xfe\x99\xcb\x55\xcd\x31\x66\x1d\x63\x37\x28\x2f\x1e\xf1\xbf\xe7\x17\xe7\x87\xff\x77\xf2\xfa\x55\x56\x10\x4f\x0c\x91\x48\x83\x15\x84\x43\xaa\xa4\x18\xcf\x65\xa0\x8c\x17\x4a\xc1\xf5\xe6\xcb\xc7\x43\xc0\x73\x00\x9a\x13\x58\xdf\x64\xff\xda\x94\xcb\xb8\x48\xca\x45\x42\x6a\x55\x1e\xc8\xc5\x2c\x95\x97\x44\x24\x2c\x16\xe4\x17\x96\xbc\xa2\xeb\xc2\xee\xb1\xc0\x06\xa0\x41\xf9\xb2\xe3\x32\x2f\xa8\x3e\x8d\x8f\xd3\xf5\x82\x70\x70\x79\xd8\xf8\x93\x15\xf8\xbd\xe0\x15\x37\xbd\xc1\xaa\x82\x91\x84\x0d\xbf\xcd\x76\x83\x5c\x02\x24\x39\xbe\x27\xd1\x30\x8b\xad\xd6\x77\x1e\x3e\xfd\x6e\x8c\x26\x68\xc5\x12\x14\x01\x8a\x00\xf9\x08\xdd\x11\x62\x80\x2a\x30\x42\x9f\xd5\x72\x82\xf5\xf5\xf0\xb1\xa9\x56\x61\x71\x80\x67\x10\x19\x57\x1c\x40\x0b\x6f\xff\x23\x06\x94\x8d\xe7\xc3\xb0\xc8\x5d\x75\x4c\x27\xea\x18\xda\x61\x59\xa3\xc2\x9e\xd8\xdc\xe8\x1d\x01\x8e\x6e\x40\x4c\x6f\xec\x92\x7b\xa3\x2e\x7e\x31\x7f\xd9\x71\x0b\x7b\xe8\x91\xd5\x70\x31\xc7\x40\xf6\xc4\x7f\xfa\xfa\x74\x7e\xff\xc4\x8c\xb2\x2f\x3f\x0c\x42\x7a\xe9\xb3\x58\xd9\x6c\x6b\x66\x5f\x58\x04\xcd\x8b\x3d\xa0\x59\x59\x6a\xba\xad\x80\x0e\x1f\xf2\x81\xd6\xce\xbd\xca\x2a\xb6\x8d\x89\x6a\x57\x03\x13\x1b\x43\x8d\x8a\xa8\x8e\xd3\xf8\x24\x21\x53\x00\xd4\x13\xa4\x54\x72\x12\x91\x7b\x1c\x4b\x55\x25\x05\x6a\xae\xbf\x7b\xd4\x54\x81\x7d\xf2\xdb\xfc\xec\xe4\x49\xb5\x08\xbb\x45\x01\xcc\x7b\xdb\xff\xc8\xf6\x3f\x32\xfd\x97\x6a\xcc\xb7\xf1\x7e\x87\x61\x75\x2b\x27\xbf\xfb\x60\xae\x07\xc7\x15\x02\x56\x77\x84\x56\x67\xfb\x02\x8d\xea\x94\x75\x90\xa4\x13\x1e\xac\xa8\x24\x81\x4c\xf9\x2e\xa6\xea\xc9\xec\x0d\x72\x41\x59\x72\x9d\x9d\x3c\xc9\x69\x0a\x6b\xef\x18\xf9\x4c\xce\x9b\xeb\xc1\xfb\x1f\x9e\xfe\xeb\x29\x54\x90\x81\xc2\x0f\x78\x1d\xe6\xbf\xf9\x5a\xfd\xee\x35\xa5\x77\xc4\xc7\x35\x81\x35\x62\xc5\xfa\x0b\xee\x7b\x85\x6b\xc3\x6b\xbe\x2e\xbd\xee\x62\x2a\xeb\x4e\x0b\x2d\x61\xde\xae\x43\xcf\x43\xe8\xa0\xc6\xac\xce\x9b\x0e\x96\x49\x2a\x76\x59\x85\x85\x2a\x7d\x49\x49\x79\xed\x7a\x31\x7b\xd3\x6f\xf5\x6b\x04\x94\xc1\xc9\xf4\x20\x24\x52\x90\xf5\x6e\xc7\x35\xc5\x2e\x35\x38\x04\x87\x28\x69\x4c\xa5\xcd\x88\x54\x9a\xfb\x05\x7d\xbe\xc3\x60\xda\x20\x7b\x47\x77\x7f\x32\x7b\xf3\x51\x38\xa3\x01\x6f\x3f\x9a\x32\xa4\x2d\xd7\xaa\x32\x1a\x96\x9d\xce\x13\x25\x9b\xc3\x7a\xbd\xb4\x97\x05\x4c\x9b\xf4\x05\x05\x60\xa3\x06\xad\x77\x22\xc3\xa9\x8d\x50\x5d\x60\x15\xb4\xf3\xcb\x9a\x5b\x0b\x3b\x28\x69\xb3\x14\x4c\x67\xf7\xdf\x41\x16\x52\x9d\xa4\x74\x51\xd2\x90\x0f\xca\x71\xbc\xcc\x22\x04\x09\x27\xe8\xc6\xa4\xcf\x4d\x67\x37\x4a\xfb\x21\x2c\x04\x5d\xc6\x3d\x63\x2f\xfc\xb0\xb5\x22\xcc\x3a\x30\x0a\xb0\xd4\xcd\x96\x72\x55\xa6\xcb\x5e\x84\xc4\x04\xa8\x65\x55\xe8\x5c\xb3\xb8\xaf\x90\x74\x81\x55\x10\x92\x57\x38\x8d\x83\xd5\x15\x59\x27\x60\xfa\xb4\x3b\xa3\x68\x58\x1d\x74\x9d\x14\xb5\x96\x01\x68\x12\x1c\x8d\x18\x92\x06\x33\x34\x3d\xed\x25\x1b\x9e\xcf\xb3\xaf\x3f\x78\x2a\x7c\xed\x0f\x51\x03\xb1\x10\x05\xe5\x26\xc1\x47\x35\xed\xaf\x2e\x4e\x2f\x90\xb9\x0f\x0c\xfd\xcd\x7c\x3d\x44\x7f\x7b\xa5\xac\xb8\x9d\x06\xff\x91\x50\xda\x72\x12\x15\xd3\x24\x4d\x5f\xfd\xa6\x52\x41\x84\x2b\xd7\x76\xb7\x0a\x71\xbf\x04\x3d\xbc\xa6\x3b\x88\x87\xad\x91\xfd\x56\xe7\xd9\xa2\xc9\xeb\x69\x9e\xa2\x6b\x12\x53\xf1\x9a\xe6\xd7\xd2\x0d\xd1\x0d\xd4\x01\x1a\x09\xb1\xbe\x31\xbf\x6f\x86\x6a\xaf\x0a\x89\x0d\x34\xb8\xe9\x25\x0a\xb6\xfb\xca\x59\x86\xa7\xeb\xeb\xc1\xb1\x83\x24\x98\xfb\xb6\x2c\x98\x45\xc8\x28\x53\xf7\x71\xf6\x28\xdb\xb1\x6a\x34\xcd\x73\x4b\x66\x47\x38\x40\x4d\xae\xe9\xcf\x78\x4d\xa3\xcd\x0e\x84\xad\xb1\xe9\xf5\xfd\x44\xaf\x68\x9c\xbe\x7f\x52\xa8\xef\xa8\xaa\xbb\xbd\x59\xa4\xb1\x4c\x9f\x7c\xfb\x6d\x56\x37\x52\x3f\x39\xfa\x21\x7f\xf2\x9c\x49\x19\x11\xce\x82\x3b\x22\xed\xb3\xdf\x68\x1c\xb2\x07\x01\x65\xc3\x09\x7f\xf2\xed\xd1\x8f\x27\x8c\xab\x7b\x7e\x30\x8d\x09\xaf\x6d\xf5\x73\x1a\x45\x6d\xad\xbe\xfd\xae\x0c\x6b\xdc\x8b\xc3\x6d\x7b\x09\x97\x20\xc5\x2d\x43\x4d\xf5\xb7\x9c\x46\x85\xe6\xbe\x46\x47\x3f\x34\x36\x72\x29\xd9\xd0\xac\x99\xb8\x7d\x3e\x2c\xd0\xbb\xfb\x87\xdf\x7e\x57\xdf\x63\x89\x19\x86\x64\x40\x78\x97\xb0\x5d\xf6\x57\xb5\xed\x11\x1a\xe4\x34\xf7\xbf\x39\xfa\xa1\xfa\xc6\xa5\x6e\xf9\x5d\x33\x49\x5b\x5b\x17\xe8\xd8\xd2\xba\x44\xbc\xf6\x5d\x21\x16\xcb\x79\x2a\x12\x12\x87\x33\xce\xa0\x6e\x09\xf9\x7c\x89\x92\xf3\xed\x5c\x45\xea\x02\x8a\x9f\x6d\x01\xcd\xaa\xa3\x05\x3f\x88\x51\x76\x43\xd7\x28\x4d\x42\x2c\x89\xf2\x86\x6f\xc6\x30\x85\xbf\x09\x6e\xe3\xfc\xbd\x28\x34\x80\xfb\x59\xe1\x84\x52\x3f\x1b\x09\x4d\xa9\xc4\x52\xaa\xdf\x09\xf6\xbc\x8f\xcb\xe8\xf3\x0d\xaa\xd9\xdb\x54\x95\x1f\x73\x35\xc9\x4c\xd5\x1d\x98\xce\xca\xd2\xd3\x27\xce\xd5\x94\x3c\x11\xb0\x31\x51\xee\x58\xe5\x6c\x2b\x6c\x16\x20\x76\x54\xf5\x84\xa6\x33\x28\x1c\xc5\x89\x10\xc5\x20\x77\xb0\xa5\x74\x46\xec\xdf\x05\x82\x45\x71\xa4\x37\x1a\xce\x77\x26\xaf\xaf\x17\xf7\x3e\x35\x6e\x7e\x6a\x57\xee\x88\xff\x5c\x73\x55\x39\x96\xd1\xdb\xac\xe6\x93\xf1\x1c\x04\x68\xf2\x7b\x6e\x51\xc1\x08\x45\x80\x61\x06\x1d\x7e\xf3\x07\x8b\xc9\x08\x3f\x60\x4e\x46\xf0\x7c\x64\x5e\xf4\x9b\x43\xba\xdb\x8a\xfd\xd4\xa5\xa3\xeb\xc1\xb1\x17\xdb\x7a\xd9\x0e\x49\x44\x24\x39\x3b\x9f\x5e\xc4\x57\x90\x42\x15\x63\x83\xc6\x9f\x3e\x9a\x6d\x25\xe0\x99\x47\xf9\xef\x76\x73\x08\xb9\x0a\x84\xdf\xe2\xc0\x08\x97\x46\xc2\xd4\xbf\x72\x3d\xd4\xfa\xb5\x34\x88\x91\x70\x37\x69\xde\x27\x22\x35\xc4\x14\xb0\x81\x3d\xc1\x09\x0e\xa8\xdc\xb4\xf9\xbb\xfc\x30\x74\x31\x30\x75\xd0\x73\xb4\x0b\x1f\xcc\x4e\x44\x7c\x82\xb3\xa5\x7d\x76\x95\xdb\x3b\xf9\xc6\xab\x86\x46\x33\x16\x02\xce\xbb\x10\xc9\xd4\xf3\x82\x30\x3e\x00\x95\x0f\x40\xf9\x8e\xf6\x72\x10\xba\x8f\x2e\xba\x10\x85\x2c\x04\x1c\x61\xaf\xe9\x1f\x24\xdc\x85\x24\xf6\x96\xd6\xb7\x67\xcf\xe7\xca\x67\xb8\x36\xd7\xc2\x6f\x77\x9e\x45\x16\x62\x64\xa0\x90\x70\x8b\xbb\x91\x2d\x3a\xbb\x1d\x44\x55\xb1\x80\x20\xb9\xd2\x00\xeb\xb5\x24\xb9\xc5\x3a\x2c\x70\x27\xca\xea\x1c\x05\xe3\x45\xc7\xef\xe9\x3a\x5d\x83\x58\xb0\x07\x12\x3a\x7e\xe8\xb3\x9f\x27\x23\x3d\xe8\xd0\x0a\x05\x0a\x30\x57\x85\x69\xcc\x82\xac\x72\x79\xa8\x30\xa5\x0a\x7b\x91\xf3\x63\xe1\xe0\x25\x1b\xc5\xeb\xc1\xb3\x2e\x11\x4a\x99\x2b\x65\x3a\x79\x5d\x03\xaa\x35\x5a\xa3\x01\x7c\x5d\xa8\x47\x23\xb3\xb6\x39\x33\x1d\x23\x03\x1a\xc9\x15\x96\x6a\xcd\x80\x04\x5d\x89\xef\xa0\x80\x0b\x09\x48\x08\x45\xd8\x10\xbb\x37\xab\x11\x98\x37\x88\xae\x93\x88\x9a\xab\x5f\x8c\x66\x03\x5d\x74\x7f\x74\xa3\x22\x38\x6e\x8a\xda\xae\x9f\x37\xe6\xb3\x8c\x42\x6f\xdb\x0b\x43\x31\x7b\x5b\x35\xa0\xc2\x6b\x33\x2a\xf3\xbe\x99\xf7\x1d\xae\xf9\x6b\xfc\x7e\xa6\x6a\x4d\xef\x02\xc1\x73\xee\xdc\x41\xec\xb2\xaf\x9a\xe4\xcd\x98\x6b\xc4\xd6\x07\x15\x2a\xc1\xd6\x7b\xfa\xd2\x4b\x02\xfa\xc0\x6d\x1c\xfb\x55\x7b\x9c\x67\xeb\xf7\x9f\xcf\x96\xcf\xc9\x80\x91\xbd\xca\xd4\x62\x56\x0a\xff\xed\x47\xd5\x5a\x70\x07\x1e\x94\xbf\x80\x22\x26\x95\x78\xb8\x2a\x8a\x35\x07\x34\x0d\x92\x5e\x3a\xd4\xe9\xc8\x88\x38\x2f\x85\x58\x3e\x10\x30\x76\xa2\xcd\xf4\x06\xb5\xb4\x2c\x95\x1e\xec\xc5\xa4\x6d\xba\xf2\x53\x87\x2d\x2f\x89\x84\x28\x52\x16\x4f\xe3\x53\xbc\xa9\x30\xb3\x6c\xe5\x37\x11\xc3\xae\xc6\x18\x29\x5f\xc8\x6f\x58\x06\x2b\x14\xb1\xa5\xa9\x53\x6c\x71\x8a\xd8\x52\x94\x62\x73\xe0\x44\x21\x44\x37\x87\xf8\x41\x05\xa2\x1e\xfe\x64\xce\xdf\x8e\x0f\xb3\x01\x1c\xfe\x94\xfd\x3c\xbe\x19\x42\xfc\x66\x02\xc9\x64\x0e\x1c\xf5\x0e\x09\x89\x83\xbb\x61\x5e\xc5\x78\x49\xef\x55\x68\xa1\x19\xa5\x0d\x1e\x21\xb1\xe4\xd4\x6e\x84\x56\x24\x6f\x00\x89\xae\x14\x8a\xdb\x39\x63\x30\x1e\x7e\x13\x44\x74\x33\xd7\x7f\x92\xf0\x55\x85\x7c\x37\x5b\x99\x2f\xdb\x12\x4c\xaf\x3d\x5d\xa9\x66\x56\xa5\xcf\x4a\x3b\x8d\x71\x03\x01\x1b\x97\xce\x35\x7e\x3f\x63\xa1\x98\x11\x0e\x26\x56\x9b\xa8\xd6\x81\x98\xd3\x3f\xb6\xfc\x96\xc6\x5b\x7f\xdb\xa1\x4c\xa5\xff\x3b\x16\x92\x4b\x92\x60\xca\x2b\xe1\x07\x0d\x1a\xec\xbc\xfc\x55\xe3\xb4\xcd\xad\xaa\xb3\x97\x73\xd0\x20\xb8\x50\xa7\x9c\xab\xee\x95\x40\xa4\xf1\x8a\xe0\x48\xae\x36\xc6\x6c\x2e\x4b\xd0\x18\xc1\xed\x9b\x96\xe7\x26\x61\xd5\xad\x1a\xae\x24\x51\x6c\x6b\xf4\x7d\x2a\xf4\xbc\x9c\x00\x03\x91\xd3\x90\x3c\xb7\x19\x78\x27\x6c\xbd\xc6\x71\xd8\xc2\xd5\x26\xca\x5f\x18\x90\xd9\x25\x89\x7f\x17\x28\x4b\xf0\x4b\x60\x25\xd1\xc6\x4f\x2f\x7a\x65\x40\x3d\xb7\x24\xd6\xc1\xf7\x0e\x38\xab\x15\xd8\x4d\xe6\x66\x59\xf3\xa6\x21\xe7\xab\x18\x30\x2c\x2f\x47\xa8\x04\x03\xb6\x61\x3a\x19\x5f\xf3\xcf\x94\x31\x84\x42\x0e\x09\x7e\xe8\x1b\xdf\xb2\x63\x57\x7e\x9a\xf0\x0a\xff\x3f\x9f\x15\x48\x54\xf5\x3f\xd8\x6b\x91\x5b\xa8\x12\x50\x64\xad\x35\xe0\x32\xf7\x95\x59\x1d\x7a\xd1\x70\xcb\x2e\x0e\x3c\x43\xb3\x57\x14\x99\x68\x2a\x98\x1b\x25\xc2\xf5\xf1\x3e\x98\x94\xc0\xb7\xf6\x9a\x0d\xb3\xaf\xa7\xf1\x32\xf3\x65\xfb\xaa\x5b\x9b\xe6\x23\x53\x07\x71\x74\xcb\xf8\x48\x69\x4d\x1c\x8d\x32\x05\xa0\x6b\xbc\x67\x7f\xf6\x22\x98\xc1\xab\xe2\xef\xde\x1a\x99\xeb\xc1\x71\x75\x8c\xe0\xdb\x69\x42\xb2\x5b\x5d\x8d\x84\x33\x08\x23\xfe\x99\xb3\xf5\x25\xc9\xe6\xc7\x2e\x5c\x11\x70\xd3\x09\xd6\x76\x84\x4e\xa0\xd9\xd8\x7a\x5e\x19\xa6\xe6\x6d\x48\xe2\x0d\xc8\x90\x3e\x08\x33\x9b\x73\x37\x0d\x10\x2e\xcb\x40\x73\x7d\x12\x60\xe6\xac\x4f\x5b\x0f\xd5\x2e\x5c\x30\xf0\x3d\x81\x55\x49\xa5\x30\x53\x1a\x4b\xc4\x54\x09\x75\xa3\x5d\x51\x9a\x2c\x39\x0e\x5d\x54\x46\x23\xe5\x2d\x1a\x99\x7e\x61\xf8\x37\x28\xa2\xb7\x52\x20\x2a\x33\xfb\x2b\xcc\x32\x22\x6f\x21\xed\xca\x80\x29\x9e\x13\xdd\x28\x57\x66\x3f\xf3\xef\xcb\xa4\x96\xbb\x6c\x74\x23\x99\x59\x5c\xb6\x23\x9c\xee\x4e\x51\xcf\xc0\xa9\x13\xe5\x7a\x67\x71\xe1\x02\x0a\xd1\x6d\xb9\xca\x9c\x74\xf3\x17\x35\x0b\xbe\x48\xd8\x4e\x93\x21\xb7\xee\x01\x52\x4e\xc2\x5e\x32\xd2\x0d\x48\xb7\xf9\x2e\xc4\xaa\x2f\x6d\xe6\xbf\x34\x0f\x31\xb7\xcd\x84\x58\xd9\xfb\x43\x34\xfb\xa9\xd8\x76\xc8\x5d\x81\xfa\x07\xf9\x99\x6b\x47\xeb\xf3\xce\xea\xb9\xa5\xc5\xab\x0f\x25\xda\x60\x1d\x78\x90\xfd\xb2\xaa\x2d\x4f\x12\xed\x47\x35\xf6\xc1\x24\x3f\xf5\x45\x2f\xf2\xcb\x8b\x58\x25\xd1\x43\xa0\x47\xd9\x35\x45\x8f\x87\xa8\x04\x06\xf6\x01\xe7\x56\x0c\xb2\x3b\x9c\x1b\x60\x59\x48\xbd\xa8\xff\x45\xe3\xde\xc1\xf5\xa5\x57\xd6\xbe\xdb\x46\xc5\x96\x37\xee\xa7\x4d\xfc\xcd\x4e\xa5\x57\xec\x01\xd6\x66\xbb\xf3\x82\x8c\x47\x28\x0a\x1e\xe7\x37\xaf\x86\x2a\x71\x0a\xea\xd5\xe9\x08\x1d\xb3\x96\x55\x36\x69\xbd\x78\xf4\x31\xfa\xf7\x12\xf3\x9e\x45\xe9\x9a\x9c\xc5\x01\xdf\x24\xb2\xfd\xe0\xac\x01\xc6\xf4\x62\x36\xdf\xca\x85\xa0\x51\x78\xb9\x16\x2f\xc9\x66\x7a\x5a\x07\xa2\x3c\x79\xab\x10\xb6\x3d\x78\xd0\x5f\x77\xf1\x80\x34\x49\xcc\x92\x2e\xf1\x62\x23\x7b\x7a\xa8\x6b\xbe\xca\x67\xc1\x0f\xdf\x36\xe0\x7c\xb5\xe2\x2c\x5d\xae\x92\x54\xb6\x61\xde\x04\xe4\xa3\x54\x86\x58\x26\x2a\xcc\x96\x0a\xf4\xc2\x5c\x31\x3d\x4b\x79\xc2\x04\x41\xf3\xf9\xa9\x8a\x77\x5d\x26\xff\xa8\x6f\x61\xf6\xb0\x46\xdc\xe1\x4c\x64\x4d\x6d\xa1\x30\xb8\xe3\x19\xc9\x6c\xe8\xa5\x50\x5e\xca\x8e\x0c\x58\x55\x44\x01\x62\xe8\x49\x88\x40\x38\xb3\x9e\x45\x60\x9b\x9c\xb0\x28\x44\xbf\x9c\x9a\xc7\xd2\x3e\xce\xe9\x8a\xb2\xc3\x7a\x68\xb6\xdf\x08\xdc\x65\x52\x0a\xbc\xad\x23\x56\xf1\xa3\x7f\x74\xf9\x68\x4b\xfa\xb9\x3d\x51\x76\x54\xe9\xc9\x4f\x52\xf7\x2b\x11\x54\xbf\xca\xa9\x5c\x68\x29\xab\x2d\x3b\x12\xde\x20\x0c\x44\x5e\x26\xff\xe8\x12\x64\xbb\x4c\x2a\xb1\xb5\xe5\x2f\xc1\xc3\xc1\x8e\xca\x8f\x44\x50\x7d\x24\x8f\x6a\xa2\x59\x0f\x4a\x73\xac\xd7\xb5\x07\x79\xf0\xbb\xf3\xd0\xae\x97\xea\x58\xaf\x31\xfc\xce\x79\x59\x35\xc9\xca\x87\xab\x9e\x37\xe7\x25\x74\xca\x51\x52\xce\x2b\xeb\x2f\xf6\xb8\x9f\xfd\x6a\xd5\x79\x0a\xb6\x7a\xf5\xa4\xcd\x79\x52\xf5\xa6\x34\xc6\x78\xb6\x07\xc9\x35\x5c\x09\x01\x91\x0f\xce\x9f\x90\xd1\x51\xbf\xfb\xaa\xf7\xd7\xb7\x04\x31\xd7\x45\xff\xf8\x35\x71\xe5\x69\x99\x31\xe5\x15\xbb\x7e\x25\xad\xbc\x81\x29\x5b\x7d\x9a\x4f\xba\x41\x9b\x47\xd0\x79\x5f\xeb\x36\x76\xda\x14\xa3\xe4\xaa\x2f\x4c\x58\x81\x4f\x1c\xeb\x83\x40\x06\xd9\xfe\x7c\xe0\x8f\xfd\xf1\x40\xf3\x9c\xed\xfb\xce\x08\x9b\xce\x27\xda\xfd\x4b\x9e\x7e\xaf\x4a\x47\xd6\x03\xd8\xec\x0e\xea\x8f\x71\xeb\xac\xdc\x4a\x52\xd2\x36\x09\x85\x9c\x24\x9c\x08\xa8\x15\x03\xde\x9e\xb3\x97\xf3\x91\x31\xc1\x73\x1b\x51\xa7\x76\xa9\x85\x0b\x4c\x58\x58\x2d\x60\xbb\x92\x40\xb1\xe1\x5b\x4a\x20\xd3\x54\x6d\x46\x56\x1c\x6e\xd5\x8c\x11\xe1\xdc\x21\x6a\xdb\x82\xf8\xd1\x10\x28\xe6\x7d\x11\xc9\x69\x20\x4e\x58\x04\x3c\x2f\x86\xc9\xd6\x24\x7e\x2d\x39\x8e\xd3\x08\x83\xab\xa5\x7b\xfe\x97\xfb\x51\xb3\xf9\x94\xbd\xca\x16\x06\xd0\x21\x1a\xcd\x8f\xba\x9f\xdf\x32\x13\xcf\x1d\x99\x07\xe3\x0a\x85\xb6\x11\x46\x55\x7e\x76\xb1\x51\x3b\x50\xbb\xfb\xd4\x27\x62\xa6\x50\x47\x00\xc7\xd4\xb7\x36\xed\x60\x7f\xf9\x17\x39\x3b\x47\x58\x8c\xcc\x98\x82\x4c\x58\x7a\xd6\xec\x68\x1b\xc6\x5e\xb3\x2c\xba\xa0\x0e\xc9\x7a\x55\xca\xe5\x31\x93\x46\x02\x06\xe7\x57\xbf\x18\xdd\x92\xcb\x5a\xad\xa8\x6b\x67\xdd\x44\xd7\xf0\x3e\xbb\x27\xb1\xdc\xf9\xde\x6d\xeb\xff\x03\xc2\x29\x88\xcf\x39\x0d\x97\xf6\x0a\x39\x41\xe2\x10\x48\x09\x6f\x41\x65\xea\x08\x70\x9e\xaa\xcf\x87\xd9\xb5\x28\x21\x0a\x56\x2a\xcf\x1b\x74\x02\x27\x0b\x1c\xc1\xd2\x81\x08\xc0\xcb\x4e\x49\x1f\x56\x2c\x22\xb6\xba\xb7\xf5\x45\xfc\x3b\x25\x29\x41\xa6\xda\xba\x76\xdc\x97\xf7\xcb\x63\xf4\x26\x8e\xe8\x1d\x01\xf7\x2f\x09\x36\x41\x64\x01\x0f\xa1\x9d\xc8\xba\x81\x68\x01\xb8\x22\x3a\xf3\x62\xc1\x49\x6e\x0e\x66\x08\x5e\x69\x06\xa7\xc7\x2c\x76\xa0\x43\x38\x86\xc1\x62\x8d\x37\x4e\x7d\xf1\xf5\xd0\x68\x38\xb2\x29\x06\xbb\x43\xe0\x07\x95\xbb\x7b\xe7\xbf\x52\x7e\x1f\x94\xdf\x87\x7b\x5f\xc7\xee\x5e\x5a\xea\x9d\x72\x7d\x1f\xcd\x2e\xd3\x6a\x85\xe3\x10\xd8\x98\xb3\x84\x13\xb8\xe8\x85\xc4\xa1\xd2\x06\x62\x77\xf9\xe9\xd9\xc5\xfe\x08\x35\xb7\xb2\xa7\xa4\x76\x9f\xd4\xca\xa5\x1a\x2a\x04\x4a\x12\x3b\xc2\x5c\x22\x18\x5c\xdd\xbe\x1d\xbd\xba\x77\xa2\x49\x06\x3d\xb5\x50\xac\xf6\x1e\x79\x43\xb0\x84\xc9\xa9\x33\x7d\xf7\x4a\xb2\xb2\x6e\x40\x31\x93\x34\x20\xa5\xa1\xec\x42\xaf\x6e\x3d\xec\x4e\xac\x75\x43\xcc\x95\xb1\xb7\x9a\x28\xe2\xd4\x90\xa3\xeb\x50\xe8\xfa\x71\x4a\xb3\xdf\x94\x68\xa1\x5e\xef\x54\x0e\x0e\x20\x98\x61\xe6\x89\xf5\xaa\x2f\xf3\xd4\x47\x1b\xe7\xa3\x3a\xda\x0c\xa0\x8d\xdf\x4e\x55\xd0\x77\xbb\xcc\xd7\x14\x19\x84\x8b\x7c\x8d\xaa\x9f\xff\xd7\xdc\xa8\x60\x47\x95\xc3\xd6\x04\x49\x36\x74\x6e\xd7\x8a\xad\xa2\x66\x21\x19\xdb\x62\x9c\x21\x83\x1b\x75\x98\xb4\x4b\x50\xbe\xaa\xe8\x93\xf9\xa1\x71\x7e\xad\x53\x21\xe1\xf4\x9c\x3d\x14\xd6\xb9\x47\x37\x66\xce\x69\xdb\x11\xac\xc8\x80\xad\x6f\x1e\x03\xc1\x60\xf5\x43\x6b\x22\x20\xb4\x21\x0b\xfe\x50\xb0\xfb\xb2\xed\x4b\x1a\xb0\x39\xf9\xf6\x8c\xda\x88\x45\xdb\xd8\xb7\xdc\x47\xac\x4b\xce\x91\x4c\x94\x9c\x67\x2d\x9a\xaa\xda\xd2\xbf\x08\x74\x58\x55\x87\xed\xf6\xec\x5e\x76\x36\xba\x20\x13\x10\xcf\x9e\x22\x67\xb1\x02\xf6\x36\x55\x38\x8f\x42\x8e\x8f\x0a\xfd\xa2\xd6\x54\xae\x0e\x83\x1d\x0b\x66\x8c\xa6\xae\x40\x0c\xad\x40\xb8\x26\x5c\x21\x40\x22\x37\x98\x56\x8c\xdd\x59\x73\xa6\xc5\xcc\xb3\x29\x44\x46\x32\x5d\xce\x2b\x04\x20\x9f\x45\x09\x24\x8a\x59\x76\xb0\xa7\x25\x58\x23\x92\xbb\x5c\xda\x26\xc6\xff\x8f\xb4\x29\x6e\xbb\xec\x49\x64\xbb\x53\xa2\x67\x35\x9a\x4c\x52\x7f\x55\xae\xbe\xae\x8e\x05\xff\x81\xa9\x86\xf1\x5a\x07\x53\xe6\xd3\x3f\x1b\x47\x69\x0d\x68\x8f\x1e\x00\x82\x40\xa6\x22\xb8\xf4\x90\x76\x3b\x0a\x84\xa5\xc4\x30\x9d\x2d\x59\xb3\x94\x35\x3b\xed\xec\x0b\xce\x98\x34\x5f\x0d\x11\x19\x2f\xc7\x26\x6a\x22\xbb\xc5\x91\x70\xb8\xbc\x5d\xd2\x75\x3f\x3d\xfd\xe9\xb0\x3a\xf0\x10\xf0\x6b\x05\xa1\xaf\x15\x84\xbe\x56\x10\xfa\x5a\x41\xe8\x6b\x05\xa1\x7d\x55\x10\x5a\xd3\x99\xad\x36\x6f\xb3\x05\x76\xdf\xb6\xc0\xe5\x6e\x26\xd0\x73\x3e\x7f\x9d\xd7\xb3\x47\x60\xcc\x58\x3b\x61\x7a\x9a\xd9\x30\xaf\xa7\xb0\x40\xa4\x82\xc0\x46\x46\xb0\xe8\x1e\xae\x09\x8e\x85\x24\x38\xcc\x4a\xff\xbe\x9c\xe7\x79\xee\xa0\xb8\x73\xa8\xea\x26\x59\x70\x6a\x2d\x4c\x3a\x2f\x5b\xea\xb2\x18\x2a\x79\x09\xc7\xaa\xf5\xf4\xb4\xd7\x44\xfe\xa2\x07\xe2\xe7\xa4\x58\x36\x1d\xe3\xf4\x37\x68\xaa\xd0\x9c\xaf\x3e\x0c\x7d\x12\x52\x3e\x42\x69\x39\xe5\xed\x86\x5d\x49\xfc\x3a\x22\xd1\x24\xa5\x5f\x4b\x55\x7d\x2d\x55\xf5\xb5\x54\xd5\xd7\x52\x55\x5f\x4a\xa9\xaa\x2c\x93\xea\x12\x54\x6e\x95\xd8\xe5\xc8\xc4\x26\x7a\x99\x85\x2b\xaf\x78\x02\x3b\xbc\x72\xaa\x9f\xf6\x09\x40\xf4\x18\x57\x3d\x86\x08\xdf\xc2\xaa\x86\xd1\x2d\xa6\x51\xca\x4b\x79\x19\xca\x87\x01\xed\x44\x2f\x1a\x7e\x64\x54\x9a\x49\x79\x45\xd7\x84\xd5\x07\x79\x1a\x01\xed\x40\x49\x08\xda\x81\x0c\x19\xa0\x63\x56\x50\x06\x76\xad\xbe\x71\x0c\x11\x8d\x83\x28\x55\xbe\x10\x83\x67\x05\x7f\x69\x30\xdb\x82\x94\x9f\x06\x17\x73\x91\x93\xa8\x5a\xcd\x47\xff\x5c\xb7\x9b\x94\x0b\xd7\x14\x2e\x91\xbf\x25\xd6\xbc\x60\x45\x7b\x81\x07\x38\xc6\x7c\xd3\x0d\xec\x89\x6a\x6b\x8e\xec\x9b\x38\xed\xfa\xbf\x32\x67\x19\x64\x41\xc1\xe5\xed\x61\x1a\xc0\xd1\xad\x89\xe9\x43\xb7\x94\x0b\xa9\x4f\x3d\xd5\x39\x29\x68\x03\xf0\x75\xa8\xc3\x5a\x48\x38\x33\x41\x80\xf9\x17\x90\x4a\x65\x6a\xd4\xa8\x7c\x3e\x47\xa1\x73\x82\xc3\x4d\x2f\x41\xf8\xcc\xa8\xd6\xf0\x44\xd3\xe6\x39\xd4\x03\x6b\x8d\x46\x6f\x62\x04\x15\x25\x83\xfa\xad\x8d\xa5\x44\x0a\xb8\x92\xf5\xd7\xaf\xde\x3d\xda\xaa\x52\x56\xf0\x64\x64\x51\x1d\xe9\xda\x65\xca\x84\x79\x9c\x11\x53\xaf\xb2\x3a\xbe\x4c\x19\xee\x92\x8d\x51\x11\x03\x95\x4e\x60\x4c\x74\x15\xc8\xa2\x4c\x73\x73\xea\xaf\xc3\xf0\xb6\x52\x92\xf9\x90\x77\x2c\x07\x56\x33\xc8\xeb\xc1\xb1\x97\x94\xb0\x28\xed\x7d\xfc\x8d\x52\x72\x49\xa0\xbe\x94\xb7\x18\x63\xdd\x34\xae\x7e\xd8\x24\x44\xd6\x4d\x6e\x66\xc9\xdb\x8b\x78\x74\x4a\x20\xcc\x32\x1f\x8a\x03\x4a\xec\x41\x98\xb8\x03\xae\x5d\xa4\x7a\x89\x47\x69\x30\x7b\x14\x8e\x0a\xd2\xd7\x83\xe3\x16\x52\xb5\x09\x8b\xdf\xba\x09\x22\xb0\x3d\x83\x57\x0c\x87\xcf\xf5\xf9\x12\x87\xf8\xdc\x8f\x6d\x52\xfe\x3f\xf6\xae\xb6\xb9\x71\x1b\x49\x7f\xd7\xaf\x40\x29\x55\x77\x99\x2d\x51\xf2\x24\x95\xad\xcb\xee\x95\xeb\x1c\x7b\x76\xa2\x4a\x3c\xf1\x59\x33\x97\x0f\x76\xea\x4c\x89\x90\xcc\x32\x45\x6a\x09\xd2\x2f\xa9\x99\xfb\xed\x57\x0f\x5e\x48\x90\x04\x5f\x40\xd1\x9e\xc9\x46\xf9\x92\xb1\x48\x02\xe8\x46\xa3\xd1\x68\x74\x3f\x5d\x2f\x20\x27\xea\x28\x41\x82\xc8\xf5\x88\xbc\xf4\x8a\x99\x3c\x66\xa7\xb8\xd8\x93\x17\x20\xf6\x59\x48\xd6\x8d\x8f\x0c\xe4\x8c\x65\xf2\xfc\xd9\xbb\xc5\x1e\xda\xf4\xea\x54\x38\x90\xe5\x09\xe1\xb7\xaf\x6b\xf2\xcf\xa5\xf7\x59\xf6\xe9\x78\x21\x73\xe4\x27\xaf\xf2\x52\xf3\x67\xef\x16\x24\x88\xa2\x3b\x5b\xc8\x8e\x8a\x09\xdd\xbd\x77\xe8\xac\x02\x05\x5c\xfe\x8c\x23\x32\x33\x71\x97\x9e\xc6\xd4\xf3\x13\xb6\x07\x13\xb5\x05\x78\xf5\xfe\x5b\x1e\xdf\xb6\xf5\x13\xea\xf5\x53\x1b\xcb\x34\x66\x09\xae\x0e\x9d\x1d\x8d\x79\xbc\x61\xb8\xa2\x59\x01\x31\xe6\xa4\xaa\x79\x07\x17\x64\x7c\x59\xbe\x9a\x90\x7b\xc4\xf6\x8a\x3d\x1c\x53\xf1\xde\xc1\xf8\x7b\xee\x37\x1a\x3d\xfb\x29\x93\x1e\xa4\x5c\x8f\x8f\x75\x16\x62\x3a\xdb\x89\x33\x4e\xad\xf4\xfc\x9e\x46\x51\xe0\x45\x0f\xe1\x82\xae\xa2\xd0\xab\x9d\x66\x8b\x73\x93\xb0\xac\xe5\x09\x44\x2d\x54\x77\x95\xf8\xf7\xd8\x37\x56\x11\xca\xa0\xc2\xfe\x92\x18\x18\x95\x1b\x53\xae\x30\x60\x23\x20\x59\x3f\x4e\x88\x1b\x46\xdc\x15\x59\x6e\xaa\x8f\x8d\xf0\x62\x63\xab\x61\xf9\x01\x4f\xf9\x80\xa7\x7c\xc0\x53\x3e\xe0\x29\x1f\xf0\x94\x0f\x78\xca\x03\xe3\x29\x6f\x76\x69\x25\xb5\xa2\x8b\xc3\xe8\xed\xc5\x07\xf9\x9d\xb1\xd9\x03\x4c\xf3\x01\xa6\xf9\x00\xd3\xfc\x2f\x02\xd3\xbc\x48\xa2\x98\x5e\xf0\x9b\xc5\x16\x16\x76\x88\xff\xb8\x3c\x99\x9f\x1d\x15\xe8\x40\x1a\x24\x62\x5b\xcb\x3f\xbe\x8b\x42\x2d\x16\x4d\x8b\x2b\x34\x31\x91\x1f\xe9\x10\xf8\x83\x98\x68\xb4\x26\xf4\xe7\xbb\xff\x39\xd7\x64\x9f\x81\x90\x2c\x76\x4e\x17\x7c\xcd\x74\x17\x99\x4c\xb9\x5f\x9f\x7a\x53\x52\x8d\x2e\x22\x37\x9c\x90\x1b\x15\xce\xbc\x8a\xb6\x4b\x1f\xab\x01\xb9\x39\xb0\x59\x23\x2c\x0e\xd9\x17\x59\xba\xab\x3b\x15\xb4\x70\x97\x2e\x61\x4b\x8b\x88\x3c\xcf\x8f\xf9\x04\x3c\x4d\xc8\xcd\x39\x86\x9d\x35\x28\x89\x40\xbd\x79\xd5\x4a\x1a\x7a\x34\x26\xb3\x6d\x98\xcc\x14\x49\x0e\x27\x49\x78\xc5\x6f\xc0\x30\x2d\xd4\xcb\x4a\x58\x5e\x9c\x7f\x42\x17\x70\x26\x4a\x15\x30\x1c\x2b\x45\xdb\x9c\x9f\xa5\xb6\xed\xb9\x2a\xda\x02\x6b\x2b\x01\x63\xcf\x0b\x6e\xce\xce\x7c\xbc\xb6\x4c\xe5\x14\x75\x50\x3b\xf9\x66\x6a\x6c\xc3\xd8\x9d\xe4\xe1\x9b\xc7\x24\x76\x6d\x2c\x81\x79\x18\xf8\x21\x3d\x8b\x56\x69\x29\x9f\xbc\xd6\x1d\xe6\xff\x4e\xc9\x8d\xec\xee\x46\xc6\x54\x67\xae\xb1\x95\x7c\x05\x65\xdd\x93\x5b\xea\xc8\xf7\x66\x76\x96\x68\xc5\xe7\x55\xd7\x6c\xe6\xe1\xc2\xa0\xc4\x14\xcb\x47\x6a\x96\xc5\xf8\xea\xed\xcd\x3f\x02\xec\x7a\x15\x34\xa0\x34\xdc\xf2\x71\xb7\x69\x16\xf7\xc5\xc9\x3e\x00\x8b\x1f\x80\xc5\xdb\x80\xc5\x95\xde\xfa\xd9\x5f\x53\x38\xdd\x5a\xf4\x67\x93\xb8\xfa\xc5\x03\x14\x5a\x43\x20\x9f\xd2\xae\x0a\x6d\xc8\x0f\x33\x43\xb8\xd9\x7b\x27\xa1\x36\x71\xe1\x3c\x25\xf3\x84\x87\x70\x44\xd8\x91\x3d\x02\x47\x28\xdc\x26\x4c\x38\x43\xf9\x66\xcc\xf3\xb9\x96\x94\x1c\x91\xaf\xa5\xbd\xeb\xbd\x42\x16\xdc\x92\x26\x0f\x94\x86\xe4\x35\x7f\xeb\xdb\xbf\x7e\x47\x3c\xf7\x89\x59\xc9\xd5\x1f\x98\xb2\x86\x00\x87\xbf\xfe\xc7\x6d\x7b\x84\xc3\x01\x7b\xfe\x80\x3d\xff\xd9\xb0\xe7\xd1\xa4\xe6\x80\x97\xa9\x5e\x1d\xe7\x23\x43\xb0\xe8\x38\x11\xa0\x26\x4f\xd1\x92\xde\xdb\x0c\xbd\xe3\xaa\x29\xf9\x2c\x77\x02\x6e\xfc\xe4\x36\x5d\x72\xaf\x1b\x76\x11\x44\x94\x82\x08\x47\x39\xc9\xfd\x28\x74\x44\x06\x73\xfc\x8a\x78\x74\x17\x44\x4f\xd4\x33\x81\xbc\xf6\x9c\xae\x66\x22\xaa\xee\x42\x8b\xf1\x5e\x8f\x8f\x9b\x78\x00\xbb\xad\x91\x22\xe3\x0c\xd7\xc2\x44\x35\xaf\xdb\xa6\x29\xcd\x0a\x01\x1c\xaa\x0b\xfc\x51\xaa\x0b\x44\xde\x42\x62\xd2\x7d\xae\xd0\x5b\x65\x7a\xcd\xcf\x32\x15\x26\x6c\x0b\x37\x7e\x92\xd1\xc5\x8c\xdf\x72\x14\x83\x93\xe7\x17\xf2\xc6\x82\x9b\x78\x57\xa7\xef\xe6\x44\x66\xb2\x49\x07\x31\x07\xe6\x6f\xf2\xcd\xc3\xce\x94\x8e\xf9\x94\xd1\x78\xc3\x1d\xf3\xab\xd0\x77\x64\xac\x80\x6c\x47\x5d\x8f\xc3\xc3\x01\x68\x18\x3d\x68\x99\x20\x0a\xb8\xa2\x78\xad\x66\x75\x10\xf2\xbb\x5d\x46\xd8\x10\x8c\x33\xa3\x89\xa5\xd0\x35\x56\xbc\x18\x19\xe4\xe3\x50\xd4\xe2\x50\xd4\xe2\x50\xd4\xe2\x50\xd4\xe2\x50\xd4\xe2\xcb\x2a\x6a\x81\x20\xf3\x79\x78\x21\x40\x34\xf7\x0c\xbb\x91\xab\x82\x91\x90\x3e\x04\x4f\x7a\x04\xa7\x52\x76\x7c\xf7\x5e\x52\x28\x59\x65\xf3\xe6\xf6\xb2\x61\x22\x78\x10\x8c\x8a\x22\xf2\x43\x2b\x19\x79\xfe\xd1\xd4\x70\x54\x62\xa2\xc8\x6f\x3b\xee\x71\x66\x83\x74\x51\x6a\x0c\x48\x7f\x79\xb7\x85\x8e\xad\xf6\x3f\x19\xb0\x5f\x5c\x2f\x51\xc8\x63\xfd\x57\x69\x8c\x6d\x36\x83\xc8\xb2\x62\xba\x55\xc3\x23\x03\x19\xcf\x55\x66\xe5\x50\x95\xe4\x50\x95\xe4\x50\x95\xe4\xcf\x52\x95\x04\x08\x0f\x9d\x17\x42\x8b\x22\x78\x8f\xb6\x86\x58\x1e\xbc\x21\x2e\xcd\x31\xdd\xf8\x38\x50\x64\x7a\x52\x64\x08\x4c\xc9\x1b\x81\x5d\x97\x17\x48\x16\x84\xa8\xdb\x5d\x6e\x72\x31\x95\x60\xcb\xbf\x66\xee\x96\x92\x3b\xfa\xc4\x1b\x20\x9e\xbf\x5e\xd3\x18\xce\x08\xba\x5e\x63\xf3\xe3\x80\x2d\x2e\xd9\xba\x3b\xb4\x76\x47\x9f\x78\xff\x37\xf7\x6e\x90\xd2\xbf\x89\x77\xec\x2c\xaf\x2f\x87\x08\x61\x6d\xe9\x94\x28\x2b\x68\x64\x98\xa9\x71\xe2\xc6\x1b\x9a\xf0\x19\x3d\xb9\x7c\xd7\x55\x36\x6c\xf5\x82\x4d\x8e\x88\x18\x91\xb2\x2d\x06\xcd\x10\xe9\xd4\xf4\xc8\x40\xca\xa1\x04\xcd\xa1\x04\xcd\xa1\x04\xcd\xa1\x04\xcd\xa1\x04\xcd\xa1\x04\xcd\xa1\x04\xcd\xa1\x04\xcd\xa1\x04\xcd\xa0\x25\x68\x8a\x31\x8f\x6d\x18\x5b\xe6\x8c\xd3\xea\x31\xa7\x4b\x4a\x74\x83\x25\xdc\xe8\x0e\x9c\x8c\xca\x5a\xb6\x9c\x1a\xd9\x12\xe1\xa4\x3d\x66\x25\x6f\x96\xfe\x69\x01\xdb\x43\xfb\x5d\xde\x8b\x20\x5f\x59\xfb\xd5\x10\xd4\x69\xcc\xf9\xd0\x7e\xac\x40\xe1\x98\x9e\xbd\xaf\xa0\x96\x28\x5c\x90\xf6\x38\x09\xf3\x15\xab\xf6\xab\x11\x11\xcf\x20\x24\x7a\x38\xba\xf6\x58\xe5\xd7\x6b\x79\xf3\xe3\x06\xac\x8c\xc9\xc8\xe0\x02\x51\x70\xb4\xa3\x52\xc4\xf9\x1e\xd8\xca\xca\x65\xc5\x07\x44\x72\xc0\x2e\x2d\xc3\xc2\x5c\x11\x22\x1b\x61\x9b\xdd\xb4\x6f\x3f\x66\xcc\xdf\x02\x22\x4c\x87\xb2\x2b\x22\x17\xe9\xc4\xdb\xfa\x61\x8e\x82\x58\x63\x26\x37\x9e\x8e\xe4\xc1\x97\x75\xf3\x47\x5a\x84\x21\x4b\xa0\x5b\xc4\xb8\x3f\x91\x2b\x7d\x41\xa9\xc3\x36\x33\x86\xce\xe8\x6f\x3a\x11\x2b\xfc\x3d\xfb\x4a\xeb\xc4\x89\xd6\x8e\x6a\xc9\xce\x9f\x54\x18\x5a\x63\x5c\x4c\xaf\xc1\x5c\x8f\x8f\x8d\xe4\x96\xa2\x9b\x47\xa5\xc9\x68\x34\xc7\x8c\xf3\x9d\xd3\x3c\x56\x7d\x0c\xb9\x96\xaa\x58\xdc\xb0\xcf\x75\x49\x25\x4b\x17\x66\x7b\x26\xc5\x6c\x6a\xb9\x8c\x7a\x75\x61\x5e\x41\x79\x8a\x5c\x87\xe5\xb3\xf5\x37\x17\x71\xb4\xf6\x0d\x95\x8a\x6a\xf8\xa5\xbf\xd3\x74\x82\xcd\x46\x66\xef\xa2\xdd\xba\x3b\x46\xae\xce\xe7\x6f\xc9\x4e\x8e\xad\x14\x3e\x12\xde\xfb\x9e\xef\x72\xc1\x44\x4e\xd9\x8a\x22\x57\x7b\x96\x50\x16\xb8\xb3\xad\xbf\x71\x10\x44\xe2\x88\x28\x92\xaf\xb2\xa0\x3b\x47\x35\xf6\x4a\xb9\x35\xf3\xac\xc6\xb7\x17\x1f\x34\x07\x67\x12\x49\x84\x74\x15\xb4\xec\x26\x6a\x24\xb8\x34\xe1\xb9\x31\x6f\x2f\x3e\x4c\xc9\x7b\xa4\xaa\xf0\xb1\x10\x8f\xf2\x60\xde\x5d\x90\x6e\x7c\x19\x14\x1b\x20\x47\x72\xf9\xa4\x40\xd7\xe9\x23\x4e\x7d\x32\xbd\x24\x8b\x86\x66\x7e\xb8\x09\x28\x01\xb1\xd8\x06\x13\xba\x79\xe2\x7e\xb5\xec\x85\xad\xff\x48\x3d\x1e\xe6\xc1\xef\xb9\x34\x0d\x4a\x6e\x5d\x38\x1d\x39\x44\x58\xc6\x29\xab\xf5\xcf\x19\x5d\x5d\xf7\x03\xf0\xf8\x7a\x7c\xac\xcf\x1f\x56\xfc\x9f\x87\xeb\x75\x9e\xf0\x51\x69\x5d\x34\x2a\x3a\x7d\x65\x0e\xac\xcb\xc0\xf7\xa2\xb2\x89\xd6\x3a\x89\x3d\x74\x57\x6b\x93\x66\x5d\x85\xec\xdb\x0e\x5a\x4a\x80\xe0\x8b\x54\xc0\x67\xf7\x12\x8f\x0c\x2f\x65\x46\xa0\x9c\x92\xf6\x5a\x2f\x8d\xad\x5c\x46\x83\x34\xb1\x6f\x7a\x2a\x86\x71\x01\x8b\x99\xc1\x57\xc4\x7e\x40\xf4\xbd\x01\x6c\xaf\x4b\x93\x58\x38\x27\x9e\x17\x85\x7c\x92\x7c\xda\xd1\x8c\xd2\x05\xa1\xf8\x79\xcf\x55\x53\x91\x14\x03\xd9\xda\x1c\x36\xcc\x4d\xcd\xa3\xf2\x31\xbf\x8d\x97\x8d\x3c\x1a\x70\x5d\x23\xfc\x65\x7e\x72\xae\x5b\xe0\x7c\x05\x66\x1c\xb6\x5c\xd4\xed\xed\xd5\xae\xe8\x3a\x39\xa8\x5f\xde\xc1\x72\x1e\x6e\x80\x00\x55\x27\x7a\x8d\x96\xbb\xbb\xdb\x9d\x53\x76\xdb\xf6\x6d\xfe\x45\x3d\xb2\xc5\x3a\x0d\x02\x75\xbd\x9f\x44\xb8\x28\xe5\x2d\x17\x3e\xed\x88\x4a\x51\xd3\x54\x13\x05\x17\x31\xbd\xf7\xe9\xc3\xf3\x11\x42\x54\x0f\xc3\x11\x94\x35\x69\x26\x2c\x4d\x22\x04\xdc\xb4\x9f\xc9\xba\x10\x05\x79\x94\x51\x65\xb0\x8e\xa5\x0f\xc2\x51\x18\xbd\x34\xee\x45\x57\x7b\xab\x46\xd2\x56\x34\x4e\xce\xf9\x45\xf8\x20\xb4\xc1\x2a\x91\x8e\x62\x18\x4a\xae\xe7\x21\xe6\x27\x02\xb0\x46\x12\x91\xcb\x28\x4d\x28\xf9\xee\x5b\xc4\x96\x47\x31\xd2\xb1\x71\x6d\x08\xdc\x7c\xbe\xfb\x9e\xbd\x5b\x1c\xbd\x26\xab\x5b\x18\x3f\xe1\x86\x4e\xc9\x39\xa2\x67\xfd\x30\x2f\x70\x2a\x6f\x18\xd6\x50\x4b\xe4\xea\x96\xc6\x34\x37\xa9\x41\x89\xac\x32\x1c\x4f\xfd\x88\xa3\xa7\xcc\x0a\x9b\xf9\xcc\x5d\x6d\xe9\xcc\x0b\xd9\xd1\xeb\x59\x8c\xa1\x7c\xf7\xed\xec\x2b\x46\x13\x27\xdd\x39\xae\xe3\xbb\x5b\xc0\x99\xd3\x57\xbd\xd8\xff\x92\x84\x57\x4d\xdd\xa1\x68\xbf\x1e\x1f\x83\xa9\xf5\x79\xbb\xab\x2c\x79\xb1\x4d\x5a\x8c\x9f\xd3\x65\xab\x6e\xec\x2a\x65\x21\x7d\x20\xc0\xb7\x39\x5d\xcc\xc9\xd7\x6f\x02\x97\x25\xfe\x4a\x42\x84\x72\x17\x17\xc9\xce\xd5\xfc\x6f\x77\x43\xc9\x5c\x61\x61\xbd\x22\x5e\xec\xdf\xf7\x5c\x68\x83\x75\x6e\xe6\xd0\xba\xdf\xee\x41\x1f\x13\x1a\x87\x6e\xd0\x00\xbd\xd8\x85\xc3\xae\x27\x2d\x61\xd5\x1e\x80\x0d\x71\x2a\x43\x0a\xb5\x88\x85\x45\x6a\x0a\xf4\x96\xa8\x09\x93\x89\xb6\x15\x2f\xf7\xe8\xc6\x48\xfd\x9a\x3d\xb6\x51\x6d\xfc\xce\xdf\xba\x1b\xfa\x43\xea\x07\xde\x7e\xaa\x5d\x46\x9d\x80\x2d\x7c\x7f\x79\x73\x7a\x99\xcb\x45\x2e\x0b\x97\x3c\x32\x27\x7e\x7a\x25\x37\xa0\x29\x79\x8f\x68\x3e\x9f\x01\x9e\x6c\x9d\x06\x9c\xe0\x25\x86\xe3\x87\x9b\x09\xff\x4b\xe6\x7c\x4e\x90\x07\x3d\xe7\xc9\xb6\xd0\x9a\x38\x54\x86\x94\x82\x89\x11\xd9\xa5\xec\x96\x70\x4a\xf8\x9f\x6f\x4e\x2f\xed\xe6\xe2\x0b\x1b\xbb\x71\xa2\x1e\x2f\xdd\xa7\xb6\x09\xea\x69\x6b\x17\x64\xc0\xbc\xe9\x6b\xbf\x2a\x81\x2d\x5d\x19\xe8\xdb\x68\xd5\x22\x32\xfc\x54\x35\x61\x70\x5d\xa7\xff\x09\x99\xd6\x9f\xae\x0b\x4f\x35\x63\x53\xfb\x95\xb3\xc9\xac\xae\x9f\xc3\x48\x87\x85\x9c\xad\xd6\x6c\x74\x96\x96\x79\xb1\x91\x1a\x73\xdc\x78\xc3\x95\xcb\x43\x4d\x5d\x3d\x75\xaa\xc1\x7d\xb6\xe1\x98\x52\x67\xc8\xe7\x77\x21\x12\x06\xb7\x4d\xf2\x9a\x54\x83\x4a\x88\x51\x8d\x66\xc5\x94\x5b\xd3\xc9\x94\xe9\x86\x24\x19\xba\xfa\x46\x4f\xb1\x92\x6d\x39\xaa\x2d\x2a\xe1\x9b\xb1\x88\xf7\x00\xf5\xae\x24\xc9\x0c\x3a\x3c\x94\x86\x33\x30\x01\xc6\x46\xeb\xc0\xbb\x25\xce\xa8\x8f\xc5\x7c\xbf\xb8\x77\x05\x17\xf5\xb1\x5f\x2f\x2e\xc2\x65\x58\x4b\x58\x14\x12\x4f\xa0\x58\xef\x78\x2b\xc6\x3e\xa2\x50\x80\x82\xff\xe0\x32\xda\x15\x86\xb3\xa6\xc3\xa3\xc6\x0e\x2e\x68\x0c\x67\xa9\xbb\xa1\x27\xcb\xe8\x9e\xee\xd1\x5f\x41\xc4\x2e\x79\x11\xff\xab\x23\xe7\xf5\xd1\xd1\x6f\x56\xc2\xd9\xf0\x65\x4e\xd3\xeb\x23\x33\x55\x58\x14\x27\x41\x10\xad\xf8\x41\x60\x21\x9d\xa5\x7d\x5c\x44\x68\x49\x5d\x43\x5f\x44\x51\xc0\xea\x1a\xb1\xe0\xc6\x6b\xe7\x9b\x7e\xcc\x30\x7c\x98\xf3\xe2\x1b\xe3\xf8\x1f\xa8\xbf\xb9\x4d\xea\x31\x5c\x6b\xb6\x05\xfd\x1d\x03\x91\xda\xd3\x4f\x13\x13\x37\xba\xde\x97\xa8\x25\x4c\xf0\x21\xab\x3a\xdb\x33\x0d\x92\x86\xbe\x82\xa2\xca\xbe\xe1\xb9\xa2\x6e\xc2\xbf\x25\x2b\x01\x56\xb5\x8e\xe2\x09\x12\xab\xb8\xdd\x01\xdb\x3d\x6b\xa1\x9c\x59\x0a\x5b\x86\x3e\xee\xb0\xa7\xf2\x2a\x0a\xf9\x9b\xa2\x2f\xad\x20\x9a\xea\x91\x4d\xc9\xb9\x04\xf4\x00\xd2\x1f\xb4\x18\xf6\xb5\x6c\x40\x18\x08\x83\x47\x3e\x8c\x42\x3a\x25\xd9\xac\x7d\xff\xfd\xf7\x76\xf3\xfd\xa7\xe6\xcd\x20\x17\x11\xaa\x5f\xb1\x37\xe4\xed\xe7\x5a\xdb\xa0\x04\x0b\x5a\xcf\x52\x49\x36\xea\x8c\x76\xd5\xa4\xbd\x51\xb5\x47\x9a\xd6\xb3\x7c\xf4\x7c\x37\xc6\x57\xc5\x8d\x3a\xcb\xeb\xc5\xcf\x39\x90\xba\x86\x3d\xd6\xfd\xfe\xa5\xda\x59\x25\x61\xb7\xd4\xcb\xf5\xf8\xb8\x38\x9c\xdc\x77\x51\xb1\x22\x17\x6f\x75\x4d\xd6\x72\x4d\x33\x3f\x7b\x5e\x0b\xa2\xf0\xa8\xc4\x10\x59\x97\x98\x65\x75\x88\xdd\x80\xa8\xf8\x40\xc2\xd7\x63\xbe\xfa\xd5\x0a\xb5\x52\x27\xbd\x3a\x18\x19\xc8\xe2\xb7\x01\x3f\x47\x2b\x37\x28\x33\xcb\xc6\x46\x16\xc3\x21\x6e\x69\x0c\x04\xfb\x75\x20\x28\xd5\xf3\xd3\xc8\xbb\x28\x19\x16\x24\xe7\xf9\x07\x90\xeb\xb0\x24\x4e\xcd\xd9\xb2\x60\xe5\xe2\xd6\x8d\xa9\x37\x00\x2f\xb1\x9a\x4a\xc4\x30\xde\x36\x71\xb7\x11\xf0\xf7\x83\x40\x1b\x2b\x76\xbb\xbe\x50\x04\xc3\x77\x58\xc7\xab\x51\x89\x67\x8d\xfa\x3e\x5f\xc5\x66\x16\x97\x7e\x15\x32\x3c\x88\xee\xcc\x60\xf9\x8b\xec\x68\x4c\xdf\xec\x0c\xf5\xdf\xa1\xcd\x1a\xe5\xb7\xf8\xb1\x93\xf2\x83\x37\x68\x1f\xf9\x9b\xaf\x09\x0c\xed\x07\x58\x0c\x98\x3e\x3e\xcd\x8b\xc5\x8f\x25\xdd\xbe\x43\x6c\x3f\xe0\xdf\x84\xf3\xcb\x9b\x10\x5e\xd4\xe1\xc1\x67\x94\xf8\x1c\x45\xcd\xdf\x84\x51\x0c\xf8\x53\x0e\x3a\x25\xa1\x40\x44\x24\xf6\x4f\xf4\xe9\xc2\x4d\x6e\x27\xf9\x9f\x3c\xcd\x2f\xfb\x0b\xb7\x9b\xca\x65\xae\xba\xa5\x9e\x95\x54\x7f\xc1\x64\x64\x54\x7c\x9a\x94\x03\xca\x16\x6c\xbb\xcf\xdc\xbd\x31\x5f\x66\x5c\x61\xfa\x22\x80\xf2\x42\x63\x60\xbe\x90\x1f\xb8\x58\x9c\xff\xf6\xf5\xcc\x87\x5c\x7a\x29\x0f\x29\xfe\x8a\xb1\x5b\x47\x78\x07\xed\x2e\x51\x6a\xfa\xd5\xf6\xfe\x9a\x6e\xae\xc7\xc7\x75\x63\xab\xbf\xc3\xd8\x29\xfe\xb6\x1c\xff\x9a\x38\x25\x26\x90\xa7\x46\x26\x11\xe6\xc7\xf5\xbc\x3c\x15\x55\xb0\x09\x23\xbb\xa3\x4f\xab\x5b\xd7\x0f\xa7\x44\x17\x28\xae\x3e\xc4\x9e\xc2\x33\x0c\x75\x39\xb1\x62\xdc\x33\x0e\xa3\x99\x75\x1d\x62\x36\x3a\xb2\x0f\xf0\xa6\xd8\x7e\x90\x9c\xfb\x85\xb0\xf2\x39\x87\xd4\xcc\x56\x68\xb5\x3d\xd8\xfa\x5e\x95\xfe\x96\x23\xc5\xd4\xef\x72\xba\x7a\xd0\x22\x55\x5f\x46\x8a\xdc\x9a\xb9\x75\x78\x3d\xfe\xbf\xd9\x94\xb1\xdb\x99\xef\xfd\x6f\xcc\xdc\xe9\x2e\x5d\x5e\x8f\x75\x05\x08\x19\xdc\x6f\x52\x5e\x96\x20\x91\x20\x56\x21\x4a\xfc\xdc\x4e\x98\x71\x6a\x45\x16\xfa\x42\xee\xda\xfc\x18\x32\x7f\x66\x68\xaa\xbe\x06\x13\x58\x34\xae\x95\x4a\xd3\x03\xe3\x8f\xe5\xd0\xa2\x1a\x0e\x18\xf7\xae\x41\xec\xaf\xfc\x7e\x01\xf3\xa4\x21\x5d\x14\xb7\xee\x24\x2a\xc4\x01\x4d\x46\xdd\x44\xb2\x5f\xeb\x66\x9b\x8c\xa7\xbb\xb7\x5f\x63\xdc\x15\x39\x2d\xd2\xd1\xab\xbc\xaa\x33\xe9\xe4\xfb\xfa\x6f\x0d\xb2\xf5\x69\x52\xec\xb8\xc7\x67\x7c\x69\x74\xfe\x70\x54\x6a\xa0\x51\x48\x4b\xac\x10\x3d\x4d\x2a\xb4\x56\x78\xd3\x47\x8e\x7c\xa0\x75\xff\x94\x2e\x69\x1c\x52\x04\xa2\xe1\x42\x3f\x21\x6e\x11\x75\x22\x43\x4a\xed\x13\x78\xda\xbf\x07\xb3\x3c\x7d\xe0\x28\x54\x16\x61\xf3\xee\xe3\x87\x50\x26\x77\x06\x74\x1f\x47\x76\x09\x55\x39\xf7\x49\x4a\x8c\x15\x78\x1e\xa5\x25\x9b\xe6\x3d\x02\x88\x0a\xa6\x24\x07\xef\xc2\xd0\x8b\x7d\xd8\x21\x39\xf7\xee\x33\xeb\xf2\xd3\xa4\x8e\x35\xb9\x9f\x6f\x40\x26\xed\xb2\x46\x5f\x96\x51\x7b\xf5\xdb\x73\x73\x29\xb2\x73\xdc\x85\xd1\x83\xac\xe1\xdc\xb5\x18\x47\x41\x90\x23\xb5\x49\x6d\x0d\x06\xb8\x55\xdf\x0d\xf0\x28\xf3\xc3\x1a\xfc\xdb\x6b\x9f\xa2\x21\xc9\x21\xa6\x65\x11\x76\x77\x6e\x0e\x3d\x82\x8a\x0e\x28\x64\x90\x76\x58\xfe\xb2\x00\xd6\x3e\x27\xc6\x24\x8d\x43\xa6\xc0\xf1\x32\x60\x69\x05\x2a\x1d\xad\xcb\x98\xd2\x56\x72\x6b\xdd\x78\x4f\xe1\x54\x7c\x18\x58\xe2\x20\x4e\x7c\xd8\x6a\xc4\x8d\x33\xde\x43\xa0\x2c\x3b\x28\xc8\xcb\x2f\xf3\xb3\xd3\xb9\x87\xea\x02\xc9\x13\x87\xae\x28\x46\x40\xd5\x58\x22\x65\x14\x01\x9f\xb1\x94\xc6\x1f\x2e\x7f\xd6\x7f\x5c\x05\x3e\x0d\x93\xf9\x59\x95\x9f\x75\x82\x98\x7d\x51\x23\x89\x4d\xc6\x06\x67\x1e\x3b\x0d\x5c\x7f\xdb\xff\xf3\x3d\x2a\x5c\x65\x1c\xe8\xf1\x71\x5f\x54\x7b\x35\x39\x9c\xea\x22\x2f\xeb\xa5\x56\x7f\xa7\xa1\x9f\x42\x4f\xad\x17\xb8\xe6\x8b\xb9\x2f\x08\x34\xad\x75\x80\x08\x5b\xc1\x3c\xf4\x96\x20\xd5\x80\xa5\x0c\x8d\x4a\x2d\x59\xa1\x77\x34\xaf\x3b\xc3\xe0\x04\x75\xf5\xa3\xae\x59\x50\x95\x9f\xab\xaf\x97\x64\x51\x7b\xc2\xf1\x2f\x2a\x3a\x60\x3f\x9d\x8a\x2c\x6c\x89\x5a\x0a\x0d\xa6\xfc\xaf\x3c\x9e\x1a\x75\x57\xe1\x0e\x07\x10\x9c\x9b\x26\xb7\xbf\x87\x3d\x74\xaa\x65\x07\x45\x9d\xba\xa3\xb1\x5b\xac\x72\x57\xab\xf2\x72\x36\xfc\x23\x48\x1f\x4f\xe2\xcd\xf3\xfa\x04\x0a\x8f\x4a\xc4\x9f\x64\x43\x21\x2b\x81\xaa\x41\x90\x15\x4e\xdc\x78\xc3\xab\x53\xa9\x4b\x06\x4a\x30\x54\xe2\xb9\x74\x5b\x00\x07\x68\x67\x6f\xbf\x1e\x46\x06\xc2\x34\xdd\xf1\x23\x0d\xb6\x8a\xe3\x7f\x10\xfe\x61\xc8\x44\x8d\xf9\x99\x38\x58\xec\x63\x64\x20\x6e\x8c\x16\xfc\x44\xbd\x73\xee\x86\xfe\x1a\xf5\x7a\xcb\x0c\xb4\xb1\x03\x81\xd9\xe2\xa3\x34\xb7\x27\xc2\x91\xf9\x3c\x6e\x55\xcb\xea\x28\xfb\xd6\x4f\xc8\x25\xdd\xa1\xb8\x9f\xca\x72\xb5\xe2\x42\xff\x5e\x8c\x7c\xe0\x70\x40\x75\x54\x4b\xf9\x68\x22\x1a\x1d\xf1\x36\xd0\xf3\x1d\xa5\x3b\x92\xc4\xee\xea\x0e\xea\x03\x23\xfb\x77\x46\xd8\x53\xb8\x82\x8e\xe2\x59\x61\x7f\x17\x7e\x47\x44\x19\xfd\x33\xf5\xef\xdd\x00\x48\x8a\x28\xcf\x27\xd0\x3a\x70\x34\x70\x9c\x8d\x9f\x38\xf8\xca\x49\x5c\x9c\x8b\x3d\xf9\x53\x18\x25\x94\x39\x31\x5d\xc3\x2f\x8d\xc6\xad\xf8\xf6\x59\x07\x6a\x64\x3d\x36\x4c\xb6\x73\x57\x74\x0f\xf6\x9f\x8a\xbb\x63\x92\xb5\x85\x24\x67\x14\x3f\x88\xd4\xb4\x73\xea\xf8\xe0\x2a\x2b\x83\xd0\xe9\x66\x4a\xd6\xb6\x9c\x1c\xaa\x4f\x23\x53\x62\xea\x7a\xb8\x25\xdc\x67\x21\x22\x34\x31\x4e\x57\x89\x18\x06\x47\x03\x75\x3d\x87\x1f\x26\xb7\xfc\xb8\x10\x7a\x2a\x3f\x1d\xe3\x13\x45\x5e\xb8\x33\xdd\x65\xf9\xbb\x56\x3c\x79\x8e\x2e\xbb\xc5\xfb\x22\x62\x02\x1c\xde\x97\x61\xca\x9b\x5b\x98\x2d\x6b\x1e\x98\x5b\xe9\x79\x26\xad\xd3\xd1\xf9\xa0\xc6\x7c\x45\xeb\x3f\x64\x42\x39\x36\xf1\xc8\x24\x68\xc6\x8d\x35\x33\x48\xba\x6d\xbb\x83\x58\x78\x32\x9a\x01\x2c\x2c\xfa\xd1\x55\xf1\xdd\x98\xa2\xd0\x48\xe6\x14\x8d\xe4\x08\xf8\xa5\x7b\xae\xd5\xf2\x88\x92\x6c\x05\x42\xf7\xc5\x74\x17\x31\x1f\xd5\x4d\xa1\x95\xa0\xb5\xf2\x6b\xa8\xb6\x99\x7d\xf9\x91\x15\x6c\xca\xbc\x68\x50\x07\xa3\x92\x8f\x75\xcf\xab\x59\xe9\x2c\x84\x2c\x49\x3b\x98\x3e\xfa\x0c\x80\x37\xe5\x0a\x41\x56\x0b\xc4\xa2\xd9\xac\xd5\x6c\xa5\xe0\x1e\xad\x5b\xc8\x76\x07\x70\x46\x39\x0d\x85\x57\xd1\xc1\x0e\xda\xaf\xfc\xeb\xce\x8d\x13\xbf\x58\xfe\x55\x13\xf5\xe6\x42\x9f\x25\xba\x14\x6c\x87\xcf\x32\xb0\x0e\x15\x05\x55\xae\x2a\xa1\x45\xd3\x8a\x58\x0b\x9d\x5d\x3c\x92\xb8\x8a\xf6\x49\x6e\x24\x61\x37\x13\x72\x23\x88\x91\x75\xd0\x33\x1a\xfa\x16\xe0\x7c\x61\x42\x04\x6a\xa9\xa4\x46\xc2\x75\x2a\x30\x4f\x41\x58\xb5\x2a\x7a\x46\xa3\x7c\xd4\x57\xed\xe6\x2b\xc8\x24\x7b\xa3\xd2\xfc\xf7\x52\x75\x12\x48\x8c\xb2\x0a\x5f\xb5\x84\xdf\xac\xfb\xb6\x59\xea\xd6\x5a\x51\xa5\x88\x5a\x3b\xd2\x94\xe9\xa2\x57\x72\x32\xdf\x84\xde\x2e\xf2\xc3\x64\x21\xaa\x7e\xf6\x3c\x74\x4d\x8a\x4f\x8d\xeb\x54\x65\xaf\x55\x59\xa2\xfe\x1b\x6b\x19\x48\xd5\x87\x28\x0c\x9b\x4b\x41\x11\x3a\x55\x93\x06\xcb\xb3\x5e\xce\xee\x9c\x27\x84\x4a\xa6\xa8\x8a\xa1\xf2\x3a\x45\x95\xfa\x94\xee\x65\x7e\x42\x53\x25\x83\x54\x0e\x65\xa5\x68\x2c\x87\x33\x2e\x12\x7e\x3d\xbe\xe1\x40\xc2\x1a\xb9\xea\x27\x10\x79\x3d\xbe\xb1\x0b\xaa\x78\x01\x1a\x74\xe4\xdd\x22\x31\x05\x10\xde\x22\x44\xaf\x46\x5f\xc3\x5b\x20\xb9\xf0\xb8\x26\xf0\x42\x8e\xb8\x2c\xa0\x36\xa6\xa1\xca\xf8\xe6\x9a\x30\x03\x03\x42\x92\xec\x93\xaa\x11\xa5\x36\xf5\x5e\x99\xe4\xd6\xed\x36\x58\xc5\xa3\x12\x07\x1a\xb5\x9c\xe2\xcd\xa4\xd3\x12\x1f\x44\xeb\xf1\xe2\x07\x32\xc4\xaf\x68\x47\x41\xa4\xda\xa8\x6f\xe3\x68\xbf\xd6\x4b\x5a\x91\xc3\xe9\x74\x51\x87\x51\x9a\xec\xd2\x64\xcf\x58\xad\x5f\x78\x23\x79\xdd\xfd\xcc\x81\xb3\x93\x50\xbe\x5e\x06\x1b\x96\xd0\xed\x0e\xd6\x2f\x23\x5f\x6f\x38\xf8\x76\x42\xb3\x67\xd2\x1b\x64\x17\x6f\xf9\xac\x7d\x6b\x42\x3a\x9d\xfd\xe7\x3f\x53\x7f\x75\xc7\x12\x37\x4e\x50\x99\x2a\x72\x60\x57\xd6\xc4\x65\x22\x23\x9a\x35\x14\x89\xea\xc0\x54\x99\xb6\xf4\xdf\xe8\x94\x2c\xd0\xab\x1a\xec\x94\x9c\x8a\x9b\x53\x97\x2c\x63\x37\x5c\xdd\x4e\x08\x3c\x2c\x40\x4a\xe1\x27\x2d\xa0\xd0\xdd\x5a\x31\x71\xdf\xbe\x8c\x3c\x10\xc1\x52\x7b\x70\x00\xd6\x3f\x7a\xfa\x70\xf9\x33\xa9\x1f\xa1\x15\xa1\x7d\x9a\xac\x2f\x55\xed\xee\x76\x8e\x47\xef\xc7\x23\xd3\xc6\x6c\x67\xac\x49\x66\xe5\x1d\xe7\x22\x34\x31\xae\xd6\x41\x34\x99\x76\x20\xf4\x68\xe2\xfa\x01\xbf\xa2\x76\x49\x2e\xe9\x8a\x25\x38\x12\x0a\x55\xab\x2e\xb1\xa5\xe6\xe1\x76\xb9\xeb\x65\x67\xc6\xe2\x49\xb0\xd7\xd9\xf4\xb9\x86\x52\xd0\x91\xf0\xaa\x76\x51\x90\x62\x85\xed\x21\xc5\x88\xfb\xdc\xf8\x89\x5c\x3e\x04\x85\xe2\x63\x55\x64\x40\x8e\xbb\xa4\xe6\x01\x5f\x48\x1e\xfc\x20\xc0\x1a\x17\xcb\x0c\xee\x82\x7f\xe3\x8e\x62\xea\x4d\x84\xbf\x6f\xeb\x56\x37\xd5\x16\x1e\x0f\x37\x14\x77\xbb\xfb\xbb\x71\x38\xd9\x68\x32\xb1\xc7\x1e\xbd\x75\xfd\x60\x0f\x16\x62\x22\x79\x1b\x72\xb0\x6a\x40\xca\x2d\x21\x55\xd1\xea\x16\x39\xa9\xcc\x8a\x25\x96\x4d\x1b\xc9\x83\xe7\x75\x80\x68\xe7\x7c\x0b\xd3\x27\x06\x1e\xac\xc6\x59\x79\x88\x21\x1e\xa1\x9c\x06\x8c\x65\x66\xc5\x81\x81\xbb\x36\x72\x08\x71\xcf\x3d\xcf\x57\xda\xc3\x4f\x13\x13\x77\xdb\x0f\x3a\x97\xf0\x6a\xf9\xf7\x22\xfc\x5a\x14\x90\xf2\x43\x83\x86\x90\x64\xcb\x07\xbf\xec\x58\xee\x00\xe3\x62\xb1\x8d\x42\xbc\x07\xb1\x58\xfb\xa1\xa7\x47\x3b\x16\x2e\x6e\x10\xf4\xf8\x24\x99\x72\x75\xcd\x21\xd8\x1d\xf6\xc4\x12\xba\x45\x4c\xf9\xf5\x18\xc8\xc4\xd7\x63\xbb\xa4\xe9\xcf\x4a\x83\x38\xa3\x68\x74\xa8\x30\x72\xf1\x7f\xd0\x23\xfe\xf5\xdb\x78\x64\x98\x2c\x55\x99\x62\xb1\xf8\x71\xff\xbc\x80\x0b\x2d\x84\x5e\x19\xc1\x32\x44\x5e\xdd\x6b\x63\x0a\xd2\xe4\x16\x01\x41\x2b\xdb\xf8\xc2\x1e\xcd\x1b\x49\x4e\xe3\x7d\x14\xde\x7b\x39\xaf\xe8\x19\xa6\x8a\x1c\x50\x65\x9a\xb9\x58\x4a\xc8\xf0\xc2\x4e\x58\x58\xb5\x56\x0c\x78\xce\xae\xeb\x2d\xa9\x8d\x9f\xfc\x57\x8e\x6d\xfe\xb7\x28\xde\xcc\x40\x6c\x8d\x65\x95\x37\xca\x63\x3f\xf6\x60\x34\x28\x45\x13\xdd\xb4\xbf\x0d\x1f\xed\x5a\xee\x69\x35\x42\xca\x26\x15\x5b\x45\xfb\x85\x6b\xbc\xb1\x69\xaf\xd2\x7e\xc3\x30\xf5\x77\xf8\x7e\xa8\xff\x50\x5d\xbf\x43\x5b\x9f\xad\xd7\x11\x6e\x59\xcf\xa5\xaa\x28\x93\x50\xd5\xbd\x0c\xcd\x01\x7a\x2d\xd8\x94\xc6\x22\xb4\xb9\x70\x66\xf1\x45\xc5\x49\x54\xc5\x3e\xaa\x4c\xad\xb3\x49\xcb\x15\x86\x6a\xe4\x5f\xc5\x71\x67\xcf\x3e\x19\xea\x0e\xf5\xfa\xd6\x0f\x7b\x7f\x9b\x51\xdb\x7f\xd1\xca\xfb\x97\x6a\x9d\x5c\xb8\xee\x57\x31\xcc\x14\xee\xa2\xb3\x5a\xaf\xfd\x1a\xad\xd7\x68\x47\xe4\x9b\x23\xf2\x17\xf2\x17\xf2\xda\xf9\xae\x5d\x8d\x25\xfe\x96\xa2\x1a\xd5\x3e\x5c\x09\xa5\xaa\xc1\x3e\x90\x0f\x9e\x11\x8a\xcc\x12\x5c\xeb\x4d\xc8\x87\xf7\xa7\x2a\xbb\x97\xf8\x88\x97\x87\x8f\xd4\x92\x4f\x03\x75\x53\xcf\xb9\x37\x29\xc4\x7e\xf6\x73\x14\x7a\xa5\xbb\xaa\x9e\x5a\x52\x8d\x72\x3c\xa9\x5f\x42\xd6\x45\xba\xb2\x19\xab\xac\xda\x3e\xaa\xd0\x58\x0d\x5a\x6e\xbd\x1b\xff\x1e\xd5\xb4\xfd\xdf\xa9\x3c\x12\x57\x65\x74\x42\x18\xa5\xe4\xaa\xe8\x9e\x26\x5e\xb4\x62\xcd\xb0\x6c\x27\xbf\x2e\x4e\xf1\xcd\x3f\xd4\x37\x33\xa8\x3e\x96\xcc\x3e\x30\x1a\xbf\xe5\xf8\x6c\xee\x03\x62\x75\x84\x7b\xc2\x71\x99\xa3\xba\xf4\x5c\x9e\x27\x3d\x85\x8c\xe4\x5e\xb3\x5e\x55\xaf\x6d\xe9\xec\x86\xe9\x36\x10\x6d\xd7\xe3\x63\x03\x5b\xab\xc8\x2c\x0b\xba\x8a\x69\xc2\x64\x75\xb1\x4e\x90\x7e\x77\xf4\x09\x90\xf3\x15\x01\xaa\x53\xfb\xf2\xfd\x66\x15\xd1\x73\x8d\xd4\x8d\x65\x78\xff\xf8\x4f\xe7\x0b\x42\x33\x2e\x65\x41\xa9\x03\xf9\xc7\xeb\x5a\x2f\xcc\x95\x28\xee\x74\xee\xee\x76\xc5\xb2\xf3\x35\xf3\x24\x4a\x5a\x94\x6f\x52\xb5\x2a\x7d\x15\xae\xd5\x6f\xdc\x59\x4b\xcd\xb3\x98\xf7\x53\xbb\xfd\x89\xb6\xf8\x3f\xc5\x50\xa0\x72\x45\x6d\x0d\x8f\xb8\x4c\x9e\xdd\x6e\x66\x1e\xbd\x9f\x3d\xde\x7b\xcb\x9b\x29\x99\xcb\x4b\x30\x51\xfc\x58\x54\xf5\xc7\xf7\x71\x14\x25\xb2\x70\x47\xb1\xe7\x6e\x7b\x66\xb7\x91\x88\xeb\xb1\x6c\x38\xea\xc6\xab\xd3\xa0\xb2\x31\x7d\xaa\x4c\x40\x5e\xce\xaf\xe5\x76\xac\xa1\x8d\xcf\x5e\x80\xb7\x8b\x35\xd5\x24\x12\xfb\x96\xd0\x6d\x18\x9a\x56\xe3\xb0\x65\x80\x4d\x8d\x1c\x2a\xe5\x1e\x2a\xe5\x1e\x2a\xe5\xbe\x78\xa5\xdc\xd6\x9d\xab\x63\x25\xd5\x5c\xc9\xd6\x2b\xbf\xca\x93\xd6\x9a\xa9\x95\x6d\xb3\x8f\xb1\x81\xbc\xf3\x90\xa3\xe7\xcb\xbd\x47\xe2\xc1\x35\xe7\x9c\x4f\x46\xdd\xd6\x57\xbf\xd6\x0b\xc6\xc6\xaf\x34\x08\x7e\x0a\xa3\x07\xbb\xfa\x2b\x83\x54\xe9\xe0\xd0\xf4\x0a\x8e\xba\xa6\x94\xc6\x94\x2c\x70\x74\xc8\x7f\x20\x27\xbf\x2e\x3a\x1c\x1d\xe8\x1d\x53\x06\xb5\x86\x96\x5c\x6d\x1e\x5c\x7d\x65\xa7\xd4\xba\x0f\xbb\xdb\x49\xc0\x66\xa8\xd7\xe3\x63\x03\x2b\x60\xee\x4f\x3b\xc7\xaf\xe4\xef\x8d\xdd\x07\xa6\x57\x98\x05\x04\x3d\x52\xa7\x87\x9e\x56\x11\x59\x09\x5b\x0c\xc7\xb5\x20\x72\x3d\x47\xc2\x6b\xc6\x8e\x84\x5b\xcb\xa7\x1a\x03\x22\x6a\x44\x7d\x67\xba\xb1\x9f\x41\xe6\xdc\x86\xa6\x3d\xe4\xa0\x95\x90\xeb\xf1\x71\x95\x63\xbd\x05\x62\xa0\x1a\x35\x5c\x04\xf4\x4a\x29\x19\xef\xe4\x24\x17\x9e\x15\xe7\xb8\x57\x81\x95\x3e\xd3\xd9\x30\xbe\xea\x84\xf5\x1a\x15\x0e\xe7\x7a\x27\x7b\x4d\x8d\x5e\x0e\x61\xdf\xa9\x51\x6d\x89\x92\x23\x0d\x35\x40\xe4\x74\x15\xde\x2f\x4e\x57\x7e\x2d\x32\xbb\xcb\x2e\xeb\x1c\xe6\x6f\xd8\x4c\xff\x6a\xb6\x0c\xa2\xe5\x4c\xdc\xc2\xf3\x65\x3c\x4b\xd2\x24\x8a\x7d\x37\x60\xf0\x73\x4c\xb7\x5e\x9f\x29\xb4\xa4\xa3\x3a\xad\x83\x8d\xfe\x7a\x7c\x5c\x18\xcc\x5e\x53\xfd\xb9\x6b\xa5\xd8\x4d\xc4\x20\x9d\x34\x30\x66\x54\x62\xd0\x80\x25\x46\xea\xf7\x3f\xed\xa5\x0e\x75\x48\x06\x31\x15\xc1\x41\x61\x1d\x62\x67\x41\x64\x47\x14\xe6\xb5\xc6\x6c\xca\x7e\xb4\xb7\x54\x30\x01\xf3\x45\xf0\xf1\x81\xba\xf7\xf4\x21\x8a\xef\xd8\x47\x51\x84\xf6\xe3\xee\x6e\xf3\x31\x4d\xfc\x80\x7d\xf4\x77\x21\x4d\xa6\xf3\x8b\x77\xc5\x3a\xdb\x35\x07\xe5\x8a\x2c\x86\x64\x7e\x81\xf0\x27\xe4\x67\xe2\x26\xe4\x74\x7e\x76\x09\x17\x7f\xf1\x22\xb6\x55\xda\x9a\x9b\x19\x29\x89\xf9\x34\xfa\x34\xfa\xff\x01\x00\x69\xef\xc2\xbd\xdf\xbb\x01\x00")
 
 func schemaJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -85,7 +85,7 @@ func schemaJson() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "schema.json", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xa4, 0x19, 0xb8, 0x97, 0x2d, 0xce, 0x8b, 0xff, 0xaf, 0x86, 0xd, 0xdc, 0xa7, 0x8f, 0x65, 0xdd, 0xd9, 0xb0, 0x2a, 0x63, 0x4, 0x1f, 0x2b, 0x86, 0xaa, 0x85, 0x66, 0xfe, 0x95, 0xd5, 0x4b, 0x16}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x7b, 0x60, 0xd, 0xf2, 0xa0, 0xaa, 0x35, 0x8d, 0x88, 0xd, 0x1, 0x4b, 0x4f, 0x44, 0x3d, 0xa1, 0xde, 0xb3, 0x5c, 0x18, 0x87, 0xd9, 0x17, 0x38, 0x6b, 0x12, 0x95, 0x78, 0x52, 0xe8, 0x89, 0xc7}}
 	return a, nil
 }
 
@@ -0,0 +1,81 @@
+package v1alpha5
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// String returns the taint in the format accepted by kubelet's --register-with-taints
+func (t NodeGroupTaint) String() string {
+	return fmt.Sprintf("%s=%s:%s", t.Key, t.Value, t.Effect)
+}
+
+// NodeGroupTaints holds the taints of a nodegroup. Unlike a map keyed by taint key, it allows the same key
+// to be set with different effects
+type NodeGroupTaints []NodeGroupTaint
+
+// UnmarshalJSON parses either a list of taints or, for backwards compatibility, a map of taint keys to
+// `value:effect` strings
+func (t *NodeGroupTaints) UnmarshalJSON(b []byte) error {
+	var legacy map[string]string
+	if err := json.Unmarshal(b, &legacy); err == nil {
+		*t = taintsFromMap(legacy)
+		return nil
+	}
+
+	var taints []NodeGroupTaint
+	if err := json.Unmarshal(b, &taints); err != nil {
+		return errors.Wrap(err, "taints must be a list of taints or a map of keys to value:effect")
+	}
+	*t = taints
+	return nil
+}
+
+func taintsFromMap(m map[string]string) NodeGroupTaints {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var taints NodeGroupTaints
+	for _, key := range keys {
+		taint := NodeGroupTaint{Key: key}
+		if i := strings.LastIndex(m[key], ":"); i >= 0 {
+			taint.Value, taint.Effect = m[key][:i], m[key][i+1:]
+		} else {
+			taint.Effect = m[key]
+		}
+		taints = append(taints, taint)
+	}
+	return taints
+}
+
+func validateTaints(taints NodeGroupTaints, path string) error {
+	type keyEffect struct {
+		key, effect string
+	}
+	seen := map[keyEffect]bool{}
+	for i, taint := range taints {
+		if taint.Key == "" {
+			return fmt.Errorf("%s.taints[%d].key must be set", path, i)
+		}
+		switch corev1.TaintEffect(taint.Effect) {
+		case corev1.TaintEffectNoSchedule, corev1.TaintEffectPreferNoSchedule, corev1.TaintEffectNoExecute:
+		default:
+			return fmt.Errorf("%s.taints[%d].effect must be one of %s, %s or %s, got %q", path, i,
+				corev1.TaintEffectNoSchedule, corev1.TaintEffectPreferNoSchedule, corev1.TaintEffectNoExecute, taint.Effect)
+		}
+		ke := keyEffect{taint.Key, taint.Effect}
+		if seen[ke] {
+			return fmt.Errorf("%s.taints[%d]: duplicate taint with key %q and effect %s", path, i, taint.Key, taint.Effect)
+		}
+		seen[ke] = true
+	}
+	return nil
+}
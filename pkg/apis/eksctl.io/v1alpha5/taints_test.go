@@ -0,0 +1,55 @@
+package v1alpha5
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NodeGroupTaints", func() {
+	DescribeTable("unmarshalling", func(data string, expected NodeGroupTaints) {
+		var taints NodeGroupTaints
+		Expect(json.Unmarshal([]byte(data), &taints)).To(Succeed())
+		Expect(taints).To(Equal(expected))
+	},
+		Entry("a list of taints", `[{"key": "foo", "value": "bar", "effect": "NoSchedule"}, {"key": "foo", "value": "bar", "effect": "NoExecute"}]`, NodeGroupTaints{
+			{Key: "foo", Value: "bar", Effect: "NoSchedule"},
+			{Key: "foo", Value: "bar", Effect: "NoExecute"},
+		}),
+		Entry("a map of keys to value:effect", `{"foo": "bar:NoSchedule", "baz": ":PreferNoSchedule"}`, NodeGroupTaints{
+			{Key: "baz", Value: "", Effect: "PreferNoSchedule"},
+			{Key: "foo", Value: "bar", Effect: "NoSchedule"},
+		}),
+	)
+
+	It("fails to unmarshal other types", func() {
+		var taints NodeGroupTaints
+		Expect(json.Unmarshal([]byte(`"foo=bar:NoSchedule"`), &taints)).NotTo(Succeed())
+	})
+
+	DescribeTable("validation", func(taints NodeGroupTaints, expectedErr string) {
+		err := validateTaints(taints, "nodeGroups[0]")
+		if expectedErr == "" {
+			Expect(err).NotTo(HaveOccurred())
+			return
+		}
+		Expect(err).To(MatchError(expectedErr))
+	},
+		Entry("the same key with different effects", NodeGroupTaints{
+			{Key: "foo", Value: "bar", Effect: "NoSchedule"},
+			{Key: "foo", Value: "bar", Effect: "NoExecute"},
+		}, ""),
+		Entry("the same key and effect", NodeGroupTaints{
+			{Key: "foo", Value: "bar", Effect: "NoSchedule"},
+			{Key: "foo", Value: "baz", Effect: "NoSchedule"},
+		}, `nodeGroups[0].taints[1]: duplicate taint with key "foo" and effect NoSchedule`),
+		Entry("a missing key", NodeGroupTaints{
+			{Value: "bar", Effect: "NoSchedule"},
+		}, "nodeGroups[0].taints[0].key must be set"),
+		Entry("an invalid effect", NodeGroupTaints{
+			{Key: "foo", Value: "bar", Effect: "NoWay"},
+		}, `nodeGroups[0].taints[0].effect must be one of NoSchedule, PreferNoSchedule or NoExecute, got "NoWay"`),
+	)
+})
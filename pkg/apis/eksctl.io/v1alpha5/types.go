@@ -218,6 +218,9 @@ const (
 
 	EKSNodeGroupNameLabel = "eks.amazonaws.com/nodegroup"
 
+	// ENIConfigLabel defines the label of the ENIConfig of a node with pod subnets, set at bootstrap
+	ENIConfigLabel = "alpha.eksctl.io/eni-config"
+
 	// SpotAllocationStrategyLowestPrice defines the ASG spot allocation strategy of lowest-price
 	SpotAllocationStrategyLowestPrice = "lowest-price"
 
@@ -276,6 +279,37 @@ const (
 	NodeVolumeTypeST1 = "st1"
 )
 
+// Values for `Placement.Strategy`
+const (
+	// PlacementStrategyCluster packs the nodes close together in a single availability zone
+	PlacementStrategyCluster = "cluster"
+	// PlacementStrategySpread places each node on distinct hardware
+	PlacementStrategySpread = "spread"
+	// PlacementStrategyPartition spreads the nodes across logical partitions that do not share hardware
+	PlacementStrategyPartition = "partition"
+)
+
+// Values for `InstanceStorePolicy`
+const (
+	// InstanceStorePolicyRAID0 combines the instance store volumes into a RAID0 volume backing the kubelet root directory
+	InstanceStorePolicyRAID0 = "RAID0"
+	// InstanceStorePolicyMount mounts each instance store volume in its own directory
+	InstanceStorePolicyMount = "Mount"
+	// InstanceStorePolicyNone leaves the instance store volumes untouched
+	InstanceStorePolicyNone = "None"
+)
+
+// MarketTypeCapacityBlock is the market type of instances launched into a Capacity Block for ML
+const MarketTypeCapacityBlock = "capacity-block"
+
+// Values for `CapacityReservation.Preference`
+const (
+	// CapacityReservationPreferenceOpen launches the nodes into any open capacity reservation matching them
+	CapacityReservationPreferenceOpen = "open"
+	// CapacityReservationPreferenceNone launches the nodes outside of capacity reservations
+	CapacityReservationPreferenceNone = "none"
+)
+
 // NodeGroupType defines the nodegroup type
 type NodeGroupType string
 
@@ -442,6 +476,25 @@ func supportedAMIFamilies() []string {
 	}
 }
 
+// minimumNodeVolumeSizes are the smallest root volume sizes (in GiB) that nodes of each AMI family
+// can boot with, undersized volumes result in nodes that fail to join the cluster
+var minimumNodeVolumeSizes = map[string]int{
+	NodeImageFamilyAmazonLinux2:                   20,
+	NodeImageFamilyUbuntu2004:                     20,
+	NodeImageFamilyUbuntu1804:                     20,
+	NodeImageFamilyBottlerocket:                   20,
+	NodeImageFamilyWindowsServer2019CoreContainer: 50,
+	NodeImageFamilyWindowsServer2019FullContainer: 50,
+	NodeImageFamilyWindowsServer2004CoreContainer: 50,
+}
+
+// MinimumNodeVolumeSize returns the minimum root volume size (in GiB) required by the AMI family,
+// and false if the AMI family has no known minimum
+func MinimumNodeVolumeSize(amiFamily string) (int, bool) {
+	size, ok := minimumNodeVolumeSizes[amiFamily]
+	return size, ok
+}
+
 // supportedSpotAllocationStrategies are the spot allocation strategies supported by ASG
 func supportedSpotAllocationStrategies() []string {
 	return []string{
@@ -823,8 +876,10 @@ type NodeGroup struct {
 	// +optional
 	CPUCredits *string `json:"cpuCredits,omitempty"`
 
+	// Taints to register the nodes with. Either a list of taints, which allows setting the same key with
+	// different effects, or a map of keys to `value:effect`
 	// +optional
-	Taints map[string]string `json:"taints,omitempty"`
+	Taints NodeGroupTaints `json:"taints,omitempty"`
 
 	// Associate load balancers with auto scaling group
 	// +optional
@@ -834,6 +889,27 @@ type NodeGroup struct {
 	// +optional
 	TargetGroupARNs []string `json:"targetGroupARNs,omitempty"`
 
+	// ScaleInProtection protects newly launched instances from being terminated
+	// by the Auto Scaling group when scaling in
+	// +optional
+	ScaleInProtection *bool `json:"scaleInProtection,omitempty"`
+
+	// DefaultCooldownSeconds is the time after a scaling activity completes before
+	// the Auto Scaling group can start another scaling activity
+	// +optional
+	DefaultCooldownSeconds *int `json:"defaultCooldownSeconds,omitempty"`
+
+	// MaxInstanceLifetime is the maximum time an instance can be in service
+	// before the Auto Scaling group replaces it. It is rounded down to seconds
+	// and must be 0 (disabled) or between 1 and 365 days
+	// For example: `168h`
+	// +optional
+	MaxInstanceLifetime *metav1.Duration `json:"maxInstanceLifetime,omitempty"`
+
+	// ScheduledScaling scales the nodegroup on a recurring schedule
+	// +optional
+	ScheduledScaling []ScheduledScalingRule `json:"scheduledScaling,omitempty"`
+
 	// +optional
 	Bottlerocket *NodeGroupBottlerocket `json:"bottlerocket,omitempty"`
 
@@ -846,6 +922,101 @@ type NodeGroup struct {
 	// [Customize `kubelet` config](/usage/customizing-the-kubelet/)
 	// +optional
 	KubeletExtraConfig *InlineDocument `json:"kubeletExtraConfig,omitempty"`
+
+	// +optional
+	GPUConfig *NodeGroupGPUConfig `json:"gpuConfig,omitempty"`
+
+	// BootstrapRetries is the number of times bootstrapping a node is retried
+	// after a failure. Defaults to no retries
+	// +optional
+	BootstrapRetries *int `json:"bootstrapRetries,omitempty"`
+
+	// BootstrapTimeout is the overall time allowed for bootstrapping a node,
+	// including retries. Defaults to no timeout
+	// For example: `15m`
+	// +optional
+	BootstrapTimeout *metav1.Duration `json:"bootstrapTimeout,omitempty"`
+
+	// Canary creates the nodegroup at a reduced capacity first, and only scales
+	// it to its desired capacity once the initial nodes are ready
+	// +optional
+	Canary *CanaryConfig `json:"canary,omitempty"`
+
+	// NodeTerminationHandler configures the resources required by the
+	// [AWS Node Termination Handler](https://github.com/aws/aws-node-termination-handler)
+	// deployed for this nodegroup
+	// +optional
+	NodeTerminationHandler *NTHConfig `json:"nodeTerminationHandler,omitempty"`
+
+	// PodSubnets are the IDs of the secondary subnets pods are assigned IPs from
+	// with [CNI custom networking](https://docs.aws.amazon.com/eks/latest/userguide/cni-custom-network.html),
+	// one per availability zone of the nodegroup
+	// +optional
+	PodSubnets []string `json:"podSubnets,omitempty"`
+
+	// AMIParameterOverride is the path of an SSM parameter holding the ID of
+	// the AMI to use, resolved instead of the EKS-optimized AMI parameter.
+	// Cannot be set together with an AMI ID
+	// +optional
+	AMIParameterOverride string `json:"amiParameterOverride,omitempty"`
+
+	// InstanceStorePolicy formats and mounts the NVMe instance store volumes
+	// of the nodes before they are bootstrapped. Valid variants are `RAID0`,
+	// which combines them into one volume backing the kubelet root directory,
+	// `Mount`, which mounts each volume under /mnt/instance-store, and `None`
+	// (default)
+	// +optional
+	InstanceStorePolicy string `json:"instanceStorePolicy,omitempty"`
+
+	// CapacityReservation configures the [On-Demand Capacity
+	// Reservations](https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ec2-capacity-reservations.html)
+	// the nodes are launched into
+	// +optional
+	CapacityReservation *CapacityReservation `json:"capacityReservation,omitempty"`
+
+	// CapacityBlockID is the ID of the [Capacity Block for
+	// ML](https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ec2-capacity-blocks.html)
+	// the nodes are launched into. Capacity Blocks cannot be used with spot instances
+	// +optional
+	CapacityBlockID string `json:"capacityBlockID,omitempty"`
+
+	// AdditionalVolumes are EBS data volumes attached to the nodes in addition
+	// to the root volume, e.g. for the container runtime
+	// +optional
+	AdditionalVolumes []VolumeMapping `json:"additionalVolumes,omitempty"`
+}
+
+// VolumeMapping is an EBS volume attached to the nodes of a nodegroup
+type VolumeMapping struct {
+	// DeviceName is the device the volume is exposed as, e.g. `/dev/xvdb`.
+	// It must differ from the root device
+	DeviceName string `json:"deviceName"`
+	// VolumeSize gigabytes
+	VolumeSize *int `json:"volumeSize"`
+	// Valid variants are `VolumeType` constants
+	// +optional
+	VolumeType *string `json:"volumeType,omitempty"`
+	// +optional
+	VolumeEncrypted *bool `json:"volumeEncrypted,omitempty"`
+	// +optional
+	VolumeKmsKeyID *string `json:"volumeKmsKeyID,omitempty"`
+	// +optional
+	VolumeIOPS *int `json:"volumeIOPS,omitempty"`
+	// +optional
+	VolumeThroughput *int `json:"volumeThroughput,omitempty"`
+}
+
+// CapacityReservation targets a capacity reservation by its ID, or sets the
+// capacity reservation preference of the nodes. Only one of them can be set
+type CapacityReservation struct {
+	// ID of the capacity reservation to launch the nodes into. Its instance
+	// type and availability zone must be the ones of the nodegroup
+	// +optional
+	ID string `json:"id,omitempty"`
+	// Preference is `open`, to launch the nodes into any open capacity
+	// reservation matching them, or `none`
+	// +optional
+	Preference string `json:"preference,omitempty"`
 }
 
 func (n *NodeGroup) InstanceTypeList() []string {
@@ -1149,6 +1320,12 @@ type (
 		// for spot instances
 		// +optional
 		CapacityRebalance bool `json:"capacityRebalance"`
+		// WeightedCapacity maps instance types to the number of capacity units
+		// each instance of that type counts for, so that the capacity of the
+		// nodegroup is expressed in capacity units instead of instances. Must be set
+		// for all instance types or none. Range [1-999]
+		// +optional
+		WeightedCapacity map[string]int `json:"weightedCapacity,omitempty"`
 	}
 
 	// NodeGroupBottlerocket holds the configuration for Bottlerocket based
@@ -1163,6 +1340,79 @@ type (
 	}
 )
 
+// NodeGroupGPUConfig holds the GPU configuration of a nodegroup
+type NodeGroupGPUConfig struct {
+	// MIGProfiles maps [MIG profiles](https://docs.nvidia.com/datacenter/tesla/mig-user-guide/#supported-profiles)
+	// to the number of GPU instances to create with that profile on each GPU. The Nvidia device plugin installed by
+	// eksctl exposes them with the single MIG strategy, or with the mixed one when a nodegroup has several profiles
+	// +optional
+	MIGProfiles map[string]int `json:"migProfiles,omitempty"`
+}
+
+// CanaryConfig holds the configuration for creating a nodegroup in canary mode
+type CanaryConfig struct {
+	// InitialDesired is the desired capacity the nodegroup is created with,
+	// before scaling it to its desired capacity. Defaults to `1`
+	// +optional
+	InitialDesired *int `json:"initialDesired,omitempty"`
+
+	// ValidationTimeout is the time allowed for the initial nodes to become
+	// ready. Defaults to the timeout of the command
+	// For example: `10m`
+	// +optional
+	ValidationTimeout *metav1.Duration `json:"validationTimeout,omitempty"`
+}
+
+// Values for `NTHConfig.Mode`
+const (
+	// NTHModeIMDS makes the handler poll the instance metadata service on each node
+	NTHModeIMDS = "imds"
+	// NTHModeQueue makes the handler consume events sent to an SQS queue
+	NTHModeQueue = "queue"
+)
+
+// NTHConfig holds the configuration of the AWS Node Termination Handler for a nodegroup.
+// In queue mode, eksctl creates the Auto Scaling lifecycle hook and the EventBridge rules
+// sending the enabled events to the queue. In IMDS mode no resources are created
+type NTHConfig struct {
+	// Mode is either `imds` or `queue`. Defaults to `imds`
+	// +optional
+	Mode string `json:"mode,omitempty"`
+
+	// QueueARN is the ARN of the SQS queue events are sent to, required in queue mode.
+	// eksctl does not change the queue policy, which must allow EventBridge
+	// (`events.amazonaws.com`) to send messages to the queue
+	// +optional
+	QueueARN string `json:"queueARN,omitempty"`
+
+	// EnableSpotInterruptionDraining handles spot interruption notices. Defaults to `true`
+	// +optional
+	EnableSpotInterruptionDraining *bool `json:"enableSpotInterruptionDraining,omitempty"`
+
+	// EnableScheduledEventDraining handles scheduled maintenance events. Defaults to `true`
+	// +optional
+	EnableScheduledEventDraining *bool `json:"enableScheduledEventDraining,omitempty"`
+
+	// EnableRebalanceDraining handles rebalance recommendations. Defaults to `false`
+	// +optional
+	EnableRebalanceDraining *bool `json:"enableRebalanceDraining,omitempty"`
+
+	// CreateAccountEventRules creates the EventBridge rules sending the spot
+	// interruption, scheduled change and rebalance events of the whole account
+	// to the queue in the stack of the nodegroup. Unlike lifecycle events, these
+	// events are not specific to a nodegroup, so only one nodegroup per queue may
+	// create them, and they are deleted with it. Defaults to `false`
+	// +optional
+	CreateAccountEventRules *bool `json:"createAccountEventRules,omitempty"`
+}
+
+// NodeGroupTaint is a Kubernetes taint applied to the nodes of a nodegroup
+type NodeGroupTaint struct {
+	Key    string `json:"key"`
+	Value  string `json:"value,omitempty"`
+	Effect string `json:"effect"`
+}
+
 // MetricsCollection used by the scaling config,
 // see [cloudformation
 // docs](https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-properties-as-metricscollection.html)
@@ -1173,6 +1423,26 @@ type MetricsCollection struct {
 	Metrics []string `json:"metrics,omitempty"`
 }
 
+// ScheduledScalingRule scales the nodegroup to the given sizes on a recurring schedule,
+// see [cloudformation
+// docs](https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-resource-as-scheduledaction.html)
+type ScheduledScalingRule struct {
+	// Schedule is the recurring schedule in cron format
+	// For example: `0 20 * * 1-5`
+	// +required
+	Schedule string `json:"schedule"`
+	// +optional
+	DesiredCapacity *int `json:"desiredCapacity,omitempty"`
+	// +optional
+	MinSize *int `json:"minSize,omitempty"`
+	// +optional
+	MaxSize *int `json:"maxSize,omitempty"`
+	// TimeZone in which the schedule is evaluated, UTC is used if not set
+	// For example: `Europe/London`
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
 // ScalingConfig defines the scaling config
 type ScalingConfig struct {
 	// +optional
@@ -1231,6 +1501,14 @@ type NodeGroupBase struct {
 	// for nodegroup
 	// +optional
 	PrivateNetworking bool `json:"privateNetworking"`
+	// AssociatePublicIP controls whether nodes are assigned a public IP address.
+	// Defaults to the subnet's auto-assign public IP setting
+	// +optional
+	AssociatePublicIP *bool `json:"associatePublicIP,omitempty"`
+	// DeleteENIOnTermination controls whether the nodes' network interfaces are
+	// deleted when the instances are terminated
+	// +optional
+	DeleteENIOnTermination *bool `json:"deleteENIOnTermination,omitempty"`
 	// Applied to the Autoscaling Group and to the EC2 instances (unmanaged),
 	// Applied to the EKS Nodegroup resource and to the EC2 instances (managed)
 	// +optional
@@ -1291,6 +1569,12 @@ type NodeGroupBase struct {
 	// +optional
 	DisablePodIMDS *bool `json:"disablePodIMDS,omitempty"`
 
+	// InstanceMetadataOptions configures the instance metadata service of the
+	// nodes. Options that are set take precedence over the ones implied by
+	// `disableIMDSv1` and `disablePodIMDS`
+	// +optional
+	InstanceMetadataOptions *InstanceMetadataOptions `json:"instanceMetadataOptions,omitempty"`
+
 	// Placement specifies the placement group in which nodes should
 	// be spawned
 	// +optional
@@ -1304,14 +1588,63 @@ type NodeGroupBase struct {
 	// InstanceSelector specifies options for EC2 instance selector
 	InstanceSelector *InstanceSelector `json:"instanceSelector,omitempty"`
 
+	// LogRetentionInDays creates a CloudWatch log group for the logs of the
+	// nodes, named `/aws/eks/<cluster>/nodegroup/<nodegroup>`, as part of the
+	// nodegroup stack, with the given retention. Valid entries are the
+	// retention periods CloudWatch supports, see `SupportedLogRetentionInDays`
+	// +optional
+	LogRetentionInDays *int `json:"logRetentionInDays,omitempty"`
+
+	// NodeRepairConfig configures EKS to automatically repair the unhealthy
+	// nodes of the nodegroup. Only supported for managed nodegroups
+	// +optional
+	NodeRepairConfig *NodeRepairConfig `json:"nodeRepairConfig,omitempty"`
+
+	// ProtectFromReplacement sets a stack policy on the nodegroup stack denying
+	// updates that replace the Auto Scaling group or managed nodegroup, and so
+	// all of its nodes at once. `eksctl upgrade nodegroup --allow-replacement`
+	// lifts it for the duration of an upgrade. Defaults to `false`
+	// +optional
+	ProtectFromReplacement *bool `json:"protectFromReplacement,omitempty"`
+
 	// Internal fields
 	// Some AMIs (bottlerocket) have a separate volume for the OS
 	AdditionalEncryptedVolume string `json:"-"`
 }
 
+// Values for InstanceMetadataOptions.HTTPTokens
+const (
+	// HTTPTokensOptional allows requests to the metadata service with or without IMDSv2 tokens
+	HTTPTokensOptional = "optional"
+	// HTTPTokensRequired requires requests to the metadata service to use IMDSv2 tokens
+	HTTPTokensRequired = "required"
+)
+
+// InstanceMetadataOptions configures the instance metadata service of the nodes,
+// see [relevant AWS
+// docs](https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/configuring-instance-metadata-service.html)
+type InstanceMetadataOptions struct {
+	// HTTPTokens is either `optional` or `required`, to require requests to the
+	// metadata service to use IMDSv2 tokens
+	// +optional
+	HTTPTokens *string `json:"httpTokens,omitempty"`
+	// HTTPPutResponseHopLimit is the number of network hops the response to a
+	// token request can travel, between 1 and 64. A hop limit of 1 keeps the
+	// tokens from reaching non host networking pods
+	// +optional
+	HTTPPutResponseHopLimit *int `json:"httpPutResponseHopLimit,omitempty"`
+}
+
 // Placement specifies placement group information
 type Placement struct {
+	// GroupName is the name of an existing placement group
+	// +optional
 	GroupName string `json:"groupName,omitempty"`
+	// Strategy creates a placement group with this strategy in the nodegroup
+	// stack instead of using an existing one. Valid variants are `cluster`,
+	// `spread` and `partition`
+	// +optional
+	Strategy string `json:"strategy,omitempty"`
 }
 
 // ListOptions returns metav1.ListOptions with label selector for the nodegroup
@@ -1365,11 +1698,31 @@ type ManagedNodeGroup struct {
 	// for the nodegroup
 	LaunchTemplate *LaunchTemplate `json:"launchTemplate,omitempty"`
 
+	// UpdateConfig controls how many nodes can be unavailable during a rolling update of the nodegroup
+	UpdateConfig *NodeGroupUpdateConfig `json:"updateConfig,omitempty"`
+
 	// Internal fields
 
 	Unowned bool `json:"-"`
 }
 
+// NodeGroupUpdateConfig holds the rolling update config of a managed nodegroup, only one of
+// its fields can be set
+type NodeGroupUpdateConfig struct {
+	// MaxUnavailable is the maximum number of nodes that can be unavailable during an update
+	MaxUnavailable *int `json:"maxUnavailable,omitempty"`
+	// MaxUnavailablePercentage is the maximum percentage of nodes that can be unavailable
+	// during an update
+	MaxUnavailablePercentage *int `json:"maxUnavailablePercentage,omitempty"`
+}
+
+// NodeRepairConfig holds the node auto repair config of a managed nodegroup
+type NodeRepairConfig struct {
+	// Enabled turns on the automatic repair of unhealthy nodes
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
 func (m *ManagedNodeGroup) InstanceTypeList() []string {
 	if len(m.InstanceTypes) > 0 {
 		return m.InstanceTypes
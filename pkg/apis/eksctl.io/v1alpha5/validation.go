@@ -3,12 +3,18 @@ package v1alpha5
 import (
 	"fmt"
 	"net"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
+	"github.com/weaveworks/eksctl/pkg/utils"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation"
 	kubeletapis "k8s.io/kubernetes/pkg/kubelet/apis"
 )
@@ -88,7 +94,7 @@ func ValidateClusterConfig(cfg *ClusterConfig) error {
 		if cfg.PrivateCluster.Enabled && !ng.PrivateNetworking {
 			return fmt.Errorf("%s.privateNetworking must be enabled for a fully-private cluster", path)
 		}
-		return nil
+		return validateNodeGroupZones(cfg, ng, path)
 	}
 
 	if err := validateIdentityProviders(cfg.IdentityProviders); err != nil {
@@ -102,6 +108,10 @@ func ValidateClusterConfig(cfg *ClusterConfig) error {
 		}
 	}
 
+	if err := validateNodeTerminationHandlerQueues(cfg.NodeGroups); err != nil {
+		return err
+	}
+
 	for i, ng := range cfg.ManagedNodeGroups {
 		path := fmt.Sprintf("managedNodeGroups[%d]", i)
 		if err := validateNg(ng.NodeGroupBase, path); err != nil {
@@ -197,6 +207,44 @@ func PrivateOnly(ces *ClusterEndpoints) bool {
 	return !*ces.PublicAccess && *ces.PrivateAccess
 }
 
+func validateInstanceMetadataOptions(ng *NodeGroupBase, path string) error {
+	opts := ng.InstanceMetadataOptions
+	if opts.HTTPTokens != nil {
+		switch *opts.HTTPTokens {
+		case HTTPTokensOptional:
+			if IsEnabled(ng.DisableIMDSv1) || IsEnabled(ng.DisablePodIMDS) {
+				return fmt.Errorf("%[1]s.instanceMetadataOptions.httpTokens cannot be %[2]q when %[1]s.disableIMDSv1 or %[1]s.disablePodIMDS is enabled", path, HTTPTokensOptional)
+			}
+		case HTTPTokensRequired:
+		default:
+			return fmt.Errorf("%s.instanceMetadataOptions.httpTokens must be either %q or %q, got %q", path, HTTPTokensOptional, HTTPTokensRequired, *opts.HTTPTokens)
+		}
+	}
+	if limit := opts.HTTPPutResponseHopLimit; limit != nil {
+		if *limit < 1 || *limit > 64 {
+			return fmt.Errorf("%s.instanceMetadataOptions.httpPutResponseHopLimit must be between 1 and 64, got %d", path, *limit)
+		}
+		if *limit > 1 && IsEnabled(ng.DisablePodIMDS) {
+			return fmt.Errorf("%[1]s.instanceMetadataOptions.httpPutResponseHopLimit must be 1 when %[1]s.disablePodIMDS is enabled", path)
+		}
+	}
+	return nil
+}
+
+func validateLogRetentionInDays(days int, path string) error {
+	supported := SupportedLogRetentionInDays()
+	for _, d := range supported {
+		if days == d {
+			return nil
+		}
+	}
+	periods := make([]string, len(supported))
+	for i, d := range supported {
+		periods[i] = strconv.Itoa(d)
+	}
+	return fmt.Errorf("%s.logRetentionInDays must be one of %s, got %d", path, strings.Join(periods, ", "), days)
+}
+
 func validateNodeGroupBase(ng *NodeGroupBase, path string) error {
 	if ng.VolumeSize == nil {
 		errCantSet := func(field string) error {
@@ -213,7 +261,7 @@ func validateNodeGroupBase(ng *NodeGroupBase, path string) error {
 		}
 	}
 
-	if err := validateVolumeOpts(ng, path); err != nil {
+	if err := validateVolumeOpts(ng.VolumeType, ng.VolumeIOPS, ng.VolumeThroughput, path); err != nil {
 		return err
 	}
 
@@ -235,16 +283,28 @@ func validateNodeGroupBase(ng *NodeGroupBase, path string) error {
 		}
 	}
 
-	if len(ng.AvailabilityZones) > 0 && len(ng.Subnets) > 0 {
-		return fmt.Errorf("only one of %[1]s.subnets or %[1]s.availabilityZones should be set", path)
+	if ng.InstanceMetadataOptions != nil {
+		if err := validateInstanceMetadataOptions(ng, path); err != nil {
+			return err
+		}
+	}
+
+	if ng.LogRetentionInDays != nil {
+		if err := validateLogRetentionInDays(*ng.LogRetentionInDays, path); err != nil {
+			return err
+		}
 	}
 
 	if ng.Placement != nil {
-		if ng.Placement.GroupName == "" {
-			return fmt.Errorf("%s.placement.groupName must be set and non-empty", path)
+		if err := validatePlacement(ng, path); err != nil {
+			return err
 		}
 	}
 
+	if IsEnabled(ng.AssociatePublicIP) && ng.PrivateNetworking {
+		return fmt.Errorf("%[1]s.associatePublicIP cannot be enabled when %[1]s.privateNetworking is true", path)
+	}
+
 	if IsEnabled(ng.EFAEnabled) {
 		if len(ng.AvailabilityZones) > 1 || len(ng.Subnets) > 1 {
 			return fmt.Errorf("%s.efaEnabled nodegroups must have only one subnet or one availability zone", path)
@@ -255,32 +315,47 @@ func validateNodeGroupBase(ng *NodeGroupBase, path string) error {
 		return fmt.Errorf("AMI Family %s is not supported - use one of: %s", ng.AMIFamily, strings.Join(supportedAMIFamilies(), ", "))
 	}
 
+	if err := validateVolumeSize(ng, path); err != nil {
+		return err
+	}
+
 	return nil
 }
 
-func validateVolumeOpts(ng *NodeGroupBase, path string) error {
-	if ng.VolumeType != nil {
-		if ng.VolumeIOPS != nil && !(*ng.VolumeType == NodeVolumeTypeIO1 || *ng.VolumeType == NodeVolumeTypeGP3) {
+func validateVolumeSize(ng *NodeGroupBase, path string) error {
+	if ng.VolumeSize == nil || *ng.VolumeSize == 0 {
+		return nil
+	}
+	if minSize, ok := MinimumNodeVolumeSize(ng.AMIFamily); ok && *ng.VolumeSize < minSize {
+		return fmt.Errorf("%s.volumeSize of nodegroup %q must be at least %dGiB for AMI family %s, got %dGiB", path, ng.Name, minSize, ng.AMIFamily, *ng.VolumeSize)
+	}
+	return nil
+}
+
+// validateVolumeOpts checks that the IOPS and throughput of an EBS volume are supported by its type
+func validateVolumeOpts(volumeType *string, volumeIOPS, volumeThroughput *int, path string) error {
+	if volumeType != nil {
+		if volumeIOPS != nil && !(*volumeType == NodeVolumeTypeIO1 || *volumeType == NodeVolumeTypeGP3) {
 			return fmt.Errorf("%s.volumeIOPS is only supported for %s and %s volume types", path, NodeVolumeTypeIO1, NodeVolumeTypeGP3)
 		}
 
-		if *ng.VolumeType == NodeVolumeTypeIO1 {
-			if ng.VolumeIOPS != nil && !(*ng.VolumeIOPS >= MinIO1Iops && *ng.VolumeIOPS <= MaxIO1Iops) {
+		if *volumeType == NodeVolumeTypeIO1 {
+			if volumeIOPS != nil && !(*volumeIOPS >= MinIO1Iops && *volumeIOPS <= MaxIO1Iops) {
 				return fmt.Errorf("value for %s.volumeIOPS must be within range %d-%d", path, MinIO1Iops, MaxIO1Iops)
 			}
 		}
 
-		if ng.VolumeThroughput != nil && *ng.VolumeType != NodeVolumeTypeGP3 {
+		if volumeThroughput != nil && *volumeType != NodeVolumeTypeGP3 {
 			return fmt.Errorf("%s.volumeThroughput is only supported for %s volume type", path, NodeVolumeTypeGP3)
 		}
 	}
 
-	if ng.VolumeType == nil || *ng.VolumeType == NodeVolumeTypeGP3 {
-		if ng.VolumeIOPS != nil && !(*ng.VolumeIOPS >= MinGP3Iops && *ng.VolumeIOPS <= MaxGP3Iops) {
+	if volumeType == nil || *volumeType == NodeVolumeTypeGP3 {
+		if volumeIOPS != nil && !(*volumeIOPS >= MinGP3Iops && *volumeIOPS <= MaxGP3Iops) {
 			return fmt.Errorf("value for %s.volumeIOPS must be within range %d-%d", path, MinGP3Iops, MaxGP3Iops)
 		}
 
-		if ng.VolumeThroughput != nil && !(*ng.VolumeThroughput >= MinThroughput && *ng.VolumeThroughput <= MaxThroughput) {
+		if volumeThroughput != nil && !(*volumeThroughput >= MinThroughput && *volumeThroughput <= MaxThroughput) {
 			return fmt.Errorf("value for %s.volumeThroughput must be within range %d-%d", path, MinThroughput, MaxThroughput)
 		}
 	}
@@ -288,6 +363,33 @@ func validateVolumeOpts(ng *NodeGroupBase, path string) error {
 	return nil
 }
 
+func validateAdditionalVolumes(ng *NodeGroup, path string) error {
+	deviceNames := map[string]bool{}
+	if IsSetAndNonEmptyString(ng.VolumeName) {
+		deviceNames[*ng.VolumeName] = true
+	}
+	for i, volume := range ng.AdditionalVolumes {
+		volumePath := fmt.Sprintf("%s.additionalVolumes[%d]", path, i)
+		if volume.DeviceName == "" {
+			return fmt.Errorf("%s.deviceName must be set", volumePath)
+		}
+		if deviceNames[volume.DeviceName] {
+			return fmt.Errorf("%s.deviceName %q is already used by the root volume or another volume", volumePath, volume.DeviceName)
+		}
+		deviceNames[volume.DeviceName] = true
+		if volume.VolumeSize == nil || *volume.VolumeSize <= 0 {
+			return fmt.Errorf("%s.volumeSize must be set and greater than 0", volumePath)
+		}
+		if IsSetAndNonEmptyString(volume.VolumeKmsKeyID) && !IsEnabled(volume.VolumeEncrypted) {
+			return fmt.Errorf("%s.volumeKmsKeyID cannot be set without %s.volumeEncrypted", volumePath, volumePath)
+		}
+		if err := validateVolumeOpts(volume.VolumeType, volume.VolumeIOPS, volume.VolumeThroughput, volumePath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func validateIdentityProvider(idP IdentityProvider) error {
 	switch idP := (idP.Inner).(type) {
 	case *OIDCIdentityProvider:
@@ -320,6 +422,10 @@ func ValidateNodeGroup(i int, ng *NodeGroup) error {
 		return err
 	}
 
+	if ng.NodeRepairConfig != nil {
+		return fmt.Errorf("%s.nodeRepairConfig is only supported for managed nodegroups", path)
+	}
+
 	if ng.IAM != nil {
 		if err := validateNodeGroupIAM(ng.IAM, ng.IAM.InstanceProfileARN, "instanceProfileARN", path); err != nil {
 			return err
@@ -341,6 +447,10 @@ func ValidateNodeGroup(i int, ng *NodeGroup) error {
 		return err
 	}
 
+	if err := validateTaints(ng.Taints, path); err != nil {
+		return err
+	}
+
 	if ng.SSH != nil {
 		if err := validateNodeGroupSSH(ng.SSH); err != nil {
 			return err
@@ -367,8 +477,23 @@ func ValidateNodeGroup(i int, ng *NodeGroup) error {
 		if ng.OverrideBootstrapCommand != nil {
 			return fieldNotSupported("overrideBootstrapCommand")
 		}
+		if ng.GPUConfig != nil {
+			return fieldNotSupported("gpuConfig")
+		}
+		if ng.BootstrapRetries != nil {
+			return fieldNotSupported("bootstrapRetries")
+		}
+		if ng.BootstrapTimeout != nil {
+			return fieldNotSupported("bootstrapTimeout")
+		}
+		if ng.InstanceStorePolicy != "" && ng.InstanceStorePolicy != InstanceStorePolicyNone {
+			return fieldNotSupported("instanceStorePolicy")
+		}
+		if len(ng.PodSubnets) > 0 {
+			return fieldNotSupported("podSubnets")
+		}
 
-	} else if err := validateNodeGroupKubeletExtraConfig(ng.KubeletExtraConfig); err != nil {
+	} else if err := validateNodeGroupKubeletExtraConfig(ng.KubeletExtraConfig, ng.ClusterDNS); err != nil {
 		return err
 	}
 
@@ -391,6 +516,142 @@ func ValidateNodeGroup(i int, ng *NodeGroup) error {
 		return err
 	}
 
+	if ng.DefaultCooldownSeconds != nil && *ng.DefaultCooldownSeconds < 0 {
+		return fmt.Errorf("%s.defaultCooldownSeconds cannot be negative", path)
+	}
+
+	if err := validateMaxInstanceLifetime(ng.MaxInstanceLifetime, path); err != nil {
+		return err
+	}
+
+	if err := validateScheduledScaling(ng.ScheduledScaling, path); err != nil {
+		return err
+	}
+
+	if ng.GPUConfig != nil {
+		if err := validateGPUConfig(ng, path); err != nil {
+			return err
+		}
+	}
+
+	if err := validateBootstrapRetries(ng, path); err != nil {
+		return err
+	}
+
+	if err := validatePreBootstrapCommands(ng, path); err != nil {
+		return err
+	}
+
+	if err := validateCanary(ng, path); err != nil {
+		return err
+	}
+
+	if err := validateNodeTerminationHandler(ng.NodeTerminationHandler, path); err != nil {
+		return err
+	}
+
+	if err := validatePodSubnets(ng.PodSubnets, path); err != nil {
+		return err
+	}
+
+	if len(ng.PodSubnets) > 0 && ng.OverrideBootstrapCommand != nil {
+		return fmt.Errorf("%[1]s.podSubnets cannot be used with %[1]s.overrideBootstrapCommand, as the %[2]s node label is set by the eksctl bootstrap script", path, ENIConfigLabel)
+	}
+
+	switch ng.InstanceStorePolicy {
+	case "", InstanceStorePolicyRAID0, InstanceStorePolicyMount, InstanceStorePolicyNone:
+	default:
+		return fmt.Errorf("%s.instanceStorePolicy must be one of %s, %s or %s, got %q", path,
+			InstanceStorePolicyRAID0, InstanceStorePolicyMount, InstanceStorePolicyNone, ng.InstanceStorePolicy)
+	}
+
+	if ng.CapacityReservation != nil {
+		if err := validateCapacityReservation(ng, path); err != nil {
+			return err
+		}
+	}
+
+	if ng.CapacityBlockID != "" {
+		if err := validateCapacityBlock(ng, path); err != nil {
+			return err
+		}
+	}
+
+	if err := validateAdditionalVolumes(ng, path); err != nil {
+		return err
+	}
+
+	if ng.AMIParameterOverride != "" && IsAMI(ng.AMI) {
+		return fmt.Errorf("%[1]s.amiParameterOverride and %[1]s.ami cannot both be set", path)
+	}
+
+	return nil
+}
+
+var capacityReservationIDPattern = regexp.MustCompile(`^cr-[0-9a-f]+$`)
+
+func validateCapacityReservation(ng *NodeGroup, path string) error {
+	cr := ng.CapacityReservation
+	switch {
+	case cr.ID == "" && cr.Preference == "":
+		return fmt.Errorf("one of %[1]s.capacityReservation.id or %[1]s.capacityReservation.preference must be set", path)
+	case cr.ID != "" && cr.Preference != "":
+		return fmt.Errorf("%[1]s.capacityReservation.id and %[1]s.capacityReservation.preference cannot both be set", path)
+	case cr.ID != "":
+		if !capacityReservationIDPattern.MatchString(cr.ID) {
+			return fmt.Errorf("%s.capacityReservation.id %q is not a valid capacity reservation ID", path, cr.ID)
+		}
+		if HasMixedInstances(ng) {
+			return fmt.Errorf("%s.capacityReservation.id cannot be set for nodegroups with mixed instances, "+
+				"a capacity reservation is for a single instance type", path)
+		}
+	default:
+		switch cr.Preference {
+		case CapacityReservationPreferenceOpen, CapacityReservationPreferenceNone:
+		default:
+			return fmt.Errorf("%s.capacityReservation.preference must be one of %s or %s, got %q", path,
+				CapacityReservationPreferenceOpen, CapacityReservationPreferenceNone, cr.Preference)
+		}
+	}
+	return nil
+}
+
+func validateCapacityBlock(ng *NodeGroup, path string) error {
+	switch {
+	case !capacityReservationIDPattern.MatchString(ng.CapacityBlockID):
+		return fmt.Errorf("%s.capacityBlockID %q is not a valid capacity reservation ID", path, ng.CapacityBlockID)
+	case ng.CapacityReservation != nil:
+		return fmt.Errorf("%[1]s.capacityBlockID and %[1]s.capacityReservation cannot both be set", path)
+	case ng.InstancesDistribution != nil:
+		return fmt.Errorf("%[1]s.capacityBlockID and %[1]s.instancesDistribution cannot both be set, "+
+			"the nodes of a Capacity Block cannot be spot instances and are of a single instance type", path)
+	}
+	return nil
+}
+
+func validatePlacement(ng *NodeGroupBase, path string) error {
+	switch ng.Placement.Strategy {
+	case "":
+		if ng.Placement.GroupName == "" {
+			return fmt.Errorf("%s.placement.groupName must be set and non-empty", path)
+		}
+		return nil
+	case PlacementStrategyCluster, PlacementStrategySpread, PlacementStrategyPartition:
+	default:
+		return fmt.Errorf("%s.placement.strategy must be one of %s, %s or %s, got %q", path,
+			PlacementStrategyCluster, PlacementStrategySpread, PlacementStrategyPartition, ng.Placement.Strategy)
+	}
+
+	if ng.Placement.GroupName != "" {
+		return fmt.Errorf("%[1]s.placement.groupName and %[1]s.placement.strategy cannot both be set, "+
+			"the placement group created for a strategy is named by CloudFormation", path)
+	}
+	// without subnets or availability zones of its own, a nodegroup spans those of the cluster
+	singleZone := len(ng.Subnets) == 1 || (len(ng.Subnets) == 0 && len(ng.AvailabilityZones) == 1)
+	if ng.Placement.Strategy == PlacementStrategyCluster && !singleZone {
+		return fmt.Errorf("%s.placement.strategy %s requires the nodegroup to have exactly one subnet or one availability zone, "+
+			"as a cluster placement group cannot span availability zones", path, PlacementStrategyCluster)
+	}
 	return nil
 }
 
@@ -442,6 +703,27 @@ func isKubernetesLabel(namespace string) bool {
 	return false
 }
 
+func validateNodeGroupUpdateConfig(updateConfig *NodeGroupUpdateConfig, minSize int, path string) error {
+	switch {
+	case updateConfig.MaxUnavailable != nil && updateConfig.MaxUnavailablePercentage != nil:
+		return fmt.Errorf("only one of %[1]s.updateConfig.maxUnavailable or %[1]s.updateConfig.maxUnavailablePercentage can be set", path)
+	case updateConfig.MaxUnavailable != nil:
+		if *updateConfig.MaxUnavailable < 1 {
+			return fmt.Errorf("%s.updateConfig.maxUnavailable must be at least 1", path)
+		}
+		if *updateConfig.MaxUnavailable > minSize {
+			return fmt.Errorf("%s.updateConfig.maxUnavailable (%d) cannot be greater than minSize (%d)", path, *updateConfig.MaxUnavailable, minSize)
+		}
+	case updateConfig.MaxUnavailablePercentage != nil:
+		if percentage := *updateConfig.MaxUnavailablePercentage; percentage < 1 || percentage > 100 {
+			return fmt.Errorf("%s.updateConfig.maxUnavailablePercentage must be between 1 and 100", path)
+		}
+	default:
+		return fmt.Errorf("one of %[1]s.updateConfig.maxUnavailable or %[1]s.updateConfig.maxUnavailablePercentage must be set", path)
+	}
+	return nil
+}
+
 func validateNodeGroupIAMWithAddonPolicies(
 	policies NodeGroupIAMAddonPolicies,
 	fmtFieldConflictErr func(conflictingField string) error,
@@ -562,6 +844,12 @@ func ValidateManagedNodeGroup(ng *ManagedNodeGroup, index int) error {
 		ng.DesiredCapacity = ng.MinSize
 	}
 
+	if ng.UpdateConfig != nil {
+		if err := validateNodeGroupUpdateConfig(ng.UpdateConfig, *ng.MinSize, path); err != nil {
+			return err
+		}
+	}
+
 	if IsEnabled(ng.SecurityGroups.WithLocal) || IsEnabled(ng.SecurityGroups.WithShared) {
 		return errors.Errorf("securityGroups.withLocal and securityGroups.withShared are not supported for managed nodegroups (%s.securityGroups)", path)
 	}
@@ -595,12 +883,12 @@ func ValidateManagedNodeGroup(ng *ManagedNodeGroup, index int) error {
 		if ng.InstanceType != "" || ng.AMI != "" || IsEnabled(ng.SSH.Allow) || IsEnabled(ng.SSH.EnableSSM) || len(ng.SSH.SourceSecurityGroupIDs) > 0 ||
 			ng.VolumeSize != nil || len(ng.PreBootstrapCommands) > 0 || ng.OverrideBootstrapCommand != nil ||
 			len(ng.SecurityGroups.AttachIDs) > 0 || ng.InstanceName != "" || ng.InstancePrefix != "" || ng.MaxPodsPerNode != 0 ||
-			IsEnabled(ng.DisableIMDSv1) || IsEnabled(ng.DisablePodIMDS) || ng.Placement != nil {
+			IsEnabled(ng.DisableIMDSv1) || IsEnabled(ng.DisablePodIMDS) || ng.InstanceMetadataOptions != nil || ng.Placement != nil {
 
 			incompatibleFields := []string{
 				"instanceType", "ami", "ssh.allow", "ssh.enableSSM", "ssh.sourceSecurityGroupIds", "securityGroups",
 				"volumeSize", "instanceName", "instancePrefix", "maxPodsPerNode", "disableIMDSv1",
-				"disablePodIMDS", "preBootstrapCommands", "overrideBootstrapCommand", "placement",
+				"disablePodIMDS", "instanceMetadataOptions", "preBootstrapCommands", "overrideBootstrapCommand", "placement",
 			}
 			return errors.Errorf("cannot set %s in managedNodeGroup when a launch template is supplied", strings.Join(incompatibleFields, ", "))
 		}
@@ -688,6 +976,48 @@ func validateInstancesDistribution(ng *NodeGroup) error {
 		}
 	}
 
+	if err := validateWeightedCapacity(distribution, hasInstanceSelector); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+const maxWeightedCapacity = 999
+
+// validateWeightedCapacity checks the weights of the instance types. Auto Scaling groups require a weight for
+// either all instance types or none of them
+func validateWeightedCapacity(distribution *NodeGroupInstancesDistribution, hasInstanceSelector bool) error {
+	if len(distribution.WeightedCapacity) == 0 {
+		return nil
+	}
+	instanceTypes := nameSet{}
+	for _, instanceType := range distribution.InstanceTypes {
+		instanceTypes[instanceType] = struct{}{}
+	}
+	for instanceType, weight := range distribution.WeightedCapacity {
+		if weight < 1 || weight > maxWeightedCapacity {
+			return fmt.Errorf("weightedCapacity of instance type %q should be between 1 and %d", instanceType, maxWeightedCapacity)
+		}
+		// the instance types are only known once the instance selector has been resolved
+		if _, ok := instanceTypes[instanceType]; !ok && !hasInstanceSelector {
+			return fmt.Errorf("weightedCapacity specified for instance type %q, which is not in instanceTypes", instanceType)
+		}
+	}
+	return ValidateWeightedCapacityCoverage(distribution)
+}
+
+// ValidateWeightedCapacityCoverage checks that every instance type of the distribution has a weight when any
+// of them has one
+func ValidateWeightedCapacityCoverage(distribution *NodeGroupInstancesDistribution) error {
+	if len(distribution.WeightedCapacity) == 0 {
+		return nil
+	}
+	for _, instanceType := range distribution.InstanceTypes {
+		if _, ok := distribution.WeightedCapacity[instanceType]; !ok {
+			return fmt.Errorf("weightedCapacity must be specified for all instance types or none, it is missing for instance type %q", instanceType)
+		}
+	}
 	return nil
 }
 
@@ -742,6 +1072,273 @@ func validateASGSuspendProcesses(ng *NodeGroup) error {
 	return nil
 }
 
+var migProfilePattern = regexp.MustCompile(`^[1-7]g\.[0-9]+gb$`)
+
+func validateGPUConfig(ng *NodeGroup, path string) error {
+	for _, instanceType := range ng.InstanceTypeList() {
+		if instanceType != "" && !utils.IsMIGCapableInstanceType(instanceType) {
+			return fmt.Errorf("%s.gpuConfig.migProfiles is not supported for instance type %q, MIG is only available on A100 and H100 GPU instance types", path, instanceType)
+		}
+	}
+	for profile, count := range ng.GPUConfig.MIGProfiles {
+		if !migProfilePattern.MatchString(profile) {
+			return fmt.Errorf("invalid MIG profile %q in %s.gpuConfig.migProfiles", profile, path)
+		}
+		if count < 1 {
+			return fmt.Errorf("%s.gpuConfig.migProfiles[%q] must be at least 1", path, profile)
+		}
+	}
+	return nil
+}
+
+const (
+	maxBootstrapRetries = 10
+	minBootstrapTimeout = time.Minute
+	maxBootstrapTimeout = time.Hour
+)
+
+func validateBootstrapRetries(ng *NodeGroup, path string) error {
+	if ng.BootstrapRetries == nil && ng.BootstrapTimeout == nil {
+		return nil
+	}
+	if ng.OverrideBootstrapCommand != nil {
+		return fmt.Errorf("%[1]s.bootstrapRetries and %[1]s.bootstrapTimeout cannot be used with %[1]s.overrideBootstrapCommand", path)
+	}
+	if ng.BootstrapRetries != nil && (*ng.BootstrapRetries < 0 || *ng.BootstrapRetries > maxBootstrapRetries) {
+		return fmt.Errorf("%s.bootstrapRetries must be between 0 and %d", path, maxBootstrapRetries)
+	}
+	if ng.BootstrapTimeout != nil && (ng.BootstrapTimeout.Duration < minBootstrapTimeout || ng.BootstrapTimeout.Duration > maxBootstrapTimeout) {
+		return fmt.Errorf("%s.bootstrapTimeout must be between %s and %s", path, minBootstrapTimeout, maxBootstrapTimeout)
+	}
+	return nil
+}
+
+// the bounds of the MaxInstanceLifetime of Auto Scaling groups
+const (
+	minMaxInstanceLifetime = 24 * time.Hour
+	maxMaxInstanceLifetime = 365 * 24 * time.Hour
+)
+
+func validateMaxInstanceLifetime(maxInstanceLifetime *metav1.Duration, path string) error {
+	if maxInstanceLifetime == nil {
+		return nil
+	}
+	// the Auto Scaling group is given whole seconds
+	lifetime := maxInstanceLifetime.Duration.Truncate(time.Second)
+	if lifetime != 0 && (lifetime < minMaxInstanceLifetime || lifetime > maxMaxInstanceLifetime) {
+		return fmt.Errorf("%s.maxInstanceLifetime must be 0 or between %d and %d seconds, got %d seconds", path,
+			int64(minMaxInstanceLifetime.Seconds()), int64(maxMaxInstanceLifetime.Seconds()), int64(lifetime.Seconds()))
+	}
+	return nil
+}
+
+const eksBootstrapScript = "/etc/eks/bootstrap.sh"
+
+// shellCommandSeparators separate the commands of a shell command line
+var shellCommandSeparators = regexp.MustCompile(`&&|\|\||[;&|\n]`)
+
+// shellCommandPrefixes are the words that may precede a command in a shell command line
+var shellCommandPrefixes = map[string]bool{
+	"sudo":   true,
+	"exec":   true,
+	"bash":   true,
+	"sh":     true,
+	"source": true,
+	".":      true,
+}
+
+// invokesBootstrapScript reports whether a shell command line runs the EKS bootstrap script, i.e. has it in
+// the position of a command rather than as an argument of another command, like `sed -i ... /etc/eks/bootstrap.sh`
+func invokesBootstrapScript(commandLine string) bool {
+	for _, command := range shellCommandSeparators.Split(commandLine, -1) {
+		words := strings.Fields(strings.TrimLeft(strings.TrimSpace(command), "({ "))
+		// skip environment variable assignments and command prefixes
+		for len(words) > 0 && (strings.Contains(words[0], "=") || shellCommandPrefixes[words[0]]) {
+			words = words[1:]
+		}
+		if len(words) > 0 && strings.Trim(words[0], `"'`) == eksBootstrapScript {
+			return true
+		}
+	}
+	return false
+}
+
+// validatePreBootstrapCommands rejects preBootstrapCommands that bootstrap the node themselves, as the node is
+// always bootstrapped after them, either by eksctl or by overrideBootstrapCommand
+func validatePreBootstrapCommands(ng *NodeGroup, path string) error {
+	if IsWindowsImage(ng.AMIFamily) {
+		return nil
+	}
+	bootstrappedBy := "eksctl"
+	if ng.OverrideBootstrapCommand != nil {
+		bootstrappedBy = fmt.Sprintf("%s.overrideBootstrapCommand", path)
+	}
+	for i, command := range ng.PreBootstrapCommands {
+		if invokesBootstrapScript(command) {
+			return fmt.Errorf("%[1]s.preBootstrapCommands[%[2]d] must not invoke %[3]s: preBootstrapCommands run before the node is bootstrapped by %[4]s, "+
+				"so the node would be bootstrapped twice; set %[1]s.overrideBootstrapCommand to replace the default bootstrap command instead",
+				path, i, eksBootstrapScript, bootstrappedBy)
+		}
+	}
+	return nil
+}
+
+func validateCanary(ng *NodeGroup, path string) error {
+	if ng.Canary == nil {
+		return nil
+	}
+	if initialDesired := ng.Canary.InitialDesired; initialDesired != nil {
+		if *initialDesired < 1 {
+			return fmt.Errorf("%s.canary.initialDesired must be at least 1", path)
+		}
+		if ng.DesiredCapacity != nil && *initialDesired >= *ng.DesiredCapacity {
+			return fmt.Errorf("%[1]s.canary.initialDesired must be less than %[1]s.desiredCapacity", path)
+		}
+	}
+	if ng.Canary.ValidationTimeout != nil && ng.Canary.ValidationTimeout.Duration <= 0 {
+		return fmt.Errorf("%s.canary.validationTimeout must be positive", path)
+	}
+	return nil
+}
+
+func validateNodeTerminationHandler(nth *NTHConfig, path string) error {
+	if nth == nil {
+		return nil
+	}
+	nthPath := path + ".nodeTerminationHandler"
+	switch nth.Mode {
+	case "", NTHModeIMDS:
+		if nth.QueueARN != "" {
+			return fmt.Errorf("%s.queueARN can only be set in %q mode", nthPath, NTHModeQueue)
+		}
+		if nth.CreateAccountEventRules != nil {
+			return fmt.Errorf("%s.createAccountEventRules can only be set in %q mode", nthPath, NTHModeQueue)
+		}
+	case NTHModeQueue:
+		if nth.QueueARN == "" {
+			return fmt.Errorf("%s.queueARN must be set in %q mode", nthPath, NTHModeQueue)
+		}
+		if _, err := arn.Parse(nth.QueueARN); err != nil {
+			return errors.Wrapf(err, "invalid ARN %q in %s.queueARN", nth.QueueARN, nthPath)
+		}
+	default:
+		return fmt.Errorf("%s.mode must be one of %q or %q", nthPath, NTHModeIMDS, NTHModeQueue)
+	}
+	return nil
+}
+
+// validateNodeTerminationHandlerQueues checks that the account-wide EventBridge rules of each
+// Node Termination Handler queue are only created by one nodegroup
+func validateNodeTerminationHandlerQueues(nodeGroups []*NodeGroup) error {
+	owners := map[string]string{}
+	for i, ng := range nodeGroups {
+		nth := ng.NodeTerminationHandler
+		if nth == nil || nth.Mode != NTHModeQueue || !IsEnabled(nth.CreateAccountEventRules) {
+			continue
+		}
+		path := fmt.Sprintf("nodeGroups[%d].nodeTerminationHandler", i)
+		if owner, ok := owners[nth.QueueARN]; ok {
+			return fmt.Errorf("%[1]s.createAccountEventRules cannot be enabled, as %[2]s already creates the EventBridge rules for queue %[3]q; "+
+				"the spot interruption, scheduled change and rebalance events are not specific to a nodegroup and would be sent once per nodegroup", path, owner, nth.QueueARN)
+		}
+		owners[nth.QueueARN] = path
+	}
+	return nil
+}
+
+func validatePodSubnets(podSubnets []string, path string) error {
+	seen := nameSet{}
+	for i, subnetID := range podSubnets {
+		if !strings.HasPrefix(subnetID, "subnet-") {
+			return fmt.Errorf("%s.podSubnets[%d] must be a subnet ID, got %q", path, i, subnetID)
+		}
+		if ok, err := seen.checkUnique(fmt.Sprintf("%s.podSubnets[%d]", path, i), subnetID); !ok {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateScheduledScaling(rules []ScheduledScalingRule, path string) error {
+	for i, rule := range rules {
+		rulePath := fmt.Sprintf("%s.scheduledScaling[%d]", path, i)
+		if rule.Schedule == "" {
+			return fmt.Errorf("%s.schedule must be set", rulePath)
+		}
+		if err := validateCronExpression(rule.Schedule); err != nil {
+			return errors.Wrapf(err, "invalid %s.schedule %q", rulePath, rule.Schedule)
+		}
+		if rule.DesiredCapacity == nil && rule.MinSize == nil && rule.MaxSize == nil {
+			return fmt.Errorf("at least one of %[1]s.desiredCapacity, %[1]s.minSize or %[1]s.maxSize must be set", rulePath)
+		}
+		if rule.MinSize != nil && rule.MaxSize != nil && *rule.MinSize > *rule.MaxSize {
+			return fmt.Errorf("%[1]s.minSize must be less than or equal to %[1]s.maxSize", rulePath)
+		}
+		if rule.DesiredCapacity != nil {
+			if rule.MinSize != nil && *rule.DesiredCapacity < *rule.MinSize {
+				return fmt.Errorf("%[1]s.desiredCapacity must be greater than or equal to %[1]s.minSize", rulePath)
+			}
+			if rule.MaxSize != nil && *rule.DesiredCapacity > *rule.MaxSize {
+				return fmt.Errorf("%[1]s.desiredCapacity must be less than or equal to %[1]s.maxSize", rulePath)
+			}
+		}
+	}
+	return nil
+}
+
+// validateCronExpression validates a standard five field cron expression
+// (minute, hour, day of month, month and day of week)
+func validateCronExpression(expr string) error {
+	fieldRanges := []struct {
+		name     string
+		min, max int
+	}{
+		{"minute", 0, 59},
+		{"hour", 0, 23},
+		{"day of month", 1, 31},
+		{"month", 1, 12},
+		{"day of week", 0, 6},
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != len(fieldRanges) {
+		return fmt.Errorf("expected %d fields but found %d", len(fieldRanges), len(fields))
+	}
+
+	parseValue := func(value string, min, max int) error {
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("%q is not a number", value)
+		}
+		if n < min || n > max {
+			return fmt.Errorf("%d is out of range [%d-%d]", n, min, max)
+		}
+		return nil
+	}
+
+	for i, field := range fields {
+		r := fieldRanges[i]
+		for _, item := range strings.Split(field, ",") {
+			valueRange := item
+			if parts := strings.SplitN(item, "/", 2); len(parts) == 2 {
+				valueRange = parts[0]
+				if step, err := strconv.Atoi(parts[1]); err != nil || step < 1 {
+					return fmt.Errorf("invalid step %q in %s field", parts[1], r.name)
+				}
+			}
+			if valueRange == "*" {
+				continue
+			}
+			for _, value := range strings.SplitN(valueRange, "-", 2) {
+				if err := parseValue(value, r.min, r.max); err != nil {
+					return errors.Wrapf(err, "invalid %s field", r.name)
+				}
+			}
+		}
+	}
+	return nil
+}
+
 func validateNodeGroupSSH(SSH *NodeGroupSSH) error {
 	numSSHFlagsEnabled := countEnabledFields(
 		SSH.PublicKeyPath,
@@ -764,7 +1361,7 @@ func countEnabledFields(fields ...*string) int {
 	return count
 }
 
-func validateNodeGroupKubeletExtraConfig(kubeletConfig *InlineDocument) error {
+func validateNodeGroupKubeletExtraConfig(kubeletConfig *InlineDocument, clusterDNS string) error {
 	if kubeletConfig == nil {
 		return nil
 	}
@@ -784,6 +1381,12 @@ func validateNodeGroupKubeletExtraConfig(kubeletConfig *InlineDocument) error {
 			return fmt.Errorf("cannot override %q in kubelet config, as it's critical to eksctl functionality", k)
 		}
 	}
+
+	// clusterDNS may list several DNS servers in the kubelet config, but it would silently override the one
+	// eksctl passes to the bootstrap script
+	if _, exists := (*kubeletConfig)["clusterDNS"]; exists && clusterDNS != "" {
+		return errors.New(`cannot override "clusterDNS" in kubelet config when clusterDNS is set for the nodegroup`)
+	}
 	return nil
 }
 
@@ -888,5 +1491,158 @@ func checkBottlerocketSettings(doc *InlineDocument, path string) error {
 		}
 	}
 
+	// These are always set from the cluster and would be overwritten silently
+	for _, managedKey := range []string{"cluster-name", "api-server", "cluster-certificate"} {
+		if _, ok := kube[managedKey]; ok {
+			return errors.Errorf("invalid Bottlerocket setting: kubernetes.%[1]s is set by eksctl from the cluster and cannot be overridden (path=%[2]s.bottlerocket.settings.kubernetes.%[1]s)",
+				managedKey, path)
+		}
+	}
+
+	return nil
+}
+
+// ValidateClusterHA checks that the nodegroups of the cluster collectively avoid single points of failure:
+// there must be at least two nodegroups, some on-demand capacity, and on-demand capacity in each of at least
+// two availability zones. It returns all the problems found so they can be fixed at once
+func ValidateClusterHA(cfg *ClusterConfig) []error {
+	var errs []error
+
+	if count := len(cfg.NodeGroups) + len(cfg.ManagedNodeGroups); count < 2 {
+		errs = append(errs, fmt.Errorf("cluster has %d nodegroup(s), at least 2 are required to avoid a single point of failure", count))
+	}
+
+	clusterAZs := cfg.AvailabilityZones
+	if len(clusterAZs) == 0 && cfg.VPC != nil && cfg.VPC.Subnets != nil {
+		clusterAZs = append(cfg.VPC.Subnets.Private.WithAZs(), cfg.VPC.Subnets.Public.WithAZs()...)
+	}
+
+	coveredAZs, onDemandAZs := nameSet{}, nameSet{}
+	hasOnDemand := false
+	addNodeGroup := func(ng *NodeGroupBase, onDemand bool) {
+		for _, az := range nodeGroupAvailabilityZones(cfg, ng, clusterAZs) {
+			coveredAZs[az] = struct{}{}
+			if onDemand {
+				onDemandAZs[az] = struct{}{}
+			}
+		}
+		hasOnDemand = hasOnDemand || onDemand
+	}
+	for _, ng := range cfg.NodeGroups {
+		addNodeGroup(ng.NodeGroupBase, hasOnDemandCapacity(ng))
+	}
+	for _, ng := range cfg.ManagedNodeGroups {
+		addNodeGroup(ng.NodeGroupBase, !ng.Spot)
+	}
+
+	var sortedAZs []string
+	for az := range coveredAZs {
+		sortedAZs = append(sortedAZs, az)
+	}
+	sort.Strings(sortedAZs)
+
+	if len(sortedAZs) < 2 {
+		errs = append(errs, fmt.Errorf("nodegroups only cover availability zone(s) %v, spread them across at least 2 availability zones", sortedAZs))
+	}
+
+	if !hasOnDemand {
+		errs = append(errs, errors.New("all nodegroups use spot instances without on-demand capacity, set instancesDistribution.onDemandBaseCapacity or add an on-demand nodegroup"))
+	} else {
+		for _, az := range sortedAZs {
+			if _, ok := onDemandAZs[az]; !ok {
+				errs = append(errs, fmt.Errorf("availability zone %s has no on-demand capacity, add an on-demand nodegroup covering it", az))
+			}
+		}
+	}
+
+	return errs
+}
+
+// hasOnDemandCapacity returns true when the nodegroup runs at least some on-demand instances
+func hasOnDemandCapacity(ng *NodeGroup) bool {
+	dist := ng.InstancesDistribution
+	if dist == nil {
+		return true
+	}
+	if dist.OnDemandBaseCapacity != nil && *dist.OnDemandBaseCapacity > 0 {
+		return true
+	}
+	// the percentage of on-demand instances above the base capacity defaults to 100
+	return dist.OnDemandPercentageAboveBaseCapacity == nil || *dist.OnDemandPercentageAboveBaseCapacity > 0
+}
+
+// nodeGroupAvailabilityZones returns the availability zones the nodegroup can launch instances in
+func nodeGroupAvailabilityZones(cfg *ClusterConfig, ng *NodeGroupBase, clusterAZs []string) []string {
+	if len(ng.AvailabilityZones) > 0 {
+		return ng.AvailabilityZones
+	}
+	if len(ng.Subnets) == 0 || cfg.VPC == nil || cfg.VPC.Subnets == nil {
+		return clusterAZs
+	}
+
+	var azs []string
+	for _, subnet := range ng.Subnets {
+		if az, ok := subnetAvailabilityZone(cfg, subnet); ok {
+			azs = append(azs, az)
+		}
+	}
+	return azs
+}
+
+// subnetAvailabilityZone returns the availability zone of the subnet with the given name or ID in vpc.subnets
+func subnetAvailabilityZone(cfg *ClusterConfig, subnet string) (string, bool) {
+	if cfg.VPC == nil || cfg.VPC.Subnets == nil {
+		return "", false
+	}
+	for _, mapping := range []AZSubnetMapping{cfg.VPC.Subnets.Private, cfg.VPC.Subnets.Public} {
+		for name, spec := range mapping {
+			if name == subnet || spec.ID == subnet {
+				return spec.AZ, true
+			}
+		}
+	}
+	return "", false
+}
+
+// validateNodeGroupZones checks that a nodegroup setting both availabilityZones and subnets references the same
+// availability zones in both, as the nodegroup would otherwise be placed differently than either field implies.
+// When they agree, availabilityZones is redundant and only the subnets are used
+func validateNodeGroupZones(cfg *ClusterConfig, ng *NodeGroupBase, path string) error {
+	if len(ng.AvailabilityZones) == 0 || len(ng.Subnets) == 0 {
+		return nil
+	}
+
+	subnetAZs := nameSet{}
+	for _, subnet := range ng.Subnets {
+		az, ok := subnetAvailabilityZone(cfg, subnet)
+		if !ok {
+			return fmt.Errorf("%[1]s: subnets and availabilityZones of nodegroup %[2]q can only be set together for subnets defined in vpc.subnets, subnet %[3]q is not",
+				path, ng.Name, subnet)
+		}
+		subnetAZs[az] = struct{}{}
+	}
+	ngAZs := nameSet{}
+	for _, az := range ng.AvailabilityZones {
+		ngAZs[az] = struct{}{}
+	}
+
+	var conflicting []string
+	for az := range ngAZs {
+		if _, ok := subnetAZs[az]; !ok {
+			conflicting = append(conflicting, az)
+		}
+	}
+	for az := range subnetAZs {
+		if _, ok := ngAZs[az]; !ok {
+			conflicting = append(conflicting, az)
+		}
+	}
+	if len(conflicting) > 0 {
+		sort.Strings(conflicting)
+		return fmt.Errorf("%[1]s: subnets and availabilityZones of nodegroup %[2]q reference different availability zones: %[3]s",
+			path, ng.Name, strings.Join(conflicting, ", "))
+	}
+
+	logger.Warning("%[1]s.availabilityZones of nodegroup %[2]q is redundant, as %[1]s.subnets are in the same availability zones", path, ng.Name)
 	return nil
 }
@@ -2,6 +2,7 @@ package v1alpha5_test
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	. "github.com/onsi/ginkgo"
@@ -9,6 +10,7 @@ import (
 	. "github.com/onsi/gomega"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/utils/strings"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 var _ = Describe("ClusterConfig validation", func() {
@@ -163,6 +165,800 @@ var _ = Describe("ClusterConfig validation", func() {
 		})
 	})
 
+	Describe("nodeGroups[*].volumeSize", func() {
+		var ng *api.NodeGroup
+
+		BeforeEach(func() {
+			ng = api.NewNodeGroup()
+			ng.Name = "ng0"
+		})
+
+		It("allows the volume size to equal the AMI family minimum", func() {
+			ng.AMIFamily = api.NodeImageFamilyAmazonLinux2
+			ng.VolumeSize = aws.Int(20)
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("ignores the volume size when the AMI family is not set", func() {
+			ng.VolumeSize = aws.Int(2)
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		DescribeTable("rejects a volume size below the AMI family minimum", func(amiFamily string, volumeSize int, expectedErr string) {
+			ng.AMIFamily = amiFamily
+			ng.VolumeSize = aws.Int(volumeSize)
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(expectedErr))
+		},
+			Entry("AmazonLinux2", api.NodeImageFamilyAmazonLinux2, 10,
+				`nodeGroups[0].volumeSize of nodegroup "ng0" must be at least 20GiB for AMI family AmazonLinux2, got 10GiB`),
+			Entry("Bottlerocket", api.NodeImageFamilyBottlerocket, 2,
+				`nodeGroups[0].volumeSize of nodegroup "ng0" must be at least 20GiB for AMI family Bottlerocket, got 2GiB`),
+			Entry("Windows", api.NodeImageFamilyWindowsServer2019CoreContainer, 30,
+				`nodeGroups[0].volumeSize of nodegroup "ng0" must be at least 50GiB for AMI family WindowsServer2019CoreContainer, got 30GiB`),
+		)
+	})
+
+	Describe("nodeGroups[*].associatePublicIP", func() {
+		var ng *api.NodeGroup
+
+		BeforeEach(func() {
+			ng = api.NewNodeGroup()
+		})
+
+		It("allows associatePublicIP to be enabled for public nodegroups", func() {
+			ng.AssociatePublicIP = api.Enabled()
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("allows associatePublicIP to be disabled for private nodegroups", func() {
+			ng.PrivateNetworking = true
+			ng.AssociatePublicIP = api.Disabled()
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("rejects associatePublicIP for private nodegroups", func() {
+			ng.PrivateNetworking = true
+			ng.AssociatePublicIP = api.Enabled()
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].associatePublicIP cannot be enabled when nodeGroups[0].privateNetworking is true"))
+		})
+	})
+
+	Describe("nodeGroups[*].defaultCooldownSeconds", func() {
+		var ng *api.NodeGroup
+
+		BeforeEach(func() {
+			ng = api.NewNodeGroup()
+		})
+
+		It("allows a zero cooldown", func() {
+			ng.DefaultCooldownSeconds = aws.Int(0)
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("rejects a negative cooldown", func() {
+			ng.DefaultCooldownSeconds = aws.Int(-1)
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].defaultCooldownSeconds cannot be negative"))
+		})
+	})
+
+	Describe("nodeGroups[*].maxInstanceLifetime", func() {
+		var ng *api.NodeGroup
+
+		BeforeEach(func() {
+			ng = api.NewNodeGroup()
+		})
+
+		lifetime := func(d time.Duration) *metav1.Duration {
+			return &metav1.Duration{Duration: d}
+		}
+
+		It("allows disabling the maximum instance lifetime", func() {
+			ng.MaxInstanceLifetime = lifetime(0)
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("allows lifetimes between one day and one year", func() {
+			for _, d := range []time.Duration{24 * time.Hour, 7 * 24 * time.Hour, 365 * 24 * time.Hour} {
+				ng.MaxInstanceLifetime = lifetime(d)
+				Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+			}
+		})
+
+		It("rejects lifetimes shorter than one day", func() {
+			ng.MaxInstanceLifetime = lifetime(time.Hour)
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].maxInstanceLifetime must be 0 or between 86400 and 31536000 seconds, got 3600 seconds"))
+		})
+
+		It("rejects lifetimes longer than one year", func() {
+			ng.MaxInstanceLifetime = lifetime(366 * 24 * time.Hour)
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].maxInstanceLifetime must be 0 or between 86400 and 31536000 seconds, got 31622400 seconds"))
+		})
+	})
+
+	Describe("nodeGroups[*].scheduledScaling", func() {
+		var ng *api.NodeGroup
+
+		BeforeEach(func() {
+			ng = api.NewNodeGroup()
+		})
+
+		It("allows valid rules", func() {
+			ng.ScheduledScaling = []api.ScheduledScalingRule{
+				{Schedule: "0 20 * * 1-5", MinSize: aws.Int(0), MaxSize: aws.Int(1), DesiredCapacity: aws.Int(0), TimeZone: "Europe/London"},
+				{Schedule: "*/30 7,8 * * *", DesiredCapacity: aws.Int(3)},
+			}
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		DescribeTable("rejects invalid rules", func(rule api.ScheduledScalingRule, expectedErr string) {
+			ng.ScheduledScaling = []api.ScheduledScalingRule{rule}
+			err := api.ValidateNodeGroup(0, ng)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(expectedErr))
+		},
+			Entry("missing schedule", api.ScheduledScalingRule{DesiredCapacity: aws.Int(1)},
+				"nodeGroups[0].scheduledScaling[0].schedule must be set"),
+			Entry("wrong number of cron fields", api.ScheduledScalingRule{Schedule: "0 20 * *", DesiredCapacity: aws.Int(1)},
+				"expected 5 fields but found 4"),
+			Entry("out of range cron field", api.ScheduledScalingRule{Schedule: "0 24 * * *", DesiredCapacity: aws.Int(1)},
+				"invalid hour field: 24 is out of range [0-23]"),
+			Entry("non-numeric cron field", api.ScheduledScalingRule{Schedule: "0 20 * * MON", DesiredCapacity: aws.Int(1)},
+				`invalid day of week field: "MON" is not a number`),
+			Entry("no sizes", api.ScheduledScalingRule{Schedule: "0 20 * * *"},
+				"at least one of nodeGroups[0].scheduledScaling[0].desiredCapacity"),
+			Entry("min greater than max", api.ScheduledScalingRule{Schedule: "0 20 * * *", MinSize: aws.Int(3), MaxSize: aws.Int(2)},
+				"nodeGroups[0].scheduledScaling[0].minSize must be less than or equal to nodeGroups[0].scheduledScaling[0].maxSize"),
+			Entry("desired below min", api.ScheduledScalingRule{Schedule: "0 20 * * *", MinSize: aws.Int(2), DesiredCapacity: aws.Int(1)},
+				"nodeGroups[0].scheduledScaling[0].desiredCapacity must be greater than or equal to nodeGroups[0].scheduledScaling[0].minSize"),
+			Entry("desired above max", api.ScheduledScalingRule{Schedule: "0 20 * * *", MaxSize: aws.Int(2), DesiredCapacity: aws.Int(3)},
+				"nodeGroups[0].scheduledScaling[0].desiredCapacity must be less than or equal to nodeGroups[0].scheduledScaling[0].maxSize"),
+		)
+	})
+
+	Describe("nodeGroups[*].gpuConfig", func() {
+		var ng *api.NodeGroup
+
+		BeforeEach(func() {
+			ng = api.NewNodeGroup()
+			ng.InstanceType = "p4d.24xlarge"
+			ng.GPUConfig = &api.NodeGroupGPUConfig{
+				MIGProfiles: map[string]int{"1g.5gb": 7},
+			}
+		})
+
+		It("allows MIG profiles on MIG-capable instance types", func() {
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("rejects MIG profiles on instance types without MIG support", func() {
+			ng.InstanceType = "p3.2xlarge"
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(`nodeGroups[0].gpuConfig.migProfiles is not supported for instance type "p3.2xlarge", MIG is only available on A100 and H100 GPU instance types`))
+		})
+
+		It("rejects invalid MIG profile names", func() {
+			ng.GPUConfig.MIGProfiles = map[string]int{"8g.40gb": 1}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(`invalid MIG profile "8g.40gb" in nodeGroups[0].gpuConfig.migProfiles`))
+		})
+
+		It("rejects non-positive MIG profile counts", func() {
+			ng.GPUConfig.MIGProfiles = map[string]int{"1g.5gb": 0}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(`nodeGroups[0].gpuConfig.migProfiles["1g.5gb"] must be at least 1`))
+		})
+
+		It("rejects MIG profiles on Bottlerocket nodegroups", func() {
+			ng.AMIFamily = api.NodeImageFamilyBottlerocket
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring("gpuConfig is not supported for Bottlerocket nodegroups")))
+		})
+	})
+
+	Describe("nodeGroups[*].bootstrapRetries", func() {
+		var ng *api.NodeGroup
+		BeforeEach(func() {
+			ng = api.NewNodeGroup()
+		})
+
+		It("allows retries and a timeout within bounds", func() {
+			ng.BootstrapRetries = aws.Int(3)
+			ng.BootstrapTimeout = &metav1.Duration{Duration: 15 * time.Minute}
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("rejects too many retries", func() {
+			ng.BootstrapRetries = aws.Int(11)
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].bootstrapRetries must be between 0 and 10"))
+		})
+
+		It("rejects a timeout out of bounds", func() {
+			ng.BootstrapTimeout = &metav1.Duration{Duration: 10 * time.Second}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].bootstrapTimeout must be between 1m0s and 1h0m0s"))
+		})
+
+		It("rejects retries with overrideBootstrapCommand", func() {
+			ng.BootstrapRetries = aws.Int(3)
+			ng.OverrideBootstrapCommand = aws.String("/etc/eks/bootstrap.sh cluster")
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].bootstrapRetries and nodeGroups[0].bootstrapTimeout cannot be used with nodeGroups[0].overrideBootstrapCommand"))
+		})
+
+		It("rejects retries on Windows nodegroups", func() {
+			ng.AMIFamily = api.NodeImageFamilyWindowsServer2019FullContainer
+			ng.BootstrapRetries = aws.Int(3)
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring("bootstrapRetries is not supported for WindowsServer2019FullContainer nodegroups")))
+		})
+	})
+
+	Describe("nodeGroups[*].preBootstrapCommands", func() {
+		var ng *api.NodeGroup
+		BeforeEach(func() {
+			ng = api.NewNodeGroup()
+		})
+
+		It("allows preBootstrapCommands with overrideBootstrapCommand", func() {
+			ng.PreBootstrapCommands = []string{"update-ca-trust"}
+			ng.OverrideBootstrapCommand = aws.String("/etc/eks/bootstrap.sh cluster")
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("rejects preBootstrapCommands that bootstrap the node", func() {
+			ng.PreBootstrapCommands = []string{"update-ca-trust", "/etc/eks/bootstrap.sh cluster"}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring("nodeGroups[0].preBootstrapCommands[1] must not invoke /etc/eks/bootstrap.sh: preBootstrapCommands run before the node is bootstrapped by eksctl")))
+		})
+
+		It("allows preBootstrapCommands that only reference the bootstrap script", func() {
+			ng.PreBootstrapCommands = []string{
+				"sed -i 's/--max-pods=[0-9]*//' /etc/eks/bootstrap.sh",
+				"cp /etc/eks/bootstrap.sh /tmp/bootstrap.sh.orig",
+			}
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("rejects preBootstrapCommands that bootstrap the node after other commands", func() {
+			ng.PreBootstrapCommands = []string{"update-ca-trust && HTTPS_PROXY=http://proxy:3128 sudo '/etc/eks/bootstrap.sh' cluster"}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring("nodeGroups[0].preBootstrapCommands[0] must not invoke /etc/eks/bootstrap.sh")))
+		})
+
+		It("rejects preBootstrapCommands that bootstrap the node before overrideBootstrapCommand", func() {
+			ng.PreBootstrapCommands = []string{"/etc/eks/bootstrap.sh cluster"}
+			ng.OverrideBootstrapCommand = aws.String("/etc/eks/bootstrap.sh cluster --kubelet-extra-args '--node-labels=foo=bar'")
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring("bootstrapped by nodeGroups[0].overrideBootstrapCommand, so the node would be bootstrapped twice")))
+		})
+	})
+
+	Describe("nodeGroups[*].instanceMetadataOptions", func() {
+		var ng *api.NodeGroup
+		BeforeEach(func() {
+			ng = api.NewNodeGroup()
+		})
+
+		It("allows requiring IMDSv2 with a hop limit of 1", func() {
+			ng.InstanceMetadataOptions = &api.InstanceMetadataOptions{
+				HTTPTokens:              aws.String(api.HTTPTokensRequired),
+				HTTPPutResponseHopLimit: aws.Int(1),
+			}
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("rejects invalid token modes", func() {
+			ng.InstanceMetadataOptions = &api.InstanceMetadataOptions{HTTPTokens: aws.String("disabled")}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(`nodeGroups[0].instanceMetadataOptions.httpTokens must be either "optional" or "required", got "disabled"`))
+		})
+
+		It("rejects optional tokens when IMDSv1 is disabled", func() {
+			ng.DisableIMDSv1 = api.Enabled()
+			ng.InstanceMetadataOptions = &api.InstanceMetadataOptions{HTTPTokens: aws.String(api.HTTPTokensOptional)}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring(`httpTokens cannot be "optional" when nodeGroups[0].disableIMDSv1 or nodeGroups[0].disablePodIMDS is enabled`)))
+		})
+
+		DescribeTable("hop limit range",
+			func(hopLimit int, valid bool) {
+				ng.InstanceMetadataOptions = &api.InstanceMetadataOptions{HTTPPutResponseHopLimit: aws.Int(hopLimit)}
+				err := api.ValidateNodeGroup(0, ng)
+				if valid {
+					Expect(err).NotTo(HaveOccurred())
+				} else {
+					Expect(err).To(MatchError(fmt.Sprintf("nodeGroups[0].instanceMetadataOptions.httpPutResponseHopLimit must be between 1 and 64, got %d", hopLimit)))
+				}
+			},
+			Entry("0", 0, false),
+			Entry("1", 1, true),
+			Entry("64", 64, true),
+			Entry("65", 65, false),
+		)
+
+		It("rejects a hop limit greater than 1 when pod IMDS is disabled", func() {
+			ng.DisablePodIMDS = api.Enabled()
+			ng.InstanceMetadataOptions = &api.InstanceMetadataOptions{HTTPPutResponseHopLimit: aws.Int(2)}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].instanceMetadataOptions.httpPutResponseHopLimit must be 1 when nodeGroups[0].disablePodIMDS is enabled"))
+		})
+	})
+
+	Describe("nodeGroups[*].logRetentionInDays", func() {
+		DescribeTable("retention periods",
+			func(days int, valid bool) {
+				ng := api.NewNodeGroup()
+				ng.LogRetentionInDays = aws.Int(days)
+				err := api.ValidateNodeGroup(0, ng)
+				if valid {
+					Expect(err).NotTo(HaveOccurred())
+				} else {
+					Expect(err).To(MatchError(fmt.Sprintf("nodeGroups[0].logRetentionInDays must be one of 1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180, 365, 400, 545, 731, 1827, 3653, got %d", days)))
+				}
+			},
+			Entry("0", 0, false),
+			Entry("1", 1, true),
+			Entry("10", 10, false),
+			Entry("3653", 3653, true),
+		)
+	})
+
+	Describe("cluster HA", func() {
+		var cfg *api.ClusterConfig
+
+		BeforeEach(func() {
+			cfg = api.NewClusterConfig()
+			cfg.AvailabilityZones = []string{"us-west-2a", "us-west-2b"}
+			ng := cfg.NewNodeGroup()
+			ng.Name = "ng-1"
+			mng := api.NewManagedNodeGroup()
+			mng.Name = "mng-1"
+			cfg.ManagedNodeGroups = append(cfg.ManagedNodeGroups, mng)
+		})
+
+		It("passes with multiple on-demand nodegroups across the cluster AZs", func() {
+			Expect(api.ValidateClusterHA(cfg)).To(BeEmpty())
+		})
+
+		It("requires at least two nodegroups", func() {
+			cfg.ManagedNodeGroups = nil
+			Expect(api.ValidateClusterHA(cfg)).To(ConsistOf(
+				MatchError("cluster has 1 nodegroup(s), at least 2 are required to avoid a single point of failure"),
+			))
+		})
+
+		It("requires on-demand capacity", func() {
+			cfg.NodeGroups[0].InstancesDistribution = &api.NodeGroupInstancesDistribution{
+				InstanceTypes:                       []string{"m5.large", "m5a.large"},
+				OnDemandPercentageAboveBaseCapacity: aws.Int(0),
+			}
+			cfg.ManagedNodeGroups[0].Spot = true
+			Expect(api.ValidateClusterHA(cfg)).To(ConsistOf(
+				MatchError("all nodegroups use spot instances without on-demand capacity, set instancesDistribution.onDemandBaseCapacity or add an on-demand nodegroup"),
+			))
+		})
+
+		It("requires multi-AZ coverage and on-demand capacity in each AZ", func() {
+			cfg.NodeGroups[0].AvailabilityZones = []string{"us-west-2a"}
+			cfg.ManagedNodeGroups[0].AvailabilityZones = []string{"us-west-2a"}
+			Expect(api.ValidateClusterHA(cfg)).To(ConsistOf(
+				MatchError("nodegroups only cover availability zone(s) [us-west-2a], spread them across at least 2 availability zones"),
+			))
+
+			cfg.ManagedNodeGroups[0].AvailabilityZones = []string{"us-west-2b"}
+			cfg.ManagedNodeGroups[0].Spot = true
+			Expect(api.ValidateClusterHA(cfg)).To(ConsistOf(
+				MatchError("availability zone us-west-2b has no on-demand capacity, add an on-demand nodegroup covering it"),
+			))
+		})
+	})
+
+	Describe("nodeGroups[*].{availabilityZones,subnets}", func() {
+		var (
+			cfg *api.ClusterConfig
+			ng  *api.NodeGroup
+		)
+
+		BeforeEach(func() {
+			cfg = api.NewClusterConfig()
+			cfg.VPC.Subnets = &api.ClusterSubnets{
+				Private: api.AZSubnetMapping{
+					"private-a": {ID: "subnet-a", AZ: "us-west-2a"},
+					"private-b": {ID: "subnet-b", AZ: "us-west-2b"},
+				},
+			}
+			ng = cfg.NewNodeGroup()
+			ng.Name = "ng-1"
+		})
+
+		It("accepts both when they are in the same availability zones", func() {
+			ng.AvailabilityZones = []string{"us-west-2a", "us-west-2b"}
+			ng.Subnets = []string{"private-a", "subnet-b"}
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("names the nodegroup and the conflicting availability zones", func() {
+			ng.AvailabilityZones = []string{"us-west-2a", "us-west-2c"}
+			ng.Subnets = []string{"private-a", "private-b"}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(`nodeGroups[0]: subnets and availabilityZones of nodegroup "ng-1" reference different availability zones: us-west-2b, us-west-2c`))
+		})
+
+		It("rejects setting both for subnets not defined in vpc.subnets", func() {
+			ng.AvailabilityZones = []string{"us-west-2a"}
+			ng.Subnets = []string{"subnet-unknown"}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(`nodeGroups[0]: subnets and availabilityZones of nodegroup "ng-1" can only be set together for subnets defined in vpc.subnets, subnet "subnet-unknown" is not`))
+		})
+	})
+
+	Describe("nodeGroups[*].canary", func() {
+		var ng *api.NodeGroup
+		BeforeEach(func() {
+			ng = api.NewNodeGroup()
+			ng.DesiredCapacity = aws.Int(5)
+			ng.Canary = &api.CanaryConfig{}
+		})
+
+		It("allows an initial capacity lower than the desired capacity", func() {
+			ng.Canary.InitialDesired = aws.Int(1)
+			ng.Canary.ValidationTimeout = &metav1.Duration{Duration: 10 * time.Minute}
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("rejects an initial capacity of zero", func() {
+			ng.Canary.InitialDesired = aws.Int(0)
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].canary.initialDesired must be at least 1"))
+		})
+
+		It("rejects an initial capacity not lower than the desired capacity", func() {
+			ng.Canary.InitialDesired = aws.Int(5)
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].canary.initialDesired must be less than nodeGroups[0].desiredCapacity"))
+		})
+
+		It("rejects a non-positive validation timeout", func() {
+			ng.Canary.ValidationTimeout = &metav1.Duration{}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].canary.validationTimeout must be positive"))
+		})
+	})
+
+	Describe("nodeGroups[*].nodeTerminationHandler", func() {
+		var ng *api.NodeGroup
+		BeforeEach(func() {
+			ng = api.NewNodeGroup()
+		})
+
+		It("allows queue mode with a queue", func() {
+			ng.NodeTerminationHandler = &api.NTHConfig{
+				Mode:     api.NTHModeQueue,
+				QueueARN: "arn:aws:sqs:us-west-2:123456789012:nth-queue",
+			}
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("rejects queue mode without a queue", func() {
+			ng.NodeTerminationHandler = &api.NTHConfig{Mode: api.NTHModeQueue}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(`nodeGroups[0].nodeTerminationHandler.queueARN must be set in "queue" mode`))
+		})
+
+		It("rejects a queue in IMDS mode", func() {
+			ng.NodeTerminationHandler = &api.NTHConfig{QueueARN: "arn:aws:sqs:us-west-2:123456789012:nth-queue"}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(`nodeGroups[0].nodeTerminationHandler.queueARN can only be set in "queue" mode`))
+		})
+
+		It("rejects unknown modes", func() {
+			ng.NodeTerminationHandler = &api.NTHConfig{Mode: "webhook"}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(`nodeGroups[0].nodeTerminationHandler.mode must be one of "imds" or "queue"`))
+		})
+
+		It("rejects createAccountEventRules in IMDS mode", func() {
+			ng.NodeTerminationHandler = &api.NTHConfig{CreateAccountEventRules: api.Disabled()}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(`nodeGroups[0].nodeTerminationHandler.createAccountEventRules can only be set in "queue" mode`))
+		})
+
+		Context("with several nodegroups sharing a queue", func() {
+			const queueARN = "arn:aws:sqs:us-west-2:123456789012:nth-queue"
+			var cfg *api.ClusterConfig
+			BeforeEach(func() {
+				cfg = api.NewClusterConfig()
+				for _, name := range []string{"ng0", "ng1"} {
+					ng := cfg.NewNodeGroup()
+					ng.Name = name
+					ng.NodeTerminationHandler = &api.NTHConfig{Mode: api.NTHModeQueue, QueueARN: queueARN}
+				}
+			})
+
+			It("does not create the account event rules by default", func() {
+				Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+			})
+
+			It("allows a single nodegroup to create the account event rules", func() {
+				cfg.NodeGroups[0].NodeTerminationHandler.CreateAccountEventRules = api.Enabled()
+				Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+			})
+
+			It("rejects several nodegroups creating the account event rules", func() {
+				for _, ng := range cfg.NodeGroups {
+					ng.NodeTerminationHandler.CreateAccountEventRules = api.Enabled()
+				}
+				err := api.ValidateClusterConfig(cfg)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(HavePrefix(`nodeGroups[1].nodeTerminationHandler.createAccountEventRules cannot be enabled, as nodeGroups[0].nodeTerminationHandler already creates the EventBridge rules for queue "` + queueARN + `"`))
+			})
+		})
+	})
+
+	Describe("nodeGroups[*].podSubnets", func() {
+		var ng *api.NodeGroup
+		BeforeEach(func() {
+			ng = api.NewNodeGroup()
+		})
+
+		It("allows subnet IDs", func() {
+			ng.PodSubnets = []string{"subnet-1", "subnet-2"}
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("rejects values that are not subnet IDs", func() {
+			ng.PodSubnets = []string{"100.64.0.0/19"}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(`nodeGroups[0].podSubnets[0] must be a subnet ID, got "100.64.0.0/19"`))
+		})
+
+		It("rejects duplicate subnets", func() {
+			ng.PodSubnets = []string{"subnet-1", "subnet-1"}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(`nodeGroups[0].podSubnets[1] "subnet-1" is not unique`))
+		})
+
+		It("rejects pod subnets with an overridden bootstrap command", func() {
+			ng.PodSubnets = []string{"subnet-1"}
+			ng.OverrideBootstrapCommand = aws.String("/etc/eks/bootstrap.sh cluster-1")
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].podSubnets cannot be used with nodeGroups[0].overrideBootstrapCommand, as the alpha.eksctl.io/eni-config node label is set by the eksctl bootstrap script"))
+		})
+
+		It("rejects pod subnets on Bottlerocket nodegroups", func() {
+			ng.PodSubnets = []string{"subnet-1"}
+			ng.AMIFamily = api.NodeImageFamilyBottlerocket
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("podSubnets is not supported for Bottlerocket nodegroups (path=nodeGroups[0].podSubnets)"))
+		})
+	})
+
+	Describe("nodeGroups[*].placement", func() {
+		var ng *api.NodeGroup
+		BeforeEach(func() {
+			ng = api.NewNodeGroup()
+		})
+
+		It("allows an existing placement group", func() {
+			ng.Placement = &api.Placement{GroupName: "hpc"}
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("requires a group name or a strategy", func() {
+			ng.Placement = &api.Placement{}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].placement.groupName must be set and non-empty"))
+		})
+
+		It("allows a spread placement group across availability zones", func() {
+			ng.Placement = &api.Placement{Strategy: api.PlacementStrategySpread}
+			ng.AvailabilityZones = []string{"us-west-2a", "us-west-2b"}
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("rejects unknown strategies", func() {
+			ng.Placement = &api.Placement{Strategy: "packed"}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(`nodeGroups[0].placement.strategy must be one of cluster, spread or partition, got "packed"`))
+		})
+
+		It("rejects a strategy together with a group name", func() {
+			ng.Placement = &api.Placement{GroupName: "hpc", Strategy: api.PlacementStrategyPartition}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring("nodeGroups[0].placement.groupName and nodeGroups[0].placement.strategy cannot both be set")))
+		})
+
+		It("rejects a cluster placement group across availability zones", func() {
+			ng.Placement = &api.Placement{Strategy: api.PlacementStrategyCluster}
+			ng.AvailabilityZones = []string{"us-west-2a", "us-west-2b"}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring("nodeGroups[0].placement.strategy cluster requires the nodegroup to have exactly one subnet or one availability zone")))
+
+			ng.AvailabilityZones = []string{"us-west-2a"}
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+
+			ng.AvailabilityZones = nil
+			ng.Subnets = []string{"subnet-1"}
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("rejects a cluster placement group spanning the availability zones of the cluster", func() {
+			ng.Placement = &api.Placement{Strategy: api.PlacementStrategyCluster}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring("nodeGroups[0].placement.strategy cluster requires the nodegroup to have exactly one subnet or one availability zone")))
+
+			ng.Subnets = []string{"subnet-1", "subnet-2"}
+			ng.AvailabilityZones = []string{"us-west-2a"}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring("nodeGroups[0].placement.strategy cluster requires the nodegroup to have exactly one subnet or one availability zone")))
+		})
+	})
+
+	Describe("nodeGroups[*].efaEnabled", func() {
+		var ng *api.NodeGroup
+		BeforeEach(func() {
+			ng = api.NewNodeGroup()
+			ng.EFAEnabled = api.Enabled()
+		})
+
+		It("allows a single availability zone or subnet", func() {
+			ng.AvailabilityZones = []string{"us-west-2a"}
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+
+			ng.AvailabilityZones = nil
+			ng.Subnets = []string{"subnet-1"}
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("rejects multiple availability zones or subnets", func() {
+			ng.AvailabilityZones = []string{"us-west-2a", "us-west-2b"}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].efaEnabled nodegroups must have only one subnet or one availability zone"))
+
+			ng.AvailabilityZones = nil
+			ng.Subnets = []string{"subnet-1", "subnet-2"}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].efaEnabled nodegroups must have only one subnet or one availability zone"))
+		})
+	})
+
+	Describe("nodeGroups[*].instanceStorePolicy", func() {
+		var ng *api.NodeGroup
+		BeforeEach(func() {
+			ng = api.NewNodeGroup()
+		})
+
+		It("allows the supported policies", func() {
+			for _, policy := range []string{api.InstanceStorePolicyRAID0, api.InstanceStorePolicyMount, api.InstanceStorePolicyNone} {
+				ng.InstanceStorePolicy = policy
+				Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+			}
+		})
+
+		It("rejects unknown policies", func() {
+			ng.InstanceStorePolicy = "RAID1"
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(`nodeGroups[0].instanceStorePolicy must be one of RAID0, Mount or None, got "RAID1"`))
+		})
+
+		It("rejects policies for Bottlerocket nodegroups", func() {
+			ng.AMIFamily = api.NodeImageFamilyBottlerocket
+			ng.InstanceStorePolicy = api.InstanceStorePolicyRAID0
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("instanceStorePolicy is not supported for Bottlerocket nodegroups (path=nodeGroups[0].instanceStorePolicy)"))
+		})
+	})
+
+	Describe("nodeGroups[*].capacityReservation", func() {
+		var ng *api.NodeGroup
+		BeforeEach(func() {
+			ng = api.NewNodeGroup()
+			ng.InstanceType = "m5.large"
+		})
+
+		It("allows a reservation ID or a preference", func() {
+			ng.CapacityReservation = &api.CapacityReservation{ID: "cr-0123456789abcdef0"}
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+
+			for _, preference := range []string{api.CapacityReservationPreferenceOpen, api.CapacityReservationPreferenceNone} {
+				ng.CapacityReservation = &api.CapacityReservation{Preference: preference}
+				Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+			}
+		})
+
+		It("requires exactly one of id and preference", func() {
+			ng.CapacityReservation = &api.CapacityReservation{}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("one of nodeGroups[0].capacityReservation.id or nodeGroups[0].capacityReservation.preference must be set"))
+
+			ng.CapacityReservation = &api.CapacityReservation{ID: "cr-0123456789abcdef0", Preference: api.CapacityReservationPreferenceOpen}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].capacityReservation.id and nodeGroups[0].capacityReservation.preference cannot both be set"))
+		})
+
+		It("rejects invalid IDs and unknown preferences", func() {
+			ng.CapacityReservation = &api.CapacityReservation{ID: "0123456789abcdef0"}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(`nodeGroups[0].capacityReservation.id "0123456789abcdef0" is not a valid capacity reservation ID`))
+
+			ng.CapacityReservation = &api.CapacityReservation{Preference: "targeted"}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(`nodeGroups[0].capacityReservation.preference must be one of open or none, got "targeted"`))
+		})
+
+		It("rejects a reservation ID for mixed instances", func() {
+			ng.InstanceType = "mixed"
+			ng.InstancesDistribution = &api.NodeGroupInstancesDistribution{InstanceTypes: []string{"m5.large", "m5a.large"}}
+			ng.CapacityReservation = &api.CapacityReservation{ID: "cr-0123456789abcdef0"}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring("nodeGroups[0].capacityReservation.id cannot be set for nodegroups with mixed instances")))
+		})
+	})
+
+	It("rejects nodeRepairConfig for unmanaged nodegroups", func() {
+		ng := api.NewNodeGroup()
+		ng.NodeRepairConfig = &api.NodeRepairConfig{Enabled: api.Enabled()}
+		Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].nodeRepairConfig is only supported for managed nodegroups"))
+	})
+
+	Describe("nodeGroups[*].capacityBlockID", func() {
+		var ng *api.NodeGroup
+		BeforeEach(func() {
+			ng = api.NewNodeGroup()
+			ng.InstanceType = "p5.48xlarge"
+			ng.CapacityBlockID = "cr-0123456789abcdef0"
+		})
+
+		It("allows a capacity block", func() {
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("rejects invalid IDs", func() {
+			ng.CapacityBlockID = "cb-0123456789abcdef0"
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(`nodeGroups[0].capacityBlockID "cb-0123456789abcdef0" is not a valid capacity reservation ID`))
+		})
+
+		It("rejects a capacity reservation", func() {
+			ng.CapacityReservation = &api.CapacityReservation{Preference: api.CapacityReservationPreferenceOpen}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].capacityBlockID and nodeGroups[0].capacityReservation cannot both be set"))
+		})
+
+		It("rejects spot instances", func() {
+			ng.InstanceType = "mixed"
+			ng.InstancesDistribution = &api.NodeGroupInstancesDistribution{
+				InstanceTypes:                       []string{"p5.48xlarge", "p4d.24xlarge"},
+				OnDemandPercentageAboveBaseCapacity: aws.Int(0),
+			}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring("nodeGroups[0].capacityBlockID and nodeGroups[0].instancesDistribution cannot both be set")))
+		})
+	})
+
+	Describe("nodeGroups[*].additionalVolumes", func() {
+		var ng *api.NodeGroup
+		BeforeEach(func() {
+			ng = api.NewNodeGroup()
+			ng.AdditionalVolumes = []api.VolumeMapping{{DeviceName: "/dev/xvdb", VolumeSize: aws.Int(100)}}
+		})
+
+		It("allows volumes with distinct device names", func() {
+			ng.AdditionalVolumes = append(ng.AdditionalVolumes, api.VolumeMapping{
+				DeviceName:      "/dev/xvdc",
+				VolumeSize:      aws.Int(200),
+				VolumeType:      aws.String(api.NodeVolumeTypeIO1),
+				VolumeIOPS:      aws.Int(1000),
+				VolumeEncrypted: api.Enabled(),
+				VolumeKmsKeyID:  aws.String("36c0b54e-64ed-4f2d-a1c7-96558764311e"),
+			})
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("rejects device names used by the root volume or another volume", func() {
+			ng.VolumeName = aws.String("/dev/xvdb")
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(`nodeGroups[0].additionalVolumes[0].deviceName "/dev/xvdb" is already used by the root volume or another volume`))
+
+			ng.VolumeName = aws.String("/dev/xvda")
+			ng.AdditionalVolumes = append(ng.AdditionalVolumes, api.VolumeMapping{DeviceName: "/dev/xvdb", VolumeSize: aws.Int(100)})
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(`nodeGroups[0].additionalVolumes[1].deviceName "/dev/xvdb" is already used by the root volume or another volume`))
+		})
+
+		It("requires a device name and a size", func() {
+			ng.AdditionalVolumes[0].DeviceName = ""
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].additionalVolumes[0].deviceName must be set"))
+
+			ng.AdditionalVolumes[0].DeviceName = "/dev/xvdb"
+			ng.AdditionalVolumes[0].VolumeSize = nil
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].additionalVolumes[0].volumeSize must be set and greater than 0"))
+		})
+
+		It("rejects IOPS and throughput for volume types that do not support them", func() {
+			ng.AdditionalVolumes[0].VolumeType = aws.String(api.NodeVolumeTypeST1)
+			ng.AdditionalVolumes[0].VolumeIOPS = aws.Int(3000)
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].additionalVolumes[0].volumeIOPS is only supported for io1 and gp3 volume types"))
+
+			ng.AdditionalVolumes[0].VolumeIOPS = nil
+			ng.AdditionalVolumes[0].VolumeThroughput = aws.Int(250)
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].additionalVolumes[0].volumeThroughput is only supported for gp3 volume type"))
+		})
+	})
+
+	Describe("nodeGroups[*].amiParameterOverride", func() {
+		var ng *api.NodeGroup
+		BeforeEach(func() {
+			ng = api.NewNodeGroup()
+			ng.AMIParameterOverride = "/org/hardened-ami/eks/image_id"
+		})
+
+		It("allows the AMI to be resolved from the parameter", func() {
+			ng.AMI = api.NodeImageResolverAutoSSM
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("rejects an explicit AMI ID", func() {
+			ng.AMI = "ami-123"
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].amiParameterOverride and nodeGroups[0].ami cannot both be set"))
+		})
+	})
+
 	Describe("nodeGroups[*].iam", func() {
 		var (
 			cfg *api.ClusterConfig
@@ -661,6 +1457,34 @@ var _ = Describe("ClusterConfig validation", func() {
 				err := api.ValidateNodeGroup(0, ng)
 				Expect(err).ToNot(HaveOccurred())
 			})
+
+			It("It does not fail when weightedCapacity is specified for the instance types", func() {
+				ng.InstancesDistribution.WeightedCapacity = map[string]int{"t3.medium": 1, "t3.large": 2}
+
+				err := api.ValidateNodeGroup(0, ng)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("It fails when weightedCapacity is specified for an instance type not in instanceTypes", func() {
+				ng.InstancesDistribution.WeightedCapacity = map[string]int{"t3.xlarge": 4}
+
+				err := api.ValidateNodeGroup(0, ng)
+				Expect(err).To(MatchError(`weightedCapacity specified for instance type "t3.xlarge", which is not in instanceTypes`))
+			})
+
+			It("It fails when weightedCapacity is specified for only some of the instance types", func() {
+				ng.InstancesDistribution.WeightedCapacity = map[string]int{"t3.large": 2}
+
+				err := api.ValidateNodeGroup(0, ng)
+				Expect(err).To(MatchError(`weightedCapacity must be specified for all instance types or none, it is missing for instance type "t3.medium"`))
+			})
+
+			It("It fails when a weightedCapacity is not between 1 and 999", func() {
+				ng.InstancesDistribution.WeightedCapacity = map[string]int{"t3.large": 0}
+
+				err := api.ValidateNodeGroup(0, ng)
+				Expect(err).To(MatchError(`weightedCapacity of instance type "t3.large" should be between 1 and 999`))
+			})
 		})
 	})
 
@@ -702,6 +1526,23 @@ var _ = Describe("ClusterConfig validation", func() {
 				err := api.ValidateNodeGroup(0, ng)
 				Expect(err).ToNot(HaveOccurred())
 			})
+
+			It("Allows several cluster DNS servers in the kubelet config", func() {
+				ng.KubeletExtraConfig = &api.InlineDocument{
+					"clusterDNS": []string{"169.254.20.10", "172.20.0.10"},
+				}
+				err := api.ValidateNodeGroup(0, ng)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("Forbids overriding clusterDNS when it is set for the nodegroup", func() {
+				ng.ClusterDNS = "169.254.20.10"
+				ng.KubeletExtraConfig = &api.InlineDocument{
+					"clusterDNS": []string{"172.20.0.10"},
+				}
+				err := api.ValidateNodeGroup(0, ng)
+				Expect(err).To(MatchError(`cannot override "clusterDNS" in kubelet config when clusterDNS is set for the nodegroup`))
+			})
 		})
 	})
 
@@ -876,6 +1717,37 @@ var _ = Describe("ClusterConfig validation", func() {
 			}
 		})
 
+		DescribeTable("rejects settings managed by eksctl", func(key string) {
+			ng := api.NewNodeGroup()
+			ng.AMIFamily = api.NodeImageFamilyBottlerocket
+			ng.Bottlerocket = &api.NodeGroupBottlerocket{
+				Settings: &api.InlineDocument{
+					"kubernetes": map[string]interface{}{
+						key: "value",
+					},
+				},
+			}
+			err := api.ValidateNodeGroup(0, ng)
+			Expect(err).To(MatchError(fmt.Sprintf("invalid Bottlerocket setting: kubernetes.%[1]s is set by eksctl from the cluster and cannot be overridden (path=nodeGroups[0].bottlerocket.settings.kubernetes.%[1]s)", key)))
+		},
+			Entry("cluster name", "cluster-name"),
+			Entry("API server", "api-server"),
+			Entry("cluster certificate", "cluster-certificate"),
+		)
+
+		It("allows other kubernetes settings", func() {
+			ng := api.NewNodeGroup()
+			ng.AMIFamily = api.NodeImageFamilyBottlerocket
+			ng.Bottlerocket = &api.NodeGroupBottlerocket{
+				Settings: &api.InlineDocument{
+					"kubernetes": map[string]interface{}{
+						"allowed-unsafe-sysctls": []interface{}{"net.core.somaxconn"},
+					},
+				},
+			}
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
 		It("has no error with supported fields", func() {
 			x := 32
 			ngs := []*api.NodeGroup{
@@ -22,6 +22,7 @@ package v1alpha5
 
 import (
 	ipnet "github.com/weaveworks/eksctl/pkg/utils/ipnet"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -96,6 +97,48 @@ func (in *Addon) DeepCopy() *Addon {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryConfig) DeepCopyInto(out *CanaryConfig) {
+	*out = *in
+	if in.InitialDesired != nil {
+		in, out := &in.InitialDesired, &out.InitialDesired
+		*out = new(int)
+		**out = **in
+	}
+	if in.ValidationTimeout != nil {
+		in, out := &in.ValidationTimeout, &out.ValidationTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryConfig.
+func (in *CanaryConfig) DeepCopy() *CanaryConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapacityReservation) DeepCopyInto(out *CapacityReservation) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityReservation.
+func (in *CapacityReservation) DeepCopy() *CapacityReservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CapacityReservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterCloudWatch) DeepCopyInto(out *ClusterCloudWatch) {
 	*out = *in
@@ -787,6 +830,32 @@ func (in InlineDocument) DeepCopyInto(out *InlineDocument) {
 	}
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceMetadataOptions) DeepCopyInto(out *InstanceMetadataOptions) {
+	*out = *in
+	if in.HTTPTokens != nil {
+		in, out := &in.HTTPTokens, &out.HTTPTokens
+		*out = new(string)
+		**out = **in
+	}
+	if in.HTTPPutResponseHopLimit != nil {
+		in, out := &in.HTTPPutResponseHopLimit, &out.HTTPPutResponseHopLimit
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceMetadataOptions.
+func (in *InstanceMetadataOptions) DeepCopy() *InstanceMetadataOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceMetadataOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InstanceSelector) DeepCopyInto(out *InstanceSelector) {
 	*out = *in
@@ -858,6 +927,11 @@ func (in *ManagedNodeGroup) DeepCopyInto(out *ManagedNodeGroup) {
 		*out = new(LaunchTemplate)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.UpdateConfig != nil {
+		in, out := &in.UpdateConfig, &out.UpdateConfig
+		*out = new(NodeGroupUpdateConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -892,6 +966,42 @@ func (in *MetricsCollection) DeepCopy() *MetricsCollection {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NTHConfig) DeepCopyInto(out *NTHConfig) {
+	*out = *in
+	if in.EnableSpotInterruptionDraining != nil {
+		in, out := &in.EnableSpotInterruptionDraining, &out.EnableSpotInterruptionDraining
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableScheduledEventDraining != nil {
+		in, out := &in.EnableScheduledEventDraining, &out.EnableScheduledEventDraining
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableRebalanceDraining != nil {
+		in, out := &in.EnableRebalanceDraining, &out.EnableRebalanceDraining
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CreateAccountEventRules != nil {
+		in, out := &in.CreateAccountEventRules, &out.CreateAccountEventRules
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NTHConfig.
+func (in *NTHConfig) DeepCopy() *NTHConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NTHConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Network) DeepCopyInto(out *Network) {
 	*out = *in
@@ -939,10 +1049,8 @@ func (in *NodeGroup) DeepCopyInto(out *NodeGroup) {
 	}
 	if in.Taints != nil {
 		in, out := &in.Taints, &out.Taints
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+		*out = make(NodeGroupTaints, len(*in))
+		copy(*out, *in)
 	}
 	if in.ClassicLoadBalancerNames != nil {
 		in, out := &in.ClassicLoadBalancerNames, &out.ClassicLoadBalancerNames
@@ -954,6 +1062,28 @@ func (in *NodeGroup) DeepCopyInto(out *NodeGroup) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ScaleInProtection != nil {
+		in, out := &in.ScaleInProtection, &out.ScaleInProtection
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DefaultCooldownSeconds != nil {
+		in, out := &in.DefaultCooldownSeconds, &out.DefaultCooldownSeconds
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxInstanceLifetime != nil {
+		in, out := &in.MaxInstanceLifetime, &out.MaxInstanceLifetime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ScheduledScaling != nil {
+		in, out := &in.ScheduledScaling, &out.ScheduledScaling
+		*out = make([]ScheduledScalingRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Bottlerocket != nil {
 		in, out := &in.Bottlerocket, &out.Bottlerocket
 		*out = new(NodeGroupBottlerocket)
@@ -963,6 +1093,48 @@ func (in *NodeGroup) DeepCopyInto(out *NodeGroup) {
 		in, out := &in.KubeletExtraConfig, &out.KubeletExtraConfig
 		*out = (*in).DeepCopy()
 	}
+	if in.GPUConfig != nil {
+		in, out := &in.GPUConfig, &out.GPUConfig
+		*out = new(NodeGroupGPUConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BootstrapRetries != nil {
+		in, out := &in.BootstrapRetries, &out.BootstrapRetries
+		*out = new(int)
+		**out = **in
+	}
+	if in.BootstrapTimeout != nil {
+		in, out := &in.BootstrapTimeout, &out.BootstrapTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(CanaryConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeTerminationHandler != nil {
+		in, out := &in.NodeTerminationHandler, &out.NodeTerminationHandler
+		*out = new(NTHConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodSubnets != nil {
+		in, out := &in.PodSubnets, &out.PodSubnets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CapacityReservation != nil {
+		in, out := &in.CapacityReservation, &out.CapacityReservation
+		*out = new(CapacityReservation)
+		**out = **in
+	}
+	if in.AdditionalVolumes != nil {
+		in, out := &in.AdditionalVolumes, &out.AdditionalVolumes
+		*out = make([]VolumeMapping, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1011,6 +1183,16 @@ func (in *NodeGroupBase) DeepCopyInto(out *NodeGroupBase) {
 			(*out)[key] = val
 		}
 	}
+	if in.AssociatePublicIP != nil {
+		in, out := &in.AssociatePublicIP, &out.AssociatePublicIP
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DeleteENIOnTermination != nil {
+		in, out := &in.DeleteENIOnTermination, &out.DeleteENIOnTermination
+		*out = new(bool)
+		**out = **in
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make(map[string]string, len(*in))
@@ -1088,6 +1270,11 @@ func (in *NodeGroupBase) DeepCopyInto(out *NodeGroupBase) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.InstanceMetadataOptions != nil {
+		in, out := &in.InstanceMetadataOptions, &out.InstanceMetadataOptions
+		*out = new(InstanceMetadataOptions)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Placement != nil {
 		in, out := &in.Placement, &out.Placement
 		*out = new(Placement)
@@ -1103,6 +1290,21 @@ func (in *NodeGroupBase) DeepCopyInto(out *NodeGroupBase) {
 		*out = new(InstanceSelector)
 		**out = **in
 	}
+	if in.LogRetentionInDays != nil {
+		in, out := &in.LogRetentionInDays, &out.LogRetentionInDays
+		*out = new(int)
+		**out = **in
+	}
+	if in.NodeRepairConfig != nil {
+		in, out := &in.NodeRepairConfig, &out.NodeRepairConfig
+		*out = new(NodeRepairConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProtectFromReplacement != nil {
+		in, out := &in.ProtectFromReplacement, &out.ProtectFromReplacement
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -1141,6 +1343,29 @@ func (in *NodeGroupBottlerocket) DeepCopy() *NodeGroupBottlerocket {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeGroupGPUConfig) DeepCopyInto(out *NodeGroupGPUConfig) {
+	*out = *in
+	if in.MIGProfiles != nil {
+		in, out := &in.MIGProfiles, &out.MIGProfiles
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeGroupGPUConfig.
+func (in *NodeGroupGPUConfig) DeepCopy() *NodeGroupGPUConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeGroupGPUConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeGroupIAM) DeepCopyInto(out *NodeGroupIAM) {
 	*out = *in
@@ -1272,6 +1497,13 @@ func (in *NodeGroupInstancesDistribution) DeepCopyInto(out *NodeGroupInstancesDi
 		*out = new(string)
 		**out = **in
 	}
+	if in.WeightedCapacity != nil {
+		in, out := &in.WeightedCapacity, &out.WeightedCapacity
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -1362,6 +1594,89 @@ func (in *NodeGroupSSH) DeepCopy() *NodeGroupSSH {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeGroupTaint) DeepCopyInto(out *NodeGroupTaint) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeGroupTaint.
+func (in *NodeGroupTaint) DeepCopy() *NodeGroupTaint {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeGroupTaint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in NodeGroupTaints) DeepCopyInto(out *NodeGroupTaints) {
+	{
+		in := &in
+		*out = make(NodeGroupTaints, len(*in))
+		copy(*out, *in)
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeGroupTaints.
+func (in NodeGroupTaints) DeepCopy() NodeGroupTaints {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeGroupTaints)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeGroupUpdateConfig) DeepCopyInto(out *NodeGroupUpdateConfig) {
+	*out = *in
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxUnavailablePercentage != nil {
+		in, out := &in.MaxUnavailablePercentage, &out.MaxUnavailablePercentage
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeGroupUpdateConfig.
+func (in *NodeGroupUpdateConfig) DeepCopy() *NodeGroupUpdateConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeGroupUpdateConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeRepairConfig) DeepCopyInto(out *NodeRepairConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeRepairConfig.
+func (in *NodeRepairConfig) DeepCopy() *NodeRepairConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeRepairConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OIDCIdentityProvider) DeepCopyInto(out *OIDCIdentityProvider) {
 	*out = *in
@@ -1549,6 +1864,37 @@ func (in *ScalingConfig) DeepCopy() *ScalingConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduledScalingRule) DeepCopyInto(out *ScheduledScalingRule) {
+	*out = *in
+	if in.DesiredCapacity != nil {
+		in, out := &in.DesiredCapacity, &out.DesiredCapacity
+		*out = new(int)
+		**out = **in
+	}
+	if in.MinSize != nil {
+		in, out := &in.MinSize, &out.MinSize
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduledScalingRule.
+func (in *ScheduledScalingRule) DeepCopy() *ScheduledScalingRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduledScalingRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretsEncryption) DeepCopyInto(out *SecretsEncryption) {
 	*out = *in
@@ -1565,6 +1911,52 @@ func (in *SecretsEncryption) DeepCopy() *SecretsEncryption {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeMapping) DeepCopyInto(out *VolumeMapping) {
+	*out = *in
+	if in.VolumeSize != nil {
+		in, out := &in.VolumeSize, &out.VolumeSize
+		*out = new(int)
+		**out = **in
+	}
+	if in.VolumeType != nil {
+		in, out := &in.VolumeType, &out.VolumeType
+		*out = new(string)
+		**out = **in
+	}
+	if in.VolumeEncrypted != nil {
+		in, out := &in.VolumeEncrypted, &out.VolumeEncrypted
+		*out = new(bool)
+		**out = **in
+	}
+	if in.VolumeKmsKeyID != nil {
+		in, out := &in.VolumeKmsKeyID, &out.VolumeKmsKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VolumeIOPS != nil {
+		in, out := &in.VolumeIOPS, &out.VolumeIOPS
+		*out = new(int)
+		**out = **in
+	}
+	if in.VolumeThroughput != nil {
+		in, out := &in.VolumeThroughput, &out.VolumeThroughput
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeMapping.
+func (in *VolumeMapping) DeepCopy() *VolumeMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WellKnownPolicies) DeepCopyInto(out *WellKnownPolicies) {
 	*out = *in
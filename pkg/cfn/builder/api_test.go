@@ -6,11 +6,13 @@ import (
 	"fmt"
 	"net"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/weaveworks/goformation/v4"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
@@ -74,6 +76,20 @@ type Properties struct {
 	MetricsCollection                 []map[string]interface{}
 	TargetGroupARNs                   []string
 	DesiredCapacity, MinSize, MaxSize string
+	NewInstancesProtectedFromScaleIn  *bool
+	Cooldown                          string
+	MaxInstanceLifetime               *int
+	AutoScalingGroupName              interface{}
+	Recurrence, TimeZone              string
+
+	LogGroupName    string
+	RetentionInDays int
+
+	Strategy string
+
+	LifecycleTransition, DefaultResult, HeartbeatTimeout string
+	EventPattern                                         map[string]interface{}
+	Targets                                              []map[string]interface{}
 
 	CidrIP, CidrIpv6, IPProtocol string
 	FromPort, ToPort             int
@@ -103,6 +119,10 @@ type Properties struct {
 					InstanceType string
 				}
 			}
+			Overrides []struct {
+				InstanceType     string
+				WeightedCapacity string
+			}
 		}
 		InstancesDistribution struct {
 			OnDemandBaseCapacity                string
@@ -117,6 +137,7 @@ type Properties struct {
 type NetworkInterface struct {
 	DeviceIndex              int
 	AssociatePublicIPAddress bool
+	DeleteOnTermination      *bool
 	NetworkCardIndex         int
 	InterfaceType            string
 }
@@ -136,6 +157,19 @@ type LaunchTemplateData struct {
 	CreditSpecification *struct {
 		CPUCredits string
 	}
+	MetadataOptions *struct {
+		HTTPPutResponseHopLimit int    `json:"HttpPutResponseHopLimit"`
+		HTTPTokens              string `json:"HttpTokens"`
+	}
+	Placement *struct {
+		GroupName interface{}
+	}
+	CapacityReservationSpecification *struct {
+		CapacityReservationPreference string
+		CapacityReservationTarget     *struct {
+			CapacityReservationID string `json:"CapacityReservationId"`
+		}
+	}
 }
 
 type Template struct {
@@ -1708,6 +1742,51 @@ var _ = Describe("CloudFormation template builder API", func() {
 		})
 	})
 
+	Context("NodeGroup{AdditionalVolumes}", func() {
+		cfg, ng := newClusterConfigAndNodegroup(true)
+
+		ng.AdditionalVolumes = []api.VolumeMapping{
+			{
+				DeviceName:       "/dev/xvdb",
+				VolumeSize:       aws.Int(100),
+				VolumeIOPS:       aws.Int(4000),
+				VolumeThroughput: aws.Int(250),
+				VolumeEncrypted:  api.Enabled(),
+			},
+			{
+				DeviceName: "/dev/xvdc",
+				VolumeSize: aws.Int(500),
+				VolumeType: aws.String(api.NodeVolumeTypeST1),
+			},
+		}
+
+		build(cfg, "eksctl-test-additional-volumes", ng)
+
+		roundtrip()
+
+		It("should add the volumes after the root volume", func() {
+			ltd := getLaunchTemplateData(ngTemplate)
+			Expect(ltd.BlockDeviceMappings).To(HaveLen(3))
+
+			dataVolume := ltd.BlockDeviceMappings[1].(map[string]interface{})
+			Expect(dataVolume).To(HaveKeyWithValue("DeviceName", "/dev/xvdb"))
+			Expect(dataVolume["Ebs"]).To(Equal(map[string]interface{}{
+				"VolumeSize": 100.0,
+				"VolumeType": "gp3",
+				"Iops":       4000.0,
+				"Throughput": 250.0,
+				"Encrypted":  true,
+			}))
+
+			logVolume := ltd.BlockDeviceMappings[2].(map[string]interface{})
+			Expect(logVolume).To(HaveKeyWithValue("DeviceName", "/dev/xvdc"))
+			Expect(logVolume["Ebs"]).To(Equal(map[string]interface{}{
+				"VolumeSize": 500.0,
+				"VolumeType": "st1",
+			}))
+		})
+	})
+
 	assertSSHRules := func(expectedIngressRules string) {
 		bytes, err := ngrs.RenderJSON()
 		Expect(err).ToNot(HaveOccurred())
@@ -1986,6 +2065,40 @@ var _ = Describe("CloudFormation template builder API", func() {
 		})
 	})
 
+	Context("NodeGroup{AssociatePublicIP=true DeleteENIOnTermination=false}", func() {
+		cfg, ng := newClusterConfigAndNodegroup(true)
+
+		ng.AssociatePublicIP = api.Enabled()
+		ng.DeleteENIOnTermination = api.Disabled()
+
+		build(cfg, "eksctl-test-network-interface-options", ng)
+
+		roundtrip()
+
+		It("should set the options on the network interface", func() {
+			ltd := getLaunchTemplateData(ngTemplate)
+			Expect(ltd.NetworkInterfaces).To(HaveLen(1))
+			Expect(ltd.NetworkInterfaces[0].AssociatePublicIPAddress).To(BeTrue())
+			Expect(ltd.NetworkInterfaces[0].DeleteOnTermination).ToNot(BeNil())
+			Expect(*ltd.NetworkInterfaces[0].DeleteOnTermination).To(BeFalse())
+		})
+	})
+
+	Context("NodeGroup{AssociatePublicIP=nil DeleteENIOnTermination=nil}", func() {
+		cfg, ng := newClusterConfigAndNodegroup(true)
+
+		build(cfg, "eksctl-test-network-interface-options", ng)
+
+		roundtrip()
+
+		It("should leave the options to the subnet defaults", func() {
+			ltd := getLaunchTemplateData(ngTemplate)
+			Expect(ltd.NetworkInterfaces).To(HaveLen(1))
+			Expect(ltd.NetworkInterfaces[0].AssociatePublicIPAddress).To(BeFalse())
+			Expect(ltd.NetworkInterfaces[0].DeleteOnTermination).To(BeNil())
+		})
+	})
+
 	Context("NodeGroup{EBSOptimized=nil}", func() {
 		cfg, ng := newClusterConfigAndNodegroup(true)
 
@@ -2660,6 +2773,346 @@ var _ = Describe("CloudFormation template builder API", func() {
 		})
 	})
 
+	Context("Nodegroup with Mixed instances and weighted capacity", func() {
+		cfg, ng := newClusterConfigAndNodegroup(true)
+
+		ng.InstanceType = "mixed"
+		ng.InstancesDistribution = &api.NodeGroupInstancesDistribution{
+			InstanceTypes:    []string{"m5.large", "m5.xlarge"},
+			WeightedCapacity: map[string]int{"m5.large": 1, "m5.xlarge": 2},
+		}
+
+		build(cfg, "eksctl-test-weighted-cluster", ng)
+
+		roundtrip()
+
+		It("should set the weighted capacity of the overrides", func() {
+			nodeGroupProperties := getNodeGroupProperties(ngTemplate)
+			Expect(nodeGroupProperties.MixedInstancesPolicy).To(Not(BeNil()))
+
+			overrides := nodeGroupProperties.MixedInstancesPolicy.LaunchTemplate.Overrides
+			Expect(overrides).To(HaveLen(2))
+			Expect(overrides[0].InstanceType).To(Equal("m5.large"))
+			Expect(overrides[0].WeightedCapacity).To(Equal("1"))
+			Expect(overrides[1].InstanceType).To(Equal("m5.xlarge"))
+			Expect(overrides[1].WeightedCapacity).To(Equal("2"))
+		})
+	})
+
+	Context("NodeGroup{ScaleInProtection=nil DefaultCooldownSeconds=nil}", func() {
+		cfg, ng := newClusterConfigAndNodegroup(true)
+
+		build(cfg, "eksctl-test-asg-scaling", ng)
+
+		roundtrip()
+
+		It("should not set scale-in protection or cooldown", func() {
+			Expect(ngTemplate.Resources).To(HaveKey("NodeGroup"))
+			ngProps := ngTemplate.Resources["NodeGroup"].Properties
+			Expect(ngProps.NewInstancesProtectedFromScaleIn).To(BeNil())
+			Expect(ngProps.Cooldown).To(BeEmpty())
+			Expect(ngProps.MaxInstanceLifetime).To(BeNil())
+		})
+	})
+
+	Context("NodeGroup{MaxInstanceLifetime=7d}", func() {
+		cfg, ng := newClusterConfigAndNodegroup(true)
+
+		ng.MaxInstanceLifetime = &metav1.Duration{Duration: 7*24*time.Hour + 500*time.Millisecond}
+
+		build(cfg, "eksctl-test-asg-lifetime", ng)
+
+		roundtrip()
+
+		It("should set the max instance lifetime of the ASG in seconds", func() {
+			Expect(ngTemplate.Resources).To(HaveKey("NodeGroup"))
+			ngProps := ngTemplate.Resources["NodeGroup"].Properties
+			Expect(ngProps.MaxInstanceLifetime).To(Equal(aws.Int(604800)))
+		})
+	})
+
+	Context("NodeGroup{ScaleInProtection=true DefaultCooldownSeconds=120}", func() {
+		cfg, ng := newClusterConfigAndNodegroup(true)
+
+		ng.ScaleInProtection = api.Enabled()
+		ng.DefaultCooldownSeconds = aws.Int(120)
+
+		build(cfg, "eksctl-test-asg-scaling", ng)
+
+		roundtrip()
+
+		It("should set scale-in protection and cooldown on the ASG", func() {
+			Expect(ngTemplate.Resources).To(HaveKey("NodeGroup"))
+			ngProps := ngTemplate.Resources["NodeGroup"].Properties
+			Expect(ngProps.NewInstancesProtectedFromScaleIn).ToNot(BeNil())
+			Expect(*ngProps.NewInstancesProtectedFromScaleIn).To(BeTrue())
+			Expect(ngProps.Cooldown).To(Equal("120"))
+		})
+	})
+
+	Context("NodeGroup{ScheduledScaling}", func() {
+		cfg, ng := newClusterConfigAndNodegroup(true)
+
+		ng.ScheduledScaling = []api.ScheduledScalingRule{
+			{
+				Schedule:        "0 20 * * 1-5",
+				MinSize:         aws.Int(0),
+				MaxSize:         aws.Int(0),
+				DesiredCapacity: aws.Int(0),
+				TimeZone:        "Europe/London",
+			},
+			{
+				Schedule:        "0 8 * * 1-5",
+				DesiredCapacity: aws.Int(3),
+			},
+		}
+
+		build(cfg, "eksctl-test-scheduled-scaling", ng)
+
+		roundtrip()
+
+		It("should add a scheduled action for each rule", func() {
+			Expect(ngTemplate.Resources).To(HaveKey("NodeGroupScheduledAction0"))
+			Expect(ngTemplate.Resources).To(HaveKey("NodeGroupScheduledAction1"))
+
+			scaleDown := ngTemplate.Resources["NodeGroupScheduledAction0"].Properties
+			Expect(scaleDown.AutoScalingGroupName).To(Equal(map[string]interface{}{"Ref": "NodeGroup"}))
+			Expect(scaleDown.Recurrence).To(Equal("0 20 * * 1-5"))
+			Expect(scaleDown.MinSize).To(Equal("0"))
+			Expect(scaleDown.MaxSize).To(Equal("0"))
+			Expect(scaleDown.DesiredCapacity).To(Equal("0"))
+			Expect(scaleDown.TimeZone).To(Equal("Europe/London"))
+
+			scaleUp := ngTemplate.Resources["NodeGroupScheduledAction1"].Properties
+			Expect(scaleUp.Recurrence).To(Equal("0 8 * * 1-5"))
+			Expect(scaleUp.DesiredCapacity).To(Equal("3"))
+			Expect(scaleUp.MinSize).To(BeEmpty())
+			Expect(scaleUp.TimeZone).To(BeEmpty())
+		})
+	})
+
+	Context("NodeGroup{LogRetentionInDays}", func() {
+		cfg, ng := newClusterConfigAndNodegroup(true)
+
+		ng.LogRetentionInDays = aws.Int(14)
+
+		build(cfg, "eksctl-test-log-retention", ng)
+
+		roundtrip()
+
+		It("should add a log group with the retention", func() {
+			Expect(ngTemplate.Resources).To(HaveKey("NodeGroupLogGroup"))
+
+			props := ngTemplate.Resources["NodeGroupLogGroup"].Properties
+			Expect(props.LogGroupName).To(Equal("/aws/eks/" + clusterName + "/nodegroup/ng-abcd1234"))
+			Expect(props.RetentionInDays).To(Equal(14))
+		})
+	})
+
+	Context("NodeGroup{Placement.Strategy=spread}", func() {
+		cfg, ng := newClusterConfigAndNodegroup(true)
+
+		ng.Placement = &api.Placement{Strategy: api.PlacementStrategySpread}
+
+		build(cfg, "eksctl-test-placement-strategy", ng)
+
+		roundtrip()
+
+		It("should create a placement group and launch the nodes in it", func() {
+			Expect(ngTemplate.Resources).To(HaveKey("NodeGroupPlacementGroup"))
+			Expect(ngTemplate.Resources["NodeGroupPlacementGroup"].Properties.Strategy).To(Equal("spread"))
+
+			placement := ngTemplate.Resources["NodeGroupLaunchTemplate"].Properties.LaunchTemplateData.Placement
+			Expect(placement).NotTo(BeNil())
+			Expect(placement.GroupName).To(Equal(map[string]interface{}{"Ref": "NodeGroupPlacementGroup"}))
+		})
+	})
+
+	Context("NodeGroup{Placement.GroupName}", func() {
+		cfg, ng := newClusterConfigAndNodegroup(true)
+
+		ng.Placement = &api.Placement{GroupName: "hpc"}
+
+		build(cfg, "eksctl-test-placement-group-name", ng)
+
+		roundtrip()
+
+		It("should launch the nodes in the existing placement group", func() {
+			Expect(ngTemplate.Resources).NotTo(HaveKey("NodeGroupPlacementGroup"))
+
+			placement := ngTemplate.Resources["NodeGroupLaunchTemplate"].Properties.LaunchTemplateData.Placement
+			Expect(placement).NotTo(BeNil())
+			Expect(placement.GroupName).To(Equal("hpc"))
+		})
+	})
+
+	Context("NodeGroup{CapacityReservation.ID}", func() {
+		cfg, ng := newClusterConfigAndNodegroup(true)
+
+		ng.CapacityReservation = &api.CapacityReservation{ID: "cr-0123456789abcdef0"}
+
+		build(cfg, "eksctl-test-capacity-reservation-id", ng)
+
+		roundtrip()
+
+		It("should target the capacity reservation", func() {
+			spec := ngTemplate.Resources["NodeGroupLaunchTemplate"].Properties.LaunchTemplateData.CapacityReservationSpecification
+			Expect(spec).NotTo(BeNil())
+			Expect(spec.CapacityReservationPreference).To(BeEmpty())
+			Expect(spec.CapacityReservationTarget).NotTo(BeNil())
+			Expect(spec.CapacityReservationTarget.CapacityReservationID).To(Equal("cr-0123456789abcdef0"))
+		})
+	})
+
+	Context("NodeGroup{CapacityReservation.Preference=none}", func() {
+		cfg, ng := newClusterConfigAndNodegroup(true)
+
+		ng.CapacityReservation = &api.CapacityReservation{Preference: api.CapacityReservationPreferenceNone}
+
+		build(cfg, "eksctl-test-capacity-reservation-preference", ng)
+
+		roundtrip()
+
+		It("should set the capacity reservation preference", func() {
+			spec := ngTemplate.Resources["NodeGroupLaunchTemplate"].Properties.LaunchTemplateData.CapacityReservationSpecification
+			Expect(spec).NotTo(BeNil())
+			Expect(spec.CapacityReservationPreference).To(Equal("none"))
+			Expect(spec.CapacityReservationTarget).To(BeNil())
+		})
+	})
+
+	Context("NodeGroup{CapacityBlockID}", func() {
+		cfg, ng := newClusterConfigAndNodegroup(true)
+
+		ng.CapacityBlockID = "cr-0123456789abcdef0"
+
+		build(cfg, "eksctl-test-capacity-block", ng)
+
+		roundtrip()
+
+		It("should target the capacity block", func() {
+			launchTemplateData := ngTemplate.Resources["NodeGroupLaunchTemplate"].Properties.LaunchTemplateData
+			Expect(launchTemplateData.CapacityReservationSpecification).NotTo(BeNil())
+			Expect(launchTemplateData.CapacityReservationSpecification.CapacityReservationTarget).NotTo(BeNil())
+			Expect(launchTemplateData.CapacityReservationSpecification.CapacityReservationTarget.CapacityReservationID).To(Equal("cr-0123456789abcdef0"))
+			Expect(launchTemplateData.InstanceMarketOptions).NotTo(BeNil())
+			Expect(launchTemplateData.InstanceMarketOptions.MarketType).To(Equal("capacity-block"))
+		})
+	})
+
+	Context("NodeGroup{NodeTerminationHandler.Mode=queue CreateAccountEventRules=true}", func() {
+		cfg, ng := newClusterConfigAndNodegroup(true)
+
+		ng.NodeTerminationHandler = &api.NTHConfig{
+			Mode:                    api.NTHModeQueue,
+			QueueARN:                "arn:aws:sqs:us-west-2:123456789012:nth-queue",
+			EnableRebalanceDraining: aws.Bool(true),
+			CreateAccountEventRules: aws.Bool(true),
+		}
+
+		build(cfg, "eksctl-test-nth-queue", ng)
+
+		roundtrip()
+
+		It("should add the lifecycle hook and the event rules", func() {
+			hook := ngTemplate.Resources["NodeTerminationHandlerLifecycleHook"].Properties
+			Expect(hook.AutoScalingGroupName).To(Equal(map[string]interface{}{"Ref": "NodeGroup"}))
+			Expect(hook.LifecycleTransition).To(Equal("autoscaling:EC2_INSTANCE_TERMINATING"))
+			Expect(hook.DefaultResult).To(Equal("CONTINUE"))
+
+			for _, rule := range []string{"ASG", "Spot", "ScheduledChange", "Rebalance"} {
+				Expect(ngTemplate.Resources).To(HaveKey("NodeTerminationHandler" + rule + "Rule"))
+				props := ngTemplate.Resources["NodeTerminationHandler"+rule+"Rule"].Properties
+				Expect(props.Targets).To(ConsistOf(map[string]interface{}{
+					"Id":  "NodeTerminationHandlerQueue",
+					"Arn": "arn:aws:sqs:us-west-2:123456789012:nth-queue",
+				}))
+			}
+			asgRule := ngTemplate.Resources["NodeTerminationHandlerASGRule"].Properties
+			Expect(asgRule.EventPattern["detail"]).To(Equal(map[string]interface{}{
+				"AutoScalingGroupName": []interface{}{map[string]interface{}{"Ref": "NodeGroup"}},
+			}))
+
+			Expect(getNodeGroupProperties(ngTemplate).Tags).To(ContainElement(Tag{
+				Key:               "aws-node-termination-handler/managed",
+				Value:             "",
+				PropagateAtLaunch: "true",
+			}))
+		})
+	})
+
+	Context("NodeGroup{NodeTerminationHandler.Mode=queue}", func() {
+		cfg, ng := newClusterConfigAndNodegroup(true)
+
+		ng.NodeTerminationHandler = &api.NTHConfig{
+			Mode:                    api.NTHModeQueue,
+			QueueARN:                "arn:aws:sqs:us-west-2:123456789012:nth-queue",
+			EnableRebalanceDraining: aws.Bool(true),
+		}
+
+		build(cfg, "eksctl-test-nth-queue-shared", ng)
+
+		roundtrip()
+
+		It("should only add the lifecycle hook and the rule for the nodegroup events", func() {
+			Expect(ngTemplate.Resources).To(HaveKey("NodeTerminationHandlerLifecycleHook"))
+			Expect(ngTemplate.Resources).To(HaveKey("NodeTerminationHandlerASGRule"))
+			for _, rule := range []string{"Spot", "ScheduledChange", "Rebalance"} {
+				Expect(ngTemplate.Resources).NotTo(HaveKey("NodeTerminationHandler" + rule + "Rule"))
+			}
+		})
+	})
+
+	Context("NodeGroup{NodeTerminationHandler.Mode=imds}", func() {
+		cfg, ng := newClusterConfigAndNodegroup(true)
+
+		ng.NodeTerminationHandler = &api.NTHConfig{Mode: api.NTHModeIMDS}
+
+		build(cfg, "eksctl-test-nth-imds", ng)
+
+		roundtrip()
+
+		It("should not add any resources", func() {
+			Expect(ngTemplate.Resources).NotTo(HaveKey("NodeTerminationHandlerLifecycleHook"))
+			Expect(ngTemplate.Resources).NotTo(HaveKey("NodeTerminationHandlerASGRule"))
+		})
+	})
+
+	Context("NodeGroup{DisablePodIMDS=nil InstanceMetadataOptions=nil}", func() {
+		cfg, ng := newClusterConfigAndNodegroup(true)
+
+		build(cfg, "eksctl-test-imds-default", ng)
+
+		roundtrip()
+
+		It("should keep IMDSv1 and a hop limit of 2", func() {
+			metadataOptions := getLaunchTemplateData(ngTemplate).MetadataOptions
+			Expect(metadataOptions).NotTo(BeNil())
+			Expect(metadataOptions.HTTPTokens).To(Equal("optional"))
+			Expect(metadataOptions.HTTPPutResponseHopLimit).To(Equal(2))
+		})
+	})
+
+	Context("NodeGroup{InstanceMetadataOptions.HTTPTokens=required InstanceMetadataOptions.HTTPPutResponseHopLimit=1}", func() {
+		cfg, ng := newClusterConfigAndNodegroup(true)
+
+		ng.InstanceMetadataOptions = &api.InstanceMetadataOptions{
+			HTTPTokens:              aws.String(api.HTTPTokensRequired),
+			HTTPPutResponseHopLimit: aws.Int(1),
+		}
+
+		build(cfg, "eksctl-test-imds-options", ng)
+
+		roundtrip()
+
+		It("should configure the metadata options of the launch template", func() {
+			metadataOptions := getLaunchTemplateData(ngTemplate).MetadataOptions
+			Expect(metadataOptions).NotTo(BeNil())
+			Expect(metadataOptions.HTTPTokens).To(Equal("required"))
+			Expect(metadataOptions.HTTPPutResponseHopLimit).To(Equal(1))
+		})
+	})
+
 	Context("NodeGroup{CPUCredits=nil}", func() {
 		cfg, ng := newClusterConfigAndNodegroup(true)
 
@@ -2797,6 +3250,12 @@ var _ = Describe("CloudFormation template builder API", func() {
 				},
 			))
 		})
+
+		It("should allow all traffic within the EFA security group", func() {
+			Expect(ngTemplate.Resources).To(HaveKey("EFASG"))
+			Expect(ngTemplate.Resources).To(HaveKey("EFAIngressSelf"))
+			Expect(ngTemplate.Resources).To(HaveKey("EFAEgressSelf"))
+		})
 	})
 
 })
@@ -68,6 +68,12 @@ func (m *ManagedNodeGroupResourceSet) makeLaunchTemplateData() (*gfnec2.LaunchTe
 				GroupName: groupName,
 			}
 		}
+		setNetworkInterfaceOptions(launchTemplateData, mng.NodeGroupBase)
+	} else if mng.AssociatePublicIP != nil || mng.DeleteENIOnTermination != nil {
+		if err := buildNetworkInterfaces(launchTemplateData, mng.InstanceTypeList(), false, securityGroupIDs, m.ec2API); err != nil {
+			return nil, errors.Wrap(err, "couldn't build network interfaces for launch template data")
+		}
+		setNetworkInterfaceOptions(launchTemplateData, mng.NodeGroupBase)
 	} else {
 		launchTemplateData.SecurityGroupIds = gfnt.NewSlice(securityGroupIDs...)
 	}
@@ -110,9 +116,7 @@ func (m *ManagedNodeGroupResourceSet) makeLaunchTemplateData() (*gfnec2.LaunchTe
 	}
 
 	if mng.Placement != nil {
-		launchTemplateData.Placement = &gfnec2.LaunchTemplate_Placement{
-			GroupName: gfnt.NewString(mng.Placement.GroupName),
-		}
+		launchTemplateData.Placement = makePlacement(mng.Placement, m.newResource)
 	}
 
 	return launchTemplateData, nil
@@ -1,6 +1,7 @@
 package builder
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -150,10 +151,79 @@ func (m *ManagedNodeGroupResourceSet) AddAllResources() error {
 	}
 
 	managedResource.LaunchTemplate = launchTemplate
-	m.newResource(ManagedNodeGroupResourceName, managedResource)
+
+	// the properties goformation does not support yet
+	extraProperties := map[string]interface{}{}
+	if m.nodeGroup.UpdateConfig != nil {
+		extraProperties["UpdateConfig"] = UpdateConfigProperty(m.nodeGroup.UpdateConfig)
+	}
+	if m.nodeGroup.NodeRepairConfig != nil {
+		extraProperties["NodeRepairConfig"] = NodeRepairConfigProperty(m.nodeGroup.NodeRepairConfig)
+	}
+	if len(extraProperties) > 0 {
+		resource, err := withProperties(managedResource, extraProperties)
+		if err != nil {
+			return err
+		}
+		m.newResource(ManagedNodeGroupResourceName, resource)
+	} else {
+		m.newResource(ManagedNodeGroupResourceName, managedResource)
+	}
+
+	if m.nodeGroup.LogRetentionInDays != nil {
+		m.newResource(nodeGroupLogGroupResourceName, nodeGroupLogGroupResource(m.clusterConfig.Metadata.Name, m.nodeGroup.NodeGroupBase))
+	}
 	return nil
 }
 
+// ManagedNodeGroupLaunchTemplateVersionPath is the path to the launch template version of the nodegroup in
+// managed nodegroup templates
+const ManagedNodeGroupLaunchTemplateVersionPath = "Resources." + ManagedNodeGroupResourceName + ".Properties.LaunchTemplate.Version"
+
+// ManagedNodeGroupUpdateConfigPath is the path to the update config of the nodegroup in managed nodegroup templates
+const ManagedNodeGroupUpdateConfigPath = "Resources." + ManagedNodeGroupResourceName + ".Properties.UpdateConfig"
+
+// UpdateConfigProperty returns the UpdateConfig property of the AWS::EKS::Nodegroup resource for updateConfig
+func UpdateConfigProperty(updateConfig *api.NodeGroupUpdateConfig) map[string]interface{} {
+	property := map[string]interface{}{}
+	if updateConfig.MaxUnavailable != nil {
+		property["MaxUnavailable"] = *updateConfig.MaxUnavailable
+	}
+	if updateConfig.MaxUnavailablePercentage != nil {
+		property["MaxUnavailablePercentage"] = *updateConfig.MaxUnavailablePercentage
+	}
+	return property
+}
+
+// ManagedNodeGroupNodeRepairConfigPath is the path to the node repair config of the nodegroup in managed
+// nodegroup templates
+const ManagedNodeGroupNodeRepairConfigPath = "Resources." + ManagedNodeGroupResourceName + ".Properties.NodeRepairConfig"
+
+// NodeRepairConfigProperty returns the NodeRepairConfig property of the AWS::EKS::Nodegroup resource for
+// nodeRepairConfig
+func NodeRepairConfigProperty(nodeRepairConfig *api.NodeRepairConfig) map[string]interface{} {
+	return map[string]interface{}{
+		"Enabled": api.IsEnabled(nodeRepairConfig.Enabled),
+	}
+}
+
+// withProperties returns the nodegroup resource with the given properties set, for properties
+// goformation does not support yet
+func withProperties(managedResource *gfneks.Nodegroup, properties map[string]interface{}) (*awsCloudFormationResource, error) {
+	data, err := json.Marshal(managedResource)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshalling nodegroup resource")
+	}
+	resource := &awsCloudFormationResource{}
+	if err := json.Unmarshal(data, resource); err != nil {
+		return nil, errors.Wrap(err, "unmarshalling nodegroup resource")
+	}
+	for name, value := range properties {
+		resource.Properties[name] = value
+	}
+	return resource, nil
+}
+
 func selectManagedInstanceType(ng *api.ManagedNodeGroup) string {
 	if len(ng.InstanceTypes) > 0 {
 		for _, instanceType := range ng.InstanceTypes {
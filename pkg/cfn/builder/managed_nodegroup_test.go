@@ -1,9 +1,11 @@
 package builder
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/stretchr/testify/require"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
@@ -194,3 +196,37 @@ func subs(ss []string) []*gfnt.Value {
 	}
 	return subs
 }
+
+func TestManagedNodeGroupUnsupportedProperties(t *testing.T) {
+	require := require.New(t)
+	clusterConfig := api.NewClusterConfig()
+	ng := &api.ManagedNodeGroup{
+		NodeGroupBase: &api.NodeGroupBase{
+			Name:             "update-config",
+			NodeRepairConfig: &api.NodeRepairConfig{Enabled: api.Enabled()},
+		},
+		UpdateConfig: &api.NodeGroupUpdateConfig{
+			MaxUnavailablePercentage: aws.Int(25),
+		},
+	}
+	api.SetManagedNodeGroupDefaults(ng, clusterConfig.Metadata)
+	p := mockprovider.NewMockProvider()
+	stack := NewManagedNodeGroup(p.EC2(), clusterConfig, ng, nil, false, new(vpcfakes.FakeImporter))
+	require.NoError(stack.AddAllResources())
+
+	bytes, err := stack.RenderJSON()
+	require.NoError(err)
+
+	var template struct {
+		Resources map[string]struct {
+			Type       string
+			Properties map[string]interface{}
+		}
+	}
+	require.NoError(json.Unmarshal(bytes, &template))
+	ngResource := template.Resources[ManagedNodeGroupResourceName]
+	require.Equal("AWS::EKS::Nodegroup", ngResource.Type)
+	require.Equal(map[string]interface{}{"MaxUnavailablePercentage": float64(25)}, ngResource.Properties["UpdateConfig"])
+	require.Equal(map[string]interface{}{"Enabled": true}, ngResource.Properties["NodeRepairConfig"])
+	require.Equal("update-config", ngResource.Properties["NodegroupName"])
+}
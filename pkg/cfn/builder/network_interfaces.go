@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/pkg/errors"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	gfnec2 "github.com/weaveworks/goformation/v4/cloudformation/ec2"
 	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
 )
@@ -65,3 +66,18 @@ func buildNetworkInterfaces(
 	}
 	return nil
 }
+
+// setNetworkInterfaceOptions applies the nodegroup's public IP and delete-on-termination
+// settings to the launch template network interfaces, leaving unset options to the subnet defaults
+func setNetworkInterfaceOptions(launchTemplateData *gfnec2.LaunchTemplate_LaunchTemplateData, ng *api.NodeGroupBase) {
+	for i := range launchTemplateData.NetworkInterfaces {
+		ni := &launchTemplateData.NetworkInterfaces[i]
+		if ng.AssociatePublicIP != nil && i == 0 {
+			// a public IP can only be associated with the primary network interface
+			ni.AssociatePublicIpAddress = gfnt.NewBoolean(*ng.AssociatePublicIP)
+		}
+		if ng.DeleteENIOnTermination != nil {
+			ni.DeleteOnTermination = gfnt.NewBoolean(*ng.DeleteENIOnTermination)
+		}
+	}
+}
@@ -171,6 +171,12 @@ func (n *NodeGroupResourceSet) addResourcesForNodeGroup() error {
 		}
 	}
 
+	additionalVolumes, err := makeAdditionalVolumeMappings(n.spec)
+	if err != nil {
+		return err
+	}
+	launchTemplateData.BlockDeviceMappings = append(launchTemplateData.BlockDeviceMappings, additionalVolumes...)
+
 	n.newResource("NodeGroupLaunchTemplate", &gfnec2.LaunchTemplate{
 		LaunchTemplateName: launchTemplateName,
 		LaunchTemplateData: launchTemplateData,
@@ -208,12 +214,139 @@ func (n *NodeGroupResourceSet) addResourcesForNodeGroup() error {
 		)
 	}
 
+	nth := n.spec.NodeTerminationHandler
+	if nth != nil && nth.Mode == api.NTHModeQueue {
+		// the handler only acts on instances of Auto Scaling groups with this tag
+		tags = append(tags, map[string]interface{}{
+			"Key":               nthManagedTag,
+			"Value":             "",
+			"PropagateAtLaunch": "true",
+		})
+	}
+
 	asg := nodeGroupResource(launchTemplateName, vpcZoneIdentifier, tags, n.spec)
 	n.newResource("NodeGroup", asg)
 
+	for i, rule := range n.spec.ScheduledScaling {
+		n.newResource(fmt.Sprintf("NodeGroupScheduledAction%d", i), scheduledActionResource(rule))
+	}
+
+	if n.spec.LogRetentionInDays != nil {
+		n.newResource(nodeGroupLogGroupResourceName, nodeGroupLogGroupResource(n.clusterSpec.Metadata.Name, n.spec.NodeGroupBase))
+	}
+
+	if nth != nil && nth.Mode == api.NTHModeQueue {
+		n.addResourcesForNodeTerminationHandler(nth)
+	}
+
 	return nil
 }
 
+const nodeGroupLogGroupResourceName = "NodeGroupLogGroup"
+
+// nodeGroupLogGroupResource returns the CloudWatch log group for the logs of the nodes of the nodegroup
+func nodeGroupLogGroupResource(clusterName string, ng *api.NodeGroupBase) *awsCloudFormationResource {
+	return &awsCloudFormationResource{
+		Type: "AWS::Logs::LogGroup",
+		Properties: map[string]interface{}{
+			"LogGroupName":    fmt.Sprintf("/aws/eks/%s/nodegroup/%s", clusterName, ng.Name),
+			"RetentionInDays": *ng.LogRetentionInDays,
+		},
+	}
+}
+
+const nthManagedTag = "aws-node-termination-handler/managed"
+
+// addResourcesForNodeTerminationHandler adds the lifecycle hook and EventBridge rules sending
+// the events handled by the AWS Node Termination Handler to its queue. The queue policy is
+// left to the user, as a queue policy resource would replace the existing one
+func (n *NodeGroupResourceSet) addResourcesForNodeTerminationHandler(nth *api.NTHConfig) {
+	n.newResource("NodeTerminationHandlerLifecycleHook", &awsCloudFormationResource{
+		Type: "AWS::AutoScaling::LifecycleHook",
+		Properties: map[string]interface{}{
+			"AutoScalingGroupName": gfnt.MakeRef("NodeGroup"),
+			"LifecycleTransition":  "autoscaling:EC2_INSTANCE_TERMINATING",
+			"DefaultResult":        "CONTINUE",
+			"HeartbeatTimeout":     "300",
+		},
+	})
+
+	n.newResource("NodeTerminationHandlerASGRule", nthEventRule(nth, map[string]interface{}{
+		"source":      []string{"aws.autoscaling"},
+		"detail-type": []string{"EC2 Instance-terminate Lifecycle Action"},
+		"detail": map[string]interface{}{
+			"AutoScalingGroupName": []interface{}{gfnt.MakeRef("NodeGroup")},
+		},
+	}))
+
+	// the spot interruption, scheduled change and rebalance events are not specific
+	// to the nodegroup, so their rules are only created when the nodegroup opts in
+	if !api.IsEnabled(nth.CreateAccountEventRules) {
+		return
+	}
+	if !api.IsDisabled(nth.EnableSpotInterruptionDraining) {
+		n.newResource("NodeTerminationHandlerSpotRule", nthEventRule(nth, map[string]interface{}{
+			"source":      []string{"aws.ec2"},
+			"detail-type": []string{"EC2 Spot Instance Interruption Warning"},
+		}))
+	}
+	if !api.IsDisabled(nth.EnableScheduledEventDraining) {
+		n.newResource("NodeTerminationHandlerScheduledChangeRule", nthEventRule(nth, map[string]interface{}{
+			"source":      []string{"aws.health"},
+			"detail-type": []string{"AWS Health Event"},
+			"detail": map[string]interface{}{
+				"service":           []string{"EC2"},
+				"eventTypeCategory": []string{"scheduledChange"},
+			},
+		}))
+	}
+	if api.IsEnabled(nth.EnableRebalanceDraining) {
+		n.newResource("NodeTerminationHandlerRebalanceRule", nthEventRule(nth, map[string]interface{}{
+			"source":      []string{"aws.ec2"},
+			"detail-type": []string{"EC2 Instance Rebalance Recommendation"},
+		}))
+	}
+}
+
+func nthEventRule(nth *api.NTHConfig, eventPattern map[string]interface{}) *awsCloudFormationResource {
+	return &awsCloudFormationResource{
+		Type: "AWS::Events::Rule",
+		Properties: map[string]interface{}{
+			"EventPattern": eventPattern,
+			"Targets": []map[string]interface{}{
+				{
+					"Id":  "NodeTerminationHandlerQueue",
+					"Arn": nth.QueueARN,
+				},
+			},
+		},
+	}
+}
+
+func scheduledActionResource(rule api.ScheduledScalingRule) *awsCloudFormationResource {
+	props := map[string]interface{}{
+		"AutoScalingGroupName": gfnt.MakeRef("NodeGroup"),
+		"Recurrence":           rule.Schedule,
+	}
+	if rule.DesiredCapacity != nil {
+		props["DesiredCapacity"] = fmt.Sprintf("%d", *rule.DesiredCapacity)
+	}
+	if rule.MinSize != nil {
+		props["MinSize"] = fmt.Sprintf("%d", *rule.MinSize)
+	}
+	if rule.MaxSize != nil {
+		props["MaxSize"] = fmt.Sprintf("%d", *rule.MaxSize)
+	}
+	if rule.TimeZone != "" {
+		props["TimeZone"] = rule.TimeZone
+	}
+
+	return &awsCloudFormationResource{
+		Type:       "AWS::AutoScaling::ScheduledAction",
+		Properties: props,
+	}
+}
+
 // generateNodeName formulates the name based on the configuration in input
 func generateNodeName(ng *api.NodeGroupBase, meta *api.ClusterMeta) string {
 	var nameParts []string
@@ -282,6 +415,7 @@ func newLaunchTemplateData(n *NodeGroupResourceSet) (*gfnec2.LaunchTemplate_Laun
 	if err := buildNetworkInterfaces(launchTemplateData, n.spec.InstanceTypeList(), api.IsEnabled(n.spec.EFAEnabled), n.securityGroups, n.ec2API); err != nil {
 		return nil, errors.Wrap(err, "couldn't build network interfaces for launch template data")
 	}
+	setNetworkInterfaceOptions(launchTemplateData, n.spec.NodeGroupBase)
 
 	if api.IsEnabled(n.spec.EFAEnabled) && n.spec.Placement == nil {
 		groupName := n.newResource("NodeGroupPlacementGroup", &gfnec2.PlacementGroup{
@@ -308,14 +442,92 @@ func newLaunchTemplateData(n *NodeGroupResourceSet) (*gfnec2.LaunchTemplate_Laun
 	}
 
 	if n.spec.Placement != nil {
-		launchTemplateData.Placement = &gfnec2.LaunchTemplate_Placement{
-			GroupName: gfnt.NewString(n.spec.Placement.GroupName),
+		launchTemplateData.Placement = makePlacement(n.spec.Placement, n.newResource)
+	}
+
+	if n.spec.CapacityReservation != nil {
+		launchTemplateData.CapacityReservationSpecification = makeCapacityReservationSpecification(n.spec.CapacityReservation)
+	}
+
+	if n.spec.CapacityBlockID != "" {
+		launchTemplateData.CapacityReservationSpecification = makeCapacityReservationSpecification(&api.CapacityReservation{
+			ID: n.spec.CapacityBlockID,
+		})
+		launchTemplateData.InstanceMarketOptions = &gfnec2.LaunchTemplate_InstanceMarketOptions{
+			MarketType: gfnt.NewString(api.MarketTypeCapacityBlock),
 		}
 	}
 
 	return launchTemplateData, nil
 }
 
+// makeAdditionalVolumeMappings returns the block device mappings of the additional volumes of ng. The device
+// names are checked against the root device again as it is only known once the AMI is resolved
+func makeAdditionalVolumeMappings(ng *api.NodeGroup) ([]gfnec2.LaunchTemplate_BlockDeviceMapping, error) {
+	var mappings []gfnec2.LaunchTemplate_BlockDeviceMapping
+	for i, volume := range ng.AdditionalVolumes {
+		if (ng.VolumeName != nil && volume.DeviceName == *ng.VolumeName) || volume.DeviceName == ng.AdditionalEncryptedVolume {
+			return nil, fmt.Errorf("additionalVolumes[%d].deviceName %q of nodegroup %q is used by the root volume of its AMI", i, volume.DeviceName, ng.Name)
+		}
+
+		volumeType := api.DefaultNodeVolumeType
+		if volume.VolumeType != nil {
+			volumeType = *volume.VolumeType
+		}
+		ebs := &gfnec2.LaunchTemplate_Ebs{
+			VolumeSize: gfnt.NewInteger(*volume.VolumeSize),
+			VolumeType: gfnt.NewString(volumeType),
+		}
+		if volume.VolumeEncrypted != nil {
+			ebs.Encrypted = gfnt.NewBoolean(*volume.VolumeEncrypted)
+		}
+		if api.IsSetAndNonEmptyString(volume.VolumeKmsKeyID) {
+			ebs.KmsKeyId = gfnt.NewString(*volume.VolumeKmsKeyID)
+		}
+		if volume.VolumeIOPS != nil {
+			ebs.Iops = gfnt.NewInteger(*volume.VolumeIOPS)
+		}
+		if volume.VolumeThroughput != nil {
+			ebs.Throughput = gfnt.NewInteger(*volume.VolumeThroughput)
+		}
+		mappings = append(mappings, gfnec2.LaunchTemplate_BlockDeviceMapping{
+			DeviceName: gfnt.NewString(volume.DeviceName),
+			Ebs:        ebs,
+		})
+	}
+	return mappings, nil
+}
+
+// makeCapacityReservationSpecification targets the capacity reservation of cr by its ID or, when cr sets a
+// preference, leaves the choice of reservation to EC2
+func makeCapacityReservationSpecification(cr *api.CapacityReservation) *gfnec2.LaunchTemplate_CapacityReservationSpecification {
+	if cr.ID != "" {
+		return &gfnec2.LaunchTemplate_CapacityReservationSpecification{
+			CapacityReservationTarget: &gfnec2.LaunchTemplate_CapacityReservationTarget{
+				CapacityReservationId: gfnt.NewString(cr.ID),
+			},
+		}
+	}
+	return &gfnec2.LaunchTemplate_CapacityReservationSpecification{
+		CapacityReservationPreference: gfnt.NewString(cr.Preference),
+	}
+}
+
+// makePlacement returns the placement of the nodes in the existing placement group of placement or, when
+// placement sets a strategy, in a placement group created with that strategy
+func makePlacement(placement *api.Placement, newResource func(string, gfn.Resource) *gfnt.Value) *gfnec2.LaunchTemplate_Placement {
+	if placement.Strategy != "" {
+		return &gfnec2.LaunchTemplate_Placement{
+			GroupName: newResource("NodeGroupPlacementGroup", &gfnec2.PlacementGroup{
+				Strategy: gfnt.NewString(placement.Strategy),
+			}),
+		}
+	}
+	return &gfnec2.LaunchTemplate_Placement{
+		GroupName: gfnt.NewString(placement.GroupName),
+	}
+}
+
 func makeMetadataOptions(ng *api.NodeGroupBase) *gfnec2.LaunchTemplate_MetadataOptions {
 	imdsv2TokensRequired := "optional"
 	if api.IsEnabled(ng.DisableIMDSv1) || api.IsEnabled(ng.DisablePodIMDS) {
@@ -325,6 +537,14 @@ func makeMetadataOptions(ng *api.NodeGroupBase) *gfnec2.LaunchTemplate_MetadataO
 	if api.IsEnabled(ng.DisablePodIMDS) {
 		hopLimit = 1
 	}
+	if opts := ng.InstanceMetadataOptions; opts != nil {
+		if opts.HTTPTokens != nil {
+			imdsv2TokensRequired = *opts.HTTPTokens
+		}
+		if opts.HTTPPutResponseHopLimit != nil {
+			hopLimit = *opts.HTTPPutResponseHopLimit
+		}
+	}
 	return &gfnec2.LaunchTemplate_MetadataOptions{
 		HttpPutResponseHopLimit: gfnt.NewInteger(hopLimit),
 		HttpTokens:              gfnt.NewString(imdsv2TokensRequired),
@@ -359,6 +579,15 @@ func nodeGroupResource(launchTemplateName *gfnt.Value, vpcZoneIdentifier interfa
 	if len(ng.TargetGroupARNs) > 0 {
 		ngProps["TargetGroupARNs"] = ng.TargetGroupARNs
 	}
+	if ng.ScaleInProtection != nil {
+		ngProps["NewInstancesProtectedFromScaleIn"] = *ng.ScaleInProtection
+	}
+	if ng.DefaultCooldownSeconds != nil {
+		ngProps["Cooldown"] = fmt.Sprintf("%d", *ng.DefaultCooldownSeconds)
+	}
+	if ng.MaxInstanceLifetime != nil {
+		ngProps["MaxInstanceLifetime"] = int64(ng.MaxInstanceLifetime.Duration.Seconds())
+	}
 	if api.HasMixedInstances(ng) {
 		ngProps["MixedInstancesPolicy"] = *mixedInstancesPolicy(launchTemplateName, ng)
 	} else {
@@ -390,6 +619,9 @@ func mixedInstancesPolicy(launchTemplateName *gfnt.Value, ng *api.NodeGroup) *ma
 		overrides[i] = map[string]string{
 			"InstanceType": instanceType,
 		}
+		if weight, ok := ng.InstancesDistribution.WeightedCapacity[instanceType]; ok {
+			overrides[i]["WeightedCapacity"] = fmt.Sprintf("%d", weight)
+		}
 	}
 	policy := map[string]interface{}{
 		"LaunchTemplate": map[string]interface{}{
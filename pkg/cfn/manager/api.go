@@ -3,16 +3,22 @@ package manager
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"regexp"
+	"sort"
 	"time"
 
 	"github.com/aws/aws-sdk-go/service/cloudtrail/cloudtrailiface"
 	"github.com/weaveworks/eksctl/pkg/cfn/waiter"
 
+	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
 	"github.com/aws/aws-sdk-go/service/cloudformation/cloudformationiface"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/eks/eksiface"
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
 
 	"github.com/weaveworks/eksctl/pkg/version"
 
@@ -24,6 +30,7 @@ import (
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/utils/retry"
 )
 
 const (
@@ -32,6 +39,9 @@ const (
 	mappingsRootPath  = "Mappings"
 	ourStackRegexFmt  = "^(eksctl|EKS)-%s-((cluster|nodegroup-.+|addon-.+|fargate)|(VPC|ServiceRole|ControlPlane|DefaultNodeGroup))$"
 	clusterStackRegex = "eksctl-.*-cluster"
+
+	// defaultReadMaxRetries is how many times failed CloudFormation read requests are retried by default
+	defaultReadMaxRetries = 5
 )
 
 var (
@@ -67,19 +77,42 @@ func (u TemplateURL) isTemplateData() {}
 // ChangeSet represents a CloudFormation ChangeSet
 type ChangeSet = cloudformation.DescribeChangeSetOutput
 
+// StackChange is a change a stack update would make to one of the stack's resources
+type StackChange struct {
+	LogicalResourceID string
+	ResourceType      string
+	// Action is one of Add, Modify or Remove
+	Action string
+	// Replacement is true when the change replaces the resource, or may replace it depending on values
+	// that are only known during the update
+	Replacement bool
+}
+
 // StackCollection stores the CloudFormation stack information
 type StackCollection struct {
 	cloudformationAPI cloudformationiface.CloudFormationAPI
 	ec2API            ec2iface.EC2API
+	asgAPI            autoscalingiface.AutoScalingAPI
 	eksAPI            eksiface.EKSAPI
 	iamAPI            iamiface.IAMAPI
 	cloudTrailAPI     cloudtrailiface.CloudTrailAPI
+	ssmAPI            ssmiface.SSMAPI
 	spec              *api.ClusterConfig
 	disableRollback   bool
 	roleARN           string
 	region            string
 	waitTimeout       time.Duration
 	sharedTags        []*cloudformation.Tag
+	// cloudformationReadAPI is used for the read requests retried by withReadRetries, and does not retry
+	// requests itself
+	cloudformationReadAPI cloudformationiface.CloudFormationAPI
+	// readRetryPolicy is how CloudFormation read requests are retried when they are throttled or fail with a
+	// retryable error
+	readRetryPolicy retry.Policy
+
+	// TemplateDir is the directory the templates submitted to CloudFormation are saved to, as
+	// <stack name>-<timestamp>.json, before stacks are created or updated. Templates are not saved when empty
+	TemplateDir string
 }
 
 func newTag(key, value string) *cloudformation.Tag {
@@ -97,30 +130,91 @@ func NewStackCollection(provider api.ClusterProvider, spec *api.ClusterConfig) *
 		tags = append(tags, newTag(key, value))
 	}
 	return &StackCollection{
-		spec:              spec,
-		sharedTags:        tags,
-		cloudformationAPI: provider.CloudFormation(),
-		ec2API:            provider.EC2(),
-		eksAPI:            provider.EKS(),
-		iamAPI:            provider.IAM(),
-		cloudTrailAPI:     provider.CloudTrail(),
-		disableRollback:   provider.CloudFormationDisableRollback(),
-		roleARN:           provider.CloudFormationRoleARN(),
-		region:            provider.Region(),
-		waitTimeout:       provider.WaitTimeout(),
+		spec:                  spec,
+		sharedTags:            tags,
+		cloudformationAPI:     provider.CloudFormation(),
+		cloudformationReadAPI: withoutRetries(provider.CloudFormation()),
+		ec2API:                provider.EC2(),
+		asgAPI:                provider.ASG(),
+		eksAPI:                provider.EKS(),
+		iamAPI:                provider.IAM(),
+		cloudTrailAPI:         provider.CloudTrail(),
+		ssmAPI:                provider.SSM(),
+		disableRollback:       provider.CloudFormationDisableRollback(),
+		roleARN:               provider.CloudFormationRoleARN(),
+		region:                provider.Region(),
+		waitTimeout:           provider.WaitTimeout(),
+		readRetryPolicy: &retry.JitteredExponentialBackoff{
+			MaxRetries: defaultReadMaxRetries,
+			TimeUnit:   time.Second,
+		},
+	}
+}
+
+// isEksctlStackTag returns true for the keys of the tags eksctl sets on stacks, which users cannot set
+func isEksctlStackTag(key string) bool {
+	switch key {
+	case api.ClusterNameTag, api.OldClusterNameTag, api.EksctlVersionTag,
+		api.NodeGroupNameTag, api.OldNodeGroupNameTag, api.OldNodeGroupIDTag, api.NodeGroupTypeTag:
+		return true
+	}
+	return false
+}
+
+// makeStackTags returns the shared tags of the cluster merged with the tags eksctl sets on the stack and the
+// user tags of the stack. The tags reserved by eksctl take precedence, and a warning is logged for each user tag
+// they override; the other shared tags, set from the cluster metadata, are overridden by the user tags
+func (c *StackCollection) makeStackTags(stackName string, tags, eksctlTags map[string]string) []*cloudformation.Tag {
+	var stackTags []*cloudformation.Tag
+	reserved := map[string]string{}
+	for _, tag := range c.sharedTags {
+		if isEksctlStackTag(*tag.Key) {
+			reserved[*tag.Key] = *tag.Value
+		} else if _, ok := tags[*tag.Key]; ok {
+			continue
+		}
+		stackTags = append(stackTags, tag)
+	}
+
+	for _, key := range sortedTagKeys(eksctlTags) {
+		reserved[key] = eksctlTags[key]
+		stackTags = append(stackTags, newTag(key, eksctlTags[key]))
+	}
+
+	for _, key := range sortedTagKeys(tags) {
+		if isEksctlStackTag(key) {
+			if value, ok := reserved[key]; !ok || value != tags[key] {
+				logger.Warning("ignoring tag %q of stack %q as it is reserved by eksctl", key, stackName)
+			}
+			continue
+		}
+		stackTags = append(stackTags, newTag(key, tags[key]))
 	}
+	return stackTags
+}
+
+func sortedTagKeys(tags map[string]string) []string {
+	keys := make([]string, 0, len(tags))
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
 }
 
 // DoCreateStackRequest requests the creation of a CloudFormation stack
 func (c *StackCollection) DoCreateStackRequest(i *Stack, templateData TemplateData, tags, parameters map[string]string, withIAM bool, withNamedIAM bool) error {
+	return c.doCreateStackRequest(i, templateData, tags, nil, parameters, withIAM, withNamedIAM)
+}
+
+// doCreateStackRequest requests the creation of a CloudFormation stack, tagged with the given user tags and
+// the tags eksctl sets on the stack
+func (c *StackCollection) doCreateStackRequest(i *Stack, templateData TemplateData, tags, eksctlTags, parameters map[string]string, withIAM bool, withNamedIAM bool) error {
 	input := &cloudformation.CreateStackInput{
 		StackName:       i.StackName,
 		DisableRollback: aws.Bool(c.disableRollback),
 	}
-	input.Tags = append(input.Tags, c.sharedTags...)
-	for k, v := range tags {
-		input.Tags = append(input.Tags, newTag(k, v))
-	}
+	input.Tags = c.makeStackTags(*i.StackName, tags, eksctlTags)
 
 	switch data := templateData.(type) {
 	case TemplateBody:
@@ -151,6 +245,8 @@ func (c *StackCollection) DoCreateStackRequest(i *Stack, templateData TemplateDa
 		input.Parameters = append(input.Parameters, p)
 	}
 
+	c.saveTemplate(*i.StackName, templateData)
+
 	logger.Debug("CreateStackInput = %#v", input)
 	s, err := c.cloudformationAPI.CreateStack(input)
 	if err != nil {
@@ -160,12 +256,31 @@ func (c *StackCollection) DoCreateStackRequest(i *Stack, templateData TemplateDa
 	return nil
 }
 
+// saveTemplate saves the template body submitted for the stack to TemplateDir. Failing to save it is not an
+// error, so that debugging aids cannot break stack operations
+func (c *StackCollection) saveTemplate(stackName string, templateData TemplateData) {
+	templateBody, ok := templateData.(TemplateBody)
+	if !ok || c.TemplateDir == "" {
+		return
+	}
+	path := filepath.Join(c.TemplateDir, fmt.Sprintf("%s-%s.json", stackName, time.Now().UTC().Format("20060102T150405.000Z")))
+	if err := os.MkdirAll(c.TemplateDir, 0755); err != nil {
+		logger.Warning("unable to save template of stack %q: %v", stackName, err)
+		return
+	}
+	if err := ioutil.WriteFile(path, templateBody, 0644); err != nil {
+		logger.Warning("unable to save template of stack %q: %v", stackName, err)
+		return
+	}
+	logger.Debug("saved template of stack %q to %s", stackName, path)
+}
+
 // CreateStack with given name, stack builder instance and parameters;
 // any errors will be written to errs channel, when nil is written,
 // assume completion, do not expect more then one error value on the
 // channel, it's closed immediately after it is written to
 func (c *StackCollection) CreateStack(stackName string, resourceSet builder.ResourceSet, tags, parameters map[string]string, errs chan error) error {
-	stack, err := c.createStackRequest(stackName, resourceSet, tags, parameters)
+	stack, err := c.createStackRequest(stackName, resourceSet, tags, nil, parameters)
 	if err != nil {
 		return err
 	}
@@ -177,7 +292,7 @@ func (c *StackCollection) CreateStack(stackName string, resourceSet builder.Reso
 // createClusterStack creates the cluster stack
 func (c *StackCollection) createClusterStack(stackName string, resourceSet builder.ResourceSet, errCh chan error) error {
 	// Unlike with `createNodeGroupTask`, all tags are already set for the cluster stack
-	stack, err := c.createStackRequest(stackName, resourceSet, nil, nil)
+	stack, err := c.createStackRequest(stackName, resourceSet, nil, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -223,14 +338,14 @@ func (c *StackCollection) createClusterStack(stackName string, resourceSet build
 	return nil
 }
 
-func (c *StackCollection) createStackRequest(stackName string, resourceSet builder.ResourceSet, tags, parameters map[string]string) (*Stack, error) {
+func (c *StackCollection) createStackRequest(stackName string, resourceSet builder.ResourceSet, tags, eksctlTags, parameters map[string]string) (*Stack, error) {
 	stack := &Stack{StackName: &stackName}
 	templateBody, err := resourceSet.RenderJSON()
 	if err != nil {
 		return nil, errors.Wrapf(err, "rendering template for %q stack", *stack.StackName)
 	}
 
-	if err := c.DoCreateStackRequest(stack, TemplateBody(templateBody), tags, parameters, resourceSet.WithIAM(), resourceSet.WithNamedIAM()); err != nil {
+	if err := c.doCreateStackRequest(stack, TemplateBody(templateBody), tags, eksctlTags, parameters, resourceSet.WithIAM(), resourceSet.WithNamedIAM()); err != nil {
 		return nil, err
 	}
 
@@ -240,33 +355,78 @@ func (c *StackCollection) createStackRequest(stackName string, resourceSet build
 
 // UpdateStack will update a CloudFormation stack by creating and executing a ChangeSet
 func (c *StackCollection) UpdateStack(stackName, changeSetName, description string, templateData TemplateData, parameters map[string]string) error {
+	_, err := c.updateStack(stackName, changeSetName, description, templateData, parameters, false)
+	return err
+}
+
+// updateStack creates a ChangeSet for the stack and executes it. In dry-run mode the ChangeSet is deleted
+// instead of being executed, and the changes it would make are returned
+func (c *StackCollection) updateStack(stackName, changeSetName, description string, templateData TemplateData, parameters map[string]string, dryRun bool) ([]StackChange, error) {
+	return c.updateStackWithCheck(stackName, changeSetName, description, templateData, parameters, dryRun, nil)
+}
+
+// updateStackWithCheck is like updateStack, but the changes of the ChangeSet are passed to check, when set,
+// before it is executed. The ChangeSet is deleted without being executed when check returns an error
+func (c *StackCollection) updateStackWithCheck(stackName, changeSetName, description string, templateData TemplateData, parameters map[string]string, dryRun bool, check func([]StackChange) error) ([]StackChange, error) {
 	logger.Info(description)
 	i := &Stack{StackName: &stackName}
 	// Read existing tags
 	s, err := c.DescribeStack(i)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	i.SetTags(s.Tags)
 	if err := c.doCreateChangeSetRequest(i, changeSetName, description, templateData, parameters, true); err != nil {
-		return err
+		return nil, err
+	}
+	if dryRun {
+		defer c.doDeleteChangeSet(stackName, changeSetName)
 	}
 	if err := c.doWaitUntilChangeSetIsCreated(i, changeSetName); err != nil {
 		if _, ok := err.(*noChangeError); ok {
-			return nil
+			return nil, nil
 		}
-		return err
+		return nil, err
 	}
 	changeSet, err := c.DescribeStackChangeSet(i, changeSetName)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	logger.Debug("changes = %#v", changeSet.Changes)
+	changes := makeStackChanges(changeSet)
+	if check != nil {
+		if err := check(changes); err != nil {
+			if !dryRun {
+				c.doDeleteChangeSet(stackName, changeSetName)
+			}
+			return nil, err
+		}
+	}
+	if dryRun {
+		return changes, nil
+	}
 	if err := c.doExecuteChangeSet(stackName, changeSetName); err != nil {
 		logger.Warning("error executing Cloudformation changeSet %s in stack %s. Check the Cloudformation console for further details", changeSetName, stackName)
-		return err
+		return nil, err
 	}
-	return c.doWaitUntilStackIsUpdated(i)
+	return nil, c.doWaitUntilStackIsUpdated(i)
+}
+
+func makeStackChanges(changeSet *ChangeSet) []StackChange {
+	var changes []StackChange
+	for _, change := range changeSet.Changes {
+		if change.ResourceChange == nil {
+			continue
+		}
+		replacement := aws.StringValue(change.ResourceChange.Replacement)
+		changes = append(changes, StackChange{
+			LogicalResourceID: aws.StringValue(change.ResourceChange.LogicalResourceId),
+			ResourceType:      aws.StringValue(change.ResourceChange.ResourceType),
+			Action:            aws.StringValue(change.ResourceChange.Action),
+			Replacement:       replacement == cloudformation.ReplacementTrue || replacement == cloudformation.ReplacementConditional,
+		})
+	}
+	return changes
 }
 
 // DescribeStack describes a cloudformation stack.
@@ -277,7 +437,11 @@ func (c *StackCollection) DescribeStack(i *Stack) (*Stack, error) {
 	if api.IsSetAndNonEmptyString(i.StackId) {
 		input.StackName = i.StackId
 	}
-	resp, err := c.cloudformationAPI.DescribeStacks(input)
+	var resp *cloudformation.DescribeStacksOutput
+	err := c.withReadRetries(func() (err error) {
+		resp, err = c.cloudformationReadAPI.DescribeStacks(input)
+		return err
+	})
 	if err != nil {
 		return nil, errors.Wrapf(err, "describing CloudFormation stack %q", *i.StackName)
 	}
@@ -312,36 +476,84 @@ func (c *StackCollection) UpdateNodeGroupStack(nodeGroupName, template string) e
 
 // ListStacksMatching gets all of CloudFormation stacks with names matching nameRegex.
 func (c *StackCollection) ListStacksMatching(nameRegex string, statusFilters ...string) ([]*Stack, error) {
+	re, err := regexp.Compile(nameRegex)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot list stacks")
+	}
+	matchName := func(s *cloudformation.StackSummary) bool {
+		return re.MatchString(*s.StackName)
+	}
+	return c.listStacks(statusFilters, matchName, nil)
+}
+
+// ListStacksMatchingTags gets all of CloudFormation stacks carrying all the given tags. As the tags of
+// a stack are only returned when describing it, every stack of the account is described
+func (c *StackCollection) ListStacksMatchingTags(tags map[string]string) ([]*Stack, error) {
+	matchTags := func(s *Stack) bool {
+		for key, value := range tags {
+			found := false
+			for _, tag := range s.Tags {
+				if aws.StringValue(tag.Key) == key && aws.StringValue(tag.Value) == value {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	}
+	return c.listStacks(nil, nil, matchTags)
+}
+
+// listStacks pages through the stacks with the given statuses, or all non-deleted stacks, and describes
+// those whose summary matches matchSummary, keeping the ones matching matchStack. A nil matcher matches
+// all stacks. The stacks of all pages are accumulated, and a stack listed on more than one page, as happens
+// when stacks are created or deleted while paging, is only described once
+func (c *StackCollection) listStacks(statusFilters []string, matchSummary func(*cloudformation.StackSummary) bool, matchStack func(*Stack) bool) ([]*Stack, error) {
 	var (
 		subErr error
 		stack  *Stack
+		seen   map[string]bool
 	)
 
-	re, err := regexp.Compile(nameRegex)
-	if err != nil {
-		return nil, errors.Wrap(err, "cannot list stacks")
-	}
 	input := &cloudformation.ListStacksInput{
 		StackStatusFilter: defaultStackStatusFilter(),
 	}
 	if len(statusFilters) > 0 {
 		input.StackStatusFilter = aws.StringSlice(statusFilters)
 	}
-	stacks := []*Stack{}
+	var stacks []*Stack
 
 	pager := func(p *cloudformation.ListStacksOutput, _ bool) bool {
 		for _, s := range p.StackSummaries {
-			if re.MatchString(*s.StackName) {
-				stack, subErr = c.DescribeStack(&Stack{StackName: s.StackName, StackId: s.StackId})
-				if subErr != nil {
-					return false
-				}
+			if matchSummary != nil && !matchSummary(s) {
+				continue
+			}
+			id := aws.StringValue(s.StackId)
+			if id == "" {
+				id = aws.StringValue(s.StackName)
+			}
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+			stack, subErr = c.DescribeStack(&Stack{StackName: s.StackName, StackId: s.StackId})
+			if subErr != nil {
+				return false
+			}
+			if matchStack == nil || matchStack(stack) {
 				stacks = append(stacks, stack)
 			}
 		}
 		return true
 	}
-	if err := c.cloudformationAPI.ListStacksPages(input, pager); err != nil {
+	if err := c.withReadRetries(func() error {
+		// a retry pages through all stacks again
+		stacks, seen, subErr = []*Stack{}, map[string]bool{}, nil
+		return c.cloudformationReadAPI.ListStacksPages(input, pager)
+	}); err != nil {
 		return nil, err
 	}
 	if subErr != nil {
@@ -352,7 +564,7 @@ func (c *StackCollection) ListStacksMatching(nameRegex string, statusFilters ...
 
 // ListStackNamesMatching gets all stack names matching regex
 func (c *StackCollection) ListClusterStackNames() ([]string, error) {
-	stacks := []string{}
+	var stacks []string
 	re, err := regexp.Compile(clusterStackRegex)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot list stacks")
@@ -369,7 +581,10 @@ func (c *StackCollection) ListClusterStackNames() ([]string, error) {
 		}
 		return true
 	}
-	if err := c.cloudformationAPI.ListStacksPages(input, pager); err != nil {
+	if err := c.withReadRetries(func() error {
+		stacks = []string{}
+		return c.cloudformationReadAPI.ListStacksPages(input, pager)
+	}); err != nil {
 		return nil, err
 	}
 
@@ -588,13 +803,16 @@ func (c *StackCollection) DescribeStackEvents(i *Stack) ([]*cloudformation.Stack
 		input.StackName = i.StackId
 	}
 
-	events := []*cloudformation.StackEvent{}
+	var events []*cloudformation.StackEvent
 
 	pager := func(p *cloudformation.DescribeStackEventsOutput, _ bool) bool {
 		events = append(events, p.StackEvents...)
 		return true
 	}
-	if err := c.cloudformationAPI.DescribeStackEventsPages(input, pager); err != nil {
+	if err := c.withReadRetries(func() error {
+		events = []*cloudformation.StackEvent{}
+		return c.cloudformationReadAPI.DescribeStackEventsPages(input, pager)
+	}); err != nil {
 		return nil, errors.Wrapf(err, "describing CloudFormation stack %q events", *i.StackName)
 	}
 
@@ -642,6 +860,7 @@ func (c *StackCollection) doCreateChangeSetRequest(i *Stack, changeSetName strin
 	default:
 		return fmt.Errorf("unknown template data type: %T", templateData)
 	}
+	c.saveTemplate(*i.StackName, templateData)
 
 	if withIAM {
 		input.SetCapabilities(stackCapabilitiesIAM)
@@ -682,6 +901,21 @@ func (c *StackCollection) doExecuteChangeSet(stackName string, changeSetName str
 	return nil
 }
 
+// doDeleteChangeSet deletes a ChangeSet that was only created to inspect the changes of an update. Failures
+// are only logged, as the ChangeSet does not affect the stack
+func (c *StackCollection) doDeleteChangeSet(stackName string, changeSetName string) {
+	input := &cloudformation.DeleteChangeSetInput{
+		ChangeSetName: &changeSetName,
+		StackName:     &stackName,
+	}
+
+	logger.Debug("deleting changeSet, input = %#v", input)
+
+	if _, err := c.cloudformationAPI.DeleteChangeSet(input); err != nil {
+		logger.Warning("failed to delete CloudFormation ChangeSet %q for stack %q: %v", changeSetName, stackName, err)
+	}
+}
+
 // DescribeStackChangeSet describes a ChangeSet by name
 func (c *StackCollection) DescribeStackChangeSet(i *Stack, changeSetName string) (*ChangeSet, error) {
 	input := &cloudformation.DescribeChangeSetInput{
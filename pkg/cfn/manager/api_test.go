@@ -1,8 +1,16 @@
 package manager
 
 import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/awstesting"
 	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
 	. "github.com/onsi/ginkgo"
@@ -11,6 +19,7 @@ import (
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+	"github.com/weaveworks/eksctl/pkg/utils/retry"
 )
 
 var _ = Describe("StackCollection", func() {
@@ -118,4 +127,221 @@ var _ = Describe("StackCollection", func() {
 		// Metadata tag
 		Expect(createChangeSetInput.Tags).To(ContainElement(&cfn.Tag{Key: aws.String("meta"), Value: aws.String("data")}))
 	})
+
+	Context("DoCreateStackRequest tags", func() {
+		It("merges the stack tags with the cluster tags, keeping the tags eksctl sets on every stack", func() {
+			p := mockprovider.NewMockProvider()
+			p.MockCloudFormation().On("CreateStack", mock.Anything).Return(&cfn.CreateStackOutput{StackId: aws.String("stack-id")}, nil)
+
+			spec := api.NewClusterConfig()
+			spec.Metadata.Name = "test-cluster"
+			spec.Metadata.Tags = map[string]string{"cost-center": "1234", "team": "platform"}
+			sc := NewStackCollection(p, spec)
+
+			stack := &Stack{StackName: aws.String("eksctl-test-cluster-nodegroup-ng-1")}
+			err := sc.DoCreateStackRequest(stack, TemplateBody(""), map[string]string{
+				"cost-center":      "5678",
+				api.ClusterNameTag: "other-cluster",
+			}, nil, false, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			input := p.MockCloudFormation().Calls[0].Arguments.Get(0).(*cfn.CreateStackInput)
+			tags := map[string]string{}
+			for _, tag := range input.Tags {
+				Expect(tags).NotTo(HaveKey(*tag.Key), "duplicate tag %q", *tag.Key)
+				tags[*tag.Key] = *tag.Value
+			}
+			Expect(tags).To(HaveKeyWithValue(api.ClusterNameTag, "test-cluster"))
+			Expect(tags).To(HaveKeyWithValue("cost-center", "5678"))
+			Expect(tags).To(HaveKeyWithValue("team", "platform"))
+		})
+	})
+
+	Context("TemplateDir", func() {
+		var (
+			p        *mockprovider.MockProvider
+			sc       *StackCollection
+			stack    *Stack
+			tmpDir   string
+			template = `{"Resources":{}}`
+		)
+
+		BeforeEach(func() {
+			var err error
+			tmpDir, err = ioutil.TempDir("", "templates")
+			Expect(err).NotTo(HaveOccurred())
+
+			p = mockprovider.NewMockProvider()
+			p.MockCloudFormation().On("CreateStack", mock.Anything).Return(&cfn.CreateStackOutput{StackId: aws.String("stack-id")}, nil)
+			sc = NewStackCollection(p, api.NewClusterConfig())
+			stack = &Stack{StackName: aws.String("eksctl-test-cluster-nodegroup-ng-1")}
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(tmpDir)).To(Succeed())
+		})
+
+		It("does not save templates by default", func() {
+			Expect(sc.DoCreateStackRequest(stack, TemplateBody(template), nil, nil, false, false)).To(Succeed())
+			files, err := ioutil.ReadDir(tmpDir)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(files).To(BeEmpty())
+		})
+
+		It("saves the submitted template", func() {
+			sc.TemplateDir = filepath.Join(tmpDir, "debug")
+			Expect(sc.DoCreateStackRequest(stack, TemplateBody(template), nil, nil, false, false)).To(Succeed())
+
+			files, err := filepath.Glob(filepath.Join(tmpDir, "debug", "eksctl-test-cluster-nodegroup-ng-1-*.json"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(files).To(HaveLen(1))
+			saved, err := ioutil.ReadFile(files[0])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(saved)).To(Equal(template))
+		})
+
+		It("does not fail when the template cannot be saved", func() {
+			notADir := filepath.Join(tmpDir, "file")
+			Expect(ioutil.WriteFile(notADir, nil, 0644)).To(Succeed())
+			sc.TemplateDir = notADir
+
+			Expect(sc.DoCreateStackRequest(stack, TemplateBody(template), nil, nil, false, false)).To(Succeed())
+			p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "CreateStack", 1)
+		})
+	})
+
+	Context("makeStackTags", func() {
+		It("merges the user tags with the eksctl tags, which take precedence", func() {
+			spec := api.NewClusterConfig()
+			spec.Metadata.Name = "test-cluster"
+			sc := NewStackCollection(mockprovider.NewMockProvider(), spec)
+
+			stackTags := sc.makeStackTags("eksctl-test-cluster-nodegroup-ng-1", map[string]string{
+				"cost-center":        "1234",
+				api.NodeGroupNameTag: "other",
+				api.ClusterNameTag:   "other-cluster",
+			}, map[string]string{
+				api.NodeGroupNameTag: "ng-1",
+				api.NodeGroupTypeTag: string(api.NodeGroupTypeUnmanaged),
+			})
+			tags := map[string]string{}
+			for _, tag := range stackTags {
+				Expect(tags).NotTo(HaveKey(*tag.Key), "duplicate tag %q", *tag.Key)
+				tags[*tag.Key] = *tag.Value
+			}
+			Expect(tags).To(HaveKeyWithValue("cost-center", "1234"))
+			Expect(tags).To(HaveKeyWithValue(api.NodeGroupNameTag, "ng-1"))
+			Expect(tags).To(HaveKeyWithValue(api.NodeGroupTypeTag, string(api.NodeGroupTypeUnmanaged)))
+			Expect(tags).To(HaveKeyWithValue(api.ClusterNameTag, "test-cluster"))
+		})
+	})
+
+	Context("read retries", func() {
+		const stackName = "eksctl-stack"
+
+		var (
+			p  *mockprovider.MockProvider
+			sm *StackCollection
+		)
+
+		describeOutput := &cfn.DescribeStacksOutput{Stacks: []*cfn.Stack{{
+			StackName:   aws.String(stackName),
+			StackStatus: aws.String(cfn.StackStatusCreateComplete),
+		}}}
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			sm = NewStackCollection(p, api.NewClusterConfig())
+			sm.readRetryPolicy = &retry.ConstantBackoff{MaxRetries: 2}
+		})
+
+		It("retries throttled requests", func() {
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(nil, awserr.New("Throttling", "Rate exceeded", nil)).Twice()
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(describeOutput, nil)
+
+			stack, err := sm.DescribeStack(&cfn.Stack{StackName: aws.String(stackName)})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*stack.StackName).To(Equal(stackName))
+			Expect(p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "DescribeStacks", 3)).To(BeTrue())
+		})
+
+		It("gives up once the retry policy is done", func() {
+			p.MockCloudFormation().On("ListStacksPages", mock.Anything, mock.Anything).Return(awserr.New("RequestLimitExceeded", "Rate exceeded", nil))
+
+			_, err := sm.ListClusterStackNames()
+			Expect(err).To(MatchError(ContainSubstring("RequestLimitExceeded")))
+			Expect(p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "ListStacksPages", 3)).To(BeTrue())
+		})
+
+		It("does not retry validation errors", func() {
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(nil, awserr.New("ValidationError", "Stack with id eksctl-stack does not exist", nil))
+
+			_, err := sm.DescribeStack(&cfn.Stack{StackName: aws.String(stackName)})
+			Expect(err).To(MatchError(ContainSubstring("ValidationError")))
+			Expect(p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "DescribeStacks", 1)).To(BeTrue())
+		})
+
+		It("retries requests that could not be sent", func() {
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(nil, awserr.New(request.ErrCodeRequestError, "send request failed", errors.New("connection reset by peer"))).Once()
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(describeOutput, nil)
+
+			_, err := sm.DescribeStack(&cfn.Stack{StackName: aws.String(stackName)})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "DescribeStacks", 2)).To(BeTrue())
+		})
+
+		It("disables the retries of the SDK client for the read requests", func() {
+			sess := session.Must(session.NewSession(aws.NewConfig().WithRegion("us-west-2")))
+			cfnClient := cfn.New(sess)
+
+			readClient, ok := withoutRetries(cfnClient).(*cfn.CloudFormation)
+			Expect(ok).To(BeTrue())
+			Expect(readClient.Retryer).To(Equal(client.NoOpRetryer{}))
+			Expect(cfnClient.Retryer).NotTo(Equal(client.NoOpRetryer{}))
+			Expect(withoutRetries(p.MockCloudFormation())).To(BeIdenticalTo(p.MockCloudFormation()))
+		})
+	})
+
+	Context("ListStacksMatchingTags", func() {
+		It("keeps only the stacks carrying all the tags", func() {
+			p := mockprovider.NewMockProvider()
+			sm := NewStackCollection(p, api.NewClusterConfig())
+
+			stackTags := map[string][]*cfn.Tag{
+				"team-a-web": {
+					{Key: aws.String("team"), Value: aws.String("a")},
+					{Key: aws.String("app"), Value: aws.String("web")},
+				},
+				"team-a-db": {
+					{Key: aws.String("team"), Value: aws.String("a")},
+					{Key: aws.String("app"), Value: aws.String("db")},
+				},
+				"unrelated": nil,
+			}
+
+			p.MockCloudFormation().On("ListStacksPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				consume := args[1].(func(p *cfn.ListStacksOutput, last bool) (shouldContinue bool))
+				consume(&cfn.ListStacksOutput{StackSummaries: []*cfn.StackSummary{
+					{StackName: aws.String("team-a-web")},
+					{StackName: aws.String("team-a-db")},
+				}}, false)
+				consume(&cfn.ListStacksOutput{StackSummaries: []*cfn.StackSummary{
+					{StackName: aws.String("unrelated")},
+				}}, true)
+			}).Return(nil)
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(func(input *cfn.DescribeStacksInput) *cfn.DescribeStacksOutput {
+				return &cfn.DescribeStacksOutput{Stacks: []*cfn.Stack{{
+					StackName:   input.StackName,
+					StackStatus: aws.String(cfn.StackStatusCreateComplete),
+					Tags:        stackTags[*input.StackName],
+				}}}
+			}, nil)
+
+			stacks, err := sm.ListStacksMatchingTags(map[string]string{"team": "a", "app": "web"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stacks).To(HaveLen(1))
+			Expect(*stacks[0].StackName).To(Equal("team-a-web"))
+			Expect(p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "DescribeStacks", 3)).To(BeTrue())
+		})
+	})
 })
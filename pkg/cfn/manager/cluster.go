@@ -23,7 +23,12 @@ func (c *StackCollection) MakeChangeSetName(action string) string {
 }
 
 func (c *StackCollection) MakeClusterStackName() string {
-	return "eksctl-" + c.spec.Metadata.Name + "-cluster"
+	return MakeClusterStackNameFromName(c.spec.Metadata.Name)
+}
+
+// MakeClusterStackNameFromName returns the name of the stack of the cluster with the given name
+func MakeClusterStackNameFromName(name string) string {
+	return "eksctl-" + name + "-cluster"
 }
 
 // createClusterTask creates the cluster
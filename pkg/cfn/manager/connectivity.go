@@ -0,0 +1,189 @@
+package manager
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/vpc"
+)
+
+// ConnectivityReport describes what is missing for the nodes of a nodegroup
+// to reach the services they need to join the cluster
+type ConnectivityReport struct {
+	// SubnetsWithoutEgress are the nodegroup subnets whose route table has no
+	// default route through a NAT gateway, internet gateway, transit gateway or instance
+	SubnetsWithoutEgress []string
+	// MissingEndpointServices are the endpoint services required by nodes without
+	// egress that have no VPC endpoint in the cluster VPC
+	MissingEndpointServices []string
+	// ClusterEndpointUnreachable is true when nodes without egress cannot reach
+	// the cluster API because its private endpoint access is disabled
+	ClusterEndpointUnreachable bool
+}
+
+// Reachable returns true when the nodes of the nodegroup can reach all required services
+func (r *ConnectivityReport) Reachable() bool {
+	return len(r.MissingEndpointServices) == 0 && !r.ClusterEndpointUnreachable
+}
+
+// CheckNodeGroupConnectivity inspects the route tables of the nodegroup's subnets and the
+// VPC endpoints of the cluster VPC, reporting what nodes would be missing to join the cluster
+func (c *StackCollection) CheckNodeGroupConnectivity(ng *api.NodeGroup) (*ConnectivityReport, error) {
+	if c.spec.VPC == nil || c.spec.VPC.ID == "" {
+		return nil, errors.New("cluster VPC ID must be set to check nodegroup connectivity")
+	}
+
+	subnetIDs, err := c.nodeGroupSubnetIDs(ng)
+	if err != nil {
+		return nil, err
+	}
+	if len(subnetIDs) == 0 {
+		return nil, fmt.Errorf("no subnets found for nodegroup %q", ng.Name)
+	}
+
+	routeTables, err := c.describeRouteTables(&ec2.Filter{
+		Name:   aws.String("vpc-id"),
+		Values: aws.StringSlice([]string{c.spec.VPC.ID}),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var mainRouteTable *ec2.RouteTable
+	subnetRouteTables := map[string]*ec2.RouteTable{}
+	for _, rt := range routeTables {
+		for _, rta := range rt.Associations {
+			if aws.BoolValue(rta.Main) {
+				mainRouteTable = rt
+			} else if rta.SubnetId != nil {
+				subnetRouteTables[*rta.SubnetId] = rt
+			}
+		}
+	}
+
+	report := &ConnectivityReport{}
+	for _, subnetID := range subnetIDs {
+		rt, ok := subnetRouteTables[subnetID]
+		if !ok {
+			// subnets without an explicit association use the main route table
+			rt = mainRouteTable
+		}
+		if rt == nil || !hasDefaultEgressRoute(rt) {
+			report.SubnetsWithoutEgress = append(report.SubnetsWithoutEgress, subnetID)
+		}
+	}
+
+	if len(report.SubnetsWithoutEgress) == 0 {
+		return report, nil
+	}
+
+	if c.spec.VPC.ClusterEndpoints == nil || !api.IsEnabled(c.spec.VPC.ClusterEndpoints.PrivateAccess) {
+		report.ClusterEndpointUnreachable = true
+	}
+
+	endpoints, err := c.describeVPCEndpoints(c.spec.VPC.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, service := range api.RequiredEndpointServices() {
+		if !hasEndpointForService(endpoints, c.region, service) {
+			report.MissingEndpointServices = append(report.MissingEndpointServices, service)
+		}
+	}
+
+	return report, nil
+}
+
+func (c *StackCollection) nodeGroupSubnetIDs(ng *api.NodeGroup) ([]string, error) {
+	subnets := c.spec.VPC.Subnets.Public
+	if ng.PrivateNetworking {
+		subnets = c.spec.VPC.Subnets.Private
+	}
+	if len(ng.AvailabilityZones) > 0 || len(ng.Subnets) > 0 {
+		subnetIDs, err := vpc.SelectNodeGroupSubnets(ng.AvailabilityZones, ng.Subnets, subnets)
+		if err != nil {
+			return nil, errors.Wrapf(err, "selecting subnets for nodegroup %q", ng.Name)
+		}
+		return subnetIDs, nil
+	}
+	return subnets.WithIDs(), nil
+}
+
+func (c *StackCollection) describeRouteTables(filters ...*ec2.Filter) ([]*ec2.RouteTable, error) {
+	var routeTables []*ec2.RouteTable
+	var nextToken *string
+
+	for {
+		output, err := c.ec2API.DescribeRouteTables(&ec2.DescribeRouteTablesInput{
+			Filters:   filters,
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "error describing route tables")
+		}
+
+		routeTables = append(routeTables, output.RouteTables...)
+		if nextToken = output.NextToken; nextToken == nil {
+			break
+		}
+	}
+	return routeTables, nil
+}
+
+func (c *StackCollection) describeVPCEndpoints(vpcID string) ([]*ec2.VpcEndpoint, error) {
+	var endpoints []*ec2.VpcEndpoint
+	var nextToken *string
+
+	for {
+		output, err := c.ec2API.DescribeVpcEndpoints(&ec2.DescribeVpcEndpointsInput{
+			Filters: []*ec2.Filter{
+				{
+					Name:   aws.String("vpc-id"),
+					Values: aws.StringSlice([]string{vpcID}),
+				},
+			},
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "error describing VPC endpoints")
+		}
+
+		endpoints = append(endpoints, output.VpcEndpoints...)
+		if nextToken = output.NextToken; nextToken == nil {
+			break
+		}
+	}
+	return endpoints, nil
+}
+
+func hasDefaultEgressRoute(rt *ec2.RouteTable) bool {
+	for _, route := range rt.Routes {
+		if aws.StringValue(route.DestinationCidrBlock) != "0.0.0.0/0" || aws.StringValue(route.State) == ec2.RouteStateBlackhole {
+			continue
+		}
+		if route.NatGatewayId != nil || route.TransitGatewayId != nil || route.InstanceId != nil ||
+			strings.HasPrefix(aws.StringValue(route.GatewayId), "igw-") {
+			return true
+		}
+	}
+	return false
+}
+
+func hasEndpointForService(endpoints []*ec2.VpcEndpoint, region, service string) bool {
+	// service names may have a partition-specific prefix, e.g. cn.com.amazonaws.cn-north-1.ecr.api
+	suffix := fmt.Sprintf("amazonaws.%s.%s", region, service)
+	for _, endpoint := range endpoints {
+		if !strings.EqualFold(aws.StringValue(endpoint.State), ec2.StateAvailable) {
+			continue
+		}
+		if strings.HasSuffix(aws.StringValue(endpoint.ServiceName), suffix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,128 @@
+package manager
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("StackCollection CheckNodeGroupConnectivity", func() {
+	var (
+		cfg *api.ClusterConfig
+		ng  *api.NodeGroup
+		p   *mockprovider.MockProvider
+		sc  *StackCollection
+	)
+
+	routeTable := func(subnetID string, main bool, routes ...*ec2.Route) *ec2.RouteTable {
+		association := &ec2.RouteTableAssociation{Main: aws.Bool(main)}
+		if subnetID != "" {
+			association.SubnetId = aws.String(subnetID)
+		}
+		return &ec2.RouteTable{
+			RouteTableId: aws.String("rtb-" + subnetID),
+			Associations: []*ec2.RouteTableAssociation{association},
+			Routes:       routes,
+		}
+	}
+
+	defaultRoute := func(route *ec2.Route) *ec2.Route {
+		route.DestinationCidrBlock = aws.String("0.0.0.0/0")
+		route.State = aws.String(ec2.RouteStateActive)
+		return route
+	}
+
+	mockRouteTables := func(routeTables ...*ec2.RouteTable) {
+		p.MockEC2().On("DescribeRouteTables", mock.MatchedBy(func(input *ec2.DescribeRouteTablesInput) bool {
+			return len(input.Filters) == 1 && *input.Filters[0].Name == "vpc-id" && *input.Filters[0].Values[0] == "vpc-1"
+		})).Return(&ec2.DescribeRouteTablesOutput{RouteTables: routeTables}, nil)
+	}
+
+	mockEndpoints := func(services ...string) {
+		var endpoints []*ec2.VpcEndpoint
+		for _, service := range services {
+			endpoints = append(endpoints, &ec2.VpcEndpoint{
+				ServiceName: aws.String("com.amazonaws.us-west-2." + service),
+				State:       aws.String("available"),
+			})
+		}
+		p.MockEC2().On("DescribeVpcEndpoints", mock.Anything).Return(&ec2.DescribeVpcEndpointsOutput{VpcEndpoints: endpoints}, nil)
+	}
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		cfg.VPC.ID = "vpc-1"
+		cfg.VPC.Subnets = &api.ClusterSubnets{
+			Private: api.AZSubnetMappingFromMap(map[string]api.AZSubnetSpec{
+				"us-west-2a": {ID: "subnet-private-a", AZ: "us-west-2a"},
+			}),
+			Public: api.AZSubnetMappingFromMap(map[string]api.AZSubnetSpec{
+				"us-west-2a": {ID: "subnet-public-a", AZ: "us-west-2a"},
+			}),
+		}
+		ng = cfg.NewNodeGroup()
+		ng.Name = "ng-1"
+		ng.PrivateNetworking = true
+
+		p = mockprovider.NewMockProvider()
+		p.SetRegion("us-west-2")
+		sc = NewStackCollection(p, cfg)
+	})
+
+	It("reports nothing missing when the subnets route through a NAT gateway", func() {
+		mockRouteTables(routeTable("subnet-private-a", false, defaultRoute(&ec2.Route{NatGatewayId: aws.String("nat-1")})))
+
+		report, err := sc.CheckNodeGroupConnectivity(ng)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.Reachable()).To(BeTrue())
+		Expect(report.SubnetsWithoutEgress).To(BeEmpty())
+		Expect(p.MockEC2().AssertNotCalled(GinkgoT(), "DescribeVpcEndpoints", mock.Anything)).To(BeTrue())
+	})
+
+	It("falls back to the main route table for subnets without an explicit association", func() {
+		mockRouteTables(routeTable("", true, defaultRoute(&ec2.Route{GatewayId: aws.String("igw-1")})))
+
+		report, err := sc.CheckNodeGroupConnectivity(ng)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.Reachable()).To(BeTrue())
+	})
+
+	It("reports the missing endpoints and the unreachable cluster endpoint for subnets without egress", func() {
+		mockRouteTables(routeTable("subnet-private-a", false, defaultRoute(&ec2.Route{GatewayId: aws.String("local")})))
+		mockEndpoints(api.EndpointServiceEC2, api.EndpointServiceS3)
+
+		report, err := sc.CheckNodeGroupConnectivity(ng)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.Reachable()).To(BeFalse())
+		Expect(report.SubnetsWithoutEgress).To(Equal([]string{"subnet-private-a"}))
+		Expect(report.MissingEndpointServices).To(ConsistOf(api.EndpointServiceECRAPI, api.EndpointServiceECRDKR, api.EndpointServiceSTS))
+		Expect(report.ClusterEndpointUnreachable).To(BeTrue())
+	})
+
+	It("is reachable without egress when all endpoints exist and private endpoint access is enabled", func() {
+		cfg.VPC.ClusterEndpoints = &api.ClusterEndpoints{
+			PrivateAccess: api.Enabled(),
+			PublicAccess:  api.Disabled(),
+		}
+		mockRouteTables(routeTable("subnet-private-a", false))
+		mockEndpoints(api.RequiredEndpointServices()...)
+
+		report, err := sc.CheckNodeGroupConnectivity(ng)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.SubnetsWithoutEgress).To(Equal([]string{"subnet-private-a"}))
+		Expect(report.Reachable()).To(BeTrue())
+	})
+
+	It("fails when the VPC ID is not set", func() {
+		cfg.VPC.ID = ""
+
+		_, err := sc.CheckNodeGroupConnectivity(ng)
+		Expect(err).To(MatchError("cluster VPC ID must be set to check nodegroup connectivity"))
+	})
+})
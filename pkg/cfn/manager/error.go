@@ -9,3 +9,21 @@ type StackNotFoundErr struct {
 func (e *StackNotFoundErr) Error() string {
 	return fmt.Sprintf("no eksctl-managed CloudFormation stacks found for %q", e.ClusterName)
 }
+
+// ErrDesiredExceedsMax is returned when scaling a nodegroup to a desired capacity greater than its maximum size
+type ErrDesiredExceedsMax struct {
+	Desired, Max int
+}
+
+func (e *ErrDesiredExceedsMax) Error() string {
+	return fmt.Sprintf("the desired nodes %d is greater than the nodes-max/maxSize %d", e.Desired, e.Max)
+}
+
+// ErrDesiredBelowMin is returned when scaling a nodegroup to a desired capacity less than its minimum size
+type ErrDesiredBelowMin struct {
+	Desired, Min int
+}
+
+func (e *ErrDesiredBelowMin) Error() string {
+	return fmt.Sprintf("the desired nodes %d is less than the nodes-min/minSize %d", e.Desired, e.Min)
+}
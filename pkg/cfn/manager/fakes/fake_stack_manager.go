@@ -4,8 +4,10 @@ package fakes
 import (
 	"sync"
 
+	"context"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/aws/aws-sdk-go/service/eks/eksiface"
 	"github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/builder"
@@ -14,6 +16,8 @@ import (
 	"github.com/weaveworks/eksctl/pkg/kubernetes"
 	"github.com/weaveworks/eksctl/pkg/utils/tasks"
 	"github.com/weaveworks/eksctl/pkg/vpc"
+	kubeclient "k8s.io/client-go/kubernetes"
+	"time"
 )
 
 type FakeStackManager struct {
@@ -31,6 +35,66 @@ type FakeStackManager struct {
 		result1 bool
 		result2 error
 	}
+	ApplyToAllNodeGroupsStub        func(func(ng *manager.NodeGroupSummary) (labels map[string]string, taints []v1alpha5.NodeGroupTaint)) ([]manager.NodeGroupUpdateResult, error)
+	applyToAllNodeGroupsMutex       sync.RWMutex
+	applyToAllNodeGroupsArgsForCall []struct {
+		arg1 func(ng *manager.NodeGroupSummary) (labels map[string]string, taints []v1alpha5.NodeGroupTaint)
+	}
+	applyToAllNodeGroupsReturns struct {
+		result1 []manager.NodeGroupUpdateResult
+		result2 error
+	}
+	applyToAllNodeGroupsReturnsOnCall map[int]struct {
+		result1 []manager.NodeGroupUpdateResult
+		result2 error
+	}
+	CheckNodeGroupAMIArchitectureStub        func(*v1alpha5.NodeGroup) error
+	checkNodeGroupAMIArchitectureMutex       sync.RWMutex
+	checkNodeGroupAMIArchitectureArgsForCall []struct {
+		arg1 *v1alpha5.NodeGroup
+	}
+	checkNodeGroupAMIArchitectureReturns struct {
+		result1 error
+	}
+	checkNodeGroupAMIArchitectureReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CheckNodeGroupConnectivityStub        func(*v1alpha5.NodeGroup) (*manager.ConnectivityReport, error)
+	checkNodeGroupConnectivityMutex       sync.RWMutex
+	checkNodeGroupConnectivityArgsForCall []struct {
+		arg1 *v1alpha5.NodeGroup
+	}
+	checkNodeGroupConnectivityReturns struct {
+		result1 *manager.ConnectivityReport
+		result2 error
+	}
+	checkNodeGroupConnectivityReturnsOnCall map[int]struct {
+		result1 *manager.ConnectivityReport
+		result2 error
+	}
+	CheckNodeGroupInstanceTypeOfferingsStub        func(*v1alpha5.NodeGroup) error
+	checkNodeGroupInstanceTypeOfferingsMutex       sync.RWMutex
+	checkNodeGroupInstanceTypeOfferingsArgsForCall []struct {
+		arg1 *v1alpha5.NodeGroup
+	}
+	checkNodeGroupInstanceTypeOfferingsReturns struct {
+		result1 error
+	}
+	checkNodeGroupInstanceTypeOfferingsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ContinueUpdateRollbackStub        func(string, []string) error
+	continueUpdateRollbackMutex       sync.RWMutex
+	continueUpdateRollbackArgsForCall []struct {
+		arg1 string
+		arg2 []string
+	}
+	continueUpdateRollbackReturns struct {
+		result1 error
+	}
+	continueUpdateRollbackReturnsOnCall map[int]struct {
+		result1 error
+	}
 	CreateStackStub        func(string, builder.ResourceSet, map[string]string, map[string]string, chan error) error
 	createStackMutex       sync.RWMutex
 	createStackArgsForCall []struct {
@@ -46,6 +110,18 @@ type FakeStackManager struct {
 	createStackReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DeleteNodeGroupStacksStub        func(context.Context, int) error
+	deleteNodeGroupStacksMutex       sync.RWMutex
+	deleteNodeGroupStacksArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+	}
+	deleteNodeGroupStacksReturns struct {
+		result1 error
+	}
+	deleteNodeGroupStacksReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DeleteStackByNameStub        func(string) (*cloudformation.Stack, error)
 	deleteStackByNameMutex       sync.RWMutex
 	deleteStackByNameArgsForCall []struct {
@@ -220,6 +296,19 @@ type FakeStackManager struct {
 		result1 []*cloudformation.Stack
 		result2 error
 	}
+	DetectNodeGroupDriftStub        func(*v1alpha5.NodeGroup) (*manager.DriftResult, error)
+	detectNodeGroupDriftMutex       sync.RWMutex
+	detectNodeGroupDriftArgsForCall []struct {
+		arg1 *v1alpha5.NodeGroup
+	}
+	detectNodeGroupDriftReturns struct {
+		result1 *manager.DriftResult
+		result2 error
+	}
+	detectNodeGroupDriftReturnsOnCall map[int]struct {
+		result1 *manager.DriftResult
+		result2 error
+	}
 	DoCreateStackRequestStub        func(*cloudformation.Stack, manager.TemplateData, map[string]string, map[string]string, bool, bool) error
 	doCreateStackRequestMutex       sync.RWMutex
 	doCreateStackRequestArgsForCall []struct {
@@ -257,6 +346,19 @@ type FakeStackManager struct {
 	ensureMapPublicIPOnLaunchEnabledReturnsOnCall map[int]struct {
 		result1 error
 	}
+	FindNodeGroupsUsingInstanceTypesStub        func([]string) ([]*manager.NodeGroupSummary, error)
+	findNodeGroupsUsingInstanceTypesMutex       sync.RWMutex
+	findNodeGroupsUsingInstanceTypesArgsForCall []struct {
+		arg1 []string
+	}
+	findNodeGroupsUsingInstanceTypesReturns struct {
+		result1 []*manager.NodeGroupSummary
+		result2 error
+	}
+	findNodeGroupsUsingInstanceTypesReturnsOnCall map[int]struct {
+		result1 []*manager.NodeGroupSummary
+		result2 error
+	}
 	FixClusterCompatibilityStub        func() error
 	fixClusterCompatibilityMutex       sync.RWMutex
 	fixClusterCompatibilityArgsForCall []struct {
@@ -267,6 +369,32 @@ type FakeStackManager struct {
 	fixClusterCompatibilityReturnsOnCall map[int]struct {
 		result1 error
 	}
+	GenerateNodeGroupCapacityReportStub        func(kubeclient.Interface) (*manager.CapacityReport, error)
+	generateNodeGroupCapacityReportMutex       sync.RWMutex
+	generateNodeGroupCapacityReportArgsForCall []struct {
+		arg1 kubeclient.Interface
+	}
+	generateNodeGroupCapacityReportReturns struct {
+		result1 *manager.CapacityReport
+		result2 error
+	}
+	generateNodeGroupCapacityReportReturnsOnCall map[int]struct {
+		result1 *manager.CapacityReport
+		result2 error
+	}
+	GenerateNodeGroupConfigStub        func(string) (*v1alpha5.NodeGroup, error)
+	generateNodeGroupConfigMutex       sync.RWMutex
+	generateNodeGroupConfigArgsForCall []struct {
+		arg1 string
+	}
+	generateNodeGroupConfigReturns struct {
+		result1 *v1alpha5.NodeGroup
+		result2 error
+	}
+	generateNodeGroupConfigReturnsOnCall map[int]struct {
+		result1 *v1alpha5.NodeGroup
+		result2 error
+	}
 	GetAutoScalingGroupNameStub        func(*cloudformation.Stack) (string, error)
 	getAutoScalingGroupNameMutex       sync.RWMutex
 	getAutoScalingGroupNameArgsForCall []struct {
@@ -327,6 +455,19 @@ type FakeStackManager struct {
 		result1 []*v1alpha5.ClusterIAMServiceAccount
 		result2 error
 	}
+	GetManagedNodeGroupStub        func(*v1alpha5.NodeGroup) (*eks.Nodegroup, error)
+	getManagedNodeGroupMutex       sync.RWMutex
+	getManagedNodeGroupArgsForCall []struct {
+		arg1 *v1alpha5.NodeGroup
+	}
+	getManagedNodeGroupReturns struct {
+		result1 *eks.Nodegroup
+		result2 error
+	}
+	getManagedNodeGroupReturnsOnCall map[int]struct {
+		result1 *eks.Nodegroup
+		result2 error
+	}
 	GetManagedNodeGroupAutoScalingGroupNameStub        func(*cloudformation.Stack) (string, error)
 	getManagedNodeGroupAutoScalingGroupNameMutex       sync.RWMutex
 	getManagedNodeGroupAutoScalingGroupNameArgsForCall []struct {
@@ -366,6 +507,58 @@ type FakeStackManager struct {
 		result1 string
 		result2 error
 	}
+	GetNodeGroupIAMRolesStub        func() (map[string]manager.NodeGroupIAMRole, error)
+	getNodeGroupIAMRolesMutex       sync.RWMutex
+	getNodeGroupIAMRolesArgsForCall []struct {
+	}
+	getNodeGroupIAMRolesReturns struct {
+		result1 map[string]manager.NodeGroupIAMRole
+		result2 error
+	}
+	getNodeGroupIAMRolesReturnsOnCall map[int]struct {
+		result1 map[string]manager.NodeGroupIAMRole
+		result2 error
+	}
+	GetNodeGroupInstanceHealthStub        func(*v1alpha5.NodeGroup) ([]manager.InstanceHealth, error)
+	getNodeGroupInstanceHealthMutex       sync.RWMutex
+	getNodeGroupInstanceHealthArgsForCall []struct {
+		arg1 *v1alpha5.NodeGroup
+	}
+	getNodeGroupInstanceHealthReturns struct {
+		result1 []manager.InstanceHealth
+		result2 error
+	}
+	getNodeGroupInstanceHealthReturnsOnCall map[int]struct {
+		result1 []manager.InstanceHealth
+		result2 error
+	}
+	GetNodeGroupInstanceIDsStub        func(*v1alpha5.NodeGroup) ([]string, error)
+	getNodeGroupInstanceIDsMutex       sync.RWMutex
+	getNodeGroupInstanceIDsArgsForCall []struct {
+		arg1 *v1alpha5.NodeGroup
+	}
+	getNodeGroupInstanceIDsReturns struct {
+		result1 []string
+		result2 error
+	}
+	getNodeGroupInstanceIDsReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	GetNodeGroupKubeletVersionStub        func(*v1alpha5.NodeGroup, kubeclient.Interface) (string, error)
+	getNodeGroupKubeletVersionMutex       sync.RWMutex
+	getNodeGroupKubeletVersionArgsForCall []struct {
+		arg1 *v1alpha5.NodeGroup
+		arg2 kubeclient.Interface
+	}
+	getNodeGroupKubeletVersionReturns struct {
+		result1 string
+		result2 error
+	}
+	getNodeGroupKubeletVersionReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	GetNodeGroupNameStub        func(*cloudformation.Stack) string
 	getNodeGroupNameMutex       sync.RWMutex
 	getNodeGroupNameArgsForCall []struct {
@@ -377,6 +570,48 @@ type FakeStackManager struct {
 	getNodeGroupNameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	GetNodeGroupPodCapacityStub        func(*v1alpha5.NodeGroup, kubeclient.Interface) (int, int, error)
+	getNodeGroupPodCapacityMutex       sync.RWMutex
+	getNodeGroupPodCapacityArgsForCall []struct {
+		arg1 *v1alpha5.NodeGroup
+		arg2 kubeclient.Interface
+	}
+	getNodeGroupPodCapacityReturns struct {
+		result1 int
+		result2 int
+		result3 error
+	}
+	getNodeGroupPodCapacityReturnsOnCall map[int]struct {
+		result1 int
+		result2 int
+		result3 error
+	}
+	GetNodeGroupSecurityGroupIDsStub        func(*v1alpha5.NodeGroup) ([]string, error)
+	getNodeGroupSecurityGroupIDsMutex       sync.RWMutex
+	getNodeGroupSecurityGroupIDsArgsForCall []struct {
+		arg1 *v1alpha5.NodeGroup
+	}
+	getNodeGroupSecurityGroupIDsReturns struct {
+		result1 []string
+		result2 error
+	}
+	getNodeGroupSecurityGroupIDsReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	GetNodeGroupStackPolicyStub        func(*v1alpha5.NodeGroup) (string, error)
+	getNodeGroupStackPolicyMutex       sync.RWMutex
+	getNodeGroupStackPolicyArgsForCall []struct {
+		arg1 *v1alpha5.NodeGroup
+	}
+	getNodeGroupStackPolicyReturns struct {
+		result1 string
+		result2 error
+	}
+	getNodeGroupStackPolicyReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	GetNodeGroupStackTypeStub        func(string) (v1alpha5.NodeGroupType, error)
 	getNodeGroupStackTypeMutex       sync.RWMutex
 	getNodeGroupStackTypeArgsForCall []struct {
@@ -403,6 +638,33 @@ type FakeStackManager struct {
 		result1 []*manager.NodeGroupSummary
 		result2 error
 	}
+	GetNodeGroupWorkloadImpactStub        func(*v1alpha5.NodeGroup, kubeclient.Interface) (*manager.WorkloadImpact, error)
+	getNodeGroupWorkloadImpactMutex       sync.RWMutex
+	getNodeGroupWorkloadImpactArgsForCall []struct {
+		arg1 *v1alpha5.NodeGroup
+		arg2 kubeclient.Interface
+	}
+	getNodeGroupWorkloadImpactReturns struct {
+		result1 *manager.WorkloadImpact
+		result2 error
+	}
+	getNodeGroupWorkloadImpactReturnsOnCall map[int]struct {
+		result1 *manager.WorkloadImpact
+		result2 error
+	}
+	GetNodeGroupsByTagStub        func(string) (map[string][]*manager.NodeGroupSummary, error)
+	getNodeGroupsByTagMutex       sync.RWMutex
+	getNodeGroupsByTagArgsForCall []struct {
+		arg1 string
+	}
+	getNodeGroupsByTagReturns struct {
+		result1 map[string][]*manager.NodeGroupSummary
+		result2 error
+	}
+	getNodeGroupsByTagReturnsOnCall map[int]struct {
+		result1 map[string][]*manager.NodeGroupSummary
+		result2 error
+	}
 	GetStackTemplateStub        func(string) (string, error)
 	getStackTemplateMutex       sync.RWMutex
 	getStackTemplateArgsForCall []struct {
@@ -441,6 +703,19 @@ type FakeStackManager struct {
 		result1 bool
 		result2 error
 	}
+	IsNodeGroupProtectedFromReplacementStub        func(*v1alpha5.NodeGroup) (bool, error)
+	isNodeGroupProtectedFromReplacementMutex       sync.RWMutex
+	isNodeGroupProtectedFromReplacementArgsForCall []struct {
+		arg1 *v1alpha5.NodeGroup
+	}
+	isNodeGroupProtectedFromReplacementReturns struct {
+		result1 bool
+		result2 error
+	}
+	isNodeGroupProtectedFromReplacementReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	ListClusterStackNamesStub        func() ([]string, error)
 	listClusterStackNamesMutex       sync.RWMutex
 	listClusterStackNamesArgsForCall []struct {
@@ -477,6 +752,18 @@ type FakeStackManager struct {
 		result1 []manager.NodeGroupStack
 		result2 error
 	}
+	ListOrphanedNodeGroupStacksStub        func() ([]*cloudformation.Stack, error)
+	listOrphanedNodeGroupStacksMutex       sync.RWMutex
+	listOrphanedNodeGroupStacksArgsForCall []struct {
+	}
+	listOrphanedNodeGroupStacksReturns struct {
+		result1 []*cloudformation.Stack
+		result2 error
+	}
+	listOrphanedNodeGroupStacksReturnsOnCall map[int]struct {
+		result1 []*cloudformation.Stack
+		result2 error
+	}
 	ListStacksStub        func(...string) ([]*cloudformation.Stack, error)
 	listStacksMutex       sync.RWMutex
 	listStacksArgsForCall []struct {
@@ -504,6 +791,19 @@ type FakeStackManager struct {
 		result1 []*cloudformation.Stack
 		result2 error
 	}
+	ListStacksMatchingTagsStub        func(map[string]string) ([]*cloudformation.Stack, error)
+	listStacksMatchingTagsMutex       sync.RWMutex
+	listStacksMatchingTagsArgsForCall []struct {
+		arg1 map[string]string
+	}
+	listStacksMatchingTagsReturns struct {
+		result1 []*cloudformation.Stack
+		result2 error
+	}
+	listStacksMatchingTagsReturnsOnCall map[int]struct {
+		result1 []*cloudformation.Stack
+		result2 error
+	}
 	LookupCloudTrailEventsStub        func(*cloudformation.Stack) ([]*cloudtrail.Event, error)
 	lookupCloudTrailEventsMutex       sync.RWMutex
 	lookupCloudTrailEventsArgsForCall []struct {
@@ -538,6 +838,18 @@ type FakeStackManager struct {
 	makeClusterStackNameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	MigrateToManagedNodeGroupStub        func(*v1alpha5.NodeGroup, func(*v1alpha5.NodeGroup) error) error
+	migrateToManagedNodeGroupMutex       sync.RWMutex
+	migrateToManagedNodeGroupArgsForCall []struct {
+		arg1 *v1alpha5.NodeGroup
+		arg2 func(*v1alpha5.NodeGroup) error
+	}
+	migrateToManagedNodeGroupReturns struct {
+		result1 error
+	}
+	migrateToManagedNodeGroupReturnsOnCall map[int]struct {
+		result1 error
+	}
 	NewClusterCompatTaskStub        func() tasks.Task
 	newClusterCompatTaskMutex       sync.RWMutex
 	newClusterCompatTaskArgsForCall []struct {
@@ -690,6 +1002,45 @@ type FakeStackManager struct {
 	newUnmanagedNodeGroupTaskReturnsOnCall map[int]struct {
 		result1 *tasks.TaskTree
 	}
+	NodeGroupChangeSetStub        func(*v1alpha5.NodeGroup) ([]manager.StackChange, error)
+	nodeGroupChangeSetMutex       sync.RWMutex
+	nodeGroupChangeSetArgsForCall []struct {
+		arg1 *v1alpha5.NodeGroup
+	}
+	nodeGroupChangeSetReturns struct {
+		result1 []manager.StackChange
+		result2 error
+	}
+	nodeGroupChangeSetReturnsOnCall map[int]struct {
+		result1 []manager.StackChange
+		result2 error
+	}
+	NodeGroupScalingDriftStub        func(*v1alpha5.NodeGroup) (*manager.ScalingDrift, error)
+	nodeGroupScalingDriftMutex       sync.RWMutex
+	nodeGroupScalingDriftArgsForCall []struct {
+		arg1 *v1alpha5.NodeGroup
+	}
+	nodeGroupScalingDriftReturns struct {
+		result1 *manager.ScalingDrift
+		result2 error
+	}
+	nodeGroupScalingDriftReturnsOnCall map[int]struct {
+		result1 *manager.ScalingDrift
+		result2 error
+	}
+	NodeGroupStackExistsStub        func(string) (bool, error)
+	nodeGroupStackExistsMutex       sync.RWMutex
+	nodeGroupStackExistsArgsForCall []struct {
+		arg1 string
+	}
+	nodeGroupStackExistsReturns struct {
+		result1 bool
+		result2 error
+	}
+	nodeGroupStackExistsReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	RefreshFargatePodExecutionRoleARNStub        func() error
 	refreshFargatePodExecutionRoleARNMutex       sync.RWMutex
 	refreshFargatePodExecutionRoleARNArgsForCall []struct {
@@ -700,15 +1051,129 @@ type FakeStackManager struct {
 	refreshFargatePodExecutionRoleARNReturnsOnCall map[int]struct {
 		result1 error
 	}
-	ScaleNodeGroupStub        func(*v1alpha5.NodeGroup) error
+	RollbackNodeGroupStub        func(*v1alpha5.NodeGroup) error
+	rollbackNodeGroupMutex       sync.RWMutex
+	rollbackNodeGroupArgsForCall []struct {
+		arg1 *v1alpha5.NodeGroup
+	}
+	rollbackNodeGroupReturns struct {
+		result1 error
+	}
+	rollbackNodeGroupReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RollingScaleNodeGroupStub        func(*v1alpha5.NodeGroup, int, time.Duration) error
+	rollingScaleNodeGroupMutex       sync.RWMutex
+	rollingScaleNodeGroupArgsForCall []struct {
+		arg1 *v1alpha5.NodeGroup
+		arg2 int
+		arg3 time.Duration
+	}
+	rollingScaleNodeGroupReturns struct {
+		result1 error
+	}
+	rollingScaleNodeGroupReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ScaleNodeGroupStub        func(*v1alpha5.NodeGroup, bool) ([]manager.StackChange, error)
 	scaleNodeGroupMutex       sync.RWMutex
 	scaleNodeGroupArgsForCall []struct {
 		arg1 *v1alpha5.NodeGroup
+		arg2 bool
 	}
 	scaleNodeGroupReturns struct {
-		result1 error
+		result1 []manager.StackChange
+		result2 error
 	}
 	scaleNodeGroupReturnsOnCall map[int]struct {
+		result1 []manager.StackChange
+		result2 error
+	}
+	ScaleNodeGroupByDeltaStub        func(*v1alpha5.NodeGroup, int) (string, error)
+	scaleNodeGroupByDeltaMutex       sync.RWMutex
+	scaleNodeGroupByDeltaArgsForCall []struct {
+		arg1 *v1alpha5.NodeGroup
+		arg2 int
+	}
+	scaleNodeGroupByDeltaReturns struct {
+		result1 string
+		result2 error
+	}
+	scaleNodeGroupByDeltaReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	ScaleNodeGroupsStub        func([]*v1alpha5.NodeGroup) error
+	scaleNodeGroupsMutex       sync.RWMutex
+	scaleNodeGroupsArgsForCall []struct {
+		arg1 []*v1alpha5.NodeGroup
+	}
+	scaleNodeGroupsReturns struct {
+		result1 error
+	}
+	scaleNodeGroupsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SetInstanceProtectionStub        func(*v1alpha5.NodeGroup, []string, bool) error
+	setInstanceProtectionMutex       sync.RWMutex
+	setInstanceProtectionArgsForCall []struct {
+		arg1 *v1alpha5.NodeGroup
+		arg2 []string
+		arg3 bool
+	}
+	setInstanceProtectionReturns struct {
+		result1 error
+	}
+	setInstanceProtectionReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SetNodeGroupAutoscalerPausedStub        func(*v1alpha5.NodeGroup, bool) (bool, error)
+	setNodeGroupAutoscalerPausedMutex       sync.RWMutex
+	setNodeGroupAutoscalerPausedArgsForCall []struct {
+		arg1 *v1alpha5.NodeGroup
+		arg2 bool
+	}
+	setNodeGroupAutoscalerPausedReturns struct {
+		result1 bool
+		result2 error
+	}
+	setNodeGroupAutoscalerPausedReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	SetNodeGroupStackPolicyStub        func(*v1alpha5.NodeGroup, bool) error
+	setNodeGroupStackPolicyMutex       sync.RWMutex
+	setNodeGroupStackPolicyArgsForCall []struct {
+		arg1 *v1alpha5.NodeGroup
+		arg2 bool
+	}
+	setNodeGroupStackPolicyReturns struct {
+		result1 error
+	}
+	setNodeGroupStackPolicyReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SetNodeGroupStackPolicyBodyStub        func(*v1alpha5.NodeGroup, string) error
+	setNodeGroupStackPolicyBodyMutex       sync.RWMutex
+	setNodeGroupStackPolicyBodyArgsForCall []struct {
+		arg1 *v1alpha5.NodeGroup
+		arg2 string
+	}
+	setNodeGroupStackPolicyBodyReturns struct {
+		result1 error
+	}
+	setNodeGroupStackPolicyBodyReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SetNodeGroupsOutdatedStub        func([]*manager.NodeGroupSummary) error
+	setNodeGroupsOutdatedMutex       sync.RWMutex
+	setNodeGroupsOutdatedArgsForCall []struct {
+		arg1 []*manager.NodeGroupSummary
+	}
+	setNodeGroupsOutdatedReturns struct {
+		result1 error
+	}
+	setNodeGroupsOutdatedReturnsOnCall map[int]struct {
 		result1 error
 	}
 	StackStatusIsNotReadyStub        func(*cloudformation.Stack) bool
@@ -733,31 +1198,102 @@ type FakeStackManager struct {
 	stackStatusIsNotTransitionalReturnsOnCall map[int]struct {
 		result1 bool
 	}
-	UpdateNodeGroupStackStub        func(string, string) error
-	updateNodeGroupStackMutex       sync.RWMutex
-	updateNodeGroupStackArgsForCall []struct {
-		arg1 string
-		arg2 string
+	SyncNodeGroupBoundsToCloudFormationStub        func(*v1alpha5.NodeGroup) error
+	syncNodeGroupBoundsToCloudFormationMutex       sync.RWMutex
+	syncNodeGroupBoundsToCloudFormationArgsForCall []struct {
+		arg1 *v1alpha5.NodeGroup
 	}
-	updateNodeGroupStackReturns struct {
+	syncNodeGroupBoundsToCloudFormationReturns struct {
 		result1 error
 	}
-	updateNodeGroupStackReturnsOnCall map[int]struct {
+	syncNodeGroupBoundsToCloudFormationReturnsOnCall map[int]struct {
 		result1 error
 	}
-	UpdateStackStub        func(string, string, string, manager.TemplateData, map[string]string) error
-	updateStackMutex       sync.RWMutex
-	updateStackArgsForCall []struct {
-		arg1 string
-		arg2 string
-		arg3 string
-		arg4 manager.TemplateData
-		arg5 map[string]string
+	UpdateManagedNodeGroupLabelsStub        func(*v1alpha5.ManagedNodeGroup) error
+	updateManagedNodeGroupLabelsMutex       sync.RWMutex
+	updateManagedNodeGroupLabelsArgsForCall []struct {
+		arg1 *v1alpha5.ManagedNodeGroup
 	}
-	updateStackReturns struct {
+	updateManagedNodeGroupLabelsReturns struct {
 		result1 error
 	}
-	updateStackReturnsOnCall map[int]struct {
+	updateManagedNodeGroupLabelsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateManagedNodeGroupNodeRepairConfigStub        func(*v1alpha5.ManagedNodeGroup) error
+	updateManagedNodeGroupNodeRepairConfigMutex       sync.RWMutex
+	updateManagedNodeGroupNodeRepairConfigArgsForCall []struct {
+		arg1 *v1alpha5.ManagedNodeGroup
+	}
+	updateManagedNodeGroupNodeRepairConfigReturns struct {
+		result1 error
+	}
+	updateManagedNodeGroupNodeRepairConfigReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateManagedNodeGroupUpdateConfigStub        func(*v1alpha5.ManagedNodeGroup) error
+	updateManagedNodeGroupUpdateConfigMutex       sync.RWMutex
+	updateManagedNodeGroupUpdateConfigArgsForCall []struct {
+		arg1 *v1alpha5.ManagedNodeGroup
+	}
+	updateManagedNodeGroupUpdateConfigReturns struct {
+		result1 error
+	}
+	updateManagedNodeGroupUpdateConfigReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateNodeGroupStackStub        func(string, string) error
+	updateNodeGroupStackMutex       sync.RWMutex
+	updateNodeGroupStackArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	updateNodeGroupStackReturns struct {
+		result1 error
+	}
+	updateNodeGroupStackReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateNodeGroupStackTagsStub        func(*v1alpha5.NodeGroup, map[string]string) (map[string]string, error)
+	updateNodeGroupStackTagsMutex       sync.RWMutex
+	updateNodeGroupStackTagsArgsForCall []struct {
+		arg1 *v1alpha5.NodeGroup
+		arg2 map[string]string
+	}
+	updateNodeGroupStackTagsReturns struct {
+		result1 map[string]string
+		result2 error
+	}
+	updateNodeGroupStackTagsReturnsOnCall map[int]struct {
+		result1 map[string]string
+		result2 error
+	}
+	UpdateStackStub        func(string, string, string, manager.TemplateData, map[string]string) error
+	updateStackMutex       sync.RWMutex
+	updateStackArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 manager.TemplateData
+		arg5 map[string]string
+	}
+	updateStackReturns struct {
+		result1 error
+	}
+	updateStackReturnsOnCall map[int]struct {
+		result1 error
+	}
+	WaitForNodeGroupStackStub        func(context.Context, *v1alpha5.NodeGroup, string) error
+	waitForNodeGroupStackMutex       sync.RWMutex
+	waitForNodeGroupStackArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha5.NodeGroup
+		arg3 string
+	}
+	waitForNodeGroupStackReturns struct {
+		result1 error
+	}
+	waitForNodeGroupStackReturnsOnCall map[int]struct {
 		result1 error
 	}
 	invocations      map[string][][]interface{}
@@ -829,6 +1365,323 @@ func (fake *FakeStackManager) AppendNewClusterStackResourceReturnsOnCall(i int,
 	}{result1, result2}
 }
 
+func (fake *FakeStackManager) ApplyToAllNodeGroups(arg1 func(ng *manager.NodeGroupSummary) (labels map[string]string, taints []v1alpha5.NodeGroupTaint)) ([]manager.NodeGroupUpdateResult, error) {
+	fake.applyToAllNodeGroupsMutex.Lock()
+	ret, specificReturn := fake.applyToAllNodeGroupsReturnsOnCall[len(fake.applyToAllNodeGroupsArgsForCall)]
+	fake.applyToAllNodeGroupsArgsForCall = append(fake.applyToAllNodeGroupsArgsForCall, struct {
+		arg1 func(ng *manager.NodeGroupSummary) (labels map[string]string, taints []v1alpha5.NodeGroupTaint)
+	}{arg1})
+	stub := fake.ApplyToAllNodeGroupsStub
+	fakeReturns := fake.applyToAllNodeGroupsReturns
+	fake.recordInvocation("ApplyToAllNodeGroups", []interface{}{arg1})
+	fake.applyToAllNodeGroupsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) ApplyToAllNodeGroupsCallCount() int {
+	fake.applyToAllNodeGroupsMutex.RLock()
+	defer fake.applyToAllNodeGroupsMutex.RUnlock()
+	return len(fake.applyToAllNodeGroupsArgsForCall)
+}
+
+func (fake *FakeStackManager) ApplyToAllNodeGroupsCalls(stub func(func(ng *manager.NodeGroupSummary) (labels map[string]string, taints []v1alpha5.NodeGroupTaint)) ([]manager.NodeGroupUpdateResult, error)) {
+	fake.applyToAllNodeGroupsMutex.Lock()
+	defer fake.applyToAllNodeGroupsMutex.Unlock()
+	fake.ApplyToAllNodeGroupsStub = stub
+}
+
+func (fake *FakeStackManager) ApplyToAllNodeGroupsArgsForCall(i int) func(ng *manager.NodeGroupSummary) (labels map[string]string, taints []v1alpha5.NodeGroupTaint) {
+	fake.applyToAllNodeGroupsMutex.RLock()
+	defer fake.applyToAllNodeGroupsMutex.RUnlock()
+	argsForCall := fake.applyToAllNodeGroupsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) ApplyToAllNodeGroupsReturns(result1 []manager.NodeGroupUpdateResult, result2 error) {
+	fake.applyToAllNodeGroupsMutex.Lock()
+	defer fake.applyToAllNodeGroupsMutex.Unlock()
+	fake.ApplyToAllNodeGroupsStub = nil
+	fake.applyToAllNodeGroupsReturns = struct {
+		result1 []manager.NodeGroupUpdateResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) ApplyToAllNodeGroupsReturnsOnCall(i int, result1 []manager.NodeGroupUpdateResult, result2 error) {
+	fake.applyToAllNodeGroupsMutex.Lock()
+	defer fake.applyToAllNodeGroupsMutex.Unlock()
+	fake.ApplyToAllNodeGroupsStub = nil
+	if fake.applyToAllNodeGroupsReturnsOnCall == nil {
+		fake.applyToAllNodeGroupsReturnsOnCall = make(map[int]struct {
+			result1 []manager.NodeGroupUpdateResult
+			result2 error
+		})
+	}
+	fake.applyToAllNodeGroupsReturnsOnCall[i] = struct {
+		result1 []manager.NodeGroupUpdateResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) CheckNodeGroupAMIArchitecture(arg1 *v1alpha5.NodeGroup) error {
+	fake.checkNodeGroupAMIArchitectureMutex.Lock()
+	ret, specificReturn := fake.checkNodeGroupAMIArchitectureReturnsOnCall[len(fake.checkNodeGroupAMIArchitectureArgsForCall)]
+	fake.checkNodeGroupAMIArchitectureArgsForCall = append(fake.checkNodeGroupAMIArchitectureArgsForCall, struct {
+		arg1 *v1alpha5.NodeGroup
+	}{arg1})
+	stub := fake.CheckNodeGroupAMIArchitectureStub
+	fakeReturns := fake.checkNodeGroupAMIArchitectureReturns
+	fake.recordInvocation("CheckNodeGroupAMIArchitecture", []interface{}{arg1})
+	fake.checkNodeGroupAMIArchitectureMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStackManager) CheckNodeGroupAMIArchitectureCallCount() int {
+	fake.checkNodeGroupAMIArchitectureMutex.RLock()
+	defer fake.checkNodeGroupAMIArchitectureMutex.RUnlock()
+	return len(fake.checkNodeGroupAMIArchitectureArgsForCall)
+}
+
+func (fake *FakeStackManager) CheckNodeGroupAMIArchitectureCalls(stub func(*v1alpha5.NodeGroup) error) {
+	fake.checkNodeGroupAMIArchitectureMutex.Lock()
+	defer fake.checkNodeGroupAMIArchitectureMutex.Unlock()
+	fake.CheckNodeGroupAMIArchitectureStub = stub
+}
+
+func (fake *FakeStackManager) CheckNodeGroupAMIArchitectureArgsForCall(i int) *v1alpha5.NodeGroup {
+	fake.checkNodeGroupAMIArchitectureMutex.RLock()
+	defer fake.checkNodeGroupAMIArchitectureMutex.RUnlock()
+	argsForCall := fake.checkNodeGroupAMIArchitectureArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) CheckNodeGroupAMIArchitectureReturns(result1 error) {
+	fake.checkNodeGroupAMIArchitectureMutex.Lock()
+	defer fake.checkNodeGroupAMIArchitectureMutex.Unlock()
+	fake.CheckNodeGroupAMIArchitectureStub = nil
+	fake.checkNodeGroupAMIArchitectureReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStackManager) CheckNodeGroupAMIArchitectureReturnsOnCall(i int, result1 error) {
+	fake.checkNodeGroupAMIArchitectureMutex.Lock()
+	defer fake.checkNodeGroupAMIArchitectureMutex.Unlock()
+	fake.CheckNodeGroupAMIArchitectureStub = nil
+	if fake.checkNodeGroupAMIArchitectureReturnsOnCall == nil {
+		fake.checkNodeGroupAMIArchitectureReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.checkNodeGroupAMIArchitectureReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStackManager) CheckNodeGroupConnectivity(arg1 *v1alpha5.NodeGroup) (*manager.ConnectivityReport, error) {
+	fake.checkNodeGroupConnectivityMutex.Lock()
+	ret, specificReturn := fake.checkNodeGroupConnectivityReturnsOnCall[len(fake.checkNodeGroupConnectivityArgsForCall)]
+	fake.checkNodeGroupConnectivityArgsForCall = append(fake.checkNodeGroupConnectivityArgsForCall, struct {
+		arg1 *v1alpha5.NodeGroup
+	}{arg1})
+	stub := fake.CheckNodeGroupConnectivityStub
+	fakeReturns := fake.checkNodeGroupConnectivityReturns
+	fake.recordInvocation("CheckNodeGroupConnectivity", []interface{}{arg1})
+	fake.checkNodeGroupConnectivityMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) CheckNodeGroupConnectivityCallCount() int {
+	fake.checkNodeGroupConnectivityMutex.RLock()
+	defer fake.checkNodeGroupConnectivityMutex.RUnlock()
+	return len(fake.checkNodeGroupConnectivityArgsForCall)
+}
+
+func (fake *FakeStackManager) CheckNodeGroupConnectivityCalls(stub func(*v1alpha5.NodeGroup) (*manager.ConnectivityReport, error)) {
+	fake.checkNodeGroupConnectivityMutex.Lock()
+	defer fake.checkNodeGroupConnectivityMutex.Unlock()
+	fake.CheckNodeGroupConnectivityStub = stub
+}
+
+func (fake *FakeStackManager) CheckNodeGroupConnectivityArgsForCall(i int) *v1alpha5.NodeGroup {
+	fake.checkNodeGroupConnectivityMutex.RLock()
+	defer fake.checkNodeGroupConnectivityMutex.RUnlock()
+	argsForCall := fake.checkNodeGroupConnectivityArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) CheckNodeGroupConnectivityReturns(result1 *manager.ConnectivityReport, result2 error) {
+	fake.checkNodeGroupConnectivityMutex.Lock()
+	defer fake.checkNodeGroupConnectivityMutex.Unlock()
+	fake.CheckNodeGroupConnectivityStub = nil
+	fake.checkNodeGroupConnectivityReturns = struct {
+		result1 *manager.ConnectivityReport
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) CheckNodeGroupConnectivityReturnsOnCall(i int, result1 *manager.ConnectivityReport, result2 error) {
+	fake.checkNodeGroupConnectivityMutex.Lock()
+	defer fake.checkNodeGroupConnectivityMutex.Unlock()
+	fake.CheckNodeGroupConnectivityStub = nil
+	if fake.checkNodeGroupConnectivityReturnsOnCall == nil {
+		fake.checkNodeGroupConnectivityReturnsOnCall = make(map[int]struct {
+			result1 *manager.ConnectivityReport
+			result2 error
+		})
+	}
+	fake.checkNodeGroupConnectivityReturnsOnCall[i] = struct {
+		result1 *manager.ConnectivityReport
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) CheckNodeGroupInstanceTypeOfferings(arg1 *v1alpha5.NodeGroup) error {
+	fake.checkNodeGroupInstanceTypeOfferingsMutex.Lock()
+	ret, specificReturn := fake.checkNodeGroupInstanceTypeOfferingsReturnsOnCall[len(fake.checkNodeGroupInstanceTypeOfferingsArgsForCall)]
+	fake.checkNodeGroupInstanceTypeOfferingsArgsForCall = append(fake.checkNodeGroupInstanceTypeOfferingsArgsForCall, struct {
+		arg1 *v1alpha5.NodeGroup
+	}{arg1})
+	stub := fake.CheckNodeGroupInstanceTypeOfferingsStub
+	fakeReturns := fake.checkNodeGroupInstanceTypeOfferingsReturns
+	fake.recordInvocation("CheckNodeGroupInstanceTypeOfferings", []interface{}{arg1})
+	fake.checkNodeGroupInstanceTypeOfferingsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStackManager) CheckNodeGroupInstanceTypeOfferingsCallCount() int {
+	fake.checkNodeGroupInstanceTypeOfferingsMutex.RLock()
+	defer fake.checkNodeGroupInstanceTypeOfferingsMutex.RUnlock()
+	return len(fake.checkNodeGroupInstanceTypeOfferingsArgsForCall)
+}
+
+func (fake *FakeStackManager) CheckNodeGroupInstanceTypeOfferingsCalls(stub func(*v1alpha5.NodeGroup) error) {
+	fake.checkNodeGroupInstanceTypeOfferingsMutex.Lock()
+	defer fake.checkNodeGroupInstanceTypeOfferingsMutex.Unlock()
+	fake.CheckNodeGroupInstanceTypeOfferingsStub = stub
+}
+
+func (fake *FakeStackManager) CheckNodeGroupInstanceTypeOfferingsArgsForCall(i int) *v1alpha5.NodeGroup {
+	fake.checkNodeGroupInstanceTypeOfferingsMutex.RLock()
+	defer fake.checkNodeGroupInstanceTypeOfferingsMutex.RUnlock()
+	argsForCall := fake.checkNodeGroupInstanceTypeOfferingsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) CheckNodeGroupInstanceTypeOfferingsReturns(result1 error) {
+	fake.checkNodeGroupInstanceTypeOfferingsMutex.Lock()
+	defer fake.checkNodeGroupInstanceTypeOfferingsMutex.Unlock()
+	fake.CheckNodeGroupInstanceTypeOfferingsStub = nil
+	fake.checkNodeGroupInstanceTypeOfferingsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStackManager) CheckNodeGroupInstanceTypeOfferingsReturnsOnCall(i int, result1 error) {
+	fake.checkNodeGroupInstanceTypeOfferingsMutex.Lock()
+	defer fake.checkNodeGroupInstanceTypeOfferingsMutex.Unlock()
+	fake.CheckNodeGroupInstanceTypeOfferingsStub = nil
+	if fake.checkNodeGroupInstanceTypeOfferingsReturnsOnCall == nil {
+		fake.checkNodeGroupInstanceTypeOfferingsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.checkNodeGroupInstanceTypeOfferingsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStackManager) ContinueUpdateRollback(arg1 string, arg2 []string) error {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.continueUpdateRollbackMutex.Lock()
+	ret, specificReturn := fake.continueUpdateRollbackReturnsOnCall[len(fake.continueUpdateRollbackArgsForCall)]
+	fake.continueUpdateRollbackArgsForCall = append(fake.continueUpdateRollbackArgsForCall, struct {
+		arg1 string
+		arg2 []string
+	}{arg1, arg2Copy})
+	stub := fake.ContinueUpdateRollbackStub
+	fakeReturns := fake.continueUpdateRollbackReturns
+	fake.recordInvocation("ContinueUpdateRollback", []interface{}{arg1, arg2Copy})
+	fake.continueUpdateRollbackMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStackManager) ContinueUpdateRollbackCallCount() int {
+	fake.continueUpdateRollbackMutex.RLock()
+	defer fake.continueUpdateRollbackMutex.RUnlock()
+	return len(fake.continueUpdateRollbackArgsForCall)
+}
+
+func (fake *FakeStackManager) ContinueUpdateRollbackCalls(stub func(string, []string) error) {
+	fake.continueUpdateRollbackMutex.Lock()
+	defer fake.continueUpdateRollbackMutex.Unlock()
+	fake.ContinueUpdateRollbackStub = stub
+}
+
+func (fake *FakeStackManager) ContinueUpdateRollbackArgsForCall(i int) (string, []string) {
+	fake.continueUpdateRollbackMutex.RLock()
+	defer fake.continueUpdateRollbackMutex.RUnlock()
+	argsForCall := fake.continueUpdateRollbackArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStackManager) ContinueUpdateRollbackReturns(result1 error) {
+	fake.continueUpdateRollbackMutex.Lock()
+	defer fake.continueUpdateRollbackMutex.Unlock()
+	fake.ContinueUpdateRollbackStub = nil
+	fake.continueUpdateRollbackReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStackManager) ContinueUpdateRollbackReturnsOnCall(i int, result1 error) {
+	fake.continueUpdateRollbackMutex.Lock()
+	defer fake.continueUpdateRollbackMutex.Unlock()
+	fake.ContinueUpdateRollbackStub = nil
+	if fake.continueUpdateRollbackReturnsOnCall == nil {
+		fake.continueUpdateRollbackReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.continueUpdateRollbackReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeStackManager) CreateStack(arg1 string, arg2 builder.ResourceSet, arg3 map[string]string, arg4 map[string]string, arg5 chan error) error {
 	fake.createStackMutex.Lock()
 	ret, specificReturn := fake.createStackReturnsOnCall[len(fake.createStackArgsForCall)]
@@ -894,6 +1747,68 @@ func (fake *FakeStackManager) CreateStackReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeStackManager) DeleteNodeGroupStacks(arg1 context.Context, arg2 int) error {
+	fake.deleteNodeGroupStacksMutex.Lock()
+	ret, specificReturn := fake.deleteNodeGroupStacksReturnsOnCall[len(fake.deleteNodeGroupStacksArgsForCall)]
+	fake.deleteNodeGroupStacksArgsForCall = append(fake.deleteNodeGroupStacksArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.DeleteNodeGroupStacksStub
+	fakeReturns := fake.deleteNodeGroupStacksReturns
+	fake.recordInvocation("DeleteNodeGroupStacks", []interface{}{arg1, arg2})
+	fake.deleteNodeGroupStacksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStackManager) DeleteNodeGroupStacksCallCount() int {
+	fake.deleteNodeGroupStacksMutex.RLock()
+	defer fake.deleteNodeGroupStacksMutex.RUnlock()
+	return len(fake.deleteNodeGroupStacksArgsForCall)
+}
+
+func (fake *FakeStackManager) DeleteNodeGroupStacksCalls(stub func(context.Context, int) error) {
+	fake.deleteNodeGroupStacksMutex.Lock()
+	defer fake.deleteNodeGroupStacksMutex.Unlock()
+	fake.DeleteNodeGroupStacksStub = stub
+}
+
+func (fake *FakeStackManager) DeleteNodeGroupStacksArgsForCall(i int) (context.Context, int) {
+	fake.deleteNodeGroupStacksMutex.RLock()
+	defer fake.deleteNodeGroupStacksMutex.RUnlock()
+	argsForCall := fake.deleteNodeGroupStacksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStackManager) DeleteNodeGroupStacksReturns(result1 error) {
+	fake.deleteNodeGroupStacksMutex.Lock()
+	defer fake.deleteNodeGroupStacksMutex.Unlock()
+	fake.DeleteNodeGroupStacksStub = nil
+	fake.deleteNodeGroupStacksReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStackManager) DeleteNodeGroupStacksReturnsOnCall(i int, result1 error) {
+	fake.deleteNodeGroupStacksMutex.Lock()
+	defer fake.deleteNodeGroupStacksMutex.Unlock()
+	fake.DeleteNodeGroupStacksStub = nil
+	if fake.deleteNodeGroupStacksReturnsOnCall == nil {
+		fake.deleteNodeGroupStacksReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteNodeGroupStacksReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeStackManager) DeleteStackByName(arg1 string) (*cloudformation.Stack, error) {
 	fake.deleteStackByNameMutex.Lock()
 	ret, specificReturn := fake.deleteStackByNameReturnsOnCall[len(fake.deleteStackByNameArgsForCall)]
@@ -1738,6 +2653,70 @@ func (fake *FakeStackManager) DescribeStacksReturnsOnCall(i int, result1 []*clou
 	}{result1, result2}
 }
 
+func (fake *FakeStackManager) DetectNodeGroupDrift(arg1 *v1alpha5.NodeGroup) (*manager.DriftResult, error) {
+	fake.detectNodeGroupDriftMutex.Lock()
+	ret, specificReturn := fake.detectNodeGroupDriftReturnsOnCall[len(fake.detectNodeGroupDriftArgsForCall)]
+	fake.detectNodeGroupDriftArgsForCall = append(fake.detectNodeGroupDriftArgsForCall, struct {
+		arg1 *v1alpha5.NodeGroup
+	}{arg1})
+	stub := fake.DetectNodeGroupDriftStub
+	fakeReturns := fake.detectNodeGroupDriftReturns
+	fake.recordInvocation("DetectNodeGroupDrift", []interface{}{arg1})
+	fake.detectNodeGroupDriftMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) DetectNodeGroupDriftCallCount() int {
+	fake.detectNodeGroupDriftMutex.RLock()
+	defer fake.detectNodeGroupDriftMutex.RUnlock()
+	return len(fake.detectNodeGroupDriftArgsForCall)
+}
+
+func (fake *FakeStackManager) DetectNodeGroupDriftCalls(stub func(*v1alpha5.NodeGroup) (*manager.DriftResult, error)) {
+	fake.detectNodeGroupDriftMutex.Lock()
+	defer fake.detectNodeGroupDriftMutex.Unlock()
+	fake.DetectNodeGroupDriftStub = stub
+}
+
+func (fake *FakeStackManager) DetectNodeGroupDriftArgsForCall(i int) *v1alpha5.NodeGroup {
+	fake.detectNodeGroupDriftMutex.RLock()
+	defer fake.detectNodeGroupDriftMutex.RUnlock()
+	argsForCall := fake.detectNodeGroupDriftArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) DetectNodeGroupDriftReturns(result1 *manager.DriftResult, result2 error) {
+	fake.detectNodeGroupDriftMutex.Lock()
+	defer fake.detectNodeGroupDriftMutex.Unlock()
+	fake.DetectNodeGroupDriftStub = nil
+	fake.detectNodeGroupDriftReturns = struct {
+		result1 *manager.DriftResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) DetectNodeGroupDriftReturnsOnCall(i int, result1 *manager.DriftResult, result2 error) {
+	fake.detectNodeGroupDriftMutex.Lock()
+	defer fake.detectNodeGroupDriftMutex.Unlock()
+	fake.DetectNodeGroupDriftStub = nil
+	if fake.detectNodeGroupDriftReturnsOnCall == nil {
+		fake.detectNodeGroupDriftReturnsOnCall = make(map[int]struct {
+			result1 *manager.DriftResult
+			result2 error
+		})
+	}
+	fake.detectNodeGroupDriftReturnsOnCall[i] = struct {
+		result1 *manager.DriftResult
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeStackManager) DoCreateStackRequest(arg1 *cloudformation.Stack, arg2 manager.TemplateData, arg3 map[string]string, arg4 map[string]string, arg5 bool, arg6 bool) error {
 	fake.doCreateStackRequestMutex.Lock()
 	ret, specificReturn := fake.doCreateStackRequestReturnsOnCall[len(fake.doCreateStackRequestArgsForCall)]
@@ -1918,9 +2897,78 @@ func (fake *FakeStackManager) EnsureMapPublicIPOnLaunchEnabledReturnsOnCall(i in
 	}{result1}
 }
 
-func (fake *FakeStackManager) FixClusterCompatibility() error {
-	fake.fixClusterCompatibilityMutex.Lock()
-	ret, specificReturn := fake.fixClusterCompatibilityReturnsOnCall[len(fake.fixClusterCompatibilityArgsForCall)]
+func (fake *FakeStackManager) FindNodeGroupsUsingInstanceTypes(arg1 []string) ([]*manager.NodeGroupSummary, error) {
+	var arg1Copy []string
+	if arg1 != nil {
+		arg1Copy = make([]string, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.findNodeGroupsUsingInstanceTypesMutex.Lock()
+	ret, specificReturn := fake.findNodeGroupsUsingInstanceTypesReturnsOnCall[len(fake.findNodeGroupsUsingInstanceTypesArgsForCall)]
+	fake.findNodeGroupsUsingInstanceTypesArgsForCall = append(fake.findNodeGroupsUsingInstanceTypesArgsForCall, struct {
+		arg1 []string
+	}{arg1Copy})
+	stub := fake.FindNodeGroupsUsingInstanceTypesStub
+	fakeReturns := fake.findNodeGroupsUsingInstanceTypesReturns
+	fake.recordInvocation("FindNodeGroupsUsingInstanceTypes", []interface{}{arg1Copy})
+	fake.findNodeGroupsUsingInstanceTypesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) FindNodeGroupsUsingInstanceTypesCallCount() int {
+	fake.findNodeGroupsUsingInstanceTypesMutex.RLock()
+	defer fake.findNodeGroupsUsingInstanceTypesMutex.RUnlock()
+	return len(fake.findNodeGroupsUsingInstanceTypesArgsForCall)
+}
+
+func (fake *FakeStackManager) FindNodeGroupsUsingInstanceTypesCalls(stub func([]string) ([]*manager.NodeGroupSummary, error)) {
+	fake.findNodeGroupsUsingInstanceTypesMutex.Lock()
+	defer fake.findNodeGroupsUsingInstanceTypesMutex.Unlock()
+	fake.FindNodeGroupsUsingInstanceTypesStub = stub
+}
+
+func (fake *FakeStackManager) FindNodeGroupsUsingInstanceTypesArgsForCall(i int) []string {
+	fake.findNodeGroupsUsingInstanceTypesMutex.RLock()
+	defer fake.findNodeGroupsUsingInstanceTypesMutex.RUnlock()
+	argsForCall := fake.findNodeGroupsUsingInstanceTypesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) FindNodeGroupsUsingInstanceTypesReturns(result1 []*manager.NodeGroupSummary, result2 error) {
+	fake.findNodeGroupsUsingInstanceTypesMutex.Lock()
+	defer fake.findNodeGroupsUsingInstanceTypesMutex.Unlock()
+	fake.FindNodeGroupsUsingInstanceTypesStub = nil
+	fake.findNodeGroupsUsingInstanceTypesReturns = struct {
+		result1 []*manager.NodeGroupSummary
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) FindNodeGroupsUsingInstanceTypesReturnsOnCall(i int, result1 []*manager.NodeGroupSummary, result2 error) {
+	fake.findNodeGroupsUsingInstanceTypesMutex.Lock()
+	defer fake.findNodeGroupsUsingInstanceTypesMutex.Unlock()
+	fake.FindNodeGroupsUsingInstanceTypesStub = nil
+	if fake.findNodeGroupsUsingInstanceTypesReturnsOnCall == nil {
+		fake.findNodeGroupsUsingInstanceTypesReturnsOnCall = make(map[int]struct {
+			result1 []*manager.NodeGroupSummary
+			result2 error
+		})
+	}
+	fake.findNodeGroupsUsingInstanceTypesReturnsOnCall[i] = struct {
+		result1 []*manager.NodeGroupSummary
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) FixClusterCompatibility() error {
+	fake.fixClusterCompatibilityMutex.Lock()
+	ret, specificReturn := fake.fixClusterCompatibilityReturnsOnCall[len(fake.fixClusterCompatibilityArgsForCall)]
 	fake.fixClusterCompatibilityArgsForCall = append(fake.fixClusterCompatibilityArgsForCall, struct {
 	}{})
 	stub := fake.FixClusterCompatibilityStub
@@ -1971,6 +3019,134 @@ func (fake *FakeStackManager) FixClusterCompatibilityReturnsOnCall(i int, result
 	}{result1}
 }
 
+func (fake *FakeStackManager) GenerateNodeGroupCapacityReport(arg1 kubeclient.Interface) (*manager.CapacityReport, error) {
+	fake.generateNodeGroupCapacityReportMutex.Lock()
+	ret, specificReturn := fake.generateNodeGroupCapacityReportReturnsOnCall[len(fake.generateNodeGroupCapacityReportArgsForCall)]
+	fake.generateNodeGroupCapacityReportArgsForCall = append(fake.generateNodeGroupCapacityReportArgsForCall, struct {
+		arg1 kubeclient.Interface
+	}{arg1})
+	stub := fake.GenerateNodeGroupCapacityReportStub
+	fakeReturns := fake.generateNodeGroupCapacityReportReturns
+	fake.recordInvocation("GenerateNodeGroupCapacityReport", []interface{}{arg1})
+	fake.generateNodeGroupCapacityReportMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) GenerateNodeGroupCapacityReportCallCount() int {
+	fake.generateNodeGroupCapacityReportMutex.RLock()
+	defer fake.generateNodeGroupCapacityReportMutex.RUnlock()
+	return len(fake.generateNodeGroupCapacityReportArgsForCall)
+}
+
+func (fake *FakeStackManager) GenerateNodeGroupCapacityReportCalls(stub func(kubeclient.Interface) (*manager.CapacityReport, error)) {
+	fake.generateNodeGroupCapacityReportMutex.Lock()
+	defer fake.generateNodeGroupCapacityReportMutex.Unlock()
+	fake.GenerateNodeGroupCapacityReportStub = stub
+}
+
+func (fake *FakeStackManager) GenerateNodeGroupCapacityReportArgsForCall(i int) kubeclient.Interface {
+	fake.generateNodeGroupCapacityReportMutex.RLock()
+	defer fake.generateNodeGroupCapacityReportMutex.RUnlock()
+	argsForCall := fake.generateNodeGroupCapacityReportArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) GenerateNodeGroupCapacityReportReturns(result1 *manager.CapacityReport, result2 error) {
+	fake.generateNodeGroupCapacityReportMutex.Lock()
+	defer fake.generateNodeGroupCapacityReportMutex.Unlock()
+	fake.GenerateNodeGroupCapacityReportStub = nil
+	fake.generateNodeGroupCapacityReportReturns = struct {
+		result1 *manager.CapacityReport
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GenerateNodeGroupCapacityReportReturnsOnCall(i int, result1 *manager.CapacityReport, result2 error) {
+	fake.generateNodeGroupCapacityReportMutex.Lock()
+	defer fake.generateNodeGroupCapacityReportMutex.Unlock()
+	fake.GenerateNodeGroupCapacityReportStub = nil
+	if fake.generateNodeGroupCapacityReportReturnsOnCall == nil {
+		fake.generateNodeGroupCapacityReportReturnsOnCall = make(map[int]struct {
+			result1 *manager.CapacityReport
+			result2 error
+		})
+	}
+	fake.generateNodeGroupCapacityReportReturnsOnCall[i] = struct {
+		result1 *manager.CapacityReport
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GenerateNodeGroupConfig(arg1 string) (*v1alpha5.NodeGroup, error) {
+	fake.generateNodeGroupConfigMutex.Lock()
+	ret, specificReturn := fake.generateNodeGroupConfigReturnsOnCall[len(fake.generateNodeGroupConfigArgsForCall)]
+	fake.generateNodeGroupConfigArgsForCall = append(fake.generateNodeGroupConfigArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GenerateNodeGroupConfigStub
+	fakeReturns := fake.generateNodeGroupConfigReturns
+	fake.recordInvocation("GenerateNodeGroupConfig", []interface{}{arg1})
+	fake.generateNodeGroupConfigMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) GenerateNodeGroupConfigCallCount() int {
+	fake.generateNodeGroupConfigMutex.RLock()
+	defer fake.generateNodeGroupConfigMutex.RUnlock()
+	return len(fake.generateNodeGroupConfigArgsForCall)
+}
+
+func (fake *FakeStackManager) GenerateNodeGroupConfigCalls(stub func(string) (*v1alpha5.NodeGroup, error)) {
+	fake.generateNodeGroupConfigMutex.Lock()
+	defer fake.generateNodeGroupConfigMutex.Unlock()
+	fake.GenerateNodeGroupConfigStub = stub
+}
+
+func (fake *FakeStackManager) GenerateNodeGroupConfigArgsForCall(i int) string {
+	fake.generateNodeGroupConfigMutex.RLock()
+	defer fake.generateNodeGroupConfigMutex.RUnlock()
+	argsForCall := fake.generateNodeGroupConfigArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) GenerateNodeGroupConfigReturns(result1 *v1alpha5.NodeGroup, result2 error) {
+	fake.generateNodeGroupConfigMutex.Lock()
+	defer fake.generateNodeGroupConfigMutex.Unlock()
+	fake.GenerateNodeGroupConfigStub = nil
+	fake.generateNodeGroupConfigReturns = struct {
+		result1 *v1alpha5.NodeGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GenerateNodeGroupConfigReturnsOnCall(i int, result1 *v1alpha5.NodeGroup, result2 error) {
+	fake.generateNodeGroupConfigMutex.Lock()
+	defer fake.generateNodeGroupConfigMutex.Unlock()
+	fake.GenerateNodeGroupConfigStub = nil
+	if fake.generateNodeGroupConfigReturnsOnCall == nil {
+		fake.generateNodeGroupConfigReturnsOnCall = make(map[int]struct {
+			result1 *v1alpha5.NodeGroup
+			result2 error
+		})
+	}
+	fake.generateNodeGroupConfigReturnsOnCall[i] = struct {
+		result1 *v1alpha5.NodeGroup
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeStackManager) GetAutoScalingGroupName(arg1 *cloudformation.Stack) (string, error) {
 	fake.getAutoScalingGroupNameMutex.Lock()
 	ret, specificReturn := fake.getAutoScalingGroupNameReturnsOnCall[len(fake.getAutoScalingGroupNameArgsForCall)]
@@ -2264,6 +3440,70 @@ func (fake *FakeStackManager) GetIAMServiceAccountsReturnsOnCall(i int, result1
 	}{result1, result2}
 }
 
+func (fake *FakeStackManager) GetManagedNodeGroup(arg1 *v1alpha5.NodeGroup) (*eks.Nodegroup, error) {
+	fake.getManagedNodeGroupMutex.Lock()
+	ret, specificReturn := fake.getManagedNodeGroupReturnsOnCall[len(fake.getManagedNodeGroupArgsForCall)]
+	fake.getManagedNodeGroupArgsForCall = append(fake.getManagedNodeGroupArgsForCall, struct {
+		arg1 *v1alpha5.NodeGroup
+	}{arg1})
+	stub := fake.GetManagedNodeGroupStub
+	fakeReturns := fake.getManagedNodeGroupReturns
+	fake.recordInvocation("GetManagedNodeGroup", []interface{}{arg1})
+	fake.getManagedNodeGroupMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) GetManagedNodeGroupCallCount() int {
+	fake.getManagedNodeGroupMutex.RLock()
+	defer fake.getManagedNodeGroupMutex.RUnlock()
+	return len(fake.getManagedNodeGroupArgsForCall)
+}
+
+func (fake *FakeStackManager) GetManagedNodeGroupCalls(stub func(*v1alpha5.NodeGroup) (*eks.Nodegroup, error)) {
+	fake.getManagedNodeGroupMutex.Lock()
+	defer fake.getManagedNodeGroupMutex.Unlock()
+	fake.GetManagedNodeGroupStub = stub
+}
+
+func (fake *FakeStackManager) GetManagedNodeGroupArgsForCall(i int) *v1alpha5.NodeGroup {
+	fake.getManagedNodeGroupMutex.RLock()
+	defer fake.getManagedNodeGroupMutex.RUnlock()
+	argsForCall := fake.getManagedNodeGroupArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) GetManagedNodeGroupReturns(result1 *eks.Nodegroup, result2 error) {
+	fake.getManagedNodeGroupMutex.Lock()
+	defer fake.getManagedNodeGroupMutex.Unlock()
+	fake.GetManagedNodeGroupStub = nil
+	fake.getManagedNodeGroupReturns = struct {
+		result1 *eks.Nodegroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) GetManagedNodeGroupReturnsOnCall(i int, result1 *eks.Nodegroup, result2 error) {
+	fake.getManagedNodeGroupMutex.Lock()
+	defer fake.getManagedNodeGroupMutex.Unlock()
+	fake.GetManagedNodeGroupStub = nil
+	if fake.getManagedNodeGroupReturnsOnCall == nil {
+		fake.getManagedNodeGroupReturnsOnCall = make(map[int]struct {
+			result1 *eks.Nodegroup
+			result2 error
+		})
+	}
+	fake.getManagedNodeGroupReturnsOnCall[i] = struct {
+		result1 *eks.Nodegroup
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeStackManager) GetManagedNodeGroupAutoScalingGroupName(arg1 *cloudformation.Stack) (string, error) {
 	fake.getManagedNodeGroupAutoScalingGroupNameMutex.Lock()
 	ret, specificReturn := fake.getManagedNodeGroupAutoScalingGroupNameReturnsOnCall[len(fake.getManagedNodeGroupAutoScalingGroupNameArgsForCall)]
@@ -2456,77 +3696,72 @@ func (fake *FakeStackManager) GetNodeGroupAutoScalingGroupNameReturnsOnCall(i in
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) GetNodeGroupName(arg1 *cloudformation.Stack) string {
-	fake.getNodeGroupNameMutex.Lock()
-	ret, specificReturn := fake.getNodeGroupNameReturnsOnCall[len(fake.getNodeGroupNameArgsForCall)]
-	fake.getNodeGroupNameArgsForCall = append(fake.getNodeGroupNameArgsForCall, struct {
-		arg1 *cloudformation.Stack
-	}{arg1})
-	stub := fake.GetNodeGroupNameStub
-	fakeReturns := fake.getNodeGroupNameReturns
-	fake.recordInvocation("GetNodeGroupName", []interface{}{arg1})
-	fake.getNodeGroupNameMutex.Unlock()
+func (fake *FakeStackManager) GetNodeGroupIAMRoles() (map[string]manager.NodeGroupIAMRole, error) {
+	fake.getNodeGroupIAMRolesMutex.Lock()
+	ret, specificReturn := fake.getNodeGroupIAMRolesReturnsOnCall[len(fake.getNodeGroupIAMRolesArgsForCall)]
+	fake.getNodeGroupIAMRolesArgsForCall = append(fake.getNodeGroupIAMRolesArgsForCall, struct {
+	}{})
+	stub := fake.GetNodeGroupIAMRolesStub
+	fakeReturns := fake.getNodeGroupIAMRolesReturns
+	fake.recordInvocation("GetNodeGroupIAMRoles", []interface{}{})
+	fake.getNodeGroupIAMRolesMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub()
 	}
 	if specificReturn {
-		return ret.result1
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1
-}
-
-func (fake *FakeStackManager) GetNodeGroupNameCallCount() int {
-	fake.getNodeGroupNameMutex.RLock()
-	defer fake.getNodeGroupNameMutex.RUnlock()
-	return len(fake.getNodeGroupNameArgsForCall)
+	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStackManager) GetNodeGroupNameCalls(stub func(*cloudformation.Stack) string) {
-	fake.getNodeGroupNameMutex.Lock()
-	defer fake.getNodeGroupNameMutex.Unlock()
-	fake.GetNodeGroupNameStub = stub
+func (fake *FakeStackManager) GetNodeGroupIAMRolesCallCount() int {
+	fake.getNodeGroupIAMRolesMutex.RLock()
+	defer fake.getNodeGroupIAMRolesMutex.RUnlock()
+	return len(fake.getNodeGroupIAMRolesArgsForCall)
 }
 
-func (fake *FakeStackManager) GetNodeGroupNameArgsForCall(i int) *cloudformation.Stack {
-	fake.getNodeGroupNameMutex.RLock()
-	defer fake.getNodeGroupNameMutex.RUnlock()
-	argsForCall := fake.getNodeGroupNameArgsForCall[i]
-	return argsForCall.arg1
+func (fake *FakeStackManager) GetNodeGroupIAMRolesCalls(stub func() (map[string]manager.NodeGroupIAMRole, error)) {
+	fake.getNodeGroupIAMRolesMutex.Lock()
+	defer fake.getNodeGroupIAMRolesMutex.Unlock()
+	fake.GetNodeGroupIAMRolesStub = stub
 }
 
-func (fake *FakeStackManager) GetNodeGroupNameReturns(result1 string) {
-	fake.getNodeGroupNameMutex.Lock()
-	defer fake.getNodeGroupNameMutex.Unlock()
-	fake.GetNodeGroupNameStub = nil
-	fake.getNodeGroupNameReturns = struct {
-		result1 string
-	}{result1}
+func (fake *FakeStackManager) GetNodeGroupIAMRolesReturns(result1 map[string]manager.NodeGroupIAMRole, result2 error) {
+	fake.getNodeGroupIAMRolesMutex.Lock()
+	defer fake.getNodeGroupIAMRolesMutex.Unlock()
+	fake.GetNodeGroupIAMRolesStub = nil
+	fake.getNodeGroupIAMRolesReturns = struct {
+		result1 map[string]manager.NodeGroupIAMRole
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeStackManager) GetNodeGroupNameReturnsOnCall(i int, result1 string) {
-	fake.getNodeGroupNameMutex.Lock()
-	defer fake.getNodeGroupNameMutex.Unlock()
-	fake.GetNodeGroupNameStub = nil
-	if fake.getNodeGroupNameReturnsOnCall == nil {
-		fake.getNodeGroupNameReturnsOnCall = make(map[int]struct {
-			result1 string
+func (fake *FakeStackManager) GetNodeGroupIAMRolesReturnsOnCall(i int, result1 map[string]manager.NodeGroupIAMRole, result2 error) {
+	fake.getNodeGroupIAMRolesMutex.Lock()
+	defer fake.getNodeGroupIAMRolesMutex.Unlock()
+	fake.GetNodeGroupIAMRolesStub = nil
+	if fake.getNodeGroupIAMRolesReturnsOnCall == nil {
+		fake.getNodeGroupIAMRolesReturnsOnCall = make(map[int]struct {
+			result1 map[string]manager.NodeGroupIAMRole
+			result2 error
 		})
 	}
-	fake.getNodeGroupNameReturnsOnCall[i] = struct {
-		result1 string
-	}{result1}
+	fake.getNodeGroupIAMRolesReturnsOnCall[i] = struct {
+		result1 map[string]manager.NodeGroupIAMRole
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeStackManager) GetNodeGroupStackType(arg1 string) (v1alpha5.NodeGroupType, error) {
-	fake.getNodeGroupStackTypeMutex.Lock()
-	ret, specificReturn := fake.getNodeGroupStackTypeReturnsOnCall[len(fake.getNodeGroupStackTypeArgsForCall)]
-	fake.getNodeGroupStackTypeArgsForCall = append(fake.getNodeGroupStackTypeArgsForCall, struct {
-		arg1 string
+func (fake *FakeStackManager) GetNodeGroupInstanceHealth(arg1 *v1alpha5.NodeGroup) ([]manager.InstanceHealth, error) {
+	fake.getNodeGroupInstanceHealthMutex.Lock()
+	ret, specificReturn := fake.getNodeGroupInstanceHealthReturnsOnCall[len(fake.getNodeGroupInstanceHealthArgsForCall)]
+	fake.getNodeGroupInstanceHealthArgsForCall = append(fake.getNodeGroupInstanceHealthArgsForCall, struct {
+		arg1 *v1alpha5.NodeGroup
 	}{arg1})
-	stub := fake.GetNodeGroupStackTypeStub
-	fakeReturns := fake.getNodeGroupStackTypeReturns
-	fake.recordInvocation("GetNodeGroupStackType", []interface{}{arg1})
-	fake.getNodeGroupStackTypeMutex.Unlock()
+	stub := fake.GetNodeGroupInstanceHealthStub
+	fakeReturns := fake.getNodeGroupInstanceHealthReturns
+	fake.recordInvocation("GetNodeGroupInstanceHealth", []interface{}{arg1})
+	fake.getNodeGroupInstanceHealthMutex.Unlock()
 	if stub != nil {
 		return stub(arg1)
 	}
@@ -2536,61 +3771,61 @@ func (fake *FakeStackManager) GetNodeGroupStackType(arg1 string) (v1alpha5.NodeG
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStackManager) GetNodeGroupStackTypeCallCount() int {
-	fake.getNodeGroupStackTypeMutex.RLock()
-	defer fake.getNodeGroupStackTypeMutex.RUnlock()
-	return len(fake.getNodeGroupStackTypeArgsForCall)
+func (fake *FakeStackManager) GetNodeGroupInstanceHealthCallCount() int {
+	fake.getNodeGroupInstanceHealthMutex.RLock()
+	defer fake.getNodeGroupInstanceHealthMutex.RUnlock()
+	return len(fake.getNodeGroupInstanceHealthArgsForCall)
 }
 
-func (fake *FakeStackManager) GetNodeGroupStackTypeCalls(stub func(string) (v1alpha5.NodeGroupType, error)) {
-	fake.getNodeGroupStackTypeMutex.Lock()
-	defer fake.getNodeGroupStackTypeMutex.Unlock()
-	fake.GetNodeGroupStackTypeStub = stub
+func (fake *FakeStackManager) GetNodeGroupInstanceHealthCalls(stub func(*v1alpha5.NodeGroup) ([]manager.InstanceHealth, error)) {
+	fake.getNodeGroupInstanceHealthMutex.Lock()
+	defer fake.getNodeGroupInstanceHealthMutex.Unlock()
+	fake.GetNodeGroupInstanceHealthStub = stub
 }
 
-func (fake *FakeStackManager) GetNodeGroupStackTypeArgsForCall(i int) string {
-	fake.getNodeGroupStackTypeMutex.RLock()
-	defer fake.getNodeGroupStackTypeMutex.RUnlock()
-	argsForCall := fake.getNodeGroupStackTypeArgsForCall[i]
+func (fake *FakeStackManager) GetNodeGroupInstanceHealthArgsForCall(i int) *v1alpha5.NodeGroup {
+	fake.getNodeGroupInstanceHealthMutex.RLock()
+	defer fake.getNodeGroupInstanceHealthMutex.RUnlock()
+	argsForCall := fake.getNodeGroupInstanceHealthArgsForCall[i]
 	return argsForCall.arg1
 }
 
-func (fake *FakeStackManager) GetNodeGroupStackTypeReturns(result1 v1alpha5.NodeGroupType, result2 error) {
-	fake.getNodeGroupStackTypeMutex.Lock()
-	defer fake.getNodeGroupStackTypeMutex.Unlock()
-	fake.GetNodeGroupStackTypeStub = nil
-	fake.getNodeGroupStackTypeReturns = struct {
-		result1 v1alpha5.NodeGroupType
+func (fake *FakeStackManager) GetNodeGroupInstanceHealthReturns(result1 []manager.InstanceHealth, result2 error) {
+	fake.getNodeGroupInstanceHealthMutex.Lock()
+	defer fake.getNodeGroupInstanceHealthMutex.Unlock()
+	fake.GetNodeGroupInstanceHealthStub = nil
+	fake.getNodeGroupInstanceHealthReturns = struct {
+		result1 []manager.InstanceHealth
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) GetNodeGroupStackTypeReturnsOnCall(i int, result1 v1alpha5.NodeGroupType, result2 error) {
-	fake.getNodeGroupStackTypeMutex.Lock()
-	defer fake.getNodeGroupStackTypeMutex.Unlock()
-	fake.GetNodeGroupStackTypeStub = nil
-	if fake.getNodeGroupStackTypeReturnsOnCall == nil {
-		fake.getNodeGroupStackTypeReturnsOnCall = make(map[int]struct {
-			result1 v1alpha5.NodeGroupType
+func (fake *FakeStackManager) GetNodeGroupInstanceHealthReturnsOnCall(i int, result1 []manager.InstanceHealth, result2 error) {
+	fake.getNodeGroupInstanceHealthMutex.Lock()
+	defer fake.getNodeGroupInstanceHealthMutex.Unlock()
+	fake.GetNodeGroupInstanceHealthStub = nil
+	if fake.getNodeGroupInstanceHealthReturnsOnCall == nil {
+		fake.getNodeGroupInstanceHealthReturnsOnCall = make(map[int]struct {
+			result1 []manager.InstanceHealth
 			result2 error
 		})
 	}
-	fake.getNodeGroupStackTypeReturnsOnCall[i] = struct {
-		result1 v1alpha5.NodeGroupType
+	fake.getNodeGroupInstanceHealthReturnsOnCall[i] = struct {
+		result1 []manager.InstanceHealth
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) GetNodeGroupSummaries(arg1 string) ([]*manager.NodeGroupSummary, error) {
-	fake.getNodeGroupSummariesMutex.Lock()
-	ret, specificReturn := fake.getNodeGroupSummariesReturnsOnCall[len(fake.getNodeGroupSummariesArgsForCall)]
-	fake.getNodeGroupSummariesArgsForCall = append(fake.getNodeGroupSummariesArgsForCall, struct {
-		arg1 string
+func (fake *FakeStackManager) GetNodeGroupInstanceIDs(arg1 *v1alpha5.NodeGroup) ([]string, error) {
+	fake.getNodeGroupInstanceIDsMutex.Lock()
+	ret, specificReturn := fake.getNodeGroupInstanceIDsReturnsOnCall[len(fake.getNodeGroupInstanceIDsArgsForCall)]
+	fake.getNodeGroupInstanceIDsArgsForCall = append(fake.getNodeGroupInstanceIDsArgsForCall, struct {
+		arg1 *v1alpha5.NodeGroup
 	}{arg1})
-	stub := fake.GetNodeGroupSummariesStub
-	fakeReturns := fake.getNodeGroupSummariesReturns
-	fake.recordInvocation("GetNodeGroupSummaries", []interface{}{arg1})
-	fake.getNodeGroupSummariesMutex.Unlock()
+	stub := fake.GetNodeGroupInstanceIDsStub
+	fakeReturns := fake.getNodeGroupInstanceIDsReturns
+	fake.recordInvocation("GetNodeGroupInstanceIDs", []interface{}{arg1})
+	fake.getNodeGroupInstanceIDsMutex.Unlock()
 	if stub != nil {
 		return stub(arg1)
 	}
@@ -2600,63 +3835,64 @@ func (fake *FakeStackManager) GetNodeGroupSummaries(arg1 string) ([]*manager.Nod
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStackManager) GetNodeGroupSummariesCallCount() int {
-	fake.getNodeGroupSummariesMutex.RLock()
-	defer fake.getNodeGroupSummariesMutex.RUnlock()
-	return len(fake.getNodeGroupSummariesArgsForCall)
+func (fake *FakeStackManager) GetNodeGroupInstanceIDsCallCount() int {
+	fake.getNodeGroupInstanceIDsMutex.RLock()
+	defer fake.getNodeGroupInstanceIDsMutex.RUnlock()
+	return len(fake.getNodeGroupInstanceIDsArgsForCall)
 }
 
-func (fake *FakeStackManager) GetNodeGroupSummariesCalls(stub func(string) ([]*manager.NodeGroupSummary, error)) {
-	fake.getNodeGroupSummariesMutex.Lock()
-	defer fake.getNodeGroupSummariesMutex.Unlock()
-	fake.GetNodeGroupSummariesStub = stub
+func (fake *FakeStackManager) GetNodeGroupInstanceIDsCalls(stub func(*v1alpha5.NodeGroup) ([]string, error)) {
+	fake.getNodeGroupInstanceIDsMutex.Lock()
+	defer fake.getNodeGroupInstanceIDsMutex.Unlock()
+	fake.GetNodeGroupInstanceIDsStub = stub
 }
 
-func (fake *FakeStackManager) GetNodeGroupSummariesArgsForCall(i int) string {
-	fake.getNodeGroupSummariesMutex.RLock()
-	defer fake.getNodeGroupSummariesMutex.RUnlock()
-	argsForCall := fake.getNodeGroupSummariesArgsForCall[i]
+func (fake *FakeStackManager) GetNodeGroupInstanceIDsArgsForCall(i int) *v1alpha5.NodeGroup {
+	fake.getNodeGroupInstanceIDsMutex.RLock()
+	defer fake.getNodeGroupInstanceIDsMutex.RUnlock()
+	argsForCall := fake.getNodeGroupInstanceIDsArgsForCall[i]
 	return argsForCall.arg1
 }
 
-func (fake *FakeStackManager) GetNodeGroupSummariesReturns(result1 []*manager.NodeGroupSummary, result2 error) {
-	fake.getNodeGroupSummariesMutex.Lock()
-	defer fake.getNodeGroupSummariesMutex.Unlock()
-	fake.GetNodeGroupSummariesStub = nil
-	fake.getNodeGroupSummariesReturns = struct {
-		result1 []*manager.NodeGroupSummary
+func (fake *FakeStackManager) GetNodeGroupInstanceIDsReturns(result1 []string, result2 error) {
+	fake.getNodeGroupInstanceIDsMutex.Lock()
+	defer fake.getNodeGroupInstanceIDsMutex.Unlock()
+	fake.GetNodeGroupInstanceIDsStub = nil
+	fake.getNodeGroupInstanceIDsReturns = struct {
+		result1 []string
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) GetNodeGroupSummariesReturnsOnCall(i int, result1 []*manager.NodeGroupSummary, result2 error) {
-	fake.getNodeGroupSummariesMutex.Lock()
-	defer fake.getNodeGroupSummariesMutex.Unlock()
-	fake.GetNodeGroupSummariesStub = nil
-	if fake.getNodeGroupSummariesReturnsOnCall == nil {
-		fake.getNodeGroupSummariesReturnsOnCall = make(map[int]struct {
-			result1 []*manager.NodeGroupSummary
+func (fake *FakeStackManager) GetNodeGroupInstanceIDsReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.getNodeGroupInstanceIDsMutex.Lock()
+	defer fake.getNodeGroupInstanceIDsMutex.Unlock()
+	fake.GetNodeGroupInstanceIDsStub = nil
+	if fake.getNodeGroupInstanceIDsReturnsOnCall == nil {
+		fake.getNodeGroupInstanceIDsReturnsOnCall = make(map[int]struct {
+			result1 []string
 			result2 error
 		})
 	}
-	fake.getNodeGroupSummariesReturnsOnCall[i] = struct {
-		result1 []*manager.NodeGroupSummary
+	fake.getNodeGroupInstanceIDsReturnsOnCall[i] = struct {
+		result1 []string
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) GetStackTemplate(arg1 string) (string, error) {
-	fake.getStackTemplateMutex.Lock()
-	ret, specificReturn := fake.getStackTemplateReturnsOnCall[len(fake.getStackTemplateArgsForCall)]
-	fake.getStackTemplateArgsForCall = append(fake.getStackTemplateArgsForCall, struct {
-		arg1 string
-	}{arg1})
-	stub := fake.GetStackTemplateStub
-	fakeReturns := fake.getStackTemplateReturns
-	fake.recordInvocation("GetStackTemplate", []interface{}{arg1})
-	fake.getStackTemplateMutex.Unlock()
+func (fake *FakeStackManager) GetNodeGroupKubeletVersion(arg1 *v1alpha5.NodeGroup, arg2 kubeclient.Interface) (string, error) {
+	fake.getNodeGroupKubeletVersionMutex.Lock()
+	ret, specificReturn := fake.getNodeGroupKubeletVersionReturnsOnCall[len(fake.getNodeGroupKubeletVersionArgsForCall)]
+	fake.getNodeGroupKubeletVersionArgsForCall = append(fake.getNodeGroupKubeletVersionArgsForCall, struct {
+		arg1 *v1alpha5.NodeGroup
+		arg2 kubeclient.Interface
+	}{arg1, arg2})
+	stub := fake.GetNodeGroupKubeletVersionStub
+	fakeReturns := fake.getNodeGroupKubeletVersionReturns
+	fake.recordInvocation("GetNodeGroupKubeletVersion", []interface{}{arg1, arg2})
+	fake.getNodeGroupKubeletVersionMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -2664,122 +3900,190 @@ func (fake *FakeStackManager) GetStackTemplate(arg1 string) (string, error) {
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStackManager) GetStackTemplateCallCount() int {
-	fake.getStackTemplateMutex.RLock()
-	defer fake.getStackTemplateMutex.RUnlock()
-	return len(fake.getStackTemplateArgsForCall)
+func (fake *FakeStackManager) GetNodeGroupKubeletVersionCallCount() int {
+	fake.getNodeGroupKubeletVersionMutex.RLock()
+	defer fake.getNodeGroupKubeletVersionMutex.RUnlock()
+	return len(fake.getNodeGroupKubeletVersionArgsForCall)
 }
 
-func (fake *FakeStackManager) GetStackTemplateCalls(stub func(string) (string, error)) {
-	fake.getStackTemplateMutex.Lock()
-	defer fake.getStackTemplateMutex.Unlock()
-	fake.GetStackTemplateStub = stub
+func (fake *FakeStackManager) GetNodeGroupKubeletVersionCalls(stub func(*v1alpha5.NodeGroup, kubeclient.Interface) (string, error)) {
+	fake.getNodeGroupKubeletVersionMutex.Lock()
+	defer fake.getNodeGroupKubeletVersionMutex.Unlock()
+	fake.GetNodeGroupKubeletVersionStub = stub
 }
 
-func (fake *FakeStackManager) GetStackTemplateArgsForCall(i int) string {
-	fake.getStackTemplateMutex.RLock()
-	defer fake.getStackTemplateMutex.RUnlock()
-	argsForCall := fake.getStackTemplateArgsForCall[i]
-	return argsForCall.arg1
+func (fake *FakeStackManager) GetNodeGroupKubeletVersionArgsForCall(i int) (*v1alpha5.NodeGroup, kubeclient.Interface) {
+	fake.getNodeGroupKubeletVersionMutex.RLock()
+	defer fake.getNodeGroupKubeletVersionMutex.RUnlock()
+	argsForCall := fake.getNodeGroupKubeletVersionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeStackManager) GetStackTemplateReturns(result1 string, result2 error) {
-	fake.getStackTemplateMutex.Lock()
-	defer fake.getStackTemplateMutex.Unlock()
-	fake.GetStackTemplateStub = nil
-	fake.getStackTemplateReturns = struct {
+func (fake *FakeStackManager) GetNodeGroupKubeletVersionReturns(result1 string, result2 error) {
+	fake.getNodeGroupKubeletVersionMutex.Lock()
+	defer fake.getNodeGroupKubeletVersionMutex.Unlock()
+	fake.GetNodeGroupKubeletVersionStub = nil
+	fake.getNodeGroupKubeletVersionReturns = struct {
 		result1 string
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) GetStackTemplateReturnsOnCall(i int, result1 string, result2 error) {
-	fake.getStackTemplateMutex.Lock()
-	defer fake.getStackTemplateMutex.Unlock()
-	fake.GetStackTemplateStub = nil
-	if fake.getStackTemplateReturnsOnCall == nil {
-		fake.getStackTemplateReturnsOnCall = make(map[int]struct {
+func (fake *FakeStackManager) GetNodeGroupKubeletVersionReturnsOnCall(i int, result1 string, result2 error) {
+	fake.getNodeGroupKubeletVersionMutex.Lock()
+	defer fake.getNodeGroupKubeletVersionMutex.Unlock()
+	fake.GetNodeGroupKubeletVersionStub = nil
+	if fake.getNodeGroupKubeletVersionReturnsOnCall == nil {
+		fake.getNodeGroupKubeletVersionReturnsOnCall = make(map[int]struct {
 			result1 string
 			result2 error
 		})
 	}
-	fake.getStackTemplateReturnsOnCall[i] = struct {
+	fake.getNodeGroupKubeletVersionReturnsOnCall[i] = struct {
 		result1 string
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) HasClusterStack() (bool, error) {
-	fake.hasClusterStackMutex.Lock()
-	ret, specificReturn := fake.hasClusterStackReturnsOnCall[len(fake.hasClusterStackArgsForCall)]
-	fake.hasClusterStackArgsForCall = append(fake.hasClusterStackArgsForCall, struct {
-	}{})
-	stub := fake.HasClusterStackStub
-	fakeReturns := fake.hasClusterStackReturns
-	fake.recordInvocation("HasClusterStack", []interface{}{})
-	fake.hasClusterStackMutex.Unlock()
+func (fake *FakeStackManager) GetNodeGroupName(arg1 *cloudformation.Stack) string {
+	fake.getNodeGroupNameMutex.Lock()
+	ret, specificReturn := fake.getNodeGroupNameReturnsOnCall[len(fake.getNodeGroupNameArgsForCall)]
+	fake.getNodeGroupNameArgsForCall = append(fake.getNodeGroupNameArgsForCall, struct {
+		arg1 *cloudformation.Stack
+	}{arg1})
+	stub := fake.GetNodeGroupNameStub
+	fakeReturns := fake.getNodeGroupNameReturns
+	fake.recordInvocation("GetNodeGroupName", []interface{}{arg1})
+	fake.getNodeGroupNameMutex.Unlock()
 	if stub != nil {
-		return stub()
+		return stub(arg1)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1
 	}
-	return fakeReturns.result1, fakeReturns.result2
+	return fakeReturns.result1
 }
 
-func (fake *FakeStackManager) HasClusterStackCallCount() int {
-	fake.hasClusterStackMutex.RLock()
-	defer fake.hasClusterStackMutex.RUnlock()
-	return len(fake.hasClusterStackArgsForCall)
+func (fake *FakeStackManager) GetNodeGroupNameCallCount() int {
+	fake.getNodeGroupNameMutex.RLock()
+	defer fake.getNodeGroupNameMutex.RUnlock()
+	return len(fake.getNodeGroupNameArgsForCall)
 }
 
-func (fake *FakeStackManager) HasClusterStackCalls(stub func() (bool, error)) {
-	fake.hasClusterStackMutex.Lock()
-	defer fake.hasClusterStackMutex.Unlock()
-	fake.HasClusterStackStub = stub
+func (fake *FakeStackManager) GetNodeGroupNameCalls(stub func(*cloudformation.Stack) string) {
+	fake.getNodeGroupNameMutex.Lock()
+	defer fake.getNodeGroupNameMutex.Unlock()
+	fake.GetNodeGroupNameStub = stub
 }
 
-func (fake *FakeStackManager) HasClusterStackReturns(result1 bool, result2 error) {
-	fake.hasClusterStackMutex.Lock()
-	defer fake.hasClusterStackMutex.Unlock()
-	fake.HasClusterStackStub = nil
-	fake.hasClusterStackReturns = struct {
-		result1 bool
-		result2 error
-	}{result1, result2}
+func (fake *FakeStackManager) GetNodeGroupNameArgsForCall(i int) *cloudformation.Stack {
+	fake.getNodeGroupNameMutex.RLock()
+	defer fake.getNodeGroupNameMutex.RUnlock()
+	argsForCall := fake.getNodeGroupNameArgsForCall[i]
+	return argsForCall.arg1
 }
 
-func (fake *FakeStackManager) HasClusterStackReturnsOnCall(i int, result1 bool, result2 error) {
-	fake.hasClusterStackMutex.Lock()
-	defer fake.hasClusterStackMutex.Unlock()
-	fake.HasClusterStackStub = nil
-	if fake.hasClusterStackReturnsOnCall == nil {
-		fake.hasClusterStackReturnsOnCall = make(map[int]struct {
-			result1 bool
-			result2 error
+func (fake *FakeStackManager) GetNodeGroupNameReturns(result1 string) {
+	fake.getNodeGroupNameMutex.Lock()
+	defer fake.getNodeGroupNameMutex.Unlock()
+	fake.GetNodeGroupNameStub = nil
+	fake.getNodeGroupNameReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeStackManager) GetNodeGroupNameReturnsOnCall(i int, result1 string) {
+	fake.getNodeGroupNameMutex.Lock()
+	defer fake.getNodeGroupNameMutex.Unlock()
+	fake.GetNodeGroupNameStub = nil
+	if fake.getNodeGroupNameReturnsOnCall == nil {
+		fake.getNodeGroupNameReturnsOnCall = make(map[int]struct {
+			result1 string
 		})
 	}
-	fake.hasClusterStackReturnsOnCall[i] = struct {
-		result1 bool
-		result2 error
-	}{result1, result2}
+	fake.getNodeGroupNameReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
 }
 
-func (fake *FakeStackManager) HasClusterStackUsingCachedList(arg1 []string) (bool, error) {
-	var arg1Copy []string
-	if arg1 != nil {
-		arg1Copy = make([]string, len(arg1))
-		copy(arg1Copy, arg1)
+func (fake *FakeStackManager) GetNodeGroupPodCapacity(arg1 *v1alpha5.NodeGroup, arg2 kubeclient.Interface) (int, int, error) {
+	fake.getNodeGroupPodCapacityMutex.Lock()
+	ret, specificReturn := fake.getNodeGroupPodCapacityReturnsOnCall[len(fake.getNodeGroupPodCapacityArgsForCall)]
+	fake.getNodeGroupPodCapacityArgsForCall = append(fake.getNodeGroupPodCapacityArgsForCall, struct {
+		arg1 *v1alpha5.NodeGroup
+		arg2 kubeclient.Interface
+	}{arg1, arg2})
+	stub := fake.GetNodeGroupPodCapacityStub
+	fakeReturns := fake.getNodeGroupPodCapacityReturns
+	fake.recordInvocation("GetNodeGroupPodCapacity", []interface{}{arg1, arg2})
+	fake.getNodeGroupPodCapacityMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
-	fake.hasClusterStackUsingCachedListMutex.Lock()
-	ret, specificReturn := fake.hasClusterStackUsingCachedListReturnsOnCall[len(fake.hasClusterStackUsingCachedListArgsForCall)]
-	fake.hasClusterStackUsingCachedListArgsForCall = append(fake.hasClusterStackUsingCachedListArgsForCall, struct {
-		arg1 []string
-	}{arg1Copy})
-	stub := fake.HasClusterStackUsingCachedListStub
-	fakeReturns := fake.hasClusterStackUsingCachedListReturns
-	fake.recordInvocation("HasClusterStackUsingCachedList", []interface{}{arg1Copy})
-	fake.hasClusterStackUsingCachedListMutex.Unlock()
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeStackManager) GetNodeGroupPodCapacityCallCount() int {
+	fake.getNodeGroupPodCapacityMutex.RLock()
+	defer fake.getNodeGroupPodCapacityMutex.RUnlock()
+	return len(fake.getNodeGroupPodCapacityArgsForCall)
+}
+
+func (fake *FakeStackManager) GetNodeGroupPodCapacityCalls(stub func(*v1alpha5.NodeGroup, kubeclient.Interface) (int, int, error)) {
+	fake.getNodeGroupPodCapacityMutex.Lock()
+	defer fake.getNodeGroupPodCapacityMutex.Unlock()
+	fake.GetNodeGroupPodCapacityStub = stub
+}
+
+func (fake *FakeStackManager) GetNodeGroupPodCapacityArgsForCall(i int) (*v1alpha5.NodeGroup, kubeclient.Interface) {
+	fake.getNodeGroupPodCapacityMutex.RLock()
+	defer fake.getNodeGroupPodCapacityMutex.RUnlock()
+	argsForCall := fake.getNodeGroupPodCapacityArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStackManager) GetNodeGroupPodCapacityReturns(result1 int, result2 int, result3 error) {
+	fake.getNodeGroupPodCapacityMutex.Lock()
+	defer fake.getNodeGroupPodCapacityMutex.Unlock()
+	fake.GetNodeGroupPodCapacityStub = nil
+	fake.getNodeGroupPodCapacityReturns = struct {
+		result1 int
+		result2 int
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStackManager) GetNodeGroupPodCapacityReturnsOnCall(i int, result1 int, result2 int, result3 error) {
+	fake.getNodeGroupPodCapacityMutex.Lock()
+	defer fake.getNodeGroupPodCapacityMutex.Unlock()
+	fake.GetNodeGroupPodCapacityStub = nil
+	if fake.getNodeGroupPodCapacityReturnsOnCall == nil {
+		fake.getNodeGroupPodCapacityReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 int
+			result3 error
+		})
+	}
+	fake.getNodeGroupPodCapacityReturnsOnCall[i] = struct {
+		result1 int
+		result2 int
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStackManager) GetNodeGroupSecurityGroupIDs(arg1 *v1alpha5.NodeGroup) ([]string, error) {
+	fake.getNodeGroupSecurityGroupIDsMutex.Lock()
+	ret, specificReturn := fake.getNodeGroupSecurityGroupIDsReturnsOnCall[len(fake.getNodeGroupSecurityGroupIDsArgsForCall)]
+	fake.getNodeGroupSecurityGroupIDsArgsForCall = append(fake.getNodeGroupSecurityGroupIDsArgsForCall, struct {
+		arg1 *v1alpha5.NodeGroup
+	}{arg1})
+	stub := fake.GetNodeGroupSecurityGroupIDsStub
+	fakeReturns := fake.getNodeGroupSecurityGroupIDsReturns
+	fake.recordInvocation("GetNodeGroupSecurityGroupIDs", []interface{}{arg1})
+	fake.getNodeGroupSecurityGroupIDsMutex.Unlock()
 	if stub != nil {
 		return stub(arg1)
 	}
@@ -2789,62 +4093,63 @@ func (fake *FakeStackManager) HasClusterStackUsingCachedList(arg1 []string) (boo
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStackManager) HasClusterStackUsingCachedListCallCount() int {
-	fake.hasClusterStackUsingCachedListMutex.RLock()
-	defer fake.hasClusterStackUsingCachedListMutex.RUnlock()
-	return len(fake.hasClusterStackUsingCachedListArgsForCall)
+func (fake *FakeStackManager) GetNodeGroupSecurityGroupIDsCallCount() int {
+	fake.getNodeGroupSecurityGroupIDsMutex.RLock()
+	defer fake.getNodeGroupSecurityGroupIDsMutex.RUnlock()
+	return len(fake.getNodeGroupSecurityGroupIDsArgsForCall)
 }
 
-func (fake *FakeStackManager) HasClusterStackUsingCachedListCalls(stub func([]string) (bool, error)) {
-	fake.hasClusterStackUsingCachedListMutex.Lock()
-	defer fake.hasClusterStackUsingCachedListMutex.Unlock()
-	fake.HasClusterStackUsingCachedListStub = stub
+func (fake *FakeStackManager) GetNodeGroupSecurityGroupIDsCalls(stub func(*v1alpha5.NodeGroup) ([]string, error)) {
+	fake.getNodeGroupSecurityGroupIDsMutex.Lock()
+	defer fake.getNodeGroupSecurityGroupIDsMutex.Unlock()
+	fake.GetNodeGroupSecurityGroupIDsStub = stub
 }
 
-func (fake *FakeStackManager) HasClusterStackUsingCachedListArgsForCall(i int) []string {
-	fake.hasClusterStackUsingCachedListMutex.RLock()
-	defer fake.hasClusterStackUsingCachedListMutex.RUnlock()
-	argsForCall := fake.hasClusterStackUsingCachedListArgsForCall[i]
+func (fake *FakeStackManager) GetNodeGroupSecurityGroupIDsArgsForCall(i int) *v1alpha5.NodeGroup {
+	fake.getNodeGroupSecurityGroupIDsMutex.RLock()
+	defer fake.getNodeGroupSecurityGroupIDsMutex.RUnlock()
+	argsForCall := fake.getNodeGroupSecurityGroupIDsArgsForCall[i]
 	return argsForCall.arg1
 }
 
-func (fake *FakeStackManager) HasClusterStackUsingCachedListReturns(result1 bool, result2 error) {
-	fake.hasClusterStackUsingCachedListMutex.Lock()
-	defer fake.hasClusterStackUsingCachedListMutex.Unlock()
-	fake.HasClusterStackUsingCachedListStub = nil
-	fake.hasClusterStackUsingCachedListReturns = struct {
-		result1 bool
+func (fake *FakeStackManager) GetNodeGroupSecurityGroupIDsReturns(result1 []string, result2 error) {
+	fake.getNodeGroupSecurityGroupIDsMutex.Lock()
+	defer fake.getNodeGroupSecurityGroupIDsMutex.Unlock()
+	fake.GetNodeGroupSecurityGroupIDsStub = nil
+	fake.getNodeGroupSecurityGroupIDsReturns = struct {
+		result1 []string
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) HasClusterStackUsingCachedListReturnsOnCall(i int, result1 bool, result2 error) {
-	fake.hasClusterStackUsingCachedListMutex.Lock()
-	defer fake.hasClusterStackUsingCachedListMutex.Unlock()
-	fake.HasClusterStackUsingCachedListStub = nil
-	if fake.hasClusterStackUsingCachedListReturnsOnCall == nil {
-		fake.hasClusterStackUsingCachedListReturnsOnCall = make(map[int]struct {
-			result1 bool
+func (fake *FakeStackManager) GetNodeGroupSecurityGroupIDsReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.getNodeGroupSecurityGroupIDsMutex.Lock()
+	defer fake.getNodeGroupSecurityGroupIDsMutex.Unlock()
+	fake.GetNodeGroupSecurityGroupIDsStub = nil
+	if fake.getNodeGroupSecurityGroupIDsReturnsOnCall == nil {
+		fake.getNodeGroupSecurityGroupIDsReturnsOnCall = make(map[int]struct {
+			result1 []string
 			result2 error
 		})
 	}
-	fake.hasClusterStackUsingCachedListReturnsOnCall[i] = struct {
-		result1 bool
+	fake.getNodeGroupSecurityGroupIDsReturnsOnCall[i] = struct {
+		result1 []string
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) ListClusterStackNames() ([]string, error) {
-	fake.listClusterStackNamesMutex.Lock()
-	ret, specificReturn := fake.listClusterStackNamesReturnsOnCall[len(fake.listClusterStackNamesArgsForCall)]
-	fake.listClusterStackNamesArgsForCall = append(fake.listClusterStackNamesArgsForCall, struct {
-	}{})
-	stub := fake.ListClusterStackNamesStub
-	fakeReturns := fake.listClusterStackNamesReturns
-	fake.recordInvocation("ListClusterStackNames", []interface{}{})
-	fake.listClusterStackNamesMutex.Unlock()
+func (fake *FakeStackManager) GetNodeGroupStackPolicy(arg1 *v1alpha5.NodeGroup) (string, error) {
+	fake.getNodeGroupStackPolicyMutex.Lock()
+	ret, specificReturn := fake.getNodeGroupStackPolicyReturnsOnCall[len(fake.getNodeGroupStackPolicyArgsForCall)]
+	fake.getNodeGroupStackPolicyArgsForCall = append(fake.getNodeGroupStackPolicyArgsForCall, struct {
+		arg1 *v1alpha5.NodeGroup
+	}{arg1})
+	stub := fake.GetNodeGroupStackPolicyStub
+	fakeReturns := fake.getNodeGroupStackPolicyReturns
+	fake.recordInvocation("GetNodeGroupStackPolicy", []interface{}{arg1})
+	fake.getNodeGroupStackPolicyMutex.Unlock()
 	if stub != nil {
-		return stub()
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -2852,55 +4157,63 @@ func (fake *FakeStackManager) ListClusterStackNames() ([]string, error) {
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStackManager) ListClusterStackNamesCallCount() int {
-	fake.listClusterStackNamesMutex.RLock()
-	defer fake.listClusterStackNamesMutex.RUnlock()
-	return len(fake.listClusterStackNamesArgsForCall)
+func (fake *FakeStackManager) GetNodeGroupStackPolicyCallCount() int {
+	fake.getNodeGroupStackPolicyMutex.RLock()
+	defer fake.getNodeGroupStackPolicyMutex.RUnlock()
+	return len(fake.getNodeGroupStackPolicyArgsForCall)
 }
 
-func (fake *FakeStackManager) ListClusterStackNamesCalls(stub func() ([]string, error)) {
-	fake.listClusterStackNamesMutex.Lock()
-	defer fake.listClusterStackNamesMutex.Unlock()
-	fake.ListClusterStackNamesStub = stub
+func (fake *FakeStackManager) GetNodeGroupStackPolicyCalls(stub func(*v1alpha5.NodeGroup) (string, error)) {
+	fake.getNodeGroupStackPolicyMutex.Lock()
+	defer fake.getNodeGroupStackPolicyMutex.Unlock()
+	fake.GetNodeGroupStackPolicyStub = stub
 }
 
-func (fake *FakeStackManager) ListClusterStackNamesReturns(result1 []string, result2 error) {
-	fake.listClusterStackNamesMutex.Lock()
-	defer fake.listClusterStackNamesMutex.Unlock()
-	fake.ListClusterStackNamesStub = nil
-	fake.listClusterStackNamesReturns = struct {
-		result1 []string
+func (fake *FakeStackManager) GetNodeGroupStackPolicyArgsForCall(i int) *v1alpha5.NodeGroup {
+	fake.getNodeGroupStackPolicyMutex.RLock()
+	defer fake.getNodeGroupStackPolicyMutex.RUnlock()
+	argsForCall := fake.getNodeGroupStackPolicyArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) GetNodeGroupStackPolicyReturns(result1 string, result2 error) {
+	fake.getNodeGroupStackPolicyMutex.Lock()
+	defer fake.getNodeGroupStackPolicyMutex.Unlock()
+	fake.GetNodeGroupStackPolicyStub = nil
+	fake.getNodeGroupStackPolicyReturns = struct {
+		result1 string
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) ListClusterStackNamesReturnsOnCall(i int, result1 []string, result2 error) {
-	fake.listClusterStackNamesMutex.Lock()
-	defer fake.listClusterStackNamesMutex.Unlock()
-	fake.ListClusterStackNamesStub = nil
-	if fake.listClusterStackNamesReturnsOnCall == nil {
-		fake.listClusterStackNamesReturnsOnCall = make(map[int]struct {
-			result1 []string
+func (fake *FakeStackManager) GetNodeGroupStackPolicyReturnsOnCall(i int, result1 string, result2 error) {
+	fake.getNodeGroupStackPolicyMutex.Lock()
+	defer fake.getNodeGroupStackPolicyMutex.Unlock()
+	fake.GetNodeGroupStackPolicyStub = nil
+	if fake.getNodeGroupStackPolicyReturnsOnCall == nil {
+		fake.getNodeGroupStackPolicyReturnsOnCall = make(map[int]struct {
+			result1 string
 			result2 error
 		})
 	}
-	fake.listClusterStackNamesReturnsOnCall[i] = struct {
-		result1 []string
+	fake.getNodeGroupStackPolicyReturnsOnCall[i] = struct {
+		result1 string
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) ListIAMServiceAccountStacks() ([]string, error) {
-	fake.listIAMServiceAccountStacksMutex.Lock()
-	ret, specificReturn := fake.listIAMServiceAccountStacksReturnsOnCall[len(fake.listIAMServiceAccountStacksArgsForCall)]
-	fake.listIAMServiceAccountStacksArgsForCall = append(fake.listIAMServiceAccountStacksArgsForCall, struct {
-	}{})
-	stub := fake.ListIAMServiceAccountStacksStub
-	fakeReturns := fake.listIAMServiceAccountStacksReturns
-	fake.recordInvocation("ListIAMServiceAccountStacks", []interface{}{})
-	fake.listIAMServiceAccountStacksMutex.Unlock()
+func (fake *FakeStackManager) GetNodeGroupStackType(arg1 string) (v1alpha5.NodeGroupType, error) {
+	fake.getNodeGroupStackTypeMutex.Lock()
+	ret, specificReturn := fake.getNodeGroupStackTypeReturnsOnCall[len(fake.getNodeGroupStackTypeArgsForCall)]
+	fake.getNodeGroupStackTypeArgsForCall = append(fake.getNodeGroupStackTypeArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetNodeGroupStackTypeStub
+	fakeReturns := fake.getNodeGroupStackTypeReturns
+	fake.recordInvocation("GetNodeGroupStackType", []interface{}{arg1})
+	fake.getNodeGroupStackTypeMutex.Unlock()
 	if stub != nil {
-		return stub()
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -2908,55 +4221,63 @@ func (fake *FakeStackManager) ListIAMServiceAccountStacks() ([]string, error) {
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStackManager) ListIAMServiceAccountStacksCallCount() int {
-	fake.listIAMServiceAccountStacksMutex.RLock()
-	defer fake.listIAMServiceAccountStacksMutex.RUnlock()
-	return len(fake.listIAMServiceAccountStacksArgsForCall)
+func (fake *FakeStackManager) GetNodeGroupStackTypeCallCount() int {
+	fake.getNodeGroupStackTypeMutex.RLock()
+	defer fake.getNodeGroupStackTypeMutex.RUnlock()
+	return len(fake.getNodeGroupStackTypeArgsForCall)
 }
 
-func (fake *FakeStackManager) ListIAMServiceAccountStacksCalls(stub func() ([]string, error)) {
-	fake.listIAMServiceAccountStacksMutex.Lock()
-	defer fake.listIAMServiceAccountStacksMutex.Unlock()
-	fake.ListIAMServiceAccountStacksStub = stub
+func (fake *FakeStackManager) GetNodeGroupStackTypeCalls(stub func(string) (v1alpha5.NodeGroupType, error)) {
+	fake.getNodeGroupStackTypeMutex.Lock()
+	defer fake.getNodeGroupStackTypeMutex.Unlock()
+	fake.GetNodeGroupStackTypeStub = stub
 }
 
-func (fake *FakeStackManager) ListIAMServiceAccountStacksReturns(result1 []string, result2 error) {
-	fake.listIAMServiceAccountStacksMutex.Lock()
-	defer fake.listIAMServiceAccountStacksMutex.Unlock()
-	fake.ListIAMServiceAccountStacksStub = nil
-	fake.listIAMServiceAccountStacksReturns = struct {
-		result1 []string
+func (fake *FakeStackManager) GetNodeGroupStackTypeArgsForCall(i int) string {
+	fake.getNodeGroupStackTypeMutex.RLock()
+	defer fake.getNodeGroupStackTypeMutex.RUnlock()
+	argsForCall := fake.getNodeGroupStackTypeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) GetNodeGroupStackTypeReturns(result1 v1alpha5.NodeGroupType, result2 error) {
+	fake.getNodeGroupStackTypeMutex.Lock()
+	defer fake.getNodeGroupStackTypeMutex.Unlock()
+	fake.GetNodeGroupStackTypeStub = nil
+	fake.getNodeGroupStackTypeReturns = struct {
+		result1 v1alpha5.NodeGroupType
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) ListIAMServiceAccountStacksReturnsOnCall(i int, result1 []string, result2 error) {
-	fake.listIAMServiceAccountStacksMutex.Lock()
-	defer fake.listIAMServiceAccountStacksMutex.Unlock()
-	fake.ListIAMServiceAccountStacksStub = nil
-	if fake.listIAMServiceAccountStacksReturnsOnCall == nil {
-		fake.listIAMServiceAccountStacksReturnsOnCall = make(map[int]struct {
-			result1 []string
+func (fake *FakeStackManager) GetNodeGroupStackTypeReturnsOnCall(i int, result1 v1alpha5.NodeGroupType, result2 error) {
+	fake.getNodeGroupStackTypeMutex.Lock()
+	defer fake.getNodeGroupStackTypeMutex.Unlock()
+	fake.GetNodeGroupStackTypeStub = nil
+	if fake.getNodeGroupStackTypeReturnsOnCall == nil {
+		fake.getNodeGroupStackTypeReturnsOnCall = make(map[int]struct {
+			result1 v1alpha5.NodeGroupType
 			result2 error
 		})
 	}
-	fake.listIAMServiceAccountStacksReturnsOnCall[i] = struct {
-		result1 []string
+	fake.getNodeGroupStackTypeReturnsOnCall[i] = struct {
+		result1 v1alpha5.NodeGroupType
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) ListNodeGroupStacks() ([]manager.NodeGroupStack, error) {
-	fake.listNodeGroupStacksMutex.Lock()
-	ret, specificReturn := fake.listNodeGroupStacksReturnsOnCall[len(fake.listNodeGroupStacksArgsForCall)]
-	fake.listNodeGroupStacksArgsForCall = append(fake.listNodeGroupStacksArgsForCall, struct {
-	}{})
-	stub := fake.ListNodeGroupStacksStub
-	fakeReturns := fake.listNodeGroupStacksReturns
-	fake.recordInvocation("ListNodeGroupStacks", []interface{}{})
-	fake.listNodeGroupStacksMutex.Unlock()
+func (fake *FakeStackManager) GetNodeGroupSummaries(arg1 string) ([]*manager.NodeGroupSummary, error) {
+	fake.getNodeGroupSummariesMutex.Lock()
+	ret, specificReturn := fake.getNodeGroupSummariesReturnsOnCall[len(fake.getNodeGroupSummariesArgsForCall)]
+	fake.getNodeGroupSummariesArgsForCall = append(fake.getNodeGroupSummariesArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetNodeGroupSummariesStub
+	fakeReturns := fake.getNodeGroupSummariesReturns
+	fake.recordInvocation("GetNodeGroupSummaries", []interface{}{arg1})
+	fake.getNodeGroupSummariesMutex.Unlock()
 	if stub != nil {
-		return stub()
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -2964,56 +4285,64 @@ func (fake *FakeStackManager) ListNodeGroupStacks() ([]manager.NodeGroupStack, e
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStackManager) ListNodeGroupStacksCallCount() int {
-	fake.listNodeGroupStacksMutex.RLock()
-	defer fake.listNodeGroupStacksMutex.RUnlock()
-	return len(fake.listNodeGroupStacksArgsForCall)
+func (fake *FakeStackManager) GetNodeGroupSummariesCallCount() int {
+	fake.getNodeGroupSummariesMutex.RLock()
+	defer fake.getNodeGroupSummariesMutex.RUnlock()
+	return len(fake.getNodeGroupSummariesArgsForCall)
 }
 
-func (fake *FakeStackManager) ListNodeGroupStacksCalls(stub func() ([]manager.NodeGroupStack, error)) {
-	fake.listNodeGroupStacksMutex.Lock()
-	defer fake.listNodeGroupStacksMutex.Unlock()
-	fake.ListNodeGroupStacksStub = stub
+func (fake *FakeStackManager) GetNodeGroupSummariesCalls(stub func(string) ([]*manager.NodeGroupSummary, error)) {
+	fake.getNodeGroupSummariesMutex.Lock()
+	defer fake.getNodeGroupSummariesMutex.Unlock()
+	fake.GetNodeGroupSummariesStub = stub
 }
 
-func (fake *FakeStackManager) ListNodeGroupStacksReturns(result1 []manager.NodeGroupStack, result2 error) {
-	fake.listNodeGroupStacksMutex.Lock()
-	defer fake.listNodeGroupStacksMutex.Unlock()
-	fake.ListNodeGroupStacksStub = nil
-	fake.listNodeGroupStacksReturns = struct {
-		result1 []manager.NodeGroupStack
+func (fake *FakeStackManager) GetNodeGroupSummariesArgsForCall(i int) string {
+	fake.getNodeGroupSummariesMutex.RLock()
+	defer fake.getNodeGroupSummariesMutex.RUnlock()
+	argsForCall := fake.getNodeGroupSummariesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) GetNodeGroupSummariesReturns(result1 []*manager.NodeGroupSummary, result2 error) {
+	fake.getNodeGroupSummariesMutex.Lock()
+	defer fake.getNodeGroupSummariesMutex.Unlock()
+	fake.GetNodeGroupSummariesStub = nil
+	fake.getNodeGroupSummariesReturns = struct {
+		result1 []*manager.NodeGroupSummary
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) ListNodeGroupStacksReturnsOnCall(i int, result1 []manager.NodeGroupStack, result2 error) {
-	fake.listNodeGroupStacksMutex.Lock()
-	defer fake.listNodeGroupStacksMutex.Unlock()
-	fake.ListNodeGroupStacksStub = nil
-	if fake.listNodeGroupStacksReturnsOnCall == nil {
-		fake.listNodeGroupStacksReturnsOnCall = make(map[int]struct {
-			result1 []manager.NodeGroupStack
+func (fake *FakeStackManager) GetNodeGroupSummariesReturnsOnCall(i int, result1 []*manager.NodeGroupSummary, result2 error) {
+	fake.getNodeGroupSummariesMutex.Lock()
+	defer fake.getNodeGroupSummariesMutex.Unlock()
+	fake.GetNodeGroupSummariesStub = nil
+	if fake.getNodeGroupSummariesReturnsOnCall == nil {
+		fake.getNodeGroupSummariesReturnsOnCall = make(map[int]struct {
+			result1 []*manager.NodeGroupSummary
 			result2 error
 		})
 	}
-	fake.listNodeGroupStacksReturnsOnCall[i] = struct {
-		result1 []manager.NodeGroupStack
+	fake.getNodeGroupSummariesReturnsOnCall[i] = struct {
+		result1 []*manager.NodeGroupSummary
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) ListStacks(arg1 ...string) ([]*cloudformation.Stack, error) {
-	fake.listStacksMutex.Lock()
-	ret, specificReturn := fake.listStacksReturnsOnCall[len(fake.listStacksArgsForCall)]
-	fake.listStacksArgsForCall = append(fake.listStacksArgsForCall, struct {
-		arg1 []string
-	}{arg1})
-	stub := fake.ListStacksStub
-	fakeReturns := fake.listStacksReturns
-	fake.recordInvocation("ListStacks", []interface{}{arg1})
-	fake.listStacksMutex.Unlock()
+func (fake *FakeStackManager) GetNodeGroupWorkloadImpact(arg1 *v1alpha5.NodeGroup, arg2 kubeclient.Interface) (*manager.WorkloadImpact, error) {
+	fake.getNodeGroupWorkloadImpactMutex.Lock()
+	ret, specificReturn := fake.getNodeGroupWorkloadImpactReturnsOnCall[len(fake.getNodeGroupWorkloadImpactArgsForCall)]
+	fake.getNodeGroupWorkloadImpactArgsForCall = append(fake.getNodeGroupWorkloadImpactArgsForCall, struct {
+		arg1 *v1alpha5.NodeGroup
+		arg2 kubeclient.Interface
+	}{arg1, arg2})
+	stub := fake.GetNodeGroupWorkloadImpactStub
+	fakeReturns := fake.getNodeGroupWorkloadImpactReturns
+	fake.recordInvocation("GetNodeGroupWorkloadImpact", []interface{}{arg1, arg2})
+	fake.getNodeGroupWorkloadImpactMutex.Unlock()
 	if stub != nil {
-		return stub(arg1...)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -3021,64 +4350,63 @@ func (fake *FakeStackManager) ListStacks(arg1 ...string) ([]*cloudformation.Stac
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStackManager) ListStacksCallCount() int {
-	fake.listStacksMutex.RLock()
-	defer fake.listStacksMutex.RUnlock()
-	return len(fake.listStacksArgsForCall)
+func (fake *FakeStackManager) GetNodeGroupWorkloadImpactCallCount() int {
+	fake.getNodeGroupWorkloadImpactMutex.RLock()
+	defer fake.getNodeGroupWorkloadImpactMutex.RUnlock()
+	return len(fake.getNodeGroupWorkloadImpactArgsForCall)
 }
 
-func (fake *FakeStackManager) ListStacksCalls(stub func(...string) ([]*cloudformation.Stack, error)) {
-	fake.listStacksMutex.Lock()
-	defer fake.listStacksMutex.Unlock()
-	fake.ListStacksStub = stub
+func (fake *FakeStackManager) GetNodeGroupWorkloadImpactCalls(stub func(*v1alpha5.NodeGroup, kubeclient.Interface) (*manager.WorkloadImpact, error)) {
+	fake.getNodeGroupWorkloadImpactMutex.Lock()
+	defer fake.getNodeGroupWorkloadImpactMutex.Unlock()
+	fake.GetNodeGroupWorkloadImpactStub = stub
 }
 
-func (fake *FakeStackManager) ListStacksArgsForCall(i int) []string {
-	fake.listStacksMutex.RLock()
-	defer fake.listStacksMutex.RUnlock()
-	argsForCall := fake.listStacksArgsForCall[i]
-	return argsForCall.arg1
+func (fake *FakeStackManager) GetNodeGroupWorkloadImpactArgsForCall(i int) (*v1alpha5.NodeGroup, kubeclient.Interface) {
+	fake.getNodeGroupWorkloadImpactMutex.RLock()
+	defer fake.getNodeGroupWorkloadImpactMutex.RUnlock()
+	argsForCall := fake.getNodeGroupWorkloadImpactArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeStackManager) ListStacksReturns(result1 []*cloudformation.Stack, result2 error) {
-	fake.listStacksMutex.Lock()
-	defer fake.listStacksMutex.Unlock()
-	fake.ListStacksStub = nil
-	fake.listStacksReturns = struct {
-		result1 []*cloudformation.Stack
+func (fake *FakeStackManager) GetNodeGroupWorkloadImpactReturns(result1 *manager.WorkloadImpact, result2 error) {
+	fake.getNodeGroupWorkloadImpactMutex.Lock()
+	defer fake.getNodeGroupWorkloadImpactMutex.Unlock()
+	fake.GetNodeGroupWorkloadImpactStub = nil
+	fake.getNodeGroupWorkloadImpactReturns = struct {
+		result1 *manager.WorkloadImpact
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) ListStacksReturnsOnCall(i int, result1 []*cloudformation.Stack, result2 error) {
-	fake.listStacksMutex.Lock()
-	defer fake.listStacksMutex.Unlock()
-	fake.ListStacksStub = nil
-	if fake.listStacksReturnsOnCall == nil {
-		fake.listStacksReturnsOnCall = make(map[int]struct {
-			result1 []*cloudformation.Stack
+func (fake *FakeStackManager) GetNodeGroupWorkloadImpactReturnsOnCall(i int, result1 *manager.WorkloadImpact, result2 error) {
+	fake.getNodeGroupWorkloadImpactMutex.Lock()
+	defer fake.getNodeGroupWorkloadImpactMutex.Unlock()
+	fake.GetNodeGroupWorkloadImpactStub = nil
+	if fake.getNodeGroupWorkloadImpactReturnsOnCall == nil {
+		fake.getNodeGroupWorkloadImpactReturnsOnCall = make(map[int]struct {
+			result1 *manager.WorkloadImpact
 			result2 error
 		})
 	}
-	fake.listStacksReturnsOnCall[i] = struct {
-		result1 []*cloudformation.Stack
+	fake.getNodeGroupWorkloadImpactReturnsOnCall[i] = struct {
+		result1 *manager.WorkloadImpact
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) ListStacksMatching(arg1 string, arg2 ...string) ([]*cloudformation.Stack, error) {
-	fake.listStacksMatchingMutex.Lock()
-	ret, specificReturn := fake.listStacksMatchingReturnsOnCall[len(fake.listStacksMatchingArgsForCall)]
-	fake.listStacksMatchingArgsForCall = append(fake.listStacksMatchingArgsForCall, struct {
+func (fake *FakeStackManager) GetNodeGroupsByTag(arg1 string) (map[string][]*manager.NodeGroupSummary, error) {
+	fake.getNodeGroupsByTagMutex.Lock()
+	ret, specificReturn := fake.getNodeGroupsByTagReturnsOnCall[len(fake.getNodeGroupsByTagArgsForCall)]
+	fake.getNodeGroupsByTagArgsForCall = append(fake.getNodeGroupsByTagArgsForCall, struct {
 		arg1 string
-		arg2 []string
-	}{arg1, arg2})
-	stub := fake.ListStacksMatchingStub
-	fakeReturns := fake.listStacksMatchingReturns
-	fake.recordInvocation("ListStacksMatching", []interface{}{arg1, arg2})
-	fake.listStacksMatchingMutex.Unlock()
+	}{arg1})
+	stub := fake.GetNodeGroupsByTagStub
+	fakeReturns := fake.getNodeGroupsByTagReturns
+	fake.recordInvocation("GetNodeGroupsByTag", []interface{}{arg1})
+	fake.getNodeGroupsByTagMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2...)
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -3086,61 +4414,61 @@ func (fake *FakeStackManager) ListStacksMatching(arg1 string, arg2 ...string) ([
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStackManager) ListStacksMatchingCallCount() int {
-	fake.listStacksMatchingMutex.RLock()
-	defer fake.listStacksMatchingMutex.RUnlock()
-	return len(fake.listStacksMatchingArgsForCall)
+func (fake *FakeStackManager) GetNodeGroupsByTagCallCount() int {
+	fake.getNodeGroupsByTagMutex.RLock()
+	defer fake.getNodeGroupsByTagMutex.RUnlock()
+	return len(fake.getNodeGroupsByTagArgsForCall)
 }
 
-func (fake *FakeStackManager) ListStacksMatchingCalls(stub func(string, ...string) ([]*cloudformation.Stack, error)) {
-	fake.listStacksMatchingMutex.Lock()
-	defer fake.listStacksMatchingMutex.Unlock()
-	fake.ListStacksMatchingStub = stub
+func (fake *FakeStackManager) GetNodeGroupsByTagCalls(stub func(string) (map[string][]*manager.NodeGroupSummary, error)) {
+	fake.getNodeGroupsByTagMutex.Lock()
+	defer fake.getNodeGroupsByTagMutex.Unlock()
+	fake.GetNodeGroupsByTagStub = stub
 }
 
-func (fake *FakeStackManager) ListStacksMatchingArgsForCall(i int) (string, []string) {
-	fake.listStacksMatchingMutex.RLock()
-	defer fake.listStacksMatchingMutex.RUnlock()
-	argsForCall := fake.listStacksMatchingArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeStackManager) GetNodeGroupsByTagArgsForCall(i int) string {
+	fake.getNodeGroupsByTagMutex.RLock()
+	defer fake.getNodeGroupsByTagMutex.RUnlock()
+	argsForCall := fake.getNodeGroupsByTagArgsForCall[i]
+	return argsForCall.arg1
 }
 
-func (fake *FakeStackManager) ListStacksMatchingReturns(result1 []*cloudformation.Stack, result2 error) {
-	fake.listStacksMatchingMutex.Lock()
-	defer fake.listStacksMatchingMutex.Unlock()
-	fake.ListStacksMatchingStub = nil
-	fake.listStacksMatchingReturns = struct {
-		result1 []*cloudformation.Stack
+func (fake *FakeStackManager) GetNodeGroupsByTagReturns(result1 map[string][]*manager.NodeGroupSummary, result2 error) {
+	fake.getNodeGroupsByTagMutex.Lock()
+	defer fake.getNodeGroupsByTagMutex.Unlock()
+	fake.GetNodeGroupsByTagStub = nil
+	fake.getNodeGroupsByTagReturns = struct {
+		result1 map[string][]*manager.NodeGroupSummary
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) ListStacksMatchingReturnsOnCall(i int, result1 []*cloudformation.Stack, result2 error) {
-	fake.listStacksMatchingMutex.Lock()
-	defer fake.listStacksMatchingMutex.Unlock()
-	fake.ListStacksMatchingStub = nil
-	if fake.listStacksMatchingReturnsOnCall == nil {
-		fake.listStacksMatchingReturnsOnCall = make(map[int]struct {
-			result1 []*cloudformation.Stack
+func (fake *FakeStackManager) GetNodeGroupsByTagReturnsOnCall(i int, result1 map[string][]*manager.NodeGroupSummary, result2 error) {
+	fake.getNodeGroupsByTagMutex.Lock()
+	defer fake.getNodeGroupsByTagMutex.Unlock()
+	fake.GetNodeGroupsByTagStub = nil
+	if fake.getNodeGroupsByTagReturnsOnCall == nil {
+		fake.getNodeGroupsByTagReturnsOnCall = make(map[int]struct {
+			result1 map[string][]*manager.NodeGroupSummary
 			result2 error
 		})
 	}
-	fake.listStacksMatchingReturnsOnCall[i] = struct {
-		result1 []*cloudformation.Stack
+	fake.getNodeGroupsByTagReturnsOnCall[i] = struct {
+		result1 map[string][]*manager.NodeGroupSummary
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) LookupCloudTrailEvents(arg1 *cloudformation.Stack) ([]*cloudtrail.Event, error) {
-	fake.lookupCloudTrailEventsMutex.Lock()
-	ret, specificReturn := fake.lookupCloudTrailEventsReturnsOnCall[len(fake.lookupCloudTrailEventsArgsForCall)]
-	fake.lookupCloudTrailEventsArgsForCall = append(fake.lookupCloudTrailEventsArgsForCall, struct {
-		arg1 *cloudformation.Stack
+func (fake *FakeStackManager) GetStackTemplate(arg1 string) (string, error) {
+	fake.getStackTemplateMutex.Lock()
+	ret, specificReturn := fake.getStackTemplateReturnsOnCall[len(fake.getStackTemplateArgsForCall)]
+	fake.getStackTemplateArgsForCall = append(fake.getStackTemplateArgsForCall, struct {
+		arg1 string
 	}{arg1})
-	stub := fake.LookupCloudTrailEventsStub
-	fakeReturns := fake.lookupCloudTrailEventsReturns
-	fake.recordInvocation("LookupCloudTrailEvents", []interface{}{arg1})
-	fake.lookupCloudTrailEventsMutex.Unlock()
+	stub := fake.GetStackTemplateStub
+	fakeReturns := fake.getStackTemplateReturns
+	fake.recordInvocation("GetStackTemplate", []interface{}{arg1})
+	fake.getStackTemplateMutex.Unlock()
 	if stub != nil {
 		return stub(arg1)
 	}
@@ -3150,123 +4478,1578 @@ func (fake *FakeStackManager) LookupCloudTrailEvents(arg1 *cloudformation.Stack)
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStackManager) LookupCloudTrailEventsCallCount() int {
-	fake.lookupCloudTrailEventsMutex.RLock()
-	defer fake.lookupCloudTrailEventsMutex.RUnlock()
-	return len(fake.lookupCloudTrailEventsArgsForCall)
+func (fake *FakeStackManager) GetStackTemplateCallCount() int {
+	fake.getStackTemplateMutex.RLock()
+	defer fake.getStackTemplateMutex.RUnlock()
+	return len(fake.getStackTemplateArgsForCall)
 }
 
-func (fake *FakeStackManager) LookupCloudTrailEventsCalls(stub func(*cloudformation.Stack) ([]*cloudtrail.Event, error)) {
-	fake.lookupCloudTrailEventsMutex.Lock()
-	defer fake.lookupCloudTrailEventsMutex.Unlock()
-	fake.LookupCloudTrailEventsStub = stub
+func (fake *FakeStackManager) GetStackTemplateCalls(stub func(string) (string, error)) {
+	fake.getStackTemplateMutex.Lock()
+	defer fake.getStackTemplateMutex.Unlock()
+	fake.GetStackTemplateStub = stub
 }
 
-func (fake *FakeStackManager) LookupCloudTrailEventsArgsForCall(i int) *cloudformation.Stack {
-	fake.lookupCloudTrailEventsMutex.RLock()
-	defer fake.lookupCloudTrailEventsMutex.RUnlock()
-	argsForCall := fake.lookupCloudTrailEventsArgsForCall[i]
+func (fake *FakeStackManager) GetStackTemplateArgsForCall(i int) string {
+	fake.getStackTemplateMutex.RLock()
+	defer fake.getStackTemplateMutex.RUnlock()
+	argsForCall := fake.getStackTemplateArgsForCall[i]
 	return argsForCall.arg1
 }
 
-func (fake *FakeStackManager) LookupCloudTrailEventsReturns(result1 []*cloudtrail.Event, result2 error) {
-	fake.lookupCloudTrailEventsMutex.Lock()
-	defer fake.lookupCloudTrailEventsMutex.Unlock()
-	fake.LookupCloudTrailEventsStub = nil
-	fake.lookupCloudTrailEventsReturns = struct {
-		result1 []*cloudtrail.Event
+func (fake *FakeStackManager) GetStackTemplateReturns(result1 string, result2 error) {
+	fake.getStackTemplateMutex.Lock()
+	defer fake.getStackTemplateMutex.Unlock()
+	fake.GetStackTemplateStub = nil
+	fake.getStackTemplateReturns = struct {
+		result1 string
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) LookupCloudTrailEventsReturnsOnCall(i int, result1 []*cloudtrail.Event, result2 error) {
-	fake.lookupCloudTrailEventsMutex.Lock()
-	defer fake.lookupCloudTrailEventsMutex.Unlock()
-	fake.LookupCloudTrailEventsStub = nil
-	if fake.lookupCloudTrailEventsReturnsOnCall == nil {
-		fake.lookupCloudTrailEventsReturnsOnCall = make(map[int]struct {
-			result1 []*cloudtrail.Event
+func (fake *FakeStackManager) GetStackTemplateReturnsOnCall(i int, result1 string, result2 error) {
+	fake.getStackTemplateMutex.Lock()
+	defer fake.getStackTemplateMutex.Unlock()
+	fake.GetStackTemplateStub = nil
+	if fake.getStackTemplateReturnsOnCall == nil {
+		fake.getStackTemplateReturnsOnCall = make(map[int]struct {
+			result1 string
 			result2 error
 		})
 	}
-	fake.lookupCloudTrailEventsReturnsOnCall[i] = struct {
-		result1 []*cloudtrail.Event
+	fake.getStackTemplateReturnsOnCall[i] = struct {
+		result1 string
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) MakeChangeSetName(arg1 string) string {
-	fake.makeChangeSetNameMutex.Lock()
-	ret, specificReturn := fake.makeChangeSetNameReturnsOnCall[len(fake.makeChangeSetNameArgsForCall)]
-	fake.makeChangeSetNameArgsForCall = append(fake.makeChangeSetNameArgsForCall, struct {
-		arg1 string
+func (fake *FakeStackManager) HasClusterStack() (bool, error) {
+	fake.hasClusterStackMutex.Lock()
+	ret, specificReturn := fake.hasClusterStackReturnsOnCall[len(fake.hasClusterStackArgsForCall)]
+	fake.hasClusterStackArgsForCall = append(fake.hasClusterStackArgsForCall, struct {
+	}{})
+	stub := fake.HasClusterStackStub
+	fakeReturns := fake.hasClusterStackReturns
+	fake.recordInvocation("HasClusterStack", []interface{}{})
+	fake.hasClusterStackMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) HasClusterStackCallCount() int {
+	fake.hasClusterStackMutex.RLock()
+	defer fake.hasClusterStackMutex.RUnlock()
+	return len(fake.hasClusterStackArgsForCall)
+}
+
+func (fake *FakeStackManager) HasClusterStackCalls(stub func() (bool, error)) {
+	fake.hasClusterStackMutex.Lock()
+	defer fake.hasClusterStackMutex.Unlock()
+	fake.HasClusterStackStub = stub
+}
+
+func (fake *FakeStackManager) HasClusterStackReturns(result1 bool, result2 error) {
+	fake.hasClusterStackMutex.Lock()
+	defer fake.hasClusterStackMutex.Unlock()
+	fake.HasClusterStackStub = nil
+	fake.hasClusterStackReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) HasClusterStackReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.hasClusterStackMutex.Lock()
+	defer fake.hasClusterStackMutex.Unlock()
+	fake.HasClusterStackStub = nil
+	if fake.hasClusterStackReturnsOnCall == nil {
+		fake.hasClusterStackReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.hasClusterStackReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) HasClusterStackUsingCachedList(arg1 []string) (bool, error) {
+	var arg1Copy []string
+	if arg1 != nil {
+		arg1Copy = make([]string, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.hasClusterStackUsingCachedListMutex.Lock()
+	ret, specificReturn := fake.hasClusterStackUsingCachedListReturnsOnCall[len(fake.hasClusterStackUsingCachedListArgsForCall)]
+	fake.hasClusterStackUsingCachedListArgsForCall = append(fake.hasClusterStackUsingCachedListArgsForCall, struct {
+		arg1 []string
+	}{arg1Copy})
+	stub := fake.HasClusterStackUsingCachedListStub
+	fakeReturns := fake.hasClusterStackUsingCachedListReturns
+	fake.recordInvocation("HasClusterStackUsingCachedList", []interface{}{arg1Copy})
+	fake.hasClusterStackUsingCachedListMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) HasClusterStackUsingCachedListCallCount() int {
+	fake.hasClusterStackUsingCachedListMutex.RLock()
+	defer fake.hasClusterStackUsingCachedListMutex.RUnlock()
+	return len(fake.hasClusterStackUsingCachedListArgsForCall)
+}
+
+func (fake *FakeStackManager) HasClusterStackUsingCachedListCalls(stub func([]string) (bool, error)) {
+	fake.hasClusterStackUsingCachedListMutex.Lock()
+	defer fake.hasClusterStackUsingCachedListMutex.Unlock()
+	fake.HasClusterStackUsingCachedListStub = stub
+}
+
+func (fake *FakeStackManager) HasClusterStackUsingCachedListArgsForCall(i int) []string {
+	fake.hasClusterStackUsingCachedListMutex.RLock()
+	defer fake.hasClusterStackUsingCachedListMutex.RUnlock()
+	argsForCall := fake.hasClusterStackUsingCachedListArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) HasClusterStackUsingCachedListReturns(result1 bool, result2 error) {
+	fake.hasClusterStackUsingCachedListMutex.Lock()
+	defer fake.hasClusterStackUsingCachedListMutex.Unlock()
+	fake.HasClusterStackUsingCachedListStub = nil
+	fake.hasClusterStackUsingCachedListReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) HasClusterStackUsingCachedListReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.hasClusterStackUsingCachedListMutex.Lock()
+	defer fake.hasClusterStackUsingCachedListMutex.Unlock()
+	fake.HasClusterStackUsingCachedListStub = nil
+	if fake.hasClusterStackUsingCachedListReturnsOnCall == nil {
+		fake.hasClusterStackUsingCachedListReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.hasClusterStackUsingCachedListReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) IsNodeGroupProtectedFromReplacement(arg1 *v1alpha5.NodeGroup) (bool, error) {
+	fake.isNodeGroupProtectedFromReplacementMutex.Lock()
+	ret, specificReturn := fake.isNodeGroupProtectedFromReplacementReturnsOnCall[len(fake.isNodeGroupProtectedFromReplacementArgsForCall)]
+	fake.isNodeGroupProtectedFromReplacementArgsForCall = append(fake.isNodeGroupProtectedFromReplacementArgsForCall, struct {
+		arg1 *v1alpha5.NodeGroup
 	}{arg1})
-	stub := fake.MakeChangeSetNameStub
-	fakeReturns := fake.makeChangeSetNameReturns
-	fake.recordInvocation("MakeChangeSetName", []interface{}{arg1})
-	fake.makeChangeSetNameMutex.Unlock()
+	stub := fake.IsNodeGroupProtectedFromReplacementStub
+	fakeReturns := fake.isNodeGroupProtectedFromReplacementReturns
+	fake.recordInvocation("IsNodeGroupProtectedFromReplacement", []interface{}{arg1})
+	fake.isNodeGroupProtectedFromReplacementMutex.Unlock()
 	if stub != nil {
 		return stub(arg1)
 	}
 	if specificReturn {
-		return ret.result1
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1
+	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStackManager) MakeChangeSetNameCallCount() int {
-	fake.makeChangeSetNameMutex.RLock()
-	defer fake.makeChangeSetNameMutex.RUnlock()
-	return len(fake.makeChangeSetNameArgsForCall)
+func (fake *FakeStackManager) IsNodeGroupProtectedFromReplacementCallCount() int {
+	fake.isNodeGroupProtectedFromReplacementMutex.RLock()
+	defer fake.isNodeGroupProtectedFromReplacementMutex.RUnlock()
+	return len(fake.isNodeGroupProtectedFromReplacementArgsForCall)
 }
 
-func (fake *FakeStackManager) MakeChangeSetNameCalls(stub func(string) string) {
-	fake.makeChangeSetNameMutex.Lock()
-	defer fake.makeChangeSetNameMutex.Unlock()
-	fake.MakeChangeSetNameStub = stub
+func (fake *FakeStackManager) IsNodeGroupProtectedFromReplacementCalls(stub func(*v1alpha5.NodeGroup) (bool, error)) {
+	fake.isNodeGroupProtectedFromReplacementMutex.Lock()
+	defer fake.isNodeGroupProtectedFromReplacementMutex.Unlock()
+	fake.IsNodeGroupProtectedFromReplacementStub = stub
 }
 
-func (fake *FakeStackManager) MakeChangeSetNameArgsForCall(i int) string {
-	fake.makeChangeSetNameMutex.RLock()
-	defer fake.makeChangeSetNameMutex.RUnlock()
-	argsForCall := fake.makeChangeSetNameArgsForCall[i]
+func (fake *FakeStackManager) IsNodeGroupProtectedFromReplacementArgsForCall(i int) *v1alpha5.NodeGroup {
+	fake.isNodeGroupProtectedFromReplacementMutex.RLock()
+	defer fake.isNodeGroupProtectedFromReplacementMutex.RUnlock()
+	argsForCall := fake.isNodeGroupProtectedFromReplacementArgsForCall[i]
 	return argsForCall.arg1
 }
 
-func (fake *FakeStackManager) MakeChangeSetNameReturns(result1 string) {
-	fake.makeChangeSetNameMutex.Lock()
-	defer fake.makeChangeSetNameMutex.Unlock()
-	fake.MakeChangeSetNameStub = nil
-	fake.makeChangeSetNameReturns = struct {
-		result1 string
-	}{result1}
+func (fake *FakeStackManager) IsNodeGroupProtectedFromReplacementReturns(result1 bool, result2 error) {
+	fake.isNodeGroupProtectedFromReplacementMutex.Lock()
+	defer fake.isNodeGroupProtectedFromReplacementMutex.Unlock()
+	fake.IsNodeGroupProtectedFromReplacementStub = nil
+	fake.isNodeGroupProtectedFromReplacementReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) IsNodeGroupProtectedFromReplacementReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.isNodeGroupProtectedFromReplacementMutex.Lock()
+	defer fake.isNodeGroupProtectedFromReplacementMutex.Unlock()
+	fake.IsNodeGroupProtectedFromReplacementStub = nil
+	if fake.isNodeGroupProtectedFromReplacementReturnsOnCall == nil {
+		fake.isNodeGroupProtectedFromReplacementReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.isNodeGroupProtectedFromReplacementReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) ListClusterStackNames() ([]string, error) {
+	fake.listClusterStackNamesMutex.Lock()
+	ret, specificReturn := fake.listClusterStackNamesReturnsOnCall[len(fake.listClusterStackNamesArgsForCall)]
+	fake.listClusterStackNamesArgsForCall = append(fake.listClusterStackNamesArgsForCall, struct {
+	}{})
+	stub := fake.ListClusterStackNamesStub
+	fakeReturns := fake.listClusterStackNamesReturns
+	fake.recordInvocation("ListClusterStackNames", []interface{}{})
+	fake.listClusterStackNamesMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) ListClusterStackNamesCallCount() int {
+	fake.listClusterStackNamesMutex.RLock()
+	defer fake.listClusterStackNamesMutex.RUnlock()
+	return len(fake.listClusterStackNamesArgsForCall)
+}
+
+func (fake *FakeStackManager) ListClusterStackNamesCalls(stub func() ([]string, error)) {
+	fake.listClusterStackNamesMutex.Lock()
+	defer fake.listClusterStackNamesMutex.Unlock()
+	fake.ListClusterStackNamesStub = stub
+}
+
+func (fake *FakeStackManager) ListClusterStackNamesReturns(result1 []string, result2 error) {
+	fake.listClusterStackNamesMutex.Lock()
+	defer fake.listClusterStackNamesMutex.Unlock()
+	fake.ListClusterStackNamesStub = nil
+	fake.listClusterStackNamesReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) ListClusterStackNamesReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.listClusterStackNamesMutex.Lock()
+	defer fake.listClusterStackNamesMutex.Unlock()
+	fake.ListClusterStackNamesStub = nil
+	if fake.listClusterStackNamesReturnsOnCall == nil {
+		fake.listClusterStackNamesReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.listClusterStackNamesReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) ListIAMServiceAccountStacks() ([]string, error) {
+	fake.listIAMServiceAccountStacksMutex.Lock()
+	ret, specificReturn := fake.listIAMServiceAccountStacksReturnsOnCall[len(fake.listIAMServiceAccountStacksArgsForCall)]
+	fake.listIAMServiceAccountStacksArgsForCall = append(fake.listIAMServiceAccountStacksArgsForCall, struct {
+	}{})
+	stub := fake.ListIAMServiceAccountStacksStub
+	fakeReturns := fake.listIAMServiceAccountStacksReturns
+	fake.recordInvocation("ListIAMServiceAccountStacks", []interface{}{})
+	fake.listIAMServiceAccountStacksMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) ListIAMServiceAccountStacksCallCount() int {
+	fake.listIAMServiceAccountStacksMutex.RLock()
+	defer fake.listIAMServiceAccountStacksMutex.RUnlock()
+	return len(fake.listIAMServiceAccountStacksArgsForCall)
+}
+
+func (fake *FakeStackManager) ListIAMServiceAccountStacksCalls(stub func() ([]string, error)) {
+	fake.listIAMServiceAccountStacksMutex.Lock()
+	defer fake.listIAMServiceAccountStacksMutex.Unlock()
+	fake.ListIAMServiceAccountStacksStub = stub
+}
+
+func (fake *FakeStackManager) ListIAMServiceAccountStacksReturns(result1 []string, result2 error) {
+	fake.listIAMServiceAccountStacksMutex.Lock()
+	defer fake.listIAMServiceAccountStacksMutex.Unlock()
+	fake.ListIAMServiceAccountStacksStub = nil
+	fake.listIAMServiceAccountStacksReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) ListIAMServiceAccountStacksReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.listIAMServiceAccountStacksMutex.Lock()
+	defer fake.listIAMServiceAccountStacksMutex.Unlock()
+	fake.ListIAMServiceAccountStacksStub = nil
+	if fake.listIAMServiceAccountStacksReturnsOnCall == nil {
+		fake.listIAMServiceAccountStacksReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.listIAMServiceAccountStacksReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) ListNodeGroupStacks() ([]manager.NodeGroupStack, error) {
+	fake.listNodeGroupStacksMutex.Lock()
+	ret, specificReturn := fake.listNodeGroupStacksReturnsOnCall[len(fake.listNodeGroupStacksArgsForCall)]
+	fake.listNodeGroupStacksArgsForCall = append(fake.listNodeGroupStacksArgsForCall, struct {
+	}{})
+	stub := fake.ListNodeGroupStacksStub
+	fakeReturns := fake.listNodeGroupStacksReturns
+	fake.recordInvocation("ListNodeGroupStacks", []interface{}{})
+	fake.listNodeGroupStacksMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) ListNodeGroupStacksCallCount() int {
+	fake.listNodeGroupStacksMutex.RLock()
+	defer fake.listNodeGroupStacksMutex.RUnlock()
+	return len(fake.listNodeGroupStacksArgsForCall)
+}
+
+func (fake *FakeStackManager) ListNodeGroupStacksCalls(stub func() ([]manager.NodeGroupStack, error)) {
+	fake.listNodeGroupStacksMutex.Lock()
+	defer fake.listNodeGroupStacksMutex.Unlock()
+	fake.ListNodeGroupStacksStub = stub
+}
+
+func (fake *FakeStackManager) ListNodeGroupStacksReturns(result1 []manager.NodeGroupStack, result2 error) {
+	fake.listNodeGroupStacksMutex.Lock()
+	defer fake.listNodeGroupStacksMutex.Unlock()
+	fake.ListNodeGroupStacksStub = nil
+	fake.listNodeGroupStacksReturns = struct {
+		result1 []manager.NodeGroupStack
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) ListNodeGroupStacksReturnsOnCall(i int, result1 []manager.NodeGroupStack, result2 error) {
+	fake.listNodeGroupStacksMutex.Lock()
+	defer fake.listNodeGroupStacksMutex.Unlock()
+	fake.ListNodeGroupStacksStub = nil
+	if fake.listNodeGroupStacksReturnsOnCall == nil {
+		fake.listNodeGroupStacksReturnsOnCall = make(map[int]struct {
+			result1 []manager.NodeGroupStack
+			result2 error
+		})
+	}
+	fake.listNodeGroupStacksReturnsOnCall[i] = struct {
+		result1 []manager.NodeGroupStack
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) ListOrphanedNodeGroupStacks() ([]*cloudformation.Stack, error) {
+	fake.listOrphanedNodeGroupStacksMutex.Lock()
+	ret, specificReturn := fake.listOrphanedNodeGroupStacksReturnsOnCall[len(fake.listOrphanedNodeGroupStacksArgsForCall)]
+	fake.listOrphanedNodeGroupStacksArgsForCall = append(fake.listOrphanedNodeGroupStacksArgsForCall, struct {
+	}{})
+	stub := fake.ListOrphanedNodeGroupStacksStub
+	fakeReturns := fake.listOrphanedNodeGroupStacksReturns
+	fake.recordInvocation("ListOrphanedNodeGroupStacks", []interface{}{})
+	fake.listOrphanedNodeGroupStacksMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) ListOrphanedNodeGroupStacksCallCount() int {
+	fake.listOrphanedNodeGroupStacksMutex.RLock()
+	defer fake.listOrphanedNodeGroupStacksMutex.RUnlock()
+	return len(fake.listOrphanedNodeGroupStacksArgsForCall)
+}
+
+func (fake *FakeStackManager) ListOrphanedNodeGroupStacksCalls(stub func() ([]*cloudformation.Stack, error)) {
+	fake.listOrphanedNodeGroupStacksMutex.Lock()
+	defer fake.listOrphanedNodeGroupStacksMutex.Unlock()
+	fake.ListOrphanedNodeGroupStacksStub = stub
+}
+
+func (fake *FakeStackManager) ListOrphanedNodeGroupStacksReturns(result1 []*cloudformation.Stack, result2 error) {
+	fake.listOrphanedNodeGroupStacksMutex.Lock()
+	defer fake.listOrphanedNodeGroupStacksMutex.Unlock()
+	fake.ListOrphanedNodeGroupStacksStub = nil
+	fake.listOrphanedNodeGroupStacksReturns = struct {
+		result1 []*cloudformation.Stack
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) ListOrphanedNodeGroupStacksReturnsOnCall(i int, result1 []*cloudformation.Stack, result2 error) {
+	fake.listOrphanedNodeGroupStacksMutex.Lock()
+	defer fake.listOrphanedNodeGroupStacksMutex.Unlock()
+	fake.ListOrphanedNodeGroupStacksStub = nil
+	if fake.listOrphanedNodeGroupStacksReturnsOnCall == nil {
+		fake.listOrphanedNodeGroupStacksReturnsOnCall = make(map[int]struct {
+			result1 []*cloudformation.Stack
+			result2 error
+		})
+	}
+	fake.listOrphanedNodeGroupStacksReturnsOnCall[i] = struct {
+		result1 []*cloudformation.Stack
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) ListStacks(arg1 ...string) ([]*cloudformation.Stack, error) {
+	fake.listStacksMutex.Lock()
+	ret, specificReturn := fake.listStacksReturnsOnCall[len(fake.listStacksArgsForCall)]
+	fake.listStacksArgsForCall = append(fake.listStacksArgsForCall, struct {
+		arg1 []string
+	}{arg1})
+	stub := fake.ListStacksStub
+	fakeReturns := fake.listStacksReturns
+	fake.recordInvocation("ListStacks", []interface{}{arg1})
+	fake.listStacksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) ListStacksCallCount() int {
+	fake.listStacksMutex.RLock()
+	defer fake.listStacksMutex.RUnlock()
+	return len(fake.listStacksArgsForCall)
+}
+
+func (fake *FakeStackManager) ListStacksCalls(stub func(...string) ([]*cloudformation.Stack, error)) {
+	fake.listStacksMutex.Lock()
+	defer fake.listStacksMutex.Unlock()
+	fake.ListStacksStub = stub
+}
+
+func (fake *FakeStackManager) ListStacksArgsForCall(i int) []string {
+	fake.listStacksMutex.RLock()
+	defer fake.listStacksMutex.RUnlock()
+	argsForCall := fake.listStacksArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) ListStacksReturns(result1 []*cloudformation.Stack, result2 error) {
+	fake.listStacksMutex.Lock()
+	defer fake.listStacksMutex.Unlock()
+	fake.ListStacksStub = nil
+	fake.listStacksReturns = struct {
+		result1 []*cloudformation.Stack
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) ListStacksReturnsOnCall(i int, result1 []*cloudformation.Stack, result2 error) {
+	fake.listStacksMutex.Lock()
+	defer fake.listStacksMutex.Unlock()
+	fake.ListStacksStub = nil
+	if fake.listStacksReturnsOnCall == nil {
+		fake.listStacksReturnsOnCall = make(map[int]struct {
+			result1 []*cloudformation.Stack
+			result2 error
+		})
+	}
+	fake.listStacksReturnsOnCall[i] = struct {
+		result1 []*cloudformation.Stack
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) ListStacksMatching(arg1 string, arg2 ...string) ([]*cloudformation.Stack, error) {
+	fake.listStacksMatchingMutex.Lock()
+	ret, specificReturn := fake.listStacksMatchingReturnsOnCall[len(fake.listStacksMatchingArgsForCall)]
+	fake.listStacksMatchingArgsForCall = append(fake.listStacksMatchingArgsForCall, struct {
+		arg1 string
+		arg2 []string
+	}{arg1, arg2})
+	stub := fake.ListStacksMatchingStub
+	fakeReturns := fake.listStacksMatchingReturns
+	fake.recordInvocation("ListStacksMatching", []interface{}{arg1, arg2})
+	fake.listStacksMatchingMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) ListStacksMatchingCallCount() int {
+	fake.listStacksMatchingMutex.RLock()
+	defer fake.listStacksMatchingMutex.RUnlock()
+	return len(fake.listStacksMatchingArgsForCall)
+}
+
+func (fake *FakeStackManager) ListStacksMatchingCalls(stub func(string, ...string) ([]*cloudformation.Stack, error)) {
+	fake.listStacksMatchingMutex.Lock()
+	defer fake.listStacksMatchingMutex.Unlock()
+	fake.ListStacksMatchingStub = stub
+}
+
+func (fake *FakeStackManager) ListStacksMatchingArgsForCall(i int) (string, []string) {
+	fake.listStacksMatchingMutex.RLock()
+	defer fake.listStacksMatchingMutex.RUnlock()
+	argsForCall := fake.listStacksMatchingArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStackManager) ListStacksMatchingReturns(result1 []*cloudformation.Stack, result2 error) {
+	fake.listStacksMatchingMutex.Lock()
+	defer fake.listStacksMatchingMutex.Unlock()
+	fake.ListStacksMatchingStub = nil
+	fake.listStacksMatchingReturns = struct {
+		result1 []*cloudformation.Stack
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) ListStacksMatchingReturnsOnCall(i int, result1 []*cloudformation.Stack, result2 error) {
+	fake.listStacksMatchingMutex.Lock()
+	defer fake.listStacksMatchingMutex.Unlock()
+	fake.ListStacksMatchingStub = nil
+	if fake.listStacksMatchingReturnsOnCall == nil {
+		fake.listStacksMatchingReturnsOnCall = make(map[int]struct {
+			result1 []*cloudformation.Stack
+			result2 error
+		})
+	}
+	fake.listStacksMatchingReturnsOnCall[i] = struct {
+		result1 []*cloudformation.Stack
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) ListStacksMatchingTags(arg1 map[string]string) ([]*cloudformation.Stack, error) {
+	fake.listStacksMatchingTagsMutex.Lock()
+	ret, specificReturn := fake.listStacksMatchingTagsReturnsOnCall[len(fake.listStacksMatchingTagsArgsForCall)]
+	fake.listStacksMatchingTagsArgsForCall = append(fake.listStacksMatchingTagsArgsForCall, struct {
+		arg1 map[string]string
+	}{arg1})
+	stub := fake.ListStacksMatchingTagsStub
+	fakeReturns := fake.listStacksMatchingTagsReturns
+	fake.recordInvocation("ListStacksMatchingTags", []interface{}{arg1})
+	fake.listStacksMatchingTagsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) ListStacksMatchingTagsCallCount() int {
+	fake.listStacksMatchingTagsMutex.RLock()
+	defer fake.listStacksMatchingTagsMutex.RUnlock()
+	return len(fake.listStacksMatchingTagsArgsForCall)
+}
+
+func (fake *FakeStackManager) ListStacksMatchingTagsCalls(stub func(map[string]string) ([]*cloudformation.Stack, error)) {
+	fake.listStacksMatchingTagsMutex.Lock()
+	defer fake.listStacksMatchingTagsMutex.Unlock()
+	fake.ListStacksMatchingTagsStub = stub
+}
+
+func (fake *FakeStackManager) ListStacksMatchingTagsArgsForCall(i int) map[string]string {
+	fake.listStacksMatchingTagsMutex.RLock()
+	defer fake.listStacksMatchingTagsMutex.RUnlock()
+	argsForCall := fake.listStacksMatchingTagsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) ListStacksMatchingTagsReturns(result1 []*cloudformation.Stack, result2 error) {
+	fake.listStacksMatchingTagsMutex.Lock()
+	defer fake.listStacksMatchingTagsMutex.Unlock()
+	fake.ListStacksMatchingTagsStub = nil
+	fake.listStacksMatchingTagsReturns = struct {
+		result1 []*cloudformation.Stack
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) ListStacksMatchingTagsReturnsOnCall(i int, result1 []*cloudformation.Stack, result2 error) {
+	fake.listStacksMatchingTagsMutex.Lock()
+	defer fake.listStacksMatchingTagsMutex.Unlock()
+	fake.ListStacksMatchingTagsStub = nil
+	if fake.listStacksMatchingTagsReturnsOnCall == nil {
+		fake.listStacksMatchingTagsReturnsOnCall = make(map[int]struct {
+			result1 []*cloudformation.Stack
+			result2 error
+		})
+	}
+	fake.listStacksMatchingTagsReturnsOnCall[i] = struct {
+		result1 []*cloudformation.Stack
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) LookupCloudTrailEvents(arg1 *cloudformation.Stack) ([]*cloudtrail.Event, error) {
+	fake.lookupCloudTrailEventsMutex.Lock()
+	ret, specificReturn := fake.lookupCloudTrailEventsReturnsOnCall[len(fake.lookupCloudTrailEventsArgsForCall)]
+	fake.lookupCloudTrailEventsArgsForCall = append(fake.lookupCloudTrailEventsArgsForCall, struct {
+		arg1 *cloudformation.Stack
+	}{arg1})
+	stub := fake.LookupCloudTrailEventsStub
+	fakeReturns := fake.lookupCloudTrailEventsReturns
+	fake.recordInvocation("LookupCloudTrailEvents", []interface{}{arg1})
+	fake.lookupCloudTrailEventsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) LookupCloudTrailEventsCallCount() int {
+	fake.lookupCloudTrailEventsMutex.RLock()
+	defer fake.lookupCloudTrailEventsMutex.RUnlock()
+	return len(fake.lookupCloudTrailEventsArgsForCall)
+}
+
+func (fake *FakeStackManager) LookupCloudTrailEventsCalls(stub func(*cloudformation.Stack) ([]*cloudtrail.Event, error)) {
+	fake.lookupCloudTrailEventsMutex.Lock()
+	defer fake.lookupCloudTrailEventsMutex.Unlock()
+	fake.LookupCloudTrailEventsStub = stub
+}
+
+func (fake *FakeStackManager) LookupCloudTrailEventsArgsForCall(i int) *cloudformation.Stack {
+	fake.lookupCloudTrailEventsMutex.RLock()
+	defer fake.lookupCloudTrailEventsMutex.RUnlock()
+	argsForCall := fake.lookupCloudTrailEventsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) LookupCloudTrailEventsReturns(result1 []*cloudtrail.Event, result2 error) {
+	fake.lookupCloudTrailEventsMutex.Lock()
+	defer fake.lookupCloudTrailEventsMutex.Unlock()
+	fake.LookupCloudTrailEventsStub = nil
+	fake.lookupCloudTrailEventsReturns = struct {
+		result1 []*cloudtrail.Event
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) LookupCloudTrailEventsReturnsOnCall(i int, result1 []*cloudtrail.Event, result2 error) {
+	fake.lookupCloudTrailEventsMutex.Lock()
+	defer fake.lookupCloudTrailEventsMutex.Unlock()
+	fake.LookupCloudTrailEventsStub = nil
+	if fake.lookupCloudTrailEventsReturnsOnCall == nil {
+		fake.lookupCloudTrailEventsReturnsOnCall = make(map[int]struct {
+			result1 []*cloudtrail.Event
+			result2 error
+		})
+	}
+	fake.lookupCloudTrailEventsReturnsOnCall[i] = struct {
+		result1 []*cloudtrail.Event
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) MakeChangeSetName(arg1 string) string {
+	fake.makeChangeSetNameMutex.Lock()
+	ret, specificReturn := fake.makeChangeSetNameReturnsOnCall[len(fake.makeChangeSetNameArgsForCall)]
+	fake.makeChangeSetNameArgsForCall = append(fake.makeChangeSetNameArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.MakeChangeSetNameStub
+	fakeReturns := fake.makeChangeSetNameReturns
+	fake.recordInvocation("MakeChangeSetName", []interface{}{arg1})
+	fake.makeChangeSetNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStackManager) MakeChangeSetNameCallCount() int {
+	fake.makeChangeSetNameMutex.RLock()
+	defer fake.makeChangeSetNameMutex.RUnlock()
+	return len(fake.makeChangeSetNameArgsForCall)
+}
+
+func (fake *FakeStackManager) MakeChangeSetNameCalls(stub func(string) string) {
+	fake.makeChangeSetNameMutex.Lock()
+	defer fake.makeChangeSetNameMutex.Unlock()
+	fake.MakeChangeSetNameStub = stub
+}
+
+func (fake *FakeStackManager) MakeChangeSetNameArgsForCall(i int) string {
+	fake.makeChangeSetNameMutex.RLock()
+	defer fake.makeChangeSetNameMutex.RUnlock()
+	argsForCall := fake.makeChangeSetNameArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) MakeChangeSetNameReturns(result1 string) {
+	fake.makeChangeSetNameMutex.Lock()
+	defer fake.makeChangeSetNameMutex.Unlock()
+	fake.MakeChangeSetNameStub = nil
+	fake.makeChangeSetNameReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeStackManager) MakeChangeSetNameReturnsOnCall(i int, result1 string) {
+	fake.makeChangeSetNameMutex.Lock()
+	defer fake.makeChangeSetNameMutex.Unlock()
+	fake.MakeChangeSetNameStub = nil
+	if fake.makeChangeSetNameReturnsOnCall == nil {
+		fake.makeChangeSetNameReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.makeChangeSetNameReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeStackManager) MakeClusterStackName() string {
+	fake.makeClusterStackNameMutex.Lock()
+	ret, specificReturn := fake.makeClusterStackNameReturnsOnCall[len(fake.makeClusterStackNameArgsForCall)]
+	fake.makeClusterStackNameArgsForCall = append(fake.makeClusterStackNameArgsForCall, struct {
+	}{})
+	stub := fake.MakeClusterStackNameStub
+	fakeReturns := fake.makeClusterStackNameReturns
+	fake.recordInvocation("MakeClusterStackName", []interface{}{})
+	fake.makeClusterStackNameMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStackManager) MakeClusterStackNameCallCount() int {
+	fake.makeClusterStackNameMutex.RLock()
+	defer fake.makeClusterStackNameMutex.RUnlock()
+	return len(fake.makeClusterStackNameArgsForCall)
+}
+
+func (fake *FakeStackManager) MakeClusterStackNameCalls(stub func() string) {
+	fake.makeClusterStackNameMutex.Lock()
+	defer fake.makeClusterStackNameMutex.Unlock()
+	fake.MakeClusterStackNameStub = stub
+}
+
+func (fake *FakeStackManager) MakeClusterStackNameReturns(result1 string) {
+	fake.makeClusterStackNameMutex.Lock()
+	defer fake.makeClusterStackNameMutex.Unlock()
+	fake.MakeClusterStackNameStub = nil
+	fake.makeClusterStackNameReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeStackManager) MakeClusterStackNameReturnsOnCall(i int, result1 string) {
+	fake.makeClusterStackNameMutex.Lock()
+	defer fake.makeClusterStackNameMutex.Unlock()
+	fake.MakeClusterStackNameStub = nil
+	if fake.makeClusterStackNameReturnsOnCall == nil {
+		fake.makeClusterStackNameReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.makeClusterStackNameReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeStackManager) MigrateToManagedNodeGroup(arg1 *v1alpha5.NodeGroup, arg2 func(*v1alpha5.NodeGroup) error) error {
+	fake.migrateToManagedNodeGroupMutex.Lock()
+	ret, specificReturn := fake.migrateToManagedNodeGroupReturnsOnCall[len(fake.migrateToManagedNodeGroupArgsForCall)]
+	fake.migrateToManagedNodeGroupArgsForCall = append(fake.migrateToManagedNodeGroupArgsForCall, struct {
+		arg1 *v1alpha5.NodeGroup
+		arg2 func(*v1alpha5.NodeGroup) error
+	}{arg1, arg2})
+	stub := fake.MigrateToManagedNodeGroupStub
+	fakeReturns := fake.migrateToManagedNodeGroupReturns
+	fake.recordInvocation("MigrateToManagedNodeGroup", []interface{}{arg1, arg2})
+	fake.migrateToManagedNodeGroupMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStackManager) MigrateToManagedNodeGroupCallCount() int {
+	fake.migrateToManagedNodeGroupMutex.RLock()
+	defer fake.migrateToManagedNodeGroupMutex.RUnlock()
+	return len(fake.migrateToManagedNodeGroupArgsForCall)
+}
+
+func (fake *FakeStackManager) MigrateToManagedNodeGroupCalls(stub func(*v1alpha5.NodeGroup, func(*v1alpha5.NodeGroup) error) error) {
+	fake.migrateToManagedNodeGroupMutex.Lock()
+	defer fake.migrateToManagedNodeGroupMutex.Unlock()
+	fake.MigrateToManagedNodeGroupStub = stub
+}
+
+func (fake *FakeStackManager) MigrateToManagedNodeGroupArgsForCall(i int) (*v1alpha5.NodeGroup, func(*v1alpha5.NodeGroup) error) {
+	fake.migrateToManagedNodeGroupMutex.RLock()
+	defer fake.migrateToManagedNodeGroupMutex.RUnlock()
+	argsForCall := fake.migrateToManagedNodeGroupArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStackManager) MigrateToManagedNodeGroupReturns(result1 error) {
+	fake.migrateToManagedNodeGroupMutex.Lock()
+	defer fake.migrateToManagedNodeGroupMutex.Unlock()
+	fake.MigrateToManagedNodeGroupStub = nil
+	fake.migrateToManagedNodeGroupReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStackManager) MigrateToManagedNodeGroupReturnsOnCall(i int, result1 error) {
+	fake.migrateToManagedNodeGroupMutex.Lock()
+	defer fake.migrateToManagedNodeGroupMutex.Unlock()
+	fake.MigrateToManagedNodeGroupStub = nil
+	if fake.migrateToManagedNodeGroupReturnsOnCall == nil {
+		fake.migrateToManagedNodeGroupReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.migrateToManagedNodeGroupReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStackManager) NewClusterCompatTask() tasks.Task {
+	fake.newClusterCompatTaskMutex.Lock()
+	ret, specificReturn := fake.newClusterCompatTaskReturnsOnCall[len(fake.newClusterCompatTaskArgsForCall)]
+	fake.newClusterCompatTaskArgsForCall = append(fake.newClusterCompatTaskArgsForCall, struct {
+	}{})
+	stub := fake.NewClusterCompatTaskStub
+	fakeReturns := fake.newClusterCompatTaskReturns
+	fake.recordInvocation("NewClusterCompatTask", []interface{}{})
+	fake.newClusterCompatTaskMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStackManager) NewClusterCompatTaskCallCount() int {
+	fake.newClusterCompatTaskMutex.RLock()
+	defer fake.newClusterCompatTaskMutex.RUnlock()
+	return len(fake.newClusterCompatTaskArgsForCall)
+}
+
+func (fake *FakeStackManager) NewClusterCompatTaskCalls(stub func() tasks.Task) {
+	fake.newClusterCompatTaskMutex.Lock()
+	defer fake.newClusterCompatTaskMutex.Unlock()
+	fake.NewClusterCompatTaskStub = stub
+}
+
+func (fake *FakeStackManager) NewClusterCompatTaskReturns(result1 tasks.Task) {
+	fake.newClusterCompatTaskMutex.Lock()
+	defer fake.newClusterCompatTaskMutex.Unlock()
+	fake.NewClusterCompatTaskStub = nil
+	fake.newClusterCompatTaskReturns = struct {
+		result1 tasks.Task
+	}{result1}
+}
+
+func (fake *FakeStackManager) NewClusterCompatTaskReturnsOnCall(i int, result1 tasks.Task) {
+	fake.newClusterCompatTaskMutex.Lock()
+	defer fake.newClusterCompatTaskMutex.Unlock()
+	fake.NewClusterCompatTaskStub = nil
+	if fake.newClusterCompatTaskReturnsOnCall == nil {
+		fake.newClusterCompatTaskReturnsOnCall = make(map[int]struct {
+			result1 tasks.Task
+		})
+	}
+	fake.newClusterCompatTaskReturnsOnCall[i] = struct {
+		result1 tasks.Task
+	}{result1}
+}
+
+func (fake *FakeStackManager) NewManagedNodeGroupTask(arg1 []*v1alpha5.ManagedNodeGroup, arg2 bool, arg3 vpc.Importer) *tasks.TaskTree {
+	var arg1Copy []*v1alpha5.ManagedNodeGroup
+	if arg1 != nil {
+		arg1Copy = make([]*v1alpha5.ManagedNodeGroup, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.newManagedNodeGroupTaskMutex.Lock()
+	ret, specificReturn := fake.newManagedNodeGroupTaskReturnsOnCall[len(fake.newManagedNodeGroupTaskArgsForCall)]
+	fake.newManagedNodeGroupTaskArgsForCall = append(fake.newManagedNodeGroupTaskArgsForCall, struct {
+		arg1 []*v1alpha5.ManagedNodeGroup
+		arg2 bool
+		arg3 vpc.Importer
+	}{arg1Copy, arg2, arg3})
+	stub := fake.NewManagedNodeGroupTaskStub
+	fakeReturns := fake.newManagedNodeGroupTaskReturns
+	fake.recordInvocation("NewManagedNodeGroupTask", []interface{}{arg1Copy, arg2, arg3})
+	fake.newManagedNodeGroupTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStackManager) NewManagedNodeGroupTaskCallCount() int {
+	fake.newManagedNodeGroupTaskMutex.RLock()
+	defer fake.newManagedNodeGroupTaskMutex.RUnlock()
+	return len(fake.newManagedNodeGroupTaskArgsForCall)
+}
+
+func (fake *FakeStackManager) NewManagedNodeGroupTaskCalls(stub func([]*v1alpha5.ManagedNodeGroup, bool, vpc.Importer) *tasks.TaskTree) {
+	fake.newManagedNodeGroupTaskMutex.Lock()
+	defer fake.newManagedNodeGroupTaskMutex.Unlock()
+	fake.NewManagedNodeGroupTaskStub = stub
+}
+
+func (fake *FakeStackManager) NewManagedNodeGroupTaskArgsForCall(i int) ([]*v1alpha5.ManagedNodeGroup, bool, vpc.Importer) {
+	fake.newManagedNodeGroupTaskMutex.RLock()
+	defer fake.newManagedNodeGroupTaskMutex.RUnlock()
+	argsForCall := fake.newManagedNodeGroupTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStackManager) NewManagedNodeGroupTaskReturns(result1 *tasks.TaskTree) {
+	fake.newManagedNodeGroupTaskMutex.Lock()
+	defer fake.newManagedNodeGroupTaskMutex.Unlock()
+	fake.NewManagedNodeGroupTaskStub = nil
+	fake.newManagedNodeGroupTaskReturns = struct {
+		result1 *tasks.TaskTree
+	}{result1}
+}
+
+func (fake *FakeStackManager) NewManagedNodeGroupTaskReturnsOnCall(i int, result1 *tasks.TaskTree) {
+	fake.newManagedNodeGroupTaskMutex.Lock()
+	defer fake.newManagedNodeGroupTaskMutex.Unlock()
+	fake.NewManagedNodeGroupTaskStub = nil
+	if fake.newManagedNodeGroupTaskReturnsOnCall == nil {
+		fake.newManagedNodeGroupTaskReturnsOnCall = make(map[int]struct {
+			result1 *tasks.TaskTree
+		})
+	}
+	fake.newManagedNodeGroupTaskReturnsOnCall[i] = struct {
+		result1 *tasks.TaskTree
+	}{result1}
+}
+
+func (fake *FakeStackManager) NewTaskToDeleteAddonIAM(arg1 bool) (*tasks.TaskTree, error) {
+	fake.newTaskToDeleteAddonIAMMutex.Lock()
+	ret, specificReturn := fake.newTaskToDeleteAddonIAMReturnsOnCall[len(fake.newTaskToDeleteAddonIAMArgsForCall)]
+	fake.newTaskToDeleteAddonIAMArgsForCall = append(fake.newTaskToDeleteAddonIAMArgsForCall, struct {
+		arg1 bool
+	}{arg1})
+	stub := fake.NewTaskToDeleteAddonIAMStub
+	fakeReturns := fake.newTaskToDeleteAddonIAMReturns
+	fake.recordInvocation("NewTaskToDeleteAddonIAM", []interface{}{arg1})
+	fake.newTaskToDeleteAddonIAMMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) NewTaskToDeleteAddonIAMCallCount() int {
+	fake.newTaskToDeleteAddonIAMMutex.RLock()
+	defer fake.newTaskToDeleteAddonIAMMutex.RUnlock()
+	return len(fake.newTaskToDeleteAddonIAMArgsForCall)
+}
+
+func (fake *FakeStackManager) NewTaskToDeleteAddonIAMCalls(stub func(bool) (*tasks.TaskTree, error)) {
+	fake.newTaskToDeleteAddonIAMMutex.Lock()
+	defer fake.newTaskToDeleteAddonIAMMutex.Unlock()
+	fake.NewTaskToDeleteAddonIAMStub = stub
+}
+
+func (fake *FakeStackManager) NewTaskToDeleteAddonIAMArgsForCall(i int) bool {
+	fake.newTaskToDeleteAddonIAMMutex.RLock()
+	defer fake.newTaskToDeleteAddonIAMMutex.RUnlock()
+	argsForCall := fake.newTaskToDeleteAddonIAMArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) NewTaskToDeleteAddonIAMReturns(result1 *tasks.TaskTree, result2 error) {
+	fake.newTaskToDeleteAddonIAMMutex.Lock()
+	defer fake.newTaskToDeleteAddonIAMMutex.Unlock()
+	fake.NewTaskToDeleteAddonIAMStub = nil
+	fake.newTaskToDeleteAddonIAMReturns = struct {
+		result1 *tasks.TaskTree
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) NewTaskToDeleteAddonIAMReturnsOnCall(i int, result1 *tasks.TaskTree, result2 error) {
+	fake.newTaskToDeleteAddonIAMMutex.Lock()
+	defer fake.newTaskToDeleteAddonIAMMutex.Unlock()
+	fake.NewTaskToDeleteAddonIAMStub = nil
+	if fake.newTaskToDeleteAddonIAMReturnsOnCall == nil {
+		fake.newTaskToDeleteAddonIAMReturnsOnCall = make(map[int]struct {
+			result1 *tasks.TaskTree
+			result2 error
+		})
+	}
+	fake.newTaskToDeleteAddonIAMReturnsOnCall[i] = struct {
+		result1 *tasks.TaskTree
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) NewTaskToDeleteUnownedNodeGroup(arg1 string, arg2 string, arg3 eksiface.EKSAPI, arg4 *manager.DeleteWaitCondition) tasks.Task {
+	fake.newTaskToDeleteUnownedNodeGroupMutex.Lock()
+	ret, specificReturn := fake.newTaskToDeleteUnownedNodeGroupReturnsOnCall[len(fake.newTaskToDeleteUnownedNodeGroupArgsForCall)]
+	fake.newTaskToDeleteUnownedNodeGroupArgsForCall = append(fake.newTaskToDeleteUnownedNodeGroupArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 eksiface.EKSAPI
+		arg4 *manager.DeleteWaitCondition
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.NewTaskToDeleteUnownedNodeGroupStub
+	fakeReturns := fake.newTaskToDeleteUnownedNodeGroupReturns
+	fake.recordInvocation("NewTaskToDeleteUnownedNodeGroup", []interface{}{arg1, arg2, arg3, arg4})
+	fake.newTaskToDeleteUnownedNodeGroupMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStackManager) NewTaskToDeleteUnownedNodeGroupCallCount() int {
+	fake.newTaskToDeleteUnownedNodeGroupMutex.RLock()
+	defer fake.newTaskToDeleteUnownedNodeGroupMutex.RUnlock()
+	return len(fake.newTaskToDeleteUnownedNodeGroupArgsForCall)
+}
+
+func (fake *FakeStackManager) NewTaskToDeleteUnownedNodeGroupCalls(stub func(string, string, eksiface.EKSAPI, *manager.DeleteWaitCondition) tasks.Task) {
+	fake.newTaskToDeleteUnownedNodeGroupMutex.Lock()
+	defer fake.newTaskToDeleteUnownedNodeGroupMutex.Unlock()
+	fake.NewTaskToDeleteUnownedNodeGroupStub = stub
+}
+
+func (fake *FakeStackManager) NewTaskToDeleteUnownedNodeGroupArgsForCall(i int) (string, string, eksiface.EKSAPI, *manager.DeleteWaitCondition) {
+	fake.newTaskToDeleteUnownedNodeGroupMutex.RLock()
+	defer fake.newTaskToDeleteUnownedNodeGroupMutex.RUnlock()
+	argsForCall := fake.newTaskToDeleteUnownedNodeGroupArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeStackManager) NewTaskToDeleteUnownedNodeGroupReturns(result1 tasks.Task) {
+	fake.newTaskToDeleteUnownedNodeGroupMutex.Lock()
+	defer fake.newTaskToDeleteUnownedNodeGroupMutex.Unlock()
+	fake.NewTaskToDeleteUnownedNodeGroupStub = nil
+	fake.newTaskToDeleteUnownedNodeGroupReturns = struct {
+		result1 tasks.Task
+	}{result1}
+}
+
+func (fake *FakeStackManager) NewTaskToDeleteUnownedNodeGroupReturnsOnCall(i int, result1 tasks.Task) {
+	fake.newTaskToDeleteUnownedNodeGroupMutex.Lock()
+	defer fake.newTaskToDeleteUnownedNodeGroupMutex.Unlock()
+	fake.NewTaskToDeleteUnownedNodeGroupStub = nil
+	if fake.newTaskToDeleteUnownedNodeGroupReturnsOnCall == nil {
+		fake.newTaskToDeleteUnownedNodeGroupReturnsOnCall = make(map[int]struct {
+			result1 tasks.Task
+		})
+	}
+	fake.newTaskToDeleteUnownedNodeGroupReturnsOnCall[i] = struct {
+		result1 tasks.Task
+	}{result1}
+}
+
+func (fake *FakeStackManager) NewTasksToCreateClusterWithNodeGroups(arg1 []*v1alpha5.NodeGroup, arg2 []*v1alpha5.ManagedNodeGroup, arg3 bool, arg4 ...tasks.Task) *tasks.TaskTree {
+	var arg1Copy []*v1alpha5.NodeGroup
+	if arg1 != nil {
+		arg1Copy = make([]*v1alpha5.NodeGroup, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	var arg2Copy []*v1alpha5.ManagedNodeGroup
+	if arg2 != nil {
+		arg2Copy = make([]*v1alpha5.ManagedNodeGroup, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.newTasksToCreateClusterWithNodeGroupsMutex.Lock()
+	ret, specificReturn := fake.newTasksToCreateClusterWithNodeGroupsReturnsOnCall[len(fake.newTasksToCreateClusterWithNodeGroupsArgsForCall)]
+	fake.newTasksToCreateClusterWithNodeGroupsArgsForCall = append(fake.newTasksToCreateClusterWithNodeGroupsArgsForCall, struct {
+		arg1 []*v1alpha5.NodeGroup
+		arg2 []*v1alpha5.ManagedNodeGroup
+		arg3 bool
+		arg4 []tasks.Task
+	}{arg1Copy, arg2Copy, arg3, arg4})
+	stub := fake.NewTasksToCreateClusterWithNodeGroupsStub
+	fakeReturns := fake.newTasksToCreateClusterWithNodeGroupsReturns
+	fake.recordInvocation("NewTasksToCreateClusterWithNodeGroups", []interface{}{arg1Copy, arg2Copy, arg3, arg4})
+	fake.newTasksToCreateClusterWithNodeGroupsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStackManager) NewTasksToCreateClusterWithNodeGroupsCallCount() int {
+	fake.newTasksToCreateClusterWithNodeGroupsMutex.RLock()
+	defer fake.newTasksToCreateClusterWithNodeGroupsMutex.RUnlock()
+	return len(fake.newTasksToCreateClusterWithNodeGroupsArgsForCall)
+}
+
+func (fake *FakeStackManager) NewTasksToCreateClusterWithNodeGroupsCalls(stub func([]*v1alpha5.NodeGroup, []*v1alpha5.ManagedNodeGroup, bool, ...tasks.Task) *tasks.TaskTree) {
+	fake.newTasksToCreateClusterWithNodeGroupsMutex.Lock()
+	defer fake.newTasksToCreateClusterWithNodeGroupsMutex.Unlock()
+	fake.NewTasksToCreateClusterWithNodeGroupsStub = stub
+}
+
+func (fake *FakeStackManager) NewTasksToCreateClusterWithNodeGroupsArgsForCall(i int) ([]*v1alpha5.NodeGroup, []*v1alpha5.ManagedNodeGroup, bool, []tasks.Task) {
+	fake.newTasksToCreateClusterWithNodeGroupsMutex.RLock()
+	defer fake.newTasksToCreateClusterWithNodeGroupsMutex.RUnlock()
+	argsForCall := fake.newTasksToCreateClusterWithNodeGroupsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeStackManager) NewTasksToCreateClusterWithNodeGroupsReturns(result1 *tasks.TaskTree) {
+	fake.newTasksToCreateClusterWithNodeGroupsMutex.Lock()
+	defer fake.newTasksToCreateClusterWithNodeGroupsMutex.Unlock()
+	fake.NewTasksToCreateClusterWithNodeGroupsStub = nil
+	fake.newTasksToCreateClusterWithNodeGroupsReturns = struct {
+		result1 *tasks.TaskTree
+	}{result1}
+}
+
+func (fake *FakeStackManager) NewTasksToCreateClusterWithNodeGroupsReturnsOnCall(i int, result1 *tasks.TaskTree) {
+	fake.newTasksToCreateClusterWithNodeGroupsMutex.Lock()
+	defer fake.newTasksToCreateClusterWithNodeGroupsMutex.Unlock()
+	fake.NewTasksToCreateClusterWithNodeGroupsStub = nil
+	if fake.newTasksToCreateClusterWithNodeGroupsReturnsOnCall == nil {
+		fake.newTasksToCreateClusterWithNodeGroupsReturnsOnCall = make(map[int]struct {
+			result1 *tasks.TaskTree
+		})
+	}
+	fake.newTasksToCreateClusterWithNodeGroupsReturnsOnCall[i] = struct {
+		result1 *tasks.TaskTree
+	}{result1}
+}
+
+func (fake *FakeStackManager) NewTasksToCreateIAMServiceAccounts(arg1 []*v1alpha5.ClusterIAMServiceAccount, arg2 *iamoidc.OpenIDConnectManager, arg3 kubernetes.ClientSetGetter) *tasks.TaskTree {
+	var arg1Copy []*v1alpha5.ClusterIAMServiceAccount
+	if arg1 != nil {
+		arg1Copy = make([]*v1alpha5.ClusterIAMServiceAccount, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.newTasksToCreateIAMServiceAccountsMutex.Lock()
+	ret, specificReturn := fake.newTasksToCreateIAMServiceAccountsReturnsOnCall[len(fake.newTasksToCreateIAMServiceAccountsArgsForCall)]
+	fake.newTasksToCreateIAMServiceAccountsArgsForCall = append(fake.newTasksToCreateIAMServiceAccountsArgsForCall, struct {
+		arg1 []*v1alpha5.ClusterIAMServiceAccount
+		arg2 *iamoidc.OpenIDConnectManager
+		arg3 kubernetes.ClientSetGetter
+	}{arg1Copy, arg2, arg3})
+	stub := fake.NewTasksToCreateIAMServiceAccountsStub
+	fakeReturns := fake.newTasksToCreateIAMServiceAccountsReturns
+	fake.recordInvocation("NewTasksToCreateIAMServiceAccounts", []interface{}{arg1Copy, arg2, arg3})
+	fake.newTasksToCreateIAMServiceAccountsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStackManager) NewTasksToCreateIAMServiceAccountsCallCount() int {
+	fake.newTasksToCreateIAMServiceAccountsMutex.RLock()
+	defer fake.newTasksToCreateIAMServiceAccountsMutex.RUnlock()
+	return len(fake.newTasksToCreateIAMServiceAccountsArgsForCall)
+}
+
+func (fake *FakeStackManager) NewTasksToCreateIAMServiceAccountsCalls(stub func([]*v1alpha5.ClusterIAMServiceAccount, *iamoidc.OpenIDConnectManager, kubernetes.ClientSetGetter) *tasks.TaskTree) {
+	fake.newTasksToCreateIAMServiceAccountsMutex.Lock()
+	defer fake.newTasksToCreateIAMServiceAccountsMutex.Unlock()
+	fake.NewTasksToCreateIAMServiceAccountsStub = stub
+}
+
+func (fake *FakeStackManager) NewTasksToCreateIAMServiceAccountsArgsForCall(i int) ([]*v1alpha5.ClusterIAMServiceAccount, *iamoidc.OpenIDConnectManager, kubernetes.ClientSetGetter) {
+	fake.newTasksToCreateIAMServiceAccountsMutex.RLock()
+	defer fake.newTasksToCreateIAMServiceAccountsMutex.RUnlock()
+	argsForCall := fake.newTasksToCreateIAMServiceAccountsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStackManager) NewTasksToCreateIAMServiceAccountsReturns(result1 *tasks.TaskTree) {
+	fake.newTasksToCreateIAMServiceAccountsMutex.Lock()
+	defer fake.newTasksToCreateIAMServiceAccountsMutex.Unlock()
+	fake.NewTasksToCreateIAMServiceAccountsStub = nil
+	fake.newTasksToCreateIAMServiceAccountsReturns = struct {
+		result1 *tasks.TaskTree
+	}{result1}
+}
+
+func (fake *FakeStackManager) NewTasksToCreateIAMServiceAccountsReturnsOnCall(i int, result1 *tasks.TaskTree) {
+	fake.newTasksToCreateIAMServiceAccountsMutex.Lock()
+	defer fake.newTasksToCreateIAMServiceAccountsMutex.Unlock()
+	fake.NewTasksToCreateIAMServiceAccountsStub = nil
+	if fake.newTasksToCreateIAMServiceAccountsReturnsOnCall == nil {
+		fake.newTasksToCreateIAMServiceAccountsReturnsOnCall = make(map[int]struct {
+			result1 *tasks.TaskTree
+		})
+	}
+	fake.newTasksToCreateIAMServiceAccountsReturnsOnCall[i] = struct {
+		result1 *tasks.TaskTree
+	}{result1}
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteClusterWithNodeGroups(arg1 bool, arg2 *iamoidc.OpenIDConnectManager, arg3 kubernetes.ClientSetGetter, arg4 bool, arg5 func(chan error, string) error) (*tasks.TaskTree, error) {
+	fake.newTasksToDeleteClusterWithNodeGroupsMutex.Lock()
+	ret, specificReturn := fake.newTasksToDeleteClusterWithNodeGroupsReturnsOnCall[len(fake.newTasksToDeleteClusterWithNodeGroupsArgsForCall)]
+	fake.newTasksToDeleteClusterWithNodeGroupsArgsForCall = append(fake.newTasksToDeleteClusterWithNodeGroupsArgsForCall, struct {
+		arg1 bool
+		arg2 *iamoidc.OpenIDConnectManager
+		arg3 kubernetes.ClientSetGetter
+		arg4 bool
+		arg5 func(chan error, string) error
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.NewTasksToDeleteClusterWithNodeGroupsStub
+	fakeReturns := fake.newTasksToDeleteClusterWithNodeGroupsReturns
+	fake.recordInvocation("NewTasksToDeleteClusterWithNodeGroups", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.newTasksToDeleteClusterWithNodeGroupsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteClusterWithNodeGroupsCallCount() int {
+	fake.newTasksToDeleteClusterWithNodeGroupsMutex.RLock()
+	defer fake.newTasksToDeleteClusterWithNodeGroupsMutex.RUnlock()
+	return len(fake.newTasksToDeleteClusterWithNodeGroupsArgsForCall)
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteClusterWithNodeGroupsCalls(stub func(bool, *iamoidc.OpenIDConnectManager, kubernetes.ClientSetGetter, bool, func(chan error, string) error) (*tasks.TaskTree, error)) {
+	fake.newTasksToDeleteClusterWithNodeGroupsMutex.Lock()
+	defer fake.newTasksToDeleteClusterWithNodeGroupsMutex.Unlock()
+	fake.NewTasksToDeleteClusterWithNodeGroupsStub = stub
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteClusterWithNodeGroupsArgsForCall(i int) (bool, *iamoidc.OpenIDConnectManager, kubernetes.ClientSetGetter, bool, func(chan error, string) error) {
+	fake.newTasksToDeleteClusterWithNodeGroupsMutex.RLock()
+	defer fake.newTasksToDeleteClusterWithNodeGroupsMutex.RUnlock()
+	argsForCall := fake.newTasksToDeleteClusterWithNodeGroupsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteClusterWithNodeGroupsReturns(result1 *tasks.TaskTree, result2 error) {
+	fake.newTasksToDeleteClusterWithNodeGroupsMutex.Lock()
+	defer fake.newTasksToDeleteClusterWithNodeGroupsMutex.Unlock()
+	fake.NewTasksToDeleteClusterWithNodeGroupsStub = nil
+	fake.newTasksToDeleteClusterWithNodeGroupsReturns = struct {
+		result1 *tasks.TaskTree
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteClusterWithNodeGroupsReturnsOnCall(i int, result1 *tasks.TaskTree, result2 error) {
+	fake.newTasksToDeleteClusterWithNodeGroupsMutex.Lock()
+	defer fake.newTasksToDeleteClusterWithNodeGroupsMutex.Unlock()
+	fake.NewTasksToDeleteClusterWithNodeGroupsStub = nil
+	if fake.newTasksToDeleteClusterWithNodeGroupsReturnsOnCall == nil {
+		fake.newTasksToDeleteClusterWithNodeGroupsReturnsOnCall = make(map[int]struct {
+			result1 *tasks.TaskTree
+			result2 error
+		})
+	}
+	fake.newTasksToDeleteClusterWithNodeGroupsReturnsOnCall[i] = struct {
+		result1 *tasks.TaskTree
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteIAMServiceAccounts(arg1 []string, arg2 kubernetes.ClientSetGetter, arg3 bool) (*tasks.TaskTree, error) {
+	var arg1Copy []string
+	if arg1 != nil {
+		arg1Copy = make([]string, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.newTasksToDeleteIAMServiceAccountsMutex.Lock()
+	ret, specificReturn := fake.newTasksToDeleteIAMServiceAccountsReturnsOnCall[len(fake.newTasksToDeleteIAMServiceAccountsArgsForCall)]
+	fake.newTasksToDeleteIAMServiceAccountsArgsForCall = append(fake.newTasksToDeleteIAMServiceAccountsArgsForCall, struct {
+		arg1 []string
+		arg2 kubernetes.ClientSetGetter
+		arg3 bool
+	}{arg1Copy, arg2, arg3})
+	stub := fake.NewTasksToDeleteIAMServiceAccountsStub
+	fakeReturns := fake.newTasksToDeleteIAMServiceAccountsReturns
+	fake.recordInvocation("NewTasksToDeleteIAMServiceAccounts", []interface{}{arg1Copy, arg2, arg3})
+	fake.newTasksToDeleteIAMServiceAccountsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteIAMServiceAccountsCallCount() int {
+	fake.newTasksToDeleteIAMServiceAccountsMutex.RLock()
+	defer fake.newTasksToDeleteIAMServiceAccountsMutex.RUnlock()
+	return len(fake.newTasksToDeleteIAMServiceAccountsArgsForCall)
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteIAMServiceAccountsCalls(stub func([]string, kubernetes.ClientSetGetter, bool) (*tasks.TaskTree, error)) {
+	fake.newTasksToDeleteIAMServiceAccountsMutex.Lock()
+	defer fake.newTasksToDeleteIAMServiceAccountsMutex.Unlock()
+	fake.NewTasksToDeleteIAMServiceAccountsStub = stub
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteIAMServiceAccountsArgsForCall(i int) ([]string, kubernetes.ClientSetGetter, bool) {
+	fake.newTasksToDeleteIAMServiceAccountsMutex.RLock()
+	defer fake.newTasksToDeleteIAMServiceAccountsMutex.RUnlock()
+	argsForCall := fake.newTasksToDeleteIAMServiceAccountsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteIAMServiceAccountsReturns(result1 *tasks.TaskTree, result2 error) {
+	fake.newTasksToDeleteIAMServiceAccountsMutex.Lock()
+	defer fake.newTasksToDeleteIAMServiceAccountsMutex.Unlock()
+	fake.NewTasksToDeleteIAMServiceAccountsStub = nil
+	fake.newTasksToDeleteIAMServiceAccountsReturns = struct {
+		result1 *tasks.TaskTree
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteIAMServiceAccountsReturnsOnCall(i int, result1 *tasks.TaskTree, result2 error) {
+	fake.newTasksToDeleteIAMServiceAccountsMutex.Lock()
+	defer fake.newTasksToDeleteIAMServiceAccountsMutex.Unlock()
+	fake.NewTasksToDeleteIAMServiceAccountsStub = nil
+	if fake.newTasksToDeleteIAMServiceAccountsReturnsOnCall == nil {
+		fake.newTasksToDeleteIAMServiceAccountsReturnsOnCall = make(map[int]struct {
+			result1 *tasks.TaskTree
+			result2 error
+		})
+	}
+	fake.newTasksToDeleteIAMServiceAccountsReturnsOnCall[i] = struct {
+		result1 *tasks.TaskTree
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteNodeGroups(arg1 func(_ string) bool, arg2 bool, arg3 func(chan error, string) error) (*tasks.TaskTree, error) {
+	fake.newTasksToDeleteNodeGroupsMutex.Lock()
+	ret, specificReturn := fake.newTasksToDeleteNodeGroupsReturnsOnCall[len(fake.newTasksToDeleteNodeGroupsArgsForCall)]
+	fake.newTasksToDeleteNodeGroupsArgsForCall = append(fake.newTasksToDeleteNodeGroupsArgsForCall, struct {
+		arg1 func(_ string) bool
+		arg2 bool
+		arg3 func(chan error, string) error
+	}{arg1, arg2, arg3})
+	stub := fake.NewTasksToDeleteNodeGroupsStub
+	fakeReturns := fake.newTasksToDeleteNodeGroupsReturns
+	fake.recordInvocation("NewTasksToDeleteNodeGroups", []interface{}{arg1, arg2, arg3})
+	fake.newTasksToDeleteNodeGroupsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteNodeGroupsCallCount() int {
+	fake.newTasksToDeleteNodeGroupsMutex.RLock()
+	defer fake.newTasksToDeleteNodeGroupsMutex.RUnlock()
+	return len(fake.newTasksToDeleteNodeGroupsArgsForCall)
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteNodeGroupsCalls(stub func(func(_ string) bool, bool, func(chan error, string) error) (*tasks.TaskTree, error)) {
+	fake.newTasksToDeleteNodeGroupsMutex.Lock()
+	defer fake.newTasksToDeleteNodeGroupsMutex.Unlock()
+	fake.NewTasksToDeleteNodeGroupsStub = stub
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteNodeGroupsArgsForCall(i int) (func(_ string) bool, bool, func(chan error, string) error) {
+	fake.newTasksToDeleteNodeGroupsMutex.RLock()
+	defer fake.newTasksToDeleteNodeGroupsMutex.RUnlock()
+	argsForCall := fake.newTasksToDeleteNodeGroupsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteNodeGroupsReturns(result1 *tasks.TaskTree, result2 error) {
+	fake.newTasksToDeleteNodeGroupsMutex.Lock()
+	defer fake.newTasksToDeleteNodeGroupsMutex.Unlock()
+	fake.NewTasksToDeleteNodeGroupsStub = nil
+	fake.newTasksToDeleteNodeGroupsReturns = struct {
+		result1 *tasks.TaskTree
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteNodeGroupsReturnsOnCall(i int, result1 *tasks.TaskTree, result2 error) {
+	fake.newTasksToDeleteNodeGroupsMutex.Lock()
+	defer fake.newTasksToDeleteNodeGroupsMutex.Unlock()
+	fake.NewTasksToDeleteNodeGroupsStub = nil
+	if fake.newTasksToDeleteNodeGroupsReturnsOnCall == nil {
+		fake.newTasksToDeleteNodeGroupsReturnsOnCall = make(map[int]struct {
+			result1 *tasks.TaskTree
+			result2 error
+		})
+	}
+	fake.newTasksToDeleteNodeGroupsReturnsOnCall[i] = struct {
+		result1 *tasks.TaskTree
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteOIDCProviderWithIAMServiceAccounts(arg1 *iamoidc.OpenIDConnectManager, arg2 kubernetes.ClientSetGetter) (*tasks.TaskTree, error) {
+	fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.Lock()
+	ret, specificReturn := fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsReturnsOnCall[len(fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsArgsForCall)]
+	fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsArgsForCall = append(fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsArgsForCall, struct {
+		arg1 *iamoidc.OpenIDConnectManager
+		arg2 kubernetes.ClientSetGetter
+	}{arg1, arg2})
+	stub := fake.NewTasksToDeleteOIDCProviderWithIAMServiceAccountsStub
+	fakeReturns := fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsReturns
+	fake.recordInvocation("NewTasksToDeleteOIDCProviderWithIAMServiceAccounts", []interface{}{arg1, arg2})
+	fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteOIDCProviderWithIAMServiceAccountsCallCount() int {
+	fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.RLock()
+	defer fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.RUnlock()
+	return len(fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsArgsForCall)
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteOIDCProviderWithIAMServiceAccountsCalls(stub func(*iamoidc.OpenIDConnectManager, kubernetes.ClientSetGetter) (*tasks.TaskTree, error)) {
+	fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.Lock()
+	defer fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.Unlock()
+	fake.NewTasksToDeleteOIDCProviderWithIAMServiceAccountsStub = stub
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteOIDCProviderWithIAMServiceAccountsArgsForCall(i int) (*iamoidc.OpenIDConnectManager, kubernetes.ClientSetGetter) {
+	fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.RLock()
+	defer fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.RUnlock()
+	argsForCall := fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeStackManager) MakeChangeSetNameReturnsOnCall(i int, result1 string) {
-	fake.makeChangeSetNameMutex.Lock()
-	defer fake.makeChangeSetNameMutex.Unlock()
-	fake.MakeChangeSetNameStub = nil
-	if fake.makeChangeSetNameReturnsOnCall == nil {
-		fake.makeChangeSetNameReturnsOnCall = make(map[int]struct {
-			result1 string
+func (fake *FakeStackManager) NewTasksToDeleteOIDCProviderWithIAMServiceAccountsReturns(result1 *tasks.TaskTree, result2 error) {
+	fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.Lock()
+	defer fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.Unlock()
+	fake.NewTasksToDeleteOIDCProviderWithIAMServiceAccountsStub = nil
+	fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsReturns = struct {
+		result1 *tasks.TaskTree
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) NewTasksToDeleteOIDCProviderWithIAMServiceAccountsReturnsOnCall(i int, result1 *tasks.TaskTree, result2 error) {
+	fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.Lock()
+	defer fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.Unlock()
+	fake.NewTasksToDeleteOIDCProviderWithIAMServiceAccountsStub = nil
+	if fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsReturnsOnCall == nil {
+		fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsReturnsOnCall = make(map[int]struct {
+			result1 *tasks.TaskTree
+			result2 error
 		})
 	}
-	fake.makeChangeSetNameReturnsOnCall[i] = struct {
-		result1 string
-	}{result1}
+	fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsReturnsOnCall[i] = struct {
+		result1 *tasks.TaskTree
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeStackManager) MakeClusterStackName() string {
-	fake.makeClusterStackNameMutex.Lock()
-	ret, specificReturn := fake.makeClusterStackNameReturnsOnCall[len(fake.makeClusterStackNameArgsForCall)]
-	fake.makeClusterStackNameArgsForCall = append(fake.makeClusterStackNameArgsForCall, struct {
-	}{})
-	stub := fake.MakeClusterStackNameStub
-	fakeReturns := fake.makeClusterStackNameReturns
-	fake.recordInvocation("MakeClusterStackName", []interface{}{})
-	fake.makeClusterStackNameMutex.Unlock()
+func (fake *FakeStackManager) NewUnmanagedNodeGroupTask(arg1 []*v1alpha5.NodeGroup, arg2 bool, arg3 bool, arg4 vpc.Importer) *tasks.TaskTree {
+	var arg1Copy []*v1alpha5.NodeGroup
+	if arg1 != nil {
+		arg1Copy = make([]*v1alpha5.NodeGroup, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.newUnmanagedNodeGroupTaskMutex.Lock()
+	ret, specificReturn := fake.newUnmanagedNodeGroupTaskReturnsOnCall[len(fake.newUnmanagedNodeGroupTaskArgsForCall)]
+	fake.newUnmanagedNodeGroupTaskArgsForCall = append(fake.newUnmanagedNodeGroupTaskArgsForCall, struct {
+		arg1 []*v1alpha5.NodeGroup
+		arg2 bool
+		arg3 bool
+		arg4 vpc.Importer
+	}{arg1Copy, arg2, arg3, arg4})
+	stub := fake.NewUnmanagedNodeGroupTaskStub
+	fakeReturns := fake.newUnmanagedNodeGroupTaskReturns
+	fake.recordInvocation("NewUnmanagedNodeGroupTask", []interface{}{arg1Copy, arg2, arg3, arg4})
+	fake.newUnmanagedNodeGroupTaskMutex.Unlock()
 	if stub != nil {
-		return stub()
+		return stub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1
@@ -3274,172 +6057,186 @@ func (fake *FakeStackManager) MakeClusterStackName() string {
 	return fakeReturns.result1
 }
 
-func (fake *FakeStackManager) MakeClusterStackNameCallCount() int {
-	fake.makeClusterStackNameMutex.RLock()
-	defer fake.makeClusterStackNameMutex.RUnlock()
-	return len(fake.makeClusterStackNameArgsForCall)
+func (fake *FakeStackManager) NewUnmanagedNodeGroupTaskCallCount() int {
+	fake.newUnmanagedNodeGroupTaskMutex.RLock()
+	defer fake.newUnmanagedNodeGroupTaskMutex.RUnlock()
+	return len(fake.newUnmanagedNodeGroupTaskArgsForCall)
 }
 
-func (fake *FakeStackManager) MakeClusterStackNameCalls(stub func() string) {
-	fake.makeClusterStackNameMutex.Lock()
-	defer fake.makeClusterStackNameMutex.Unlock()
-	fake.MakeClusterStackNameStub = stub
+func (fake *FakeStackManager) NewUnmanagedNodeGroupTaskCalls(stub func([]*v1alpha5.NodeGroup, bool, bool, vpc.Importer) *tasks.TaskTree) {
+	fake.newUnmanagedNodeGroupTaskMutex.Lock()
+	defer fake.newUnmanagedNodeGroupTaskMutex.Unlock()
+	fake.NewUnmanagedNodeGroupTaskStub = stub
 }
 
-func (fake *FakeStackManager) MakeClusterStackNameReturns(result1 string) {
-	fake.makeClusterStackNameMutex.Lock()
-	defer fake.makeClusterStackNameMutex.Unlock()
-	fake.MakeClusterStackNameStub = nil
-	fake.makeClusterStackNameReturns = struct {
-		result1 string
+func (fake *FakeStackManager) NewUnmanagedNodeGroupTaskArgsForCall(i int) ([]*v1alpha5.NodeGroup, bool, bool, vpc.Importer) {
+	fake.newUnmanagedNodeGroupTaskMutex.RLock()
+	defer fake.newUnmanagedNodeGroupTaskMutex.RUnlock()
+	argsForCall := fake.newUnmanagedNodeGroupTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeStackManager) NewUnmanagedNodeGroupTaskReturns(result1 *tasks.TaskTree) {
+	fake.newUnmanagedNodeGroupTaskMutex.Lock()
+	defer fake.newUnmanagedNodeGroupTaskMutex.Unlock()
+	fake.NewUnmanagedNodeGroupTaskStub = nil
+	fake.newUnmanagedNodeGroupTaskReturns = struct {
+		result1 *tasks.TaskTree
 	}{result1}
 }
 
-func (fake *FakeStackManager) MakeClusterStackNameReturnsOnCall(i int, result1 string) {
-	fake.makeClusterStackNameMutex.Lock()
-	defer fake.makeClusterStackNameMutex.Unlock()
-	fake.MakeClusterStackNameStub = nil
-	if fake.makeClusterStackNameReturnsOnCall == nil {
-		fake.makeClusterStackNameReturnsOnCall = make(map[int]struct {
-			result1 string
+func (fake *FakeStackManager) NewUnmanagedNodeGroupTaskReturnsOnCall(i int, result1 *tasks.TaskTree) {
+	fake.newUnmanagedNodeGroupTaskMutex.Lock()
+	defer fake.newUnmanagedNodeGroupTaskMutex.Unlock()
+	fake.NewUnmanagedNodeGroupTaskStub = nil
+	if fake.newUnmanagedNodeGroupTaskReturnsOnCall == nil {
+		fake.newUnmanagedNodeGroupTaskReturnsOnCall = make(map[int]struct {
+			result1 *tasks.TaskTree
 		})
 	}
-	fake.makeClusterStackNameReturnsOnCall[i] = struct {
-		result1 string
+	fake.newUnmanagedNodeGroupTaskReturnsOnCall[i] = struct {
+		result1 *tasks.TaskTree
 	}{result1}
 }
 
-func (fake *FakeStackManager) NewClusterCompatTask() tasks.Task {
-	fake.newClusterCompatTaskMutex.Lock()
-	ret, specificReturn := fake.newClusterCompatTaskReturnsOnCall[len(fake.newClusterCompatTaskArgsForCall)]
-	fake.newClusterCompatTaskArgsForCall = append(fake.newClusterCompatTaskArgsForCall, struct {
-	}{})
-	stub := fake.NewClusterCompatTaskStub
-	fakeReturns := fake.newClusterCompatTaskReturns
-	fake.recordInvocation("NewClusterCompatTask", []interface{}{})
-	fake.newClusterCompatTaskMutex.Unlock()
+func (fake *FakeStackManager) NodeGroupChangeSet(arg1 *v1alpha5.NodeGroup) ([]manager.StackChange, error) {
+	fake.nodeGroupChangeSetMutex.Lock()
+	ret, specificReturn := fake.nodeGroupChangeSetReturnsOnCall[len(fake.nodeGroupChangeSetArgsForCall)]
+	fake.nodeGroupChangeSetArgsForCall = append(fake.nodeGroupChangeSetArgsForCall, struct {
+		arg1 *v1alpha5.NodeGroup
+	}{arg1})
+	stub := fake.NodeGroupChangeSetStub
+	fakeReturns := fake.nodeGroupChangeSetReturns
+	fake.recordInvocation("NodeGroupChangeSet", []interface{}{arg1})
+	fake.nodeGroupChangeSetMutex.Unlock()
 	if stub != nil {
-		return stub()
+		return stub(arg1)
 	}
 	if specificReturn {
-		return ret.result1
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1
+	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStackManager) NewClusterCompatTaskCallCount() int {
-	fake.newClusterCompatTaskMutex.RLock()
-	defer fake.newClusterCompatTaskMutex.RUnlock()
-	return len(fake.newClusterCompatTaskArgsForCall)
+func (fake *FakeStackManager) NodeGroupChangeSetCallCount() int {
+	fake.nodeGroupChangeSetMutex.RLock()
+	defer fake.nodeGroupChangeSetMutex.RUnlock()
+	return len(fake.nodeGroupChangeSetArgsForCall)
 }
 
-func (fake *FakeStackManager) NewClusterCompatTaskCalls(stub func() tasks.Task) {
-	fake.newClusterCompatTaskMutex.Lock()
-	defer fake.newClusterCompatTaskMutex.Unlock()
-	fake.NewClusterCompatTaskStub = stub
+func (fake *FakeStackManager) NodeGroupChangeSetCalls(stub func(*v1alpha5.NodeGroup) ([]manager.StackChange, error)) {
+	fake.nodeGroupChangeSetMutex.Lock()
+	defer fake.nodeGroupChangeSetMutex.Unlock()
+	fake.NodeGroupChangeSetStub = stub
 }
 
-func (fake *FakeStackManager) NewClusterCompatTaskReturns(result1 tasks.Task) {
-	fake.newClusterCompatTaskMutex.Lock()
-	defer fake.newClusterCompatTaskMutex.Unlock()
-	fake.NewClusterCompatTaskStub = nil
-	fake.newClusterCompatTaskReturns = struct {
-		result1 tasks.Task
-	}{result1}
+func (fake *FakeStackManager) NodeGroupChangeSetArgsForCall(i int) *v1alpha5.NodeGroup {
+	fake.nodeGroupChangeSetMutex.RLock()
+	defer fake.nodeGroupChangeSetMutex.RUnlock()
+	argsForCall := fake.nodeGroupChangeSetArgsForCall[i]
+	return argsForCall.arg1
 }
 
-func (fake *FakeStackManager) NewClusterCompatTaskReturnsOnCall(i int, result1 tasks.Task) {
-	fake.newClusterCompatTaskMutex.Lock()
-	defer fake.newClusterCompatTaskMutex.Unlock()
-	fake.NewClusterCompatTaskStub = nil
-	if fake.newClusterCompatTaskReturnsOnCall == nil {
-		fake.newClusterCompatTaskReturnsOnCall = make(map[int]struct {
-			result1 tasks.Task
+func (fake *FakeStackManager) NodeGroupChangeSetReturns(result1 []manager.StackChange, result2 error) {
+	fake.nodeGroupChangeSetMutex.Lock()
+	defer fake.nodeGroupChangeSetMutex.Unlock()
+	fake.NodeGroupChangeSetStub = nil
+	fake.nodeGroupChangeSetReturns = struct {
+		result1 []manager.StackChange
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) NodeGroupChangeSetReturnsOnCall(i int, result1 []manager.StackChange, result2 error) {
+	fake.nodeGroupChangeSetMutex.Lock()
+	defer fake.nodeGroupChangeSetMutex.Unlock()
+	fake.NodeGroupChangeSetStub = nil
+	if fake.nodeGroupChangeSetReturnsOnCall == nil {
+		fake.nodeGroupChangeSetReturnsOnCall = make(map[int]struct {
+			result1 []manager.StackChange
+			result2 error
 		})
 	}
-	fake.newClusterCompatTaskReturnsOnCall[i] = struct {
-		result1 tasks.Task
-	}{result1}
+	fake.nodeGroupChangeSetReturnsOnCall[i] = struct {
+		result1 []manager.StackChange
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeStackManager) NewManagedNodeGroupTask(arg1 []*v1alpha5.ManagedNodeGroup, arg2 bool, arg3 vpc.Importer) *tasks.TaskTree {
-	var arg1Copy []*v1alpha5.ManagedNodeGroup
-	if arg1 != nil {
-		arg1Copy = make([]*v1alpha5.ManagedNodeGroup, len(arg1))
-		copy(arg1Copy, arg1)
-	}
-	fake.newManagedNodeGroupTaskMutex.Lock()
-	ret, specificReturn := fake.newManagedNodeGroupTaskReturnsOnCall[len(fake.newManagedNodeGroupTaskArgsForCall)]
-	fake.newManagedNodeGroupTaskArgsForCall = append(fake.newManagedNodeGroupTaskArgsForCall, struct {
-		arg1 []*v1alpha5.ManagedNodeGroup
-		arg2 bool
-		arg3 vpc.Importer
-	}{arg1Copy, arg2, arg3})
-	stub := fake.NewManagedNodeGroupTaskStub
-	fakeReturns := fake.newManagedNodeGroupTaskReturns
-	fake.recordInvocation("NewManagedNodeGroupTask", []interface{}{arg1Copy, arg2, arg3})
-	fake.newManagedNodeGroupTaskMutex.Unlock()
+func (fake *FakeStackManager) NodeGroupScalingDrift(arg1 *v1alpha5.NodeGroup) (*manager.ScalingDrift, error) {
+	fake.nodeGroupScalingDriftMutex.Lock()
+	ret, specificReturn := fake.nodeGroupScalingDriftReturnsOnCall[len(fake.nodeGroupScalingDriftArgsForCall)]
+	fake.nodeGroupScalingDriftArgsForCall = append(fake.nodeGroupScalingDriftArgsForCall, struct {
+		arg1 *v1alpha5.NodeGroup
+	}{arg1})
+	stub := fake.NodeGroupScalingDriftStub
+	fakeReturns := fake.nodeGroupScalingDriftReturns
+	fake.recordInvocation("NodeGroupScalingDrift", []interface{}{arg1})
+	fake.nodeGroupScalingDriftMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3)
+		return stub(arg1)
 	}
 	if specificReturn {
-		return ret.result1
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1
+	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStackManager) NewManagedNodeGroupTaskCallCount() int {
-	fake.newManagedNodeGroupTaskMutex.RLock()
-	defer fake.newManagedNodeGroupTaskMutex.RUnlock()
-	return len(fake.newManagedNodeGroupTaskArgsForCall)
+func (fake *FakeStackManager) NodeGroupScalingDriftCallCount() int {
+	fake.nodeGroupScalingDriftMutex.RLock()
+	defer fake.nodeGroupScalingDriftMutex.RUnlock()
+	return len(fake.nodeGroupScalingDriftArgsForCall)
 }
 
-func (fake *FakeStackManager) NewManagedNodeGroupTaskCalls(stub func([]*v1alpha5.ManagedNodeGroup, bool, vpc.Importer) *tasks.TaskTree) {
-	fake.newManagedNodeGroupTaskMutex.Lock()
-	defer fake.newManagedNodeGroupTaskMutex.Unlock()
-	fake.NewManagedNodeGroupTaskStub = stub
+func (fake *FakeStackManager) NodeGroupScalingDriftCalls(stub func(*v1alpha5.NodeGroup) (*manager.ScalingDrift, error)) {
+	fake.nodeGroupScalingDriftMutex.Lock()
+	defer fake.nodeGroupScalingDriftMutex.Unlock()
+	fake.NodeGroupScalingDriftStub = stub
 }
 
-func (fake *FakeStackManager) NewManagedNodeGroupTaskArgsForCall(i int) ([]*v1alpha5.ManagedNodeGroup, bool, vpc.Importer) {
-	fake.newManagedNodeGroupTaskMutex.RLock()
-	defer fake.newManagedNodeGroupTaskMutex.RUnlock()
-	argsForCall := fake.newManagedNodeGroupTaskArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+func (fake *FakeStackManager) NodeGroupScalingDriftArgsForCall(i int) *v1alpha5.NodeGroup {
+	fake.nodeGroupScalingDriftMutex.RLock()
+	defer fake.nodeGroupScalingDriftMutex.RUnlock()
+	argsForCall := fake.nodeGroupScalingDriftArgsForCall[i]
+	return argsForCall.arg1
 }
 
-func (fake *FakeStackManager) NewManagedNodeGroupTaskReturns(result1 *tasks.TaskTree) {
-	fake.newManagedNodeGroupTaskMutex.Lock()
-	defer fake.newManagedNodeGroupTaskMutex.Unlock()
-	fake.NewManagedNodeGroupTaskStub = nil
-	fake.newManagedNodeGroupTaskReturns = struct {
-		result1 *tasks.TaskTree
-	}{result1}
+func (fake *FakeStackManager) NodeGroupScalingDriftReturns(result1 *manager.ScalingDrift, result2 error) {
+	fake.nodeGroupScalingDriftMutex.Lock()
+	defer fake.nodeGroupScalingDriftMutex.Unlock()
+	fake.NodeGroupScalingDriftStub = nil
+	fake.nodeGroupScalingDriftReturns = struct {
+		result1 *manager.ScalingDrift
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeStackManager) NewManagedNodeGroupTaskReturnsOnCall(i int, result1 *tasks.TaskTree) {
-	fake.newManagedNodeGroupTaskMutex.Lock()
-	defer fake.newManagedNodeGroupTaskMutex.Unlock()
-	fake.NewManagedNodeGroupTaskStub = nil
-	if fake.newManagedNodeGroupTaskReturnsOnCall == nil {
-		fake.newManagedNodeGroupTaskReturnsOnCall = make(map[int]struct {
-			result1 *tasks.TaskTree
+func (fake *FakeStackManager) NodeGroupScalingDriftReturnsOnCall(i int, result1 *manager.ScalingDrift, result2 error) {
+	fake.nodeGroupScalingDriftMutex.Lock()
+	defer fake.nodeGroupScalingDriftMutex.Unlock()
+	fake.NodeGroupScalingDriftStub = nil
+	if fake.nodeGroupScalingDriftReturnsOnCall == nil {
+		fake.nodeGroupScalingDriftReturnsOnCall = make(map[int]struct {
+			result1 *manager.ScalingDrift
+			result2 error
 		})
 	}
-	fake.newManagedNodeGroupTaskReturnsOnCall[i] = struct {
-		result1 *tasks.TaskTree
-	}{result1}
+	fake.nodeGroupScalingDriftReturnsOnCall[i] = struct {
+		result1 *manager.ScalingDrift
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeStackManager) NewTaskToDeleteAddonIAM(arg1 bool) (*tasks.TaskTree, error) {
-	fake.newTaskToDeleteAddonIAMMutex.Lock()
-	ret, specificReturn := fake.newTaskToDeleteAddonIAMReturnsOnCall[len(fake.newTaskToDeleteAddonIAMArgsForCall)]
-	fake.newTaskToDeleteAddonIAMArgsForCall = append(fake.newTaskToDeleteAddonIAMArgsForCall, struct {
-		arg1 bool
+func (fake *FakeStackManager) NodeGroupStackExists(arg1 string) (bool, error) {
+	fake.nodeGroupStackExistsMutex.Lock()
+	ret, specificReturn := fake.nodeGroupStackExistsReturnsOnCall[len(fake.nodeGroupStackExistsArgsForCall)]
+	fake.nodeGroupStackExistsArgsForCall = append(fake.nodeGroupStackExistsArgsForCall, struct {
+		arg1 string
 	}{arg1})
-	stub := fake.NewTaskToDeleteAddonIAMStub
-	fakeReturns := fake.newTaskToDeleteAddonIAMReturns
-	fake.recordInvocation("NewTaskToDeleteAddonIAM", []interface{}{arg1})
-	fake.newTaskToDeleteAddonIAMMutex.Unlock()
+	stub := fake.NodeGroupStackExistsStub
+	fakeReturns := fake.nodeGroupStackExistsReturns
+	fake.recordInvocation("NodeGroupStackExists", []interface{}{arg1})
+	fake.nodeGroupStackExistsMutex.Unlock()
 	if stub != nil {
 		return stub(arg1)
 	}
@@ -3449,66 +6246,62 @@ func (fake *FakeStackManager) NewTaskToDeleteAddonIAM(arg1 bool) (*tasks.TaskTre
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStackManager) NewTaskToDeleteAddonIAMCallCount() int {
-	fake.newTaskToDeleteAddonIAMMutex.RLock()
-	defer fake.newTaskToDeleteAddonIAMMutex.RUnlock()
-	return len(fake.newTaskToDeleteAddonIAMArgsForCall)
+func (fake *FakeStackManager) NodeGroupStackExistsCallCount() int {
+	fake.nodeGroupStackExistsMutex.RLock()
+	defer fake.nodeGroupStackExistsMutex.RUnlock()
+	return len(fake.nodeGroupStackExistsArgsForCall)
 }
 
-func (fake *FakeStackManager) NewTaskToDeleteAddonIAMCalls(stub func(bool) (*tasks.TaskTree, error)) {
-	fake.newTaskToDeleteAddonIAMMutex.Lock()
-	defer fake.newTaskToDeleteAddonIAMMutex.Unlock()
-	fake.NewTaskToDeleteAddonIAMStub = stub
+func (fake *FakeStackManager) NodeGroupStackExistsCalls(stub func(string) (bool, error)) {
+	fake.nodeGroupStackExistsMutex.Lock()
+	defer fake.nodeGroupStackExistsMutex.Unlock()
+	fake.NodeGroupStackExistsStub = stub
 }
 
-func (fake *FakeStackManager) NewTaskToDeleteAddonIAMArgsForCall(i int) bool {
-	fake.newTaskToDeleteAddonIAMMutex.RLock()
-	defer fake.newTaskToDeleteAddonIAMMutex.RUnlock()
-	argsForCall := fake.newTaskToDeleteAddonIAMArgsForCall[i]
+func (fake *FakeStackManager) NodeGroupStackExistsArgsForCall(i int) string {
+	fake.nodeGroupStackExistsMutex.RLock()
+	defer fake.nodeGroupStackExistsMutex.RUnlock()
+	argsForCall := fake.nodeGroupStackExistsArgsForCall[i]
 	return argsForCall.arg1
-}
-
-func (fake *FakeStackManager) NewTaskToDeleteAddonIAMReturns(result1 *tasks.TaskTree, result2 error) {
-	fake.newTaskToDeleteAddonIAMMutex.Lock()
-	defer fake.newTaskToDeleteAddonIAMMutex.Unlock()
-	fake.NewTaskToDeleteAddonIAMStub = nil
-	fake.newTaskToDeleteAddonIAMReturns = struct {
-		result1 *tasks.TaskTree
+}
+
+func (fake *FakeStackManager) NodeGroupStackExistsReturns(result1 bool, result2 error) {
+	fake.nodeGroupStackExistsMutex.Lock()
+	defer fake.nodeGroupStackExistsMutex.Unlock()
+	fake.NodeGroupStackExistsStub = nil
+	fake.nodeGroupStackExistsReturns = struct {
+		result1 bool
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) NewTaskToDeleteAddonIAMReturnsOnCall(i int, result1 *tasks.TaskTree, result2 error) {
-	fake.newTaskToDeleteAddonIAMMutex.Lock()
-	defer fake.newTaskToDeleteAddonIAMMutex.Unlock()
-	fake.NewTaskToDeleteAddonIAMStub = nil
-	if fake.newTaskToDeleteAddonIAMReturnsOnCall == nil {
-		fake.newTaskToDeleteAddonIAMReturnsOnCall = make(map[int]struct {
-			result1 *tasks.TaskTree
+func (fake *FakeStackManager) NodeGroupStackExistsReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.nodeGroupStackExistsMutex.Lock()
+	defer fake.nodeGroupStackExistsMutex.Unlock()
+	fake.NodeGroupStackExistsStub = nil
+	if fake.nodeGroupStackExistsReturnsOnCall == nil {
+		fake.nodeGroupStackExistsReturnsOnCall = make(map[int]struct {
+			result1 bool
 			result2 error
 		})
 	}
-	fake.newTaskToDeleteAddonIAMReturnsOnCall[i] = struct {
-		result1 *tasks.TaskTree
+	fake.nodeGroupStackExistsReturnsOnCall[i] = struct {
+		result1 bool
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) NewTaskToDeleteUnownedNodeGroup(arg1 string, arg2 string, arg3 eksiface.EKSAPI, arg4 *manager.DeleteWaitCondition) tasks.Task {
-	fake.newTaskToDeleteUnownedNodeGroupMutex.Lock()
-	ret, specificReturn := fake.newTaskToDeleteUnownedNodeGroupReturnsOnCall[len(fake.newTaskToDeleteUnownedNodeGroupArgsForCall)]
-	fake.newTaskToDeleteUnownedNodeGroupArgsForCall = append(fake.newTaskToDeleteUnownedNodeGroupArgsForCall, struct {
-		arg1 string
-		arg2 string
-		arg3 eksiface.EKSAPI
-		arg4 *manager.DeleteWaitCondition
-	}{arg1, arg2, arg3, arg4})
-	stub := fake.NewTaskToDeleteUnownedNodeGroupStub
-	fakeReturns := fake.newTaskToDeleteUnownedNodeGroupReturns
-	fake.recordInvocation("NewTaskToDeleteUnownedNodeGroup", []interface{}{arg1, arg2, arg3, arg4})
-	fake.newTaskToDeleteUnownedNodeGroupMutex.Unlock()
+func (fake *FakeStackManager) RefreshFargatePodExecutionRoleARN() error {
+	fake.refreshFargatePodExecutionRoleARNMutex.Lock()
+	ret, specificReturn := fake.refreshFargatePodExecutionRoleARNReturnsOnCall[len(fake.refreshFargatePodExecutionRoleARNArgsForCall)]
+	fake.refreshFargatePodExecutionRoleARNArgsForCall = append(fake.refreshFargatePodExecutionRoleARNArgsForCall, struct {
+	}{})
+	stub := fake.RefreshFargatePodExecutionRoleARNStub
+	fakeReturns := fake.refreshFargatePodExecutionRoleARNReturns
+	fake.recordInvocation("RefreshFargatePodExecutionRoleARN", []interface{}{})
+	fake.refreshFargatePodExecutionRoleARNMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4)
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
@@ -3516,73 +6309,53 @@ func (fake *FakeStackManager) NewTaskToDeleteUnownedNodeGroup(arg1 string, arg2
 	return fakeReturns.result1
 }
 
-func (fake *FakeStackManager) NewTaskToDeleteUnownedNodeGroupCallCount() int {
-	fake.newTaskToDeleteUnownedNodeGroupMutex.RLock()
-	defer fake.newTaskToDeleteUnownedNodeGroupMutex.RUnlock()
-	return len(fake.newTaskToDeleteUnownedNodeGroupArgsForCall)
-}
-
-func (fake *FakeStackManager) NewTaskToDeleteUnownedNodeGroupCalls(stub func(string, string, eksiface.EKSAPI, *manager.DeleteWaitCondition) tasks.Task) {
-	fake.newTaskToDeleteUnownedNodeGroupMutex.Lock()
-	defer fake.newTaskToDeleteUnownedNodeGroupMutex.Unlock()
-	fake.NewTaskToDeleteUnownedNodeGroupStub = stub
+func (fake *FakeStackManager) RefreshFargatePodExecutionRoleARNCallCount() int {
+	fake.refreshFargatePodExecutionRoleARNMutex.RLock()
+	defer fake.refreshFargatePodExecutionRoleARNMutex.RUnlock()
+	return len(fake.refreshFargatePodExecutionRoleARNArgsForCall)
 }
 
-func (fake *FakeStackManager) NewTaskToDeleteUnownedNodeGroupArgsForCall(i int) (string, string, eksiface.EKSAPI, *manager.DeleteWaitCondition) {
-	fake.newTaskToDeleteUnownedNodeGroupMutex.RLock()
-	defer fake.newTaskToDeleteUnownedNodeGroupMutex.RUnlock()
-	argsForCall := fake.newTaskToDeleteUnownedNodeGroupArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+func (fake *FakeStackManager) RefreshFargatePodExecutionRoleARNCalls(stub func() error) {
+	fake.refreshFargatePodExecutionRoleARNMutex.Lock()
+	defer fake.refreshFargatePodExecutionRoleARNMutex.Unlock()
+	fake.RefreshFargatePodExecutionRoleARNStub = stub
 }
 
-func (fake *FakeStackManager) NewTaskToDeleteUnownedNodeGroupReturns(result1 tasks.Task) {
-	fake.newTaskToDeleteUnownedNodeGroupMutex.Lock()
-	defer fake.newTaskToDeleteUnownedNodeGroupMutex.Unlock()
-	fake.NewTaskToDeleteUnownedNodeGroupStub = nil
-	fake.newTaskToDeleteUnownedNodeGroupReturns = struct {
-		result1 tasks.Task
+func (fake *FakeStackManager) RefreshFargatePodExecutionRoleARNReturns(result1 error) {
+	fake.refreshFargatePodExecutionRoleARNMutex.Lock()
+	defer fake.refreshFargatePodExecutionRoleARNMutex.Unlock()
+	fake.RefreshFargatePodExecutionRoleARNStub = nil
+	fake.refreshFargatePodExecutionRoleARNReturns = struct {
+		result1 error
 	}{result1}
 }
 
-func (fake *FakeStackManager) NewTaskToDeleteUnownedNodeGroupReturnsOnCall(i int, result1 tasks.Task) {
-	fake.newTaskToDeleteUnownedNodeGroupMutex.Lock()
-	defer fake.newTaskToDeleteUnownedNodeGroupMutex.Unlock()
-	fake.NewTaskToDeleteUnownedNodeGroupStub = nil
-	if fake.newTaskToDeleteUnownedNodeGroupReturnsOnCall == nil {
-		fake.newTaskToDeleteUnownedNodeGroupReturnsOnCall = make(map[int]struct {
-			result1 tasks.Task
+func (fake *FakeStackManager) RefreshFargatePodExecutionRoleARNReturnsOnCall(i int, result1 error) {
+	fake.refreshFargatePodExecutionRoleARNMutex.Lock()
+	defer fake.refreshFargatePodExecutionRoleARNMutex.Unlock()
+	fake.RefreshFargatePodExecutionRoleARNStub = nil
+	if fake.refreshFargatePodExecutionRoleARNReturnsOnCall == nil {
+		fake.refreshFargatePodExecutionRoleARNReturnsOnCall = make(map[int]struct {
+			result1 error
 		})
 	}
-	fake.newTaskToDeleteUnownedNodeGroupReturnsOnCall[i] = struct {
-		result1 tasks.Task
+	fake.refreshFargatePodExecutionRoleARNReturnsOnCall[i] = struct {
+		result1 error
 	}{result1}
 }
 
-func (fake *FakeStackManager) NewTasksToCreateClusterWithNodeGroups(arg1 []*v1alpha5.NodeGroup, arg2 []*v1alpha5.ManagedNodeGroup, arg3 bool, arg4 ...tasks.Task) *tasks.TaskTree {
-	var arg1Copy []*v1alpha5.NodeGroup
-	if arg1 != nil {
-		arg1Copy = make([]*v1alpha5.NodeGroup, len(arg1))
-		copy(arg1Copy, arg1)
-	}
-	var arg2Copy []*v1alpha5.ManagedNodeGroup
-	if arg2 != nil {
-		arg2Copy = make([]*v1alpha5.ManagedNodeGroup, len(arg2))
-		copy(arg2Copy, arg2)
-	}
-	fake.newTasksToCreateClusterWithNodeGroupsMutex.Lock()
-	ret, specificReturn := fake.newTasksToCreateClusterWithNodeGroupsReturnsOnCall[len(fake.newTasksToCreateClusterWithNodeGroupsArgsForCall)]
-	fake.newTasksToCreateClusterWithNodeGroupsArgsForCall = append(fake.newTasksToCreateClusterWithNodeGroupsArgsForCall, struct {
-		arg1 []*v1alpha5.NodeGroup
-		arg2 []*v1alpha5.ManagedNodeGroup
-		arg3 bool
-		arg4 []tasks.Task
-	}{arg1Copy, arg2Copy, arg3, arg4})
-	stub := fake.NewTasksToCreateClusterWithNodeGroupsStub
-	fakeReturns := fake.newTasksToCreateClusterWithNodeGroupsReturns
-	fake.recordInvocation("NewTasksToCreateClusterWithNodeGroups", []interface{}{arg1Copy, arg2Copy, arg3, arg4})
-	fake.newTasksToCreateClusterWithNodeGroupsMutex.Unlock()
+func (fake *FakeStackManager) RollbackNodeGroup(arg1 *v1alpha5.NodeGroup) error {
+	fake.rollbackNodeGroupMutex.Lock()
+	ret, specificReturn := fake.rollbackNodeGroupReturnsOnCall[len(fake.rollbackNodeGroupArgsForCall)]
+	fake.rollbackNodeGroupArgsForCall = append(fake.rollbackNodeGroupArgsForCall, struct {
+		arg1 *v1alpha5.NodeGroup
+	}{arg1})
+	stub := fake.RollbackNodeGroupStub
+	fakeReturns := fake.rollbackNodeGroupReturns
+	fake.recordInvocation("RollbackNodeGroup", []interface{}{arg1})
+	fake.rollbackNodeGroupMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4...)
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1
@@ -3590,65 +6363,60 @@ func (fake *FakeStackManager) NewTasksToCreateClusterWithNodeGroups(arg1 []*v1al
 	return fakeReturns.result1
 }
 
-func (fake *FakeStackManager) NewTasksToCreateClusterWithNodeGroupsCallCount() int {
-	fake.newTasksToCreateClusterWithNodeGroupsMutex.RLock()
-	defer fake.newTasksToCreateClusterWithNodeGroupsMutex.RUnlock()
-	return len(fake.newTasksToCreateClusterWithNodeGroupsArgsForCall)
+func (fake *FakeStackManager) RollbackNodeGroupCallCount() int {
+	fake.rollbackNodeGroupMutex.RLock()
+	defer fake.rollbackNodeGroupMutex.RUnlock()
+	return len(fake.rollbackNodeGroupArgsForCall)
 }
 
-func (fake *FakeStackManager) NewTasksToCreateClusterWithNodeGroupsCalls(stub func([]*v1alpha5.NodeGroup, []*v1alpha5.ManagedNodeGroup, bool, ...tasks.Task) *tasks.TaskTree) {
-	fake.newTasksToCreateClusterWithNodeGroupsMutex.Lock()
-	defer fake.newTasksToCreateClusterWithNodeGroupsMutex.Unlock()
-	fake.NewTasksToCreateClusterWithNodeGroupsStub = stub
+func (fake *FakeStackManager) RollbackNodeGroupCalls(stub func(*v1alpha5.NodeGroup) error) {
+	fake.rollbackNodeGroupMutex.Lock()
+	defer fake.rollbackNodeGroupMutex.Unlock()
+	fake.RollbackNodeGroupStub = stub
 }
 
-func (fake *FakeStackManager) NewTasksToCreateClusterWithNodeGroupsArgsForCall(i int) ([]*v1alpha5.NodeGroup, []*v1alpha5.ManagedNodeGroup, bool, []tasks.Task) {
-	fake.newTasksToCreateClusterWithNodeGroupsMutex.RLock()
-	defer fake.newTasksToCreateClusterWithNodeGroupsMutex.RUnlock()
-	argsForCall := fake.newTasksToCreateClusterWithNodeGroupsArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+func (fake *FakeStackManager) RollbackNodeGroupArgsForCall(i int) *v1alpha5.NodeGroup {
+	fake.rollbackNodeGroupMutex.RLock()
+	defer fake.rollbackNodeGroupMutex.RUnlock()
+	argsForCall := fake.rollbackNodeGroupArgsForCall[i]
+	return argsForCall.arg1
 }
 
-func (fake *FakeStackManager) NewTasksToCreateClusterWithNodeGroupsReturns(result1 *tasks.TaskTree) {
-	fake.newTasksToCreateClusterWithNodeGroupsMutex.Lock()
-	defer fake.newTasksToCreateClusterWithNodeGroupsMutex.Unlock()
-	fake.NewTasksToCreateClusterWithNodeGroupsStub = nil
-	fake.newTasksToCreateClusterWithNodeGroupsReturns = struct {
-		result1 *tasks.TaskTree
+func (fake *FakeStackManager) RollbackNodeGroupReturns(result1 error) {
+	fake.rollbackNodeGroupMutex.Lock()
+	defer fake.rollbackNodeGroupMutex.Unlock()
+	fake.RollbackNodeGroupStub = nil
+	fake.rollbackNodeGroupReturns = struct {
+		result1 error
 	}{result1}
 }
 
-func (fake *FakeStackManager) NewTasksToCreateClusterWithNodeGroupsReturnsOnCall(i int, result1 *tasks.TaskTree) {
-	fake.newTasksToCreateClusterWithNodeGroupsMutex.Lock()
-	defer fake.newTasksToCreateClusterWithNodeGroupsMutex.Unlock()
-	fake.NewTasksToCreateClusterWithNodeGroupsStub = nil
-	if fake.newTasksToCreateClusterWithNodeGroupsReturnsOnCall == nil {
-		fake.newTasksToCreateClusterWithNodeGroupsReturnsOnCall = make(map[int]struct {
-			result1 *tasks.TaskTree
+func (fake *FakeStackManager) RollbackNodeGroupReturnsOnCall(i int, result1 error) {
+	fake.rollbackNodeGroupMutex.Lock()
+	defer fake.rollbackNodeGroupMutex.Unlock()
+	fake.RollbackNodeGroupStub = nil
+	if fake.rollbackNodeGroupReturnsOnCall == nil {
+		fake.rollbackNodeGroupReturnsOnCall = make(map[int]struct {
+			result1 error
 		})
 	}
-	fake.newTasksToCreateClusterWithNodeGroupsReturnsOnCall[i] = struct {
-		result1 *tasks.TaskTree
+	fake.rollbackNodeGroupReturnsOnCall[i] = struct {
+		result1 error
 	}{result1}
 }
 
-func (fake *FakeStackManager) NewTasksToCreateIAMServiceAccounts(arg1 []*v1alpha5.ClusterIAMServiceAccount, arg2 *iamoidc.OpenIDConnectManager, arg3 kubernetes.ClientSetGetter) *tasks.TaskTree {
-	var arg1Copy []*v1alpha5.ClusterIAMServiceAccount
-	if arg1 != nil {
-		arg1Copy = make([]*v1alpha5.ClusterIAMServiceAccount, len(arg1))
-		copy(arg1Copy, arg1)
-	}
-	fake.newTasksToCreateIAMServiceAccountsMutex.Lock()
-	ret, specificReturn := fake.newTasksToCreateIAMServiceAccountsReturnsOnCall[len(fake.newTasksToCreateIAMServiceAccountsArgsForCall)]
-	fake.newTasksToCreateIAMServiceAccountsArgsForCall = append(fake.newTasksToCreateIAMServiceAccountsArgsForCall, struct {
-		arg1 []*v1alpha5.ClusterIAMServiceAccount
-		arg2 *iamoidc.OpenIDConnectManager
-		arg3 kubernetes.ClientSetGetter
-	}{arg1Copy, arg2, arg3})
-	stub := fake.NewTasksToCreateIAMServiceAccountsStub
-	fakeReturns := fake.newTasksToCreateIAMServiceAccountsReturns
-	fake.recordInvocation("NewTasksToCreateIAMServiceAccounts", []interface{}{arg1Copy, arg2, arg3})
-	fake.newTasksToCreateIAMServiceAccountsMutex.Unlock()
+func (fake *FakeStackManager) RollingScaleNodeGroup(arg1 *v1alpha5.NodeGroup, arg2 int, arg3 time.Duration) error {
+	fake.rollingScaleNodeGroupMutex.Lock()
+	ret, specificReturn := fake.rollingScaleNodeGroupReturnsOnCall[len(fake.rollingScaleNodeGroupArgsForCall)]
+	fake.rollingScaleNodeGroupArgsForCall = append(fake.rollingScaleNodeGroupArgsForCall, struct {
+		arg1 *v1alpha5.NodeGroup
+		arg2 int
+		arg3 time.Duration
+	}{arg1, arg2, arg3})
+	stub := fake.RollingScaleNodeGroupStub
+	fakeReturns := fake.rollingScaleNodeGroupReturns
+	fake.recordInvocation("RollingScaleNodeGroup", []interface{}{arg1, arg2, arg3})
+	fake.rollingScaleNodeGroupMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2, arg3)
 	}
@@ -3658,64 +6426,126 @@ func (fake *FakeStackManager) NewTasksToCreateIAMServiceAccounts(arg1 []*v1alpha
 	return fakeReturns.result1
 }
 
-func (fake *FakeStackManager) NewTasksToCreateIAMServiceAccountsCallCount() int {
-	fake.newTasksToCreateIAMServiceAccountsMutex.RLock()
-	defer fake.newTasksToCreateIAMServiceAccountsMutex.RUnlock()
-	return len(fake.newTasksToCreateIAMServiceAccountsArgsForCall)
+func (fake *FakeStackManager) RollingScaleNodeGroupCallCount() int {
+	fake.rollingScaleNodeGroupMutex.RLock()
+	defer fake.rollingScaleNodeGroupMutex.RUnlock()
+	return len(fake.rollingScaleNodeGroupArgsForCall)
 }
 
-func (fake *FakeStackManager) NewTasksToCreateIAMServiceAccountsCalls(stub func([]*v1alpha5.ClusterIAMServiceAccount, *iamoidc.OpenIDConnectManager, kubernetes.ClientSetGetter) *tasks.TaskTree) {
-	fake.newTasksToCreateIAMServiceAccountsMutex.Lock()
-	defer fake.newTasksToCreateIAMServiceAccountsMutex.Unlock()
-	fake.NewTasksToCreateIAMServiceAccountsStub = stub
+func (fake *FakeStackManager) RollingScaleNodeGroupCalls(stub func(*v1alpha5.NodeGroup, int, time.Duration) error) {
+	fake.rollingScaleNodeGroupMutex.Lock()
+	defer fake.rollingScaleNodeGroupMutex.Unlock()
+	fake.RollingScaleNodeGroupStub = stub
 }
 
-func (fake *FakeStackManager) NewTasksToCreateIAMServiceAccountsArgsForCall(i int) ([]*v1alpha5.ClusterIAMServiceAccount, *iamoidc.OpenIDConnectManager, kubernetes.ClientSetGetter) {
-	fake.newTasksToCreateIAMServiceAccountsMutex.RLock()
-	defer fake.newTasksToCreateIAMServiceAccountsMutex.RUnlock()
-	argsForCall := fake.newTasksToCreateIAMServiceAccountsArgsForCall[i]
+func (fake *FakeStackManager) RollingScaleNodeGroupArgsForCall(i int) (*v1alpha5.NodeGroup, int, time.Duration) {
+	fake.rollingScaleNodeGroupMutex.RLock()
+	defer fake.rollingScaleNodeGroupMutex.RUnlock()
+	argsForCall := fake.rollingScaleNodeGroupArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeStackManager) NewTasksToCreateIAMServiceAccountsReturns(result1 *tasks.TaskTree) {
-	fake.newTasksToCreateIAMServiceAccountsMutex.Lock()
-	defer fake.newTasksToCreateIAMServiceAccountsMutex.Unlock()
-	fake.NewTasksToCreateIAMServiceAccountsStub = nil
-	fake.newTasksToCreateIAMServiceAccountsReturns = struct {
-		result1 *tasks.TaskTree
+func (fake *FakeStackManager) RollingScaleNodeGroupReturns(result1 error) {
+	fake.rollingScaleNodeGroupMutex.Lock()
+	defer fake.rollingScaleNodeGroupMutex.Unlock()
+	fake.RollingScaleNodeGroupStub = nil
+	fake.rollingScaleNodeGroupReturns = struct {
+		result1 error
 	}{result1}
 }
 
-func (fake *FakeStackManager) NewTasksToCreateIAMServiceAccountsReturnsOnCall(i int, result1 *tasks.TaskTree) {
-	fake.newTasksToCreateIAMServiceAccountsMutex.Lock()
-	defer fake.newTasksToCreateIAMServiceAccountsMutex.Unlock()
-	fake.NewTasksToCreateIAMServiceAccountsStub = nil
-	if fake.newTasksToCreateIAMServiceAccountsReturnsOnCall == nil {
-		fake.newTasksToCreateIAMServiceAccountsReturnsOnCall = make(map[int]struct {
-			result1 *tasks.TaskTree
+func (fake *FakeStackManager) RollingScaleNodeGroupReturnsOnCall(i int, result1 error) {
+	fake.rollingScaleNodeGroupMutex.Lock()
+	defer fake.rollingScaleNodeGroupMutex.Unlock()
+	fake.RollingScaleNodeGroupStub = nil
+	if fake.rollingScaleNodeGroupReturnsOnCall == nil {
+		fake.rollingScaleNodeGroupReturnsOnCall = make(map[int]struct {
+			result1 error
 		})
 	}
-	fake.newTasksToCreateIAMServiceAccountsReturnsOnCall[i] = struct {
-		result1 *tasks.TaskTree
+	fake.rollingScaleNodeGroupReturnsOnCall[i] = struct {
+		result1 error
 	}{result1}
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteClusterWithNodeGroups(arg1 bool, arg2 *iamoidc.OpenIDConnectManager, arg3 kubernetes.ClientSetGetter, arg4 bool, arg5 func(chan error, string) error) (*tasks.TaskTree, error) {
-	fake.newTasksToDeleteClusterWithNodeGroupsMutex.Lock()
-	ret, specificReturn := fake.newTasksToDeleteClusterWithNodeGroupsReturnsOnCall[len(fake.newTasksToDeleteClusterWithNodeGroupsArgsForCall)]
-	fake.newTasksToDeleteClusterWithNodeGroupsArgsForCall = append(fake.newTasksToDeleteClusterWithNodeGroupsArgsForCall, struct {
-		arg1 bool
-		arg2 *iamoidc.OpenIDConnectManager
-		arg3 kubernetes.ClientSetGetter
-		arg4 bool
-		arg5 func(chan error, string) error
-	}{arg1, arg2, arg3, arg4, arg5})
-	stub := fake.NewTasksToDeleteClusterWithNodeGroupsStub
-	fakeReturns := fake.newTasksToDeleteClusterWithNodeGroupsReturns
-	fake.recordInvocation("NewTasksToDeleteClusterWithNodeGroups", []interface{}{arg1, arg2, arg3, arg4, arg5})
-	fake.newTasksToDeleteClusterWithNodeGroupsMutex.Unlock()
+func (fake *FakeStackManager) ScaleNodeGroup(arg1 *v1alpha5.NodeGroup, arg2 bool) ([]manager.StackChange, error) {
+	fake.scaleNodeGroupMutex.Lock()
+	ret, specificReturn := fake.scaleNodeGroupReturnsOnCall[len(fake.scaleNodeGroupArgsForCall)]
+	fake.scaleNodeGroupArgsForCall = append(fake.scaleNodeGroupArgsForCall, struct {
+		arg1 *v1alpha5.NodeGroup
+		arg2 bool
+	}{arg1, arg2})
+	stub := fake.ScaleNodeGroupStub
+	fakeReturns := fake.scaleNodeGroupReturns
+	fake.recordInvocation("ScaleNodeGroup", []interface{}{arg1, arg2})
+	fake.scaleNodeGroupMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) ScaleNodeGroupCallCount() int {
+	fake.scaleNodeGroupMutex.RLock()
+	defer fake.scaleNodeGroupMutex.RUnlock()
+	return len(fake.scaleNodeGroupArgsForCall)
+}
+
+func (fake *FakeStackManager) ScaleNodeGroupCalls(stub func(*v1alpha5.NodeGroup, bool) ([]manager.StackChange, error)) {
+	fake.scaleNodeGroupMutex.Lock()
+	defer fake.scaleNodeGroupMutex.Unlock()
+	fake.ScaleNodeGroupStub = stub
+}
+
+func (fake *FakeStackManager) ScaleNodeGroupArgsForCall(i int) (*v1alpha5.NodeGroup, bool) {
+	fake.scaleNodeGroupMutex.RLock()
+	defer fake.scaleNodeGroupMutex.RUnlock()
+	argsForCall := fake.scaleNodeGroupArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStackManager) ScaleNodeGroupReturns(result1 []manager.StackChange, result2 error) {
+	fake.scaleNodeGroupMutex.Lock()
+	defer fake.scaleNodeGroupMutex.Unlock()
+	fake.ScaleNodeGroupStub = nil
+	fake.scaleNodeGroupReturns = struct {
+		result1 []manager.StackChange
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) ScaleNodeGroupReturnsOnCall(i int, result1 []manager.StackChange, result2 error) {
+	fake.scaleNodeGroupMutex.Lock()
+	defer fake.scaleNodeGroupMutex.Unlock()
+	fake.ScaleNodeGroupStub = nil
+	if fake.scaleNodeGroupReturnsOnCall == nil {
+		fake.scaleNodeGroupReturnsOnCall = make(map[int]struct {
+			result1 []manager.StackChange
+			result2 error
+		})
+	}
+	fake.scaleNodeGroupReturnsOnCall[i] = struct {
+		result1 []manager.StackChange
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) ScaleNodeGroupByDelta(arg1 *v1alpha5.NodeGroup, arg2 int) (string, error) {
+	fake.scaleNodeGroupByDeltaMutex.Lock()
+	ret, specificReturn := fake.scaleNodeGroupByDeltaReturnsOnCall[len(fake.scaleNodeGroupByDeltaArgsForCall)]
+	fake.scaleNodeGroupByDeltaArgsForCall = append(fake.scaleNodeGroupByDeltaArgsForCall, struct {
+		arg1 *v1alpha5.NodeGroup
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.ScaleNodeGroupByDeltaStub
+	fakeReturns := fake.scaleNodeGroupByDeltaReturns
+	fake.recordInvocation("ScaleNodeGroupByDelta", []interface{}{arg1, arg2})
+	fake.scaleNodeGroupByDeltaMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4, arg5)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -3723,199 +6553,196 @@ func (fake *FakeStackManager) NewTasksToDeleteClusterWithNodeGroups(arg1 bool, a
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteClusterWithNodeGroupsCallCount() int {
-	fake.newTasksToDeleteClusterWithNodeGroupsMutex.RLock()
-	defer fake.newTasksToDeleteClusterWithNodeGroupsMutex.RUnlock()
-	return len(fake.newTasksToDeleteClusterWithNodeGroupsArgsForCall)
+func (fake *FakeStackManager) ScaleNodeGroupByDeltaCallCount() int {
+	fake.scaleNodeGroupByDeltaMutex.RLock()
+	defer fake.scaleNodeGroupByDeltaMutex.RUnlock()
+	return len(fake.scaleNodeGroupByDeltaArgsForCall)
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteClusterWithNodeGroupsCalls(stub func(bool, *iamoidc.OpenIDConnectManager, kubernetes.ClientSetGetter, bool, func(chan error, string) error) (*tasks.TaskTree, error)) {
-	fake.newTasksToDeleteClusterWithNodeGroupsMutex.Lock()
-	defer fake.newTasksToDeleteClusterWithNodeGroupsMutex.Unlock()
-	fake.NewTasksToDeleteClusterWithNodeGroupsStub = stub
+func (fake *FakeStackManager) ScaleNodeGroupByDeltaCalls(stub func(*v1alpha5.NodeGroup, int) (string, error)) {
+	fake.scaleNodeGroupByDeltaMutex.Lock()
+	defer fake.scaleNodeGroupByDeltaMutex.Unlock()
+	fake.ScaleNodeGroupByDeltaStub = stub
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteClusterWithNodeGroupsArgsForCall(i int) (bool, *iamoidc.OpenIDConnectManager, kubernetes.ClientSetGetter, bool, func(chan error, string) error) {
-	fake.newTasksToDeleteClusterWithNodeGroupsMutex.RLock()
-	defer fake.newTasksToDeleteClusterWithNodeGroupsMutex.RUnlock()
-	argsForCall := fake.newTasksToDeleteClusterWithNodeGroupsArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+func (fake *FakeStackManager) ScaleNodeGroupByDeltaArgsForCall(i int) (*v1alpha5.NodeGroup, int) {
+	fake.scaleNodeGroupByDeltaMutex.RLock()
+	defer fake.scaleNodeGroupByDeltaMutex.RUnlock()
+	argsForCall := fake.scaleNodeGroupByDeltaArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteClusterWithNodeGroupsReturns(result1 *tasks.TaskTree, result2 error) {
-	fake.newTasksToDeleteClusterWithNodeGroupsMutex.Lock()
-	defer fake.newTasksToDeleteClusterWithNodeGroupsMutex.Unlock()
-	fake.NewTasksToDeleteClusterWithNodeGroupsStub = nil
-	fake.newTasksToDeleteClusterWithNodeGroupsReturns = struct {
-		result1 *tasks.TaskTree
+func (fake *FakeStackManager) ScaleNodeGroupByDeltaReturns(result1 string, result2 error) {
+	fake.scaleNodeGroupByDeltaMutex.Lock()
+	defer fake.scaleNodeGroupByDeltaMutex.Unlock()
+	fake.ScaleNodeGroupByDeltaStub = nil
+	fake.scaleNodeGroupByDeltaReturns = struct {
+		result1 string
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteClusterWithNodeGroupsReturnsOnCall(i int, result1 *tasks.TaskTree, result2 error) {
-	fake.newTasksToDeleteClusterWithNodeGroupsMutex.Lock()
-	defer fake.newTasksToDeleteClusterWithNodeGroupsMutex.Unlock()
-	fake.NewTasksToDeleteClusterWithNodeGroupsStub = nil
-	if fake.newTasksToDeleteClusterWithNodeGroupsReturnsOnCall == nil {
-		fake.newTasksToDeleteClusterWithNodeGroupsReturnsOnCall = make(map[int]struct {
-			result1 *tasks.TaskTree
+func (fake *FakeStackManager) ScaleNodeGroupByDeltaReturnsOnCall(i int, result1 string, result2 error) {
+	fake.scaleNodeGroupByDeltaMutex.Lock()
+	defer fake.scaleNodeGroupByDeltaMutex.Unlock()
+	fake.ScaleNodeGroupByDeltaStub = nil
+	if fake.scaleNodeGroupByDeltaReturnsOnCall == nil {
+		fake.scaleNodeGroupByDeltaReturnsOnCall = make(map[int]struct {
+			result1 string
 			result2 error
 		})
 	}
-	fake.newTasksToDeleteClusterWithNodeGroupsReturnsOnCall[i] = struct {
-		result1 *tasks.TaskTree
+	fake.scaleNodeGroupByDeltaReturnsOnCall[i] = struct {
+		result1 string
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteIAMServiceAccounts(arg1 []string, arg2 kubernetes.ClientSetGetter, arg3 bool) (*tasks.TaskTree, error) {
-	var arg1Copy []string
+func (fake *FakeStackManager) ScaleNodeGroups(arg1 []*v1alpha5.NodeGroup) error {
+	var arg1Copy []*v1alpha5.NodeGroup
 	if arg1 != nil {
-		arg1Copy = make([]string, len(arg1))
+		arg1Copy = make([]*v1alpha5.NodeGroup, len(arg1))
 		copy(arg1Copy, arg1)
 	}
-	fake.newTasksToDeleteIAMServiceAccountsMutex.Lock()
-	ret, specificReturn := fake.newTasksToDeleteIAMServiceAccountsReturnsOnCall[len(fake.newTasksToDeleteIAMServiceAccountsArgsForCall)]
-	fake.newTasksToDeleteIAMServiceAccountsArgsForCall = append(fake.newTasksToDeleteIAMServiceAccountsArgsForCall, struct {
-		arg1 []string
-		arg2 kubernetes.ClientSetGetter
-		arg3 bool
-	}{arg1Copy, arg2, arg3})
-	stub := fake.NewTasksToDeleteIAMServiceAccountsStub
-	fakeReturns := fake.newTasksToDeleteIAMServiceAccountsReturns
-	fake.recordInvocation("NewTasksToDeleteIAMServiceAccounts", []interface{}{arg1Copy, arg2, arg3})
-	fake.newTasksToDeleteIAMServiceAccountsMutex.Unlock()
+	fake.scaleNodeGroupsMutex.Lock()
+	ret, specificReturn := fake.scaleNodeGroupsReturnsOnCall[len(fake.scaleNodeGroupsArgsForCall)]
+	fake.scaleNodeGroupsArgsForCall = append(fake.scaleNodeGroupsArgsForCall, struct {
+		arg1 []*v1alpha5.NodeGroup
+	}{arg1Copy})
+	stub := fake.ScaleNodeGroupsStub
+	fakeReturns := fake.scaleNodeGroupsReturns
+	fake.recordInvocation("ScaleNodeGroups", []interface{}{arg1Copy})
+	fake.scaleNodeGroupsMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3)
+		return stub(arg1)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1
 	}
-	return fakeReturns.result1, fakeReturns.result2
+	return fakeReturns.result1
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteIAMServiceAccountsCallCount() int {
-	fake.newTasksToDeleteIAMServiceAccountsMutex.RLock()
-	defer fake.newTasksToDeleteIAMServiceAccountsMutex.RUnlock()
-	return len(fake.newTasksToDeleteIAMServiceAccountsArgsForCall)
+func (fake *FakeStackManager) ScaleNodeGroupsCallCount() int {
+	fake.scaleNodeGroupsMutex.RLock()
+	defer fake.scaleNodeGroupsMutex.RUnlock()
+	return len(fake.scaleNodeGroupsArgsForCall)
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteIAMServiceAccountsCalls(stub func([]string, kubernetes.ClientSetGetter, bool) (*tasks.TaskTree, error)) {
-	fake.newTasksToDeleteIAMServiceAccountsMutex.Lock()
-	defer fake.newTasksToDeleteIAMServiceAccountsMutex.Unlock()
-	fake.NewTasksToDeleteIAMServiceAccountsStub = stub
+func (fake *FakeStackManager) ScaleNodeGroupsCalls(stub func([]*v1alpha5.NodeGroup) error) {
+	fake.scaleNodeGroupsMutex.Lock()
+	defer fake.scaleNodeGroupsMutex.Unlock()
+	fake.ScaleNodeGroupsStub = stub
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteIAMServiceAccountsArgsForCall(i int) ([]string, kubernetes.ClientSetGetter, bool) {
-	fake.newTasksToDeleteIAMServiceAccountsMutex.RLock()
-	defer fake.newTasksToDeleteIAMServiceAccountsMutex.RUnlock()
-	argsForCall := fake.newTasksToDeleteIAMServiceAccountsArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+func (fake *FakeStackManager) ScaleNodeGroupsArgsForCall(i int) []*v1alpha5.NodeGroup {
+	fake.scaleNodeGroupsMutex.RLock()
+	defer fake.scaleNodeGroupsMutex.RUnlock()
+	argsForCall := fake.scaleNodeGroupsArgsForCall[i]
+	return argsForCall.arg1
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteIAMServiceAccountsReturns(result1 *tasks.TaskTree, result2 error) {
-	fake.newTasksToDeleteIAMServiceAccountsMutex.Lock()
-	defer fake.newTasksToDeleteIAMServiceAccountsMutex.Unlock()
-	fake.NewTasksToDeleteIAMServiceAccountsStub = nil
-	fake.newTasksToDeleteIAMServiceAccountsReturns = struct {
-		result1 *tasks.TaskTree
-		result2 error
-	}{result1, result2}
+func (fake *FakeStackManager) ScaleNodeGroupsReturns(result1 error) {
+	fake.scaleNodeGroupsMutex.Lock()
+	defer fake.scaleNodeGroupsMutex.Unlock()
+	fake.ScaleNodeGroupsStub = nil
+	fake.scaleNodeGroupsReturns = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteIAMServiceAccountsReturnsOnCall(i int, result1 *tasks.TaskTree, result2 error) {
-	fake.newTasksToDeleteIAMServiceAccountsMutex.Lock()
-	defer fake.newTasksToDeleteIAMServiceAccountsMutex.Unlock()
-	fake.NewTasksToDeleteIAMServiceAccountsStub = nil
-	if fake.newTasksToDeleteIAMServiceAccountsReturnsOnCall == nil {
-		fake.newTasksToDeleteIAMServiceAccountsReturnsOnCall = make(map[int]struct {
-			result1 *tasks.TaskTree
-			result2 error
+func (fake *FakeStackManager) ScaleNodeGroupsReturnsOnCall(i int, result1 error) {
+	fake.scaleNodeGroupsMutex.Lock()
+	defer fake.scaleNodeGroupsMutex.Unlock()
+	fake.ScaleNodeGroupsStub = nil
+	if fake.scaleNodeGroupsReturnsOnCall == nil {
+		fake.scaleNodeGroupsReturnsOnCall = make(map[int]struct {
+			result1 error
 		})
 	}
-	fake.newTasksToDeleteIAMServiceAccountsReturnsOnCall[i] = struct {
-		result1 *tasks.TaskTree
-		result2 error
-	}{result1, result2}
+	fake.scaleNodeGroupsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteNodeGroups(arg1 func(_ string) bool, arg2 bool, arg3 func(chan error, string) error) (*tasks.TaskTree, error) {
-	fake.newTasksToDeleteNodeGroupsMutex.Lock()
-	ret, specificReturn := fake.newTasksToDeleteNodeGroupsReturnsOnCall[len(fake.newTasksToDeleteNodeGroupsArgsForCall)]
-	fake.newTasksToDeleteNodeGroupsArgsForCall = append(fake.newTasksToDeleteNodeGroupsArgsForCall, struct {
-		arg1 func(_ string) bool
-		arg2 bool
-		arg3 func(chan error, string) error
-	}{arg1, arg2, arg3})
-	stub := fake.NewTasksToDeleteNodeGroupsStub
-	fakeReturns := fake.newTasksToDeleteNodeGroupsReturns
-	fake.recordInvocation("NewTasksToDeleteNodeGroups", []interface{}{arg1, arg2, arg3})
-	fake.newTasksToDeleteNodeGroupsMutex.Unlock()
+func (fake *FakeStackManager) SetInstanceProtection(arg1 *v1alpha5.NodeGroup, arg2 []string, arg3 bool) error {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.setInstanceProtectionMutex.Lock()
+	ret, specificReturn := fake.setInstanceProtectionReturnsOnCall[len(fake.setInstanceProtectionArgsForCall)]
+	fake.setInstanceProtectionArgsForCall = append(fake.setInstanceProtectionArgsForCall, struct {
+		arg1 *v1alpha5.NodeGroup
+		arg2 []string
+		arg3 bool
+	}{arg1, arg2Copy, arg3})
+	stub := fake.SetInstanceProtectionStub
+	fakeReturns := fake.setInstanceProtectionReturns
+	fake.recordInvocation("SetInstanceProtection", []interface{}{arg1, arg2Copy, arg3})
+	fake.setInstanceProtectionMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1
 	}
-	return fakeReturns.result1, fakeReturns.result2
+	return fakeReturns.result1
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteNodeGroupsCallCount() int {
-	fake.newTasksToDeleteNodeGroupsMutex.RLock()
-	defer fake.newTasksToDeleteNodeGroupsMutex.RUnlock()
-	return len(fake.newTasksToDeleteNodeGroupsArgsForCall)
+func (fake *FakeStackManager) SetInstanceProtectionCallCount() int {
+	fake.setInstanceProtectionMutex.RLock()
+	defer fake.setInstanceProtectionMutex.RUnlock()
+	return len(fake.setInstanceProtectionArgsForCall)
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteNodeGroupsCalls(stub func(func(_ string) bool, bool, func(chan error, string) error) (*tasks.TaskTree, error)) {
-	fake.newTasksToDeleteNodeGroupsMutex.Lock()
-	defer fake.newTasksToDeleteNodeGroupsMutex.Unlock()
-	fake.NewTasksToDeleteNodeGroupsStub = stub
+func (fake *FakeStackManager) SetInstanceProtectionCalls(stub func(*v1alpha5.NodeGroup, []string, bool) error) {
+	fake.setInstanceProtectionMutex.Lock()
+	defer fake.setInstanceProtectionMutex.Unlock()
+	fake.SetInstanceProtectionStub = stub
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteNodeGroupsArgsForCall(i int) (func(_ string) bool, bool, func(chan error, string) error) {
-	fake.newTasksToDeleteNodeGroupsMutex.RLock()
-	defer fake.newTasksToDeleteNodeGroupsMutex.RUnlock()
-	argsForCall := fake.newTasksToDeleteNodeGroupsArgsForCall[i]
+func (fake *FakeStackManager) SetInstanceProtectionArgsForCall(i int) (*v1alpha5.NodeGroup, []string, bool) {
+	fake.setInstanceProtectionMutex.RLock()
+	defer fake.setInstanceProtectionMutex.RUnlock()
+	argsForCall := fake.setInstanceProtectionArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteNodeGroupsReturns(result1 *tasks.TaskTree, result2 error) {
-	fake.newTasksToDeleteNodeGroupsMutex.Lock()
-	defer fake.newTasksToDeleteNodeGroupsMutex.Unlock()
-	fake.NewTasksToDeleteNodeGroupsStub = nil
-	fake.newTasksToDeleteNodeGroupsReturns = struct {
-		result1 *tasks.TaskTree
-		result2 error
-	}{result1, result2}
+func (fake *FakeStackManager) SetInstanceProtectionReturns(result1 error) {
+	fake.setInstanceProtectionMutex.Lock()
+	defer fake.setInstanceProtectionMutex.Unlock()
+	fake.SetInstanceProtectionStub = nil
+	fake.setInstanceProtectionReturns = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteNodeGroupsReturnsOnCall(i int, result1 *tasks.TaskTree, result2 error) {
-	fake.newTasksToDeleteNodeGroupsMutex.Lock()
-	defer fake.newTasksToDeleteNodeGroupsMutex.Unlock()
-	fake.NewTasksToDeleteNodeGroupsStub = nil
-	if fake.newTasksToDeleteNodeGroupsReturnsOnCall == nil {
-		fake.newTasksToDeleteNodeGroupsReturnsOnCall = make(map[int]struct {
-			result1 *tasks.TaskTree
-			result2 error
+func (fake *FakeStackManager) SetInstanceProtectionReturnsOnCall(i int, result1 error) {
+	fake.setInstanceProtectionMutex.Lock()
+	defer fake.setInstanceProtectionMutex.Unlock()
+	fake.SetInstanceProtectionStub = nil
+	if fake.setInstanceProtectionReturnsOnCall == nil {
+		fake.setInstanceProtectionReturnsOnCall = make(map[int]struct {
+			result1 error
 		})
 	}
-	fake.newTasksToDeleteNodeGroupsReturnsOnCall[i] = struct {
-		result1 *tasks.TaskTree
-		result2 error
-	}{result1, result2}
+	fake.setInstanceProtectionReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteOIDCProviderWithIAMServiceAccounts(arg1 *iamoidc.OpenIDConnectManager, arg2 kubernetes.ClientSetGetter) (*tasks.TaskTree, error) {
-	fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.Lock()
-	ret, specificReturn := fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsReturnsOnCall[len(fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsArgsForCall)]
-	fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsArgsForCall = append(fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsArgsForCall, struct {
-		arg1 *iamoidc.OpenIDConnectManager
-		arg2 kubernetes.ClientSetGetter
+func (fake *FakeStackManager) SetNodeGroupAutoscalerPaused(arg1 *v1alpha5.NodeGroup, arg2 bool) (bool, error) {
+	fake.setNodeGroupAutoscalerPausedMutex.Lock()
+	ret, specificReturn := fake.setNodeGroupAutoscalerPausedReturnsOnCall[len(fake.setNodeGroupAutoscalerPausedArgsForCall)]
+	fake.setNodeGroupAutoscalerPausedArgsForCall = append(fake.setNodeGroupAutoscalerPausedArgsForCall, struct {
+		arg1 *v1alpha5.NodeGroup
+		arg2 bool
 	}{arg1, arg2})
-	stub := fake.NewTasksToDeleteOIDCProviderWithIAMServiceAccountsStub
-	fakeReturns := fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsReturns
-	fake.recordInvocation("NewTasksToDeleteOIDCProviderWithIAMServiceAccounts", []interface{}{arg1, arg2})
-	fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.Unlock()
+	stub := fake.SetNodeGroupAutoscalerPausedStub
+	fakeReturns := fake.setNodeGroupAutoscalerPausedReturns
+	fake.recordInvocation("SetNodeGroupAutoscalerPaused", []interface{}{arg1, arg2})
+	fake.setNodeGroupAutoscalerPausedMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
@@ -3925,71 +6752,64 @@ func (fake *FakeStackManager) NewTasksToDeleteOIDCProviderWithIAMServiceAccounts
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteOIDCProviderWithIAMServiceAccountsCallCount() int {
-	fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.RLock()
-	defer fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.RUnlock()
-	return len(fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsArgsForCall)
+func (fake *FakeStackManager) SetNodeGroupAutoscalerPausedCallCount() int {
+	fake.setNodeGroupAutoscalerPausedMutex.RLock()
+	defer fake.setNodeGroupAutoscalerPausedMutex.RUnlock()
+	return len(fake.setNodeGroupAutoscalerPausedArgsForCall)
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteOIDCProviderWithIAMServiceAccountsCalls(stub func(*iamoidc.OpenIDConnectManager, kubernetes.ClientSetGetter) (*tasks.TaskTree, error)) {
-	fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.Lock()
-	defer fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.Unlock()
-	fake.NewTasksToDeleteOIDCProviderWithIAMServiceAccountsStub = stub
+func (fake *FakeStackManager) SetNodeGroupAutoscalerPausedCalls(stub func(*v1alpha5.NodeGroup, bool) (bool, error)) {
+	fake.setNodeGroupAutoscalerPausedMutex.Lock()
+	defer fake.setNodeGroupAutoscalerPausedMutex.Unlock()
+	fake.SetNodeGroupAutoscalerPausedStub = stub
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteOIDCProviderWithIAMServiceAccountsArgsForCall(i int) (*iamoidc.OpenIDConnectManager, kubernetes.ClientSetGetter) {
-	fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.RLock()
-	defer fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.RUnlock()
-	argsForCall := fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsArgsForCall[i]
+func (fake *FakeStackManager) SetNodeGroupAutoscalerPausedArgsForCall(i int) (*v1alpha5.NodeGroup, bool) {
+	fake.setNodeGroupAutoscalerPausedMutex.RLock()
+	defer fake.setNodeGroupAutoscalerPausedMutex.RUnlock()
+	argsForCall := fake.setNodeGroupAutoscalerPausedArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteOIDCProviderWithIAMServiceAccountsReturns(result1 *tasks.TaskTree, result2 error) {
-	fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.Lock()
-	defer fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.Unlock()
-	fake.NewTasksToDeleteOIDCProviderWithIAMServiceAccountsStub = nil
-	fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsReturns = struct {
-		result1 *tasks.TaskTree
+func (fake *FakeStackManager) SetNodeGroupAutoscalerPausedReturns(result1 bool, result2 error) {
+	fake.setNodeGroupAutoscalerPausedMutex.Lock()
+	defer fake.setNodeGroupAutoscalerPausedMutex.Unlock()
+	fake.SetNodeGroupAutoscalerPausedStub = nil
+	fake.setNodeGroupAutoscalerPausedReturns = struct {
+		result1 bool
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) NewTasksToDeleteOIDCProviderWithIAMServiceAccountsReturnsOnCall(i int, result1 *tasks.TaskTree, result2 error) {
-	fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.Lock()
-	defer fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.Unlock()
-	fake.NewTasksToDeleteOIDCProviderWithIAMServiceAccountsStub = nil
-	if fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsReturnsOnCall == nil {
-		fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsReturnsOnCall = make(map[int]struct {
-			result1 *tasks.TaskTree
+func (fake *FakeStackManager) SetNodeGroupAutoscalerPausedReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.setNodeGroupAutoscalerPausedMutex.Lock()
+	defer fake.setNodeGroupAutoscalerPausedMutex.Unlock()
+	fake.SetNodeGroupAutoscalerPausedStub = nil
+	if fake.setNodeGroupAutoscalerPausedReturnsOnCall == nil {
+		fake.setNodeGroupAutoscalerPausedReturnsOnCall = make(map[int]struct {
+			result1 bool
 			result2 error
 		})
 	}
-	fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsReturnsOnCall[i] = struct {
-		result1 *tasks.TaskTree
+	fake.setNodeGroupAutoscalerPausedReturnsOnCall[i] = struct {
+		result1 bool
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStackManager) NewUnmanagedNodeGroupTask(arg1 []*v1alpha5.NodeGroup, arg2 bool, arg3 bool, arg4 vpc.Importer) *tasks.TaskTree {
-	var arg1Copy []*v1alpha5.NodeGroup
-	if arg1 != nil {
-		arg1Copy = make([]*v1alpha5.NodeGroup, len(arg1))
-		copy(arg1Copy, arg1)
-	}
-	fake.newUnmanagedNodeGroupTaskMutex.Lock()
-	ret, specificReturn := fake.newUnmanagedNodeGroupTaskReturnsOnCall[len(fake.newUnmanagedNodeGroupTaskArgsForCall)]
-	fake.newUnmanagedNodeGroupTaskArgsForCall = append(fake.newUnmanagedNodeGroupTaskArgsForCall, struct {
-		arg1 []*v1alpha5.NodeGroup
+func (fake *FakeStackManager) SetNodeGroupStackPolicy(arg1 *v1alpha5.NodeGroup, arg2 bool) error {
+	fake.setNodeGroupStackPolicyMutex.Lock()
+	ret, specificReturn := fake.setNodeGroupStackPolicyReturnsOnCall[len(fake.setNodeGroupStackPolicyArgsForCall)]
+	fake.setNodeGroupStackPolicyArgsForCall = append(fake.setNodeGroupStackPolicyArgsForCall, struct {
+		arg1 *v1alpha5.NodeGroup
 		arg2 bool
-		arg3 bool
-		arg4 vpc.Importer
-	}{arg1Copy, arg2, arg3, arg4})
-	stub := fake.NewUnmanagedNodeGroupTaskStub
-	fakeReturns := fake.newUnmanagedNodeGroupTaskReturns
-	fake.recordInvocation("NewUnmanagedNodeGroupTask", []interface{}{arg1Copy, arg2, arg3, arg4})
-	fake.newUnmanagedNodeGroupTaskMutex.Unlock()
+	}{arg1, arg2})
+	stub := fake.SetNodeGroupStackPolicyStub
+	fakeReturns := fake.setNodeGroupStackPolicyReturns
+	fake.recordInvocation("SetNodeGroupStackPolicy", []interface{}{arg1, arg2})
+	fake.setNodeGroupStackPolicyMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
@@ -3997,59 +6817,61 @@ func (fake *FakeStackManager) NewUnmanagedNodeGroupTask(arg1 []*v1alpha5.NodeGro
 	return fakeReturns.result1
 }
 
-func (fake *FakeStackManager) NewUnmanagedNodeGroupTaskCallCount() int {
-	fake.newUnmanagedNodeGroupTaskMutex.RLock()
-	defer fake.newUnmanagedNodeGroupTaskMutex.RUnlock()
-	return len(fake.newUnmanagedNodeGroupTaskArgsForCall)
-}
-
-func (fake *FakeStackManager) NewUnmanagedNodeGroupTaskCalls(stub func([]*v1alpha5.NodeGroup, bool, bool, vpc.Importer) *tasks.TaskTree) {
-	fake.newUnmanagedNodeGroupTaskMutex.Lock()
-	defer fake.newUnmanagedNodeGroupTaskMutex.Unlock()
-	fake.NewUnmanagedNodeGroupTaskStub = stub
+func (fake *FakeStackManager) SetNodeGroupStackPolicyCallCount() int {
+	fake.setNodeGroupStackPolicyMutex.RLock()
+	defer fake.setNodeGroupStackPolicyMutex.RUnlock()
+	return len(fake.setNodeGroupStackPolicyArgsForCall)
 }
 
-func (fake *FakeStackManager) NewUnmanagedNodeGroupTaskArgsForCall(i int) ([]*v1alpha5.NodeGroup, bool, bool, vpc.Importer) {
-	fake.newUnmanagedNodeGroupTaskMutex.RLock()
-	defer fake.newUnmanagedNodeGroupTaskMutex.RUnlock()
-	argsForCall := fake.newUnmanagedNodeGroupTaskArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+func (fake *FakeStackManager) SetNodeGroupStackPolicyCalls(stub func(*v1alpha5.NodeGroup, bool) error) {
+	fake.setNodeGroupStackPolicyMutex.Lock()
+	defer fake.setNodeGroupStackPolicyMutex.Unlock()
+	fake.SetNodeGroupStackPolicyStub = stub
 }
-
-func (fake *FakeStackManager) NewUnmanagedNodeGroupTaskReturns(result1 *tasks.TaskTree) {
-	fake.newUnmanagedNodeGroupTaskMutex.Lock()
-	defer fake.newUnmanagedNodeGroupTaskMutex.Unlock()
-	fake.NewUnmanagedNodeGroupTaskStub = nil
-	fake.newUnmanagedNodeGroupTaskReturns = struct {
-		result1 *tasks.TaskTree
+
+func (fake *FakeStackManager) SetNodeGroupStackPolicyArgsForCall(i int) (*v1alpha5.NodeGroup, bool) {
+	fake.setNodeGroupStackPolicyMutex.RLock()
+	defer fake.setNodeGroupStackPolicyMutex.RUnlock()
+	argsForCall := fake.setNodeGroupStackPolicyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStackManager) SetNodeGroupStackPolicyReturns(result1 error) {
+	fake.setNodeGroupStackPolicyMutex.Lock()
+	defer fake.setNodeGroupStackPolicyMutex.Unlock()
+	fake.SetNodeGroupStackPolicyStub = nil
+	fake.setNodeGroupStackPolicyReturns = struct {
+		result1 error
 	}{result1}
 }
 
-func (fake *FakeStackManager) NewUnmanagedNodeGroupTaskReturnsOnCall(i int, result1 *tasks.TaskTree) {
-	fake.newUnmanagedNodeGroupTaskMutex.Lock()
-	defer fake.newUnmanagedNodeGroupTaskMutex.Unlock()
-	fake.NewUnmanagedNodeGroupTaskStub = nil
-	if fake.newUnmanagedNodeGroupTaskReturnsOnCall == nil {
-		fake.newUnmanagedNodeGroupTaskReturnsOnCall = make(map[int]struct {
-			result1 *tasks.TaskTree
+func (fake *FakeStackManager) SetNodeGroupStackPolicyReturnsOnCall(i int, result1 error) {
+	fake.setNodeGroupStackPolicyMutex.Lock()
+	defer fake.setNodeGroupStackPolicyMutex.Unlock()
+	fake.SetNodeGroupStackPolicyStub = nil
+	if fake.setNodeGroupStackPolicyReturnsOnCall == nil {
+		fake.setNodeGroupStackPolicyReturnsOnCall = make(map[int]struct {
+			result1 error
 		})
 	}
-	fake.newUnmanagedNodeGroupTaskReturnsOnCall[i] = struct {
-		result1 *tasks.TaskTree
+	fake.setNodeGroupStackPolicyReturnsOnCall[i] = struct {
+		result1 error
 	}{result1}
 }
 
-func (fake *FakeStackManager) RefreshFargatePodExecutionRoleARN() error {
-	fake.refreshFargatePodExecutionRoleARNMutex.Lock()
-	ret, specificReturn := fake.refreshFargatePodExecutionRoleARNReturnsOnCall[len(fake.refreshFargatePodExecutionRoleARNArgsForCall)]
-	fake.refreshFargatePodExecutionRoleARNArgsForCall = append(fake.refreshFargatePodExecutionRoleARNArgsForCall, struct {
-	}{})
-	stub := fake.RefreshFargatePodExecutionRoleARNStub
-	fakeReturns := fake.refreshFargatePodExecutionRoleARNReturns
-	fake.recordInvocation("RefreshFargatePodExecutionRoleARN", []interface{}{})
-	fake.refreshFargatePodExecutionRoleARNMutex.Unlock()
+func (fake *FakeStackManager) SetNodeGroupStackPolicyBody(arg1 *v1alpha5.NodeGroup, arg2 string) error {
+	fake.setNodeGroupStackPolicyBodyMutex.Lock()
+	ret, specificReturn := fake.setNodeGroupStackPolicyBodyReturnsOnCall[len(fake.setNodeGroupStackPolicyBodyArgsForCall)]
+	fake.setNodeGroupStackPolicyBodyArgsForCall = append(fake.setNodeGroupStackPolicyBodyArgsForCall, struct {
+		arg1 *v1alpha5.NodeGroup
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.SetNodeGroupStackPolicyBodyStub
+	fakeReturns := fake.setNodeGroupStackPolicyBodyReturns
+	fake.recordInvocation("SetNodeGroupStackPolicyBody", []interface{}{arg1, arg2})
+	fake.setNodeGroupStackPolicyBodyMutex.Unlock()
 	if stub != nil {
-		return stub()
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
@@ -4057,51 +6879,63 @@ func (fake *FakeStackManager) RefreshFargatePodExecutionRoleARN() error {
 	return fakeReturns.result1
 }
 
-func (fake *FakeStackManager) RefreshFargatePodExecutionRoleARNCallCount() int {
-	fake.refreshFargatePodExecutionRoleARNMutex.RLock()
-	defer fake.refreshFargatePodExecutionRoleARNMutex.RUnlock()
-	return len(fake.refreshFargatePodExecutionRoleARNArgsForCall)
+func (fake *FakeStackManager) SetNodeGroupStackPolicyBodyCallCount() int {
+	fake.setNodeGroupStackPolicyBodyMutex.RLock()
+	defer fake.setNodeGroupStackPolicyBodyMutex.RUnlock()
+	return len(fake.setNodeGroupStackPolicyBodyArgsForCall)
 }
 
-func (fake *FakeStackManager) RefreshFargatePodExecutionRoleARNCalls(stub func() error) {
-	fake.refreshFargatePodExecutionRoleARNMutex.Lock()
-	defer fake.refreshFargatePodExecutionRoleARNMutex.Unlock()
-	fake.RefreshFargatePodExecutionRoleARNStub = stub
+func (fake *FakeStackManager) SetNodeGroupStackPolicyBodyCalls(stub func(*v1alpha5.NodeGroup, string) error) {
+	fake.setNodeGroupStackPolicyBodyMutex.Lock()
+	defer fake.setNodeGroupStackPolicyBodyMutex.Unlock()
+	fake.SetNodeGroupStackPolicyBodyStub = stub
 }
 
-func (fake *FakeStackManager) RefreshFargatePodExecutionRoleARNReturns(result1 error) {
-	fake.refreshFargatePodExecutionRoleARNMutex.Lock()
-	defer fake.refreshFargatePodExecutionRoleARNMutex.Unlock()
-	fake.RefreshFargatePodExecutionRoleARNStub = nil
-	fake.refreshFargatePodExecutionRoleARNReturns = struct {
+func (fake *FakeStackManager) SetNodeGroupStackPolicyBodyArgsForCall(i int) (*v1alpha5.NodeGroup, string) {
+	fake.setNodeGroupStackPolicyBodyMutex.RLock()
+	defer fake.setNodeGroupStackPolicyBodyMutex.RUnlock()
+	argsForCall := fake.setNodeGroupStackPolicyBodyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStackManager) SetNodeGroupStackPolicyBodyReturns(result1 error) {
+	fake.setNodeGroupStackPolicyBodyMutex.Lock()
+	defer fake.setNodeGroupStackPolicyBodyMutex.Unlock()
+	fake.SetNodeGroupStackPolicyBodyStub = nil
+	fake.setNodeGroupStackPolicyBodyReturns = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeStackManager) RefreshFargatePodExecutionRoleARNReturnsOnCall(i int, result1 error) {
-	fake.refreshFargatePodExecutionRoleARNMutex.Lock()
-	defer fake.refreshFargatePodExecutionRoleARNMutex.Unlock()
-	fake.RefreshFargatePodExecutionRoleARNStub = nil
-	if fake.refreshFargatePodExecutionRoleARNReturnsOnCall == nil {
-		fake.refreshFargatePodExecutionRoleARNReturnsOnCall = make(map[int]struct {
+func (fake *FakeStackManager) SetNodeGroupStackPolicyBodyReturnsOnCall(i int, result1 error) {
+	fake.setNodeGroupStackPolicyBodyMutex.Lock()
+	defer fake.setNodeGroupStackPolicyBodyMutex.Unlock()
+	fake.SetNodeGroupStackPolicyBodyStub = nil
+	if fake.setNodeGroupStackPolicyBodyReturnsOnCall == nil {
+		fake.setNodeGroupStackPolicyBodyReturnsOnCall = make(map[int]struct {
 			result1 error
 		})
 	}
-	fake.refreshFargatePodExecutionRoleARNReturnsOnCall[i] = struct {
+	fake.setNodeGroupStackPolicyBodyReturnsOnCall[i] = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeStackManager) ScaleNodeGroup(arg1 *v1alpha5.NodeGroup) error {
-	fake.scaleNodeGroupMutex.Lock()
-	ret, specificReturn := fake.scaleNodeGroupReturnsOnCall[len(fake.scaleNodeGroupArgsForCall)]
-	fake.scaleNodeGroupArgsForCall = append(fake.scaleNodeGroupArgsForCall, struct {
-		arg1 *v1alpha5.NodeGroup
-	}{arg1})
-	stub := fake.ScaleNodeGroupStub
-	fakeReturns := fake.scaleNodeGroupReturns
-	fake.recordInvocation("ScaleNodeGroup", []interface{}{arg1})
-	fake.scaleNodeGroupMutex.Unlock()
+func (fake *FakeStackManager) SetNodeGroupsOutdated(arg1 []*manager.NodeGroupSummary) error {
+	var arg1Copy []*manager.NodeGroupSummary
+	if arg1 != nil {
+		arg1Copy = make([]*manager.NodeGroupSummary, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.setNodeGroupsOutdatedMutex.Lock()
+	ret, specificReturn := fake.setNodeGroupsOutdatedReturnsOnCall[len(fake.setNodeGroupsOutdatedArgsForCall)]
+	fake.setNodeGroupsOutdatedArgsForCall = append(fake.setNodeGroupsOutdatedArgsForCall, struct {
+		arg1 []*manager.NodeGroupSummary
+	}{arg1Copy})
+	stub := fake.SetNodeGroupsOutdatedStub
+	fakeReturns := fake.setNodeGroupsOutdatedReturns
+	fake.recordInvocation("SetNodeGroupsOutdated", []interface{}{arg1Copy})
+	fake.setNodeGroupsOutdatedMutex.Unlock()
 	if stub != nil {
 		return stub(arg1)
 	}
@@ -4111,44 +6945,44 @@ func (fake *FakeStackManager) ScaleNodeGroup(arg1 *v1alpha5.NodeGroup) error {
 	return fakeReturns.result1
 }
 
-func (fake *FakeStackManager) ScaleNodeGroupCallCount() int {
-	fake.scaleNodeGroupMutex.RLock()
-	defer fake.scaleNodeGroupMutex.RUnlock()
-	return len(fake.scaleNodeGroupArgsForCall)
+func (fake *FakeStackManager) SetNodeGroupsOutdatedCallCount() int {
+	fake.setNodeGroupsOutdatedMutex.RLock()
+	defer fake.setNodeGroupsOutdatedMutex.RUnlock()
+	return len(fake.setNodeGroupsOutdatedArgsForCall)
 }
 
-func (fake *FakeStackManager) ScaleNodeGroupCalls(stub func(*v1alpha5.NodeGroup) error) {
-	fake.scaleNodeGroupMutex.Lock()
-	defer fake.scaleNodeGroupMutex.Unlock()
-	fake.ScaleNodeGroupStub = stub
+func (fake *FakeStackManager) SetNodeGroupsOutdatedCalls(stub func([]*manager.NodeGroupSummary) error) {
+	fake.setNodeGroupsOutdatedMutex.Lock()
+	defer fake.setNodeGroupsOutdatedMutex.Unlock()
+	fake.SetNodeGroupsOutdatedStub = stub
 }
 
-func (fake *FakeStackManager) ScaleNodeGroupArgsForCall(i int) *v1alpha5.NodeGroup {
-	fake.scaleNodeGroupMutex.RLock()
-	defer fake.scaleNodeGroupMutex.RUnlock()
-	argsForCall := fake.scaleNodeGroupArgsForCall[i]
+func (fake *FakeStackManager) SetNodeGroupsOutdatedArgsForCall(i int) []*manager.NodeGroupSummary {
+	fake.setNodeGroupsOutdatedMutex.RLock()
+	defer fake.setNodeGroupsOutdatedMutex.RUnlock()
+	argsForCall := fake.setNodeGroupsOutdatedArgsForCall[i]
 	return argsForCall.arg1
 }
 
-func (fake *FakeStackManager) ScaleNodeGroupReturns(result1 error) {
-	fake.scaleNodeGroupMutex.Lock()
-	defer fake.scaleNodeGroupMutex.Unlock()
-	fake.ScaleNodeGroupStub = nil
-	fake.scaleNodeGroupReturns = struct {
+func (fake *FakeStackManager) SetNodeGroupsOutdatedReturns(result1 error) {
+	fake.setNodeGroupsOutdatedMutex.Lock()
+	defer fake.setNodeGroupsOutdatedMutex.Unlock()
+	fake.SetNodeGroupsOutdatedStub = nil
+	fake.setNodeGroupsOutdatedReturns = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeStackManager) ScaleNodeGroupReturnsOnCall(i int, result1 error) {
-	fake.scaleNodeGroupMutex.Lock()
-	defer fake.scaleNodeGroupMutex.Unlock()
-	fake.ScaleNodeGroupStub = nil
-	if fake.scaleNodeGroupReturnsOnCall == nil {
-		fake.scaleNodeGroupReturnsOnCall = make(map[int]struct {
+func (fake *FakeStackManager) SetNodeGroupsOutdatedReturnsOnCall(i int, result1 error) {
+	fake.setNodeGroupsOutdatedMutex.Lock()
+	defer fake.setNodeGroupsOutdatedMutex.Unlock()
+	fake.SetNodeGroupsOutdatedStub = nil
+	if fake.setNodeGroupsOutdatedReturnsOnCall == nil {
+		fake.setNodeGroupsOutdatedReturnsOnCall = make(map[int]struct {
 			result1 error
 		})
 	}
-	fake.scaleNodeGroupReturnsOnCall[i] = struct {
+	fake.setNodeGroupsOutdatedReturnsOnCall[i] = struct {
 		result1 error
 	}{result1}
 }
@@ -4275,6 +7109,250 @@ func (fake *FakeStackManager) StackStatusIsNotTransitionalReturnsOnCall(i int, r
 	}{result1}
 }
 
+func (fake *FakeStackManager) SyncNodeGroupBoundsToCloudFormation(arg1 *v1alpha5.NodeGroup) error {
+	fake.syncNodeGroupBoundsToCloudFormationMutex.Lock()
+	ret, specificReturn := fake.syncNodeGroupBoundsToCloudFormationReturnsOnCall[len(fake.syncNodeGroupBoundsToCloudFormationArgsForCall)]
+	fake.syncNodeGroupBoundsToCloudFormationArgsForCall = append(fake.syncNodeGroupBoundsToCloudFormationArgsForCall, struct {
+		arg1 *v1alpha5.NodeGroup
+	}{arg1})
+	stub := fake.SyncNodeGroupBoundsToCloudFormationStub
+	fakeReturns := fake.syncNodeGroupBoundsToCloudFormationReturns
+	fake.recordInvocation("SyncNodeGroupBoundsToCloudFormation", []interface{}{arg1})
+	fake.syncNodeGroupBoundsToCloudFormationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStackManager) SyncNodeGroupBoundsToCloudFormationCallCount() int {
+	fake.syncNodeGroupBoundsToCloudFormationMutex.RLock()
+	defer fake.syncNodeGroupBoundsToCloudFormationMutex.RUnlock()
+	return len(fake.syncNodeGroupBoundsToCloudFormationArgsForCall)
+}
+
+func (fake *FakeStackManager) SyncNodeGroupBoundsToCloudFormationCalls(stub func(*v1alpha5.NodeGroup) error) {
+	fake.syncNodeGroupBoundsToCloudFormationMutex.Lock()
+	defer fake.syncNodeGroupBoundsToCloudFormationMutex.Unlock()
+	fake.SyncNodeGroupBoundsToCloudFormationStub = stub
+}
+
+func (fake *FakeStackManager) SyncNodeGroupBoundsToCloudFormationArgsForCall(i int) *v1alpha5.NodeGroup {
+	fake.syncNodeGroupBoundsToCloudFormationMutex.RLock()
+	defer fake.syncNodeGroupBoundsToCloudFormationMutex.RUnlock()
+	argsForCall := fake.syncNodeGroupBoundsToCloudFormationArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) SyncNodeGroupBoundsToCloudFormationReturns(result1 error) {
+	fake.syncNodeGroupBoundsToCloudFormationMutex.Lock()
+	defer fake.syncNodeGroupBoundsToCloudFormationMutex.Unlock()
+	fake.SyncNodeGroupBoundsToCloudFormationStub = nil
+	fake.syncNodeGroupBoundsToCloudFormationReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStackManager) SyncNodeGroupBoundsToCloudFormationReturnsOnCall(i int, result1 error) {
+	fake.syncNodeGroupBoundsToCloudFormationMutex.Lock()
+	defer fake.syncNodeGroupBoundsToCloudFormationMutex.Unlock()
+	fake.SyncNodeGroupBoundsToCloudFormationStub = nil
+	if fake.syncNodeGroupBoundsToCloudFormationReturnsOnCall == nil {
+		fake.syncNodeGroupBoundsToCloudFormationReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.syncNodeGroupBoundsToCloudFormationReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStackManager) UpdateManagedNodeGroupLabels(arg1 *v1alpha5.ManagedNodeGroup) error {
+	fake.updateManagedNodeGroupLabelsMutex.Lock()
+	ret, specificReturn := fake.updateManagedNodeGroupLabelsReturnsOnCall[len(fake.updateManagedNodeGroupLabelsArgsForCall)]
+	fake.updateManagedNodeGroupLabelsArgsForCall = append(fake.updateManagedNodeGroupLabelsArgsForCall, struct {
+		arg1 *v1alpha5.ManagedNodeGroup
+	}{arg1})
+	stub := fake.UpdateManagedNodeGroupLabelsStub
+	fakeReturns := fake.updateManagedNodeGroupLabelsReturns
+	fake.recordInvocation("UpdateManagedNodeGroupLabels", []interface{}{arg1})
+	fake.updateManagedNodeGroupLabelsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStackManager) UpdateManagedNodeGroupLabelsCallCount() int {
+	fake.updateManagedNodeGroupLabelsMutex.RLock()
+	defer fake.updateManagedNodeGroupLabelsMutex.RUnlock()
+	return len(fake.updateManagedNodeGroupLabelsArgsForCall)
+}
+
+func (fake *FakeStackManager) UpdateManagedNodeGroupLabelsCalls(stub func(*v1alpha5.ManagedNodeGroup) error) {
+	fake.updateManagedNodeGroupLabelsMutex.Lock()
+	defer fake.updateManagedNodeGroupLabelsMutex.Unlock()
+	fake.UpdateManagedNodeGroupLabelsStub = stub
+}
+
+func (fake *FakeStackManager) UpdateManagedNodeGroupLabelsArgsForCall(i int) *v1alpha5.ManagedNodeGroup {
+	fake.updateManagedNodeGroupLabelsMutex.RLock()
+	defer fake.updateManagedNodeGroupLabelsMutex.RUnlock()
+	argsForCall := fake.updateManagedNodeGroupLabelsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) UpdateManagedNodeGroupLabelsReturns(result1 error) {
+	fake.updateManagedNodeGroupLabelsMutex.Lock()
+	defer fake.updateManagedNodeGroupLabelsMutex.Unlock()
+	fake.UpdateManagedNodeGroupLabelsStub = nil
+	fake.updateManagedNodeGroupLabelsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStackManager) UpdateManagedNodeGroupLabelsReturnsOnCall(i int, result1 error) {
+	fake.updateManagedNodeGroupLabelsMutex.Lock()
+	defer fake.updateManagedNodeGroupLabelsMutex.Unlock()
+	fake.UpdateManagedNodeGroupLabelsStub = nil
+	if fake.updateManagedNodeGroupLabelsReturnsOnCall == nil {
+		fake.updateManagedNodeGroupLabelsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateManagedNodeGroupLabelsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStackManager) UpdateManagedNodeGroupNodeRepairConfig(arg1 *v1alpha5.ManagedNodeGroup) error {
+	fake.updateManagedNodeGroupNodeRepairConfigMutex.Lock()
+	ret, specificReturn := fake.updateManagedNodeGroupNodeRepairConfigReturnsOnCall[len(fake.updateManagedNodeGroupNodeRepairConfigArgsForCall)]
+	fake.updateManagedNodeGroupNodeRepairConfigArgsForCall = append(fake.updateManagedNodeGroupNodeRepairConfigArgsForCall, struct {
+		arg1 *v1alpha5.ManagedNodeGroup
+	}{arg1})
+	stub := fake.UpdateManagedNodeGroupNodeRepairConfigStub
+	fakeReturns := fake.updateManagedNodeGroupNodeRepairConfigReturns
+	fake.recordInvocation("UpdateManagedNodeGroupNodeRepairConfig", []interface{}{arg1})
+	fake.updateManagedNodeGroupNodeRepairConfigMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStackManager) UpdateManagedNodeGroupNodeRepairConfigCallCount() int {
+	fake.updateManagedNodeGroupNodeRepairConfigMutex.RLock()
+	defer fake.updateManagedNodeGroupNodeRepairConfigMutex.RUnlock()
+	return len(fake.updateManagedNodeGroupNodeRepairConfigArgsForCall)
+}
+
+func (fake *FakeStackManager) UpdateManagedNodeGroupNodeRepairConfigCalls(stub func(*v1alpha5.ManagedNodeGroup) error) {
+	fake.updateManagedNodeGroupNodeRepairConfigMutex.Lock()
+	defer fake.updateManagedNodeGroupNodeRepairConfigMutex.Unlock()
+	fake.UpdateManagedNodeGroupNodeRepairConfigStub = stub
+}
+
+func (fake *FakeStackManager) UpdateManagedNodeGroupNodeRepairConfigArgsForCall(i int) *v1alpha5.ManagedNodeGroup {
+	fake.updateManagedNodeGroupNodeRepairConfigMutex.RLock()
+	defer fake.updateManagedNodeGroupNodeRepairConfigMutex.RUnlock()
+	argsForCall := fake.updateManagedNodeGroupNodeRepairConfigArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) UpdateManagedNodeGroupNodeRepairConfigReturns(result1 error) {
+	fake.updateManagedNodeGroupNodeRepairConfigMutex.Lock()
+	defer fake.updateManagedNodeGroupNodeRepairConfigMutex.Unlock()
+	fake.UpdateManagedNodeGroupNodeRepairConfigStub = nil
+	fake.updateManagedNodeGroupNodeRepairConfigReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStackManager) UpdateManagedNodeGroupNodeRepairConfigReturnsOnCall(i int, result1 error) {
+	fake.updateManagedNodeGroupNodeRepairConfigMutex.Lock()
+	defer fake.updateManagedNodeGroupNodeRepairConfigMutex.Unlock()
+	fake.UpdateManagedNodeGroupNodeRepairConfigStub = nil
+	if fake.updateManagedNodeGroupNodeRepairConfigReturnsOnCall == nil {
+		fake.updateManagedNodeGroupNodeRepairConfigReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateManagedNodeGroupNodeRepairConfigReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStackManager) UpdateManagedNodeGroupUpdateConfig(arg1 *v1alpha5.ManagedNodeGroup) error {
+	fake.updateManagedNodeGroupUpdateConfigMutex.Lock()
+	ret, specificReturn := fake.updateManagedNodeGroupUpdateConfigReturnsOnCall[len(fake.updateManagedNodeGroupUpdateConfigArgsForCall)]
+	fake.updateManagedNodeGroupUpdateConfigArgsForCall = append(fake.updateManagedNodeGroupUpdateConfigArgsForCall, struct {
+		arg1 *v1alpha5.ManagedNodeGroup
+	}{arg1})
+	stub := fake.UpdateManagedNodeGroupUpdateConfigStub
+	fakeReturns := fake.updateManagedNodeGroupUpdateConfigReturns
+	fake.recordInvocation("UpdateManagedNodeGroupUpdateConfig", []interface{}{arg1})
+	fake.updateManagedNodeGroupUpdateConfigMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStackManager) UpdateManagedNodeGroupUpdateConfigCallCount() int {
+	fake.updateManagedNodeGroupUpdateConfigMutex.RLock()
+	defer fake.updateManagedNodeGroupUpdateConfigMutex.RUnlock()
+	return len(fake.updateManagedNodeGroupUpdateConfigArgsForCall)
+}
+
+func (fake *FakeStackManager) UpdateManagedNodeGroupUpdateConfigCalls(stub func(*v1alpha5.ManagedNodeGroup) error) {
+	fake.updateManagedNodeGroupUpdateConfigMutex.Lock()
+	defer fake.updateManagedNodeGroupUpdateConfigMutex.Unlock()
+	fake.UpdateManagedNodeGroupUpdateConfigStub = stub
+}
+
+func (fake *FakeStackManager) UpdateManagedNodeGroupUpdateConfigArgsForCall(i int) *v1alpha5.ManagedNodeGroup {
+	fake.updateManagedNodeGroupUpdateConfigMutex.RLock()
+	defer fake.updateManagedNodeGroupUpdateConfigMutex.RUnlock()
+	argsForCall := fake.updateManagedNodeGroupUpdateConfigArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) UpdateManagedNodeGroupUpdateConfigReturns(result1 error) {
+	fake.updateManagedNodeGroupUpdateConfigMutex.Lock()
+	defer fake.updateManagedNodeGroupUpdateConfigMutex.Unlock()
+	fake.UpdateManagedNodeGroupUpdateConfigStub = nil
+	fake.updateManagedNodeGroupUpdateConfigReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStackManager) UpdateManagedNodeGroupUpdateConfigReturnsOnCall(i int, result1 error) {
+	fake.updateManagedNodeGroupUpdateConfigMutex.Lock()
+	defer fake.updateManagedNodeGroupUpdateConfigMutex.Unlock()
+	fake.UpdateManagedNodeGroupUpdateConfigStub = nil
+	if fake.updateManagedNodeGroupUpdateConfigReturnsOnCall == nil {
+		fake.updateManagedNodeGroupUpdateConfigReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateManagedNodeGroupUpdateConfigReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeStackManager) UpdateNodeGroupStack(arg1 string, arg2 string) error {
 	fake.updateNodeGroupStackMutex.Lock()
 	ret, specificReturn := fake.updateNodeGroupStackReturnsOnCall[len(fake.updateNodeGroupStackArgsForCall)]
@@ -4337,6 +7415,71 @@ func (fake *FakeStackManager) UpdateNodeGroupStackReturnsOnCall(i int, result1 e
 	}{result1}
 }
 
+func (fake *FakeStackManager) UpdateNodeGroupStackTags(arg1 *v1alpha5.NodeGroup, arg2 map[string]string) (map[string]string, error) {
+	fake.updateNodeGroupStackTagsMutex.Lock()
+	ret, specificReturn := fake.updateNodeGroupStackTagsReturnsOnCall[len(fake.updateNodeGroupStackTagsArgsForCall)]
+	fake.updateNodeGroupStackTagsArgsForCall = append(fake.updateNodeGroupStackTagsArgsForCall, struct {
+		arg1 *v1alpha5.NodeGroup
+		arg2 map[string]string
+	}{arg1, arg2})
+	stub := fake.UpdateNodeGroupStackTagsStub
+	fakeReturns := fake.updateNodeGroupStackTagsReturns
+	fake.recordInvocation("UpdateNodeGroupStackTags", []interface{}{arg1, arg2})
+	fake.updateNodeGroupStackTagsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) UpdateNodeGroupStackTagsCallCount() int {
+	fake.updateNodeGroupStackTagsMutex.RLock()
+	defer fake.updateNodeGroupStackTagsMutex.RUnlock()
+	return len(fake.updateNodeGroupStackTagsArgsForCall)
+}
+
+func (fake *FakeStackManager) UpdateNodeGroupStackTagsCalls(stub func(*v1alpha5.NodeGroup, map[string]string) (map[string]string, error)) {
+	fake.updateNodeGroupStackTagsMutex.Lock()
+	defer fake.updateNodeGroupStackTagsMutex.Unlock()
+	fake.UpdateNodeGroupStackTagsStub = stub
+}
+
+func (fake *FakeStackManager) UpdateNodeGroupStackTagsArgsForCall(i int) (*v1alpha5.NodeGroup, map[string]string) {
+	fake.updateNodeGroupStackTagsMutex.RLock()
+	defer fake.updateNodeGroupStackTagsMutex.RUnlock()
+	argsForCall := fake.updateNodeGroupStackTagsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStackManager) UpdateNodeGroupStackTagsReturns(result1 map[string]string, result2 error) {
+	fake.updateNodeGroupStackTagsMutex.Lock()
+	defer fake.updateNodeGroupStackTagsMutex.Unlock()
+	fake.UpdateNodeGroupStackTagsStub = nil
+	fake.updateNodeGroupStackTagsReturns = struct {
+		result1 map[string]string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) UpdateNodeGroupStackTagsReturnsOnCall(i int, result1 map[string]string, result2 error) {
+	fake.updateNodeGroupStackTagsMutex.Lock()
+	defer fake.updateNodeGroupStackTagsMutex.Unlock()
+	fake.UpdateNodeGroupStackTagsStub = nil
+	if fake.updateNodeGroupStackTagsReturnsOnCall == nil {
+		fake.updateNodeGroupStackTagsReturnsOnCall = make(map[int]struct {
+			result1 map[string]string
+			result2 error
+		})
+	}
+	fake.updateNodeGroupStackTagsReturnsOnCall[i] = struct {
+		result1 map[string]string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeStackManager) UpdateStack(arg1 string, arg2 string, arg3 string, arg4 manager.TemplateData, arg5 map[string]string) error {
 	fake.updateStackMutex.Lock()
 	ret, specificReturn := fake.updateStackReturnsOnCall[len(fake.updateStackArgsForCall)]
@@ -4402,13 +7545,88 @@ func (fake *FakeStackManager) UpdateStackReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeStackManager) WaitForNodeGroupStack(arg1 context.Context, arg2 *v1alpha5.NodeGroup, arg3 string) error {
+	fake.waitForNodeGroupStackMutex.Lock()
+	ret, specificReturn := fake.waitForNodeGroupStackReturnsOnCall[len(fake.waitForNodeGroupStackArgsForCall)]
+	fake.waitForNodeGroupStackArgsForCall = append(fake.waitForNodeGroupStackArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha5.NodeGroup
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.WaitForNodeGroupStackStub
+	fakeReturns := fake.waitForNodeGroupStackReturns
+	fake.recordInvocation("WaitForNodeGroupStack", []interface{}{arg1, arg2, arg3})
+	fake.waitForNodeGroupStackMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStackManager) WaitForNodeGroupStackCallCount() int {
+	fake.waitForNodeGroupStackMutex.RLock()
+	defer fake.waitForNodeGroupStackMutex.RUnlock()
+	return len(fake.waitForNodeGroupStackArgsForCall)
+}
+
+func (fake *FakeStackManager) WaitForNodeGroupStackCalls(stub func(context.Context, *v1alpha5.NodeGroup, string) error) {
+	fake.waitForNodeGroupStackMutex.Lock()
+	defer fake.waitForNodeGroupStackMutex.Unlock()
+	fake.WaitForNodeGroupStackStub = stub
+}
+
+func (fake *FakeStackManager) WaitForNodeGroupStackArgsForCall(i int) (context.Context, *v1alpha5.NodeGroup, string) {
+	fake.waitForNodeGroupStackMutex.RLock()
+	defer fake.waitForNodeGroupStackMutex.RUnlock()
+	argsForCall := fake.waitForNodeGroupStackArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStackManager) WaitForNodeGroupStackReturns(result1 error) {
+	fake.waitForNodeGroupStackMutex.Lock()
+	defer fake.waitForNodeGroupStackMutex.Unlock()
+	fake.WaitForNodeGroupStackStub = nil
+	fake.waitForNodeGroupStackReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStackManager) WaitForNodeGroupStackReturnsOnCall(i int, result1 error) {
+	fake.waitForNodeGroupStackMutex.Lock()
+	defer fake.waitForNodeGroupStackMutex.Unlock()
+	fake.WaitForNodeGroupStackStub = nil
+	if fake.waitForNodeGroupStackReturnsOnCall == nil {
+		fake.waitForNodeGroupStackReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.waitForNodeGroupStackReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeStackManager) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.appendNewClusterStackResourceMutex.RLock()
 	defer fake.appendNewClusterStackResourceMutex.RUnlock()
+	fake.applyToAllNodeGroupsMutex.RLock()
+	defer fake.applyToAllNodeGroupsMutex.RUnlock()
+	fake.checkNodeGroupAMIArchitectureMutex.RLock()
+	defer fake.checkNodeGroupAMIArchitectureMutex.RUnlock()
+	fake.checkNodeGroupConnectivityMutex.RLock()
+	defer fake.checkNodeGroupConnectivityMutex.RUnlock()
+	fake.checkNodeGroupInstanceTypeOfferingsMutex.RLock()
+	defer fake.checkNodeGroupInstanceTypeOfferingsMutex.RUnlock()
+	fake.continueUpdateRollbackMutex.RLock()
+	defer fake.continueUpdateRollbackMutex.RUnlock()
 	fake.createStackMutex.RLock()
 	defer fake.createStackMutex.RUnlock()
+	fake.deleteNodeGroupStacksMutex.RLock()
+	defer fake.deleteNodeGroupStacksMutex.RUnlock()
 	fake.deleteStackByNameMutex.RLock()
 	defer fake.deleteStackByNameMutex.RUnlock()
 	fake.deleteStackByNameSyncMutex.RLock()
@@ -4437,14 +7655,22 @@ func (fake *FakeStackManager) Invocations() map[string][][]interface{} {
 	defer fake.describeStackEventsMutex.RUnlock()
 	fake.describeStacksMutex.RLock()
 	defer fake.describeStacksMutex.RUnlock()
+	fake.detectNodeGroupDriftMutex.RLock()
+	defer fake.detectNodeGroupDriftMutex.RUnlock()
 	fake.doCreateStackRequestMutex.RLock()
 	defer fake.doCreateStackRequestMutex.RUnlock()
 	fake.doWaitUntilStackIsCreatedMutex.RLock()
 	defer fake.doWaitUntilStackIsCreatedMutex.RUnlock()
 	fake.ensureMapPublicIPOnLaunchEnabledMutex.RLock()
 	defer fake.ensureMapPublicIPOnLaunchEnabledMutex.RUnlock()
+	fake.findNodeGroupsUsingInstanceTypesMutex.RLock()
+	defer fake.findNodeGroupsUsingInstanceTypesMutex.RUnlock()
 	fake.fixClusterCompatibilityMutex.RLock()
 	defer fake.fixClusterCompatibilityMutex.RUnlock()
+	fake.generateNodeGroupCapacityReportMutex.RLock()
+	defer fake.generateNodeGroupCapacityReportMutex.RUnlock()
+	fake.generateNodeGroupConfigMutex.RLock()
+	defer fake.generateNodeGroupConfigMutex.RUnlock()
 	fake.getAutoScalingGroupNameMutex.RLock()
 	defer fake.getAutoScalingGroupNameMutex.RUnlock()
 	fake.getFargateStackMutex.RLock()
@@ -4455,40 +7681,68 @@ func (fake *FakeStackManager) Invocations() map[string][][]interface{} {
 	defer fake.getIAMAddonsStacksMutex.RUnlock()
 	fake.getIAMServiceAccountsMutex.RLock()
 	defer fake.getIAMServiceAccountsMutex.RUnlock()
+	fake.getManagedNodeGroupMutex.RLock()
+	defer fake.getManagedNodeGroupMutex.RUnlock()
 	fake.getManagedNodeGroupAutoScalingGroupNameMutex.RLock()
 	defer fake.getManagedNodeGroupAutoScalingGroupNameMutex.RUnlock()
 	fake.getManagedNodeGroupTemplateMutex.RLock()
 	defer fake.getManagedNodeGroupTemplateMutex.RUnlock()
 	fake.getNodeGroupAutoScalingGroupNameMutex.RLock()
 	defer fake.getNodeGroupAutoScalingGroupNameMutex.RUnlock()
+	fake.getNodeGroupIAMRolesMutex.RLock()
+	defer fake.getNodeGroupIAMRolesMutex.RUnlock()
+	fake.getNodeGroupInstanceHealthMutex.RLock()
+	defer fake.getNodeGroupInstanceHealthMutex.RUnlock()
+	fake.getNodeGroupInstanceIDsMutex.RLock()
+	defer fake.getNodeGroupInstanceIDsMutex.RUnlock()
+	fake.getNodeGroupKubeletVersionMutex.RLock()
+	defer fake.getNodeGroupKubeletVersionMutex.RUnlock()
 	fake.getNodeGroupNameMutex.RLock()
 	defer fake.getNodeGroupNameMutex.RUnlock()
+	fake.getNodeGroupPodCapacityMutex.RLock()
+	defer fake.getNodeGroupPodCapacityMutex.RUnlock()
+	fake.getNodeGroupSecurityGroupIDsMutex.RLock()
+	defer fake.getNodeGroupSecurityGroupIDsMutex.RUnlock()
+	fake.getNodeGroupStackPolicyMutex.RLock()
+	defer fake.getNodeGroupStackPolicyMutex.RUnlock()
 	fake.getNodeGroupStackTypeMutex.RLock()
 	defer fake.getNodeGroupStackTypeMutex.RUnlock()
 	fake.getNodeGroupSummariesMutex.RLock()
 	defer fake.getNodeGroupSummariesMutex.RUnlock()
+	fake.getNodeGroupWorkloadImpactMutex.RLock()
+	defer fake.getNodeGroupWorkloadImpactMutex.RUnlock()
+	fake.getNodeGroupsByTagMutex.RLock()
+	defer fake.getNodeGroupsByTagMutex.RUnlock()
 	fake.getStackTemplateMutex.RLock()
 	defer fake.getStackTemplateMutex.RUnlock()
 	fake.hasClusterStackMutex.RLock()
 	defer fake.hasClusterStackMutex.RUnlock()
 	fake.hasClusterStackUsingCachedListMutex.RLock()
 	defer fake.hasClusterStackUsingCachedListMutex.RUnlock()
+	fake.isNodeGroupProtectedFromReplacementMutex.RLock()
+	defer fake.isNodeGroupProtectedFromReplacementMutex.RUnlock()
 	fake.listClusterStackNamesMutex.RLock()
 	defer fake.listClusterStackNamesMutex.RUnlock()
 	fake.listIAMServiceAccountStacksMutex.RLock()
 	defer fake.listIAMServiceAccountStacksMutex.RUnlock()
 	fake.listNodeGroupStacksMutex.RLock()
 	defer fake.listNodeGroupStacksMutex.RUnlock()
+	fake.listOrphanedNodeGroupStacksMutex.RLock()
+	defer fake.listOrphanedNodeGroupStacksMutex.RUnlock()
 	fake.listStacksMutex.RLock()
 	defer fake.listStacksMutex.RUnlock()
 	fake.listStacksMatchingMutex.RLock()
 	defer fake.listStacksMatchingMutex.RUnlock()
+	fake.listStacksMatchingTagsMutex.RLock()
+	defer fake.listStacksMatchingTagsMutex.RUnlock()
 	fake.lookupCloudTrailEventsMutex.RLock()
 	defer fake.lookupCloudTrailEventsMutex.RUnlock()
 	fake.makeChangeSetNameMutex.RLock()
 	defer fake.makeChangeSetNameMutex.RUnlock()
 	fake.makeClusterStackNameMutex.RLock()
 	defer fake.makeClusterStackNameMutex.RUnlock()
+	fake.migrateToManagedNodeGroupMutex.RLock()
+	defer fake.migrateToManagedNodeGroupMutex.RUnlock()
 	fake.newClusterCompatTaskMutex.RLock()
 	defer fake.newClusterCompatTaskMutex.RUnlock()
 	fake.newManagedNodeGroupTaskMutex.RLock()
@@ -4511,18 +7765,54 @@ func (fake *FakeStackManager) Invocations() map[string][][]interface{} {
 	defer fake.newTasksToDeleteOIDCProviderWithIAMServiceAccountsMutex.RUnlock()
 	fake.newUnmanagedNodeGroupTaskMutex.RLock()
 	defer fake.newUnmanagedNodeGroupTaskMutex.RUnlock()
+	fake.nodeGroupChangeSetMutex.RLock()
+	defer fake.nodeGroupChangeSetMutex.RUnlock()
+	fake.nodeGroupScalingDriftMutex.RLock()
+	defer fake.nodeGroupScalingDriftMutex.RUnlock()
+	fake.nodeGroupStackExistsMutex.RLock()
+	defer fake.nodeGroupStackExistsMutex.RUnlock()
 	fake.refreshFargatePodExecutionRoleARNMutex.RLock()
 	defer fake.refreshFargatePodExecutionRoleARNMutex.RUnlock()
+	fake.rollbackNodeGroupMutex.RLock()
+	defer fake.rollbackNodeGroupMutex.RUnlock()
+	fake.rollingScaleNodeGroupMutex.RLock()
+	defer fake.rollingScaleNodeGroupMutex.RUnlock()
 	fake.scaleNodeGroupMutex.RLock()
 	defer fake.scaleNodeGroupMutex.RUnlock()
+	fake.scaleNodeGroupByDeltaMutex.RLock()
+	defer fake.scaleNodeGroupByDeltaMutex.RUnlock()
+	fake.scaleNodeGroupsMutex.RLock()
+	defer fake.scaleNodeGroupsMutex.RUnlock()
+	fake.setInstanceProtectionMutex.RLock()
+	defer fake.setInstanceProtectionMutex.RUnlock()
+	fake.setNodeGroupAutoscalerPausedMutex.RLock()
+	defer fake.setNodeGroupAutoscalerPausedMutex.RUnlock()
+	fake.setNodeGroupStackPolicyMutex.RLock()
+	defer fake.setNodeGroupStackPolicyMutex.RUnlock()
+	fake.setNodeGroupStackPolicyBodyMutex.RLock()
+	defer fake.setNodeGroupStackPolicyBodyMutex.RUnlock()
+	fake.setNodeGroupsOutdatedMutex.RLock()
+	defer fake.setNodeGroupsOutdatedMutex.RUnlock()
 	fake.stackStatusIsNotReadyMutex.RLock()
 	defer fake.stackStatusIsNotReadyMutex.RUnlock()
 	fake.stackStatusIsNotTransitionalMutex.RLock()
 	defer fake.stackStatusIsNotTransitionalMutex.RUnlock()
+	fake.syncNodeGroupBoundsToCloudFormationMutex.RLock()
+	defer fake.syncNodeGroupBoundsToCloudFormationMutex.RUnlock()
+	fake.updateManagedNodeGroupLabelsMutex.RLock()
+	defer fake.updateManagedNodeGroupLabelsMutex.RUnlock()
+	fake.updateManagedNodeGroupNodeRepairConfigMutex.RLock()
+	defer fake.updateManagedNodeGroupNodeRepairConfigMutex.RUnlock()
+	fake.updateManagedNodeGroupUpdateConfigMutex.RLock()
+	defer fake.updateManagedNodeGroupUpdateConfigMutex.RUnlock()
 	fake.updateNodeGroupStackMutex.RLock()
 	defer fake.updateNodeGroupStackMutex.RUnlock()
+	fake.updateNodeGroupStackTagsMutex.RLock()
+	defer fake.updateNodeGroupStackTagsMutex.RUnlock()
 	fake.updateStackMutex.RLock()
 	defer fake.updateStackMutex.RUnlock()
+	fake.waitForNodeGroupStackMutex.RLock()
+	defer fake.waitForNodeGroupStackMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
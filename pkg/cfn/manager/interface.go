@@ -1,8 +1,12 @@
 package manager
 
 import (
+	"context"
+	"time"
+
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/aws/aws-sdk-go/service/eks/eksiface"
 	"github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/builder"
@@ -10,19 +14,61 @@ import (
 	"github.com/weaveworks/eksctl/pkg/kubernetes"
 	"github.com/weaveworks/eksctl/pkg/utils/tasks"
 	"github.com/weaveworks/eksctl/pkg/vpc"
+	kubeclient "k8s.io/client-go/kubernetes"
 )
 
 //go:generate counterfeiter -o fakes/fake_stack_manager.go . StackManager
 type StackManager interface {
 	ListNodeGroupStacks() ([]NodeGroupStack, error)
 	DescribeNodeGroupStacksAndResources() (map[string]StackInfo, error)
-	ScaleNodeGroup(ng *v1alpha5.NodeGroup) error
+	ScaleNodeGroup(ng *v1alpha5.NodeGroup, dryRun bool) ([]StackChange, error)
+	NodeGroupChangeSet(ng *v1alpha5.NodeGroup) ([]StackChange, error)
+	ScaleNodeGroups(ngs []*v1alpha5.NodeGroup) error
+	RollingScaleNodeGroup(ng *v1alpha5.NodeGroup, stepSize int, stepTimeout time.Duration) error
+	ContinueUpdateRollback(stackName string, skipResources []string) error
+	ScaleNodeGroupByDelta(ng *v1alpha5.NodeGroup, delta int) (string, error)
+	NodeGroupScalingDrift(ng *v1alpha5.NodeGroup) (*ScalingDrift, error)
+	UpdateNodeGroupStackTags(ng *v1alpha5.NodeGroup, tags map[string]string) (map[string]string, error)
+	SyncNodeGroupBoundsToCloudFormation(ng *v1alpha5.NodeGroup) error
+	SetNodeGroupAutoscalerPaused(ng *v1alpha5.NodeGroup, paused bool) (bool, error)
 	GetNodeGroupSummaries(name string) ([]*NodeGroupSummary, error)
+	GetNodeGroupIAMRoles() (map[string]NodeGroupIAMRole, error)
+	GetNodeGroupSecurityGroupIDs(ng *v1alpha5.NodeGroup) ([]string, error)
+	GetNodeGroupInstanceIDs(ng *v1alpha5.NodeGroup) ([]string, error)
+	SetInstanceProtection(ng *v1alpha5.NodeGroup, instanceIDs []string, protected bool) error
+	GenerateNodeGroupConfig(stackName string) (*v1alpha5.NodeGroup, error)
+	SetNodeGroupsOutdated(summaries []*NodeGroupSummary) error
+	GetNodeGroupsByTag(tagKey string) (map[string][]*NodeGroupSummary, error)
 	GetNodeGroupAutoScalingGroupName(s *Stack) (string, error)
 	GetManagedNodeGroupAutoScalingGroupName(s *Stack) (string, error)
 	DescribeNodeGroupStack(nodeGroupName string) (*Stack, error)
+	NodeGroupStackExists(ngName string) (bool, error)
 	DescribeNodeGroupStacks() ([]*Stack, error)
 	GetNodeGroupStackType(name string) (v1alpha5.NodeGroupType, error)
+	GetNodeGroupKubeletVersion(ng *v1alpha5.NodeGroup, kubeClient kubeclient.Interface) (string, error)
+	GetNodeGroupPodCapacity(ng *v1alpha5.NodeGroup, kubeClient kubeclient.Interface) (int, int, error)
+	GenerateNodeGroupCapacityReport(kubeClient kubeclient.Interface) (*CapacityReport, error)
+	DetectNodeGroupDrift(ng *v1alpha5.NodeGroup) (*DriftResult, error)
+	DeleteNodeGroupStacks(ctx context.Context, parallelism int) error
+	ListOrphanedNodeGroupStacks() ([]*Stack, error)
+	MigrateToManagedNodeGroup(ng *v1alpha5.NodeGroup, drainNodeGroup func(*v1alpha5.NodeGroup) error) error
+	WaitForNodeGroupStack(ctx context.Context, ng *v1alpha5.NodeGroup, desiredStatus string) error
+	GetNodeGroupWorkloadImpact(ng *v1alpha5.NodeGroup, kubeClient kubeclient.Interface) (*WorkloadImpact, error)
+	CheckNodeGroupConnectivity(ng *v1alpha5.NodeGroup) (*ConnectivityReport, error)
+	CheckNodeGroupAMIArchitecture(ng *v1alpha5.NodeGroup) error
+	CheckNodeGroupInstanceTypeOfferings(ng *v1alpha5.NodeGroup) error
+	GetManagedNodeGroup(ng *v1alpha5.NodeGroup) (*eks.Nodegroup, error)
+	FindNodeGroupsUsingInstanceTypes(instanceTypes []string) ([]*NodeGroupSummary, error)
+	RollbackNodeGroup(ng *v1alpha5.NodeGroup) error
+	SetNodeGroupStackPolicy(ng *v1alpha5.NodeGroup, allowReplace bool) error
+	SetNodeGroupStackPolicyBody(ng *v1alpha5.NodeGroup, policyBody string) error
+	GetNodeGroupStackPolicy(ng *v1alpha5.NodeGroup) (string, error)
+	IsNodeGroupProtectedFromReplacement(ng *v1alpha5.NodeGroup) (bool, error)
+	GetNodeGroupInstanceHealth(ng *v1alpha5.NodeGroup) ([]InstanceHealth, error)
+	ApplyToAllNodeGroups(fn func(ng *NodeGroupSummary) (labels map[string]string, taints []v1alpha5.NodeGroupTaint)) ([]NodeGroupUpdateResult, error)
+	UpdateManagedNodeGroupLabels(ng *v1alpha5.ManagedNodeGroup) error
+	UpdateManagedNodeGroupUpdateConfig(ng *v1alpha5.ManagedNodeGroup) error
+	UpdateManagedNodeGroupNodeRepairConfig(ng *v1alpha5.ManagedNodeGroup) error
 	GetNodeGroupName(s *Stack) string
 	DoWaitUntilStackIsCreated(i *Stack) error
 	DoCreateStackRequest(i *Stack, templateData TemplateData, tags, parameters map[string]string, withIAM bool, withNamedIAM bool) error
@@ -32,6 +78,7 @@ type StackManager interface {
 	GetManagedNodeGroupTemplate(nodeGroupName string) (string, error)
 	UpdateNodeGroupStack(nodeGroupName, template string) error
 	ListStacksMatching(nameRegex string, statusFilters ...string) ([]*Stack, error)
+	ListStacksMatchingTags(tags map[string]string) ([]*Stack, error)
 	ListClusterStackNames() ([]string, error)
 	ListStacks(statusFilters ...string) ([]*Stack, error)
 	StackStatusIsNotTransitional(s *Stack) bool
@@ -0,0 +1,106 @@
+package manager
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+// mockedStack is a stack returned by the CloudFormation mocks of mockStacks. Its name is the key it is mocked
+// with, its ID is the name with an "-id" suffix and its status is CREATE_COMPLETE unless Status is set
+type mockedStack struct {
+	Status     string
+	Tags       []*cfn.Tag
+	Outputs    []*cfn.Output
+	Parameters []*cfn.Parameter
+	Template   string
+}
+
+// mockStacks mocks ListStacksPages to list the stacks in a single page, and DescribeStacks and GetTemplate
+// as mockStackDescriptions does
+func mockStacks(p *mockprovider.MockProvider, stacks map[string]*mockedStack) {
+	p.MockCloudFormation().On("ListStacksPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		consume := args[1].(func(p *cfn.ListStacksOutput, last bool) (shouldContinue bool))
+		var names []string
+		for name := range stacks {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		out := &cfn.ListStacksOutput{}
+		for _, name := range names {
+			out.StackSummaries = append(out.StackSummaries, &cfn.StackSummary{StackName: aws.String(name), StackId: aws.String(name + "-id")})
+		}
+		consume(out, true)
+	}).Return(nil)
+	mockStackDescriptions(p, stacks)
+}
+
+// mockStackDescriptions mocks DescribeStacks, by stack name or ID, and GetTemplate to return the given stacks.
+// Stacks that are not in stacks do not exist. The map is read on every call, so that tests can change the
+// stacks after mocking them
+func mockStackDescriptions(p *mockprovider.MockProvider, stacks map[string]*mockedStack) {
+	find := func(nameOrID *string) (string, *mockedStack) {
+		name := strings.TrimSuffix(aws.StringValue(nameOrID), "-id")
+		return name, stacks[name]
+	}
+	notFound := func(name string) error {
+		return awserr.New("ValidationError", fmt.Sprintf("Stack with id %s does not exist", name), nil)
+	}
+
+	p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(func(input *cfn.DescribeStacksInput) *cfn.DescribeStacksOutput {
+		name, s := find(input.StackName)
+		if s == nil {
+			return nil
+		}
+		status := s.Status
+		if status == "" {
+			status = cfn.StackStatusCreateComplete
+		}
+		return &cfn.DescribeStacksOutput{
+			Stacks: []*cfn.Stack{
+				{
+					StackName:   aws.String(name),
+					StackId:     aws.String(name + "-id"),
+					StackStatus: aws.String(status),
+					Tags:        s.Tags,
+					Outputs:     s.Outputs,
+					Parameters:  s.Parameters,
+				},
+			},
+		}
+	}, func(input *cfn.DescribeStacksInput) error {
+		if name, s := find(input.StackName); s == nil {
+			return notFound(name)
+		}
+		return nil
+	})
+
+	p.MockCloudFormation().On("GetTemplate", mock.Anything).Return(func(input *cfn.GetTemplateInput) *cfn.GetTemplateOutput {
+		if _, s := find(input.StackName); s != nil {
+			return &cfn.GetTemplateOutput{TemplateBody: aws.String(s.Template)}
+		}
+		return nil
+	}, func(input *cfn.GetTemplateInput) error {
+		if name, s := find(input.StackName); s == nil {
+			return notFound(name)
+		}
+		return nil
+	})
+}
+
+// nodeGroupStackTags returns the tags eksctl sets on the stack of a nodegroup of the cluster "test-cluster"
+func nodeGroupStackTags(name string, nodeGroupType api.NodeGroupType) []*cfn.Tag {
+	return []*cfn.Tag{
+		{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")},
+		{Key: aws.String(api.NodeGroupNameTag), Value: aws.String(name)},
+		{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(string(nodeGroupType))},
+	}
+}
@@ -2,12 +2,23 @@ package manager
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
 	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/blang/semver"
 	"github.com/kris-nova/logger"
@@ -18,39 +29,113 @@ import (
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/builder"
 	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	"github.com/weaveworks/eksctl/pkg/ssh/client"
 	"github.com/weaveworks/eksctl/pkg/version"
 	"github.com/weaveworks/eksctl/pkg/vpc"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
 )
 
 const (
-	imageIDPath = resourcesRootPath + ".NodeGroupLaunchTemplate.Properties.LaunchTemplateData.ImageId"
+	imageIDPath        = resourcesRootPath + ".NodeGroupLaunchTemplate.Properties.LaunchTemplateData.ImageId"
+	managedImageIDPath = resourcesRootPath + ".LaunchTemplate.Properties.LaunchTemplateData.ImageId"
+	// managedAMITypePath is only set in the templates of managed nodegroups using the default AMI
+	managedAMITypePath = resourcesRootPath + ".ManagedNodeGroup.Properties.AmiType"
+
+	// scaleNodeGroupsConcurrency is the maximum number of nodegroup stacks ScaleNodeGroups updates at once
+	scaleNodeGroupsConcurrency = 8
 )
 
-// NodeGroupSummary represents a summary of a nodegroup stack
+// NodeGroupSummary represents a summary of a nodegroup stack. MaxSize, MinSize and DesiredCapacity are nil
+// when the template of the nodegroup does not set them
 type NodeGroupSummary struct {
-	StackName            string
-	Cluster              string
-	Name                 string
-	Status               string
-	MaxSize              int
-	MinSize              int
-	DesiredCapacity      int
-	InstanceType         string
-	ImageID              string
+	StackName       string
+	Cluster         string
+	Name            string
+	NodeGroupType   api.NodeGroupType
+	Status          string
+	MaxSize         *int
+	MinSize         *int
+	DesiredCapacity *int
+	InstanceType    string
+	ImageID         string
+	// CreationTime is the creation time of the EKS nodegroup for managed nodegroups, falling back to the
+	// creation time of the stack. It is nil when neither is known
 	CreationTime         *time.Time
 	NodeInstanceRoleARN  string
 	AutoScalingGroupName string
+	RemoteAccess         *RemoteAccessInfo
+	// SSHKeyExists is false when the EC2 key pair of the nodegroup was deleted, or no key pair is set
+	SSHKeyExists bool
+	// HealthStatus is nil when the Auto Scaling groups of the nodegroup could not be described
+	HealthStatus *NodeGroupHealthStatus
+	// LaunchTemplateID and LaunchTemplateVersion are empty when the nodegroup does not use a launch template
+	LaunchTemplateID      string
+	LaunchTemplateVersion string
+	// Outdated is true when the nodegroup runs an EKS-optimized AMI older than the latest one recommended for the
+	// cluster, as set by SetNodeGroupsOutdated. It is nil when unknown, e.g. for custom AMIs
+	Outdated *bool
+	// AvailabilityZoneSubnets are the subnets the nodegroup launches instances into, by availability zone, as set
+	// by setAvailabilityZoneSubnets
+	AvailabilityZoneSubnets map[string][]string
+
+	// subnetIDs are the subnets of the nodegroup, resolved to AvailabilityZoneSubnets once the subnets of all the
+	// summaries are known
+	subnetIDs []string
+}
+
+// Age returns how long ago the nodegroup was created, or zero when its creation time is unknown
+func (s *NodeGroupSummary) Age(now time.Time) time.Duration {
+	if s.CreationTime == nil {
+		return 0
+	}
+	return now.Sub(*s.CreationTime)
+}
+
+// RemoteAccessInfo describes the SSH access to the nodes of a nodegroup
+type RemoteAccessInfo struct {
+	Enabled              bool
+	SSHKeyName           string
+	SourceSecurityGroups []string
+	SourceCIDRs          []string
 }
 
-// NodeGroupStack represents a nodegroup and its type
+// NodeGroupStack represents a nodegroup, its type and the stack it is created by
 type NodeGroupStack struct {
 	NodeGroupName string
 	Type          api.NodeGroupType
+	StackName     string
+	StackStatus   string
 }
 
 // makeNodeGroupStackName generates the name of the nodegroup stack identified by its name, isolated by the cluster this StackCollection operates on
 func (c *StackCollection) makeNodeGroupStackName(name string) string {
-	return fmt.Sprintf("eksctl-%s-nodegroup-%s", c.spec.Metadata.Name, name)
+	return ResolveNodeGroupStackName(c.spec.Metadata.Name, name)
+}
+
+// ResolveNodeGroupStackName returns the name of the stack of the nodegroup ngName in the cluster clusterName
+func ResolveNodeGroupStackName(clusterName, ngName string) string {
+	return fmt.Sprintf("eksctl-%s-nodegroup-%s", clusterName, ngName)
+}
+
+// ParseNodeGroupStackName returns the cluster and nodegroup names of a nodegroup stack name, and false when
+// the name is not the one of a nodegroup stack. Cluster names containing "-nodegroup-" are supported, as the
+// name is split at the last occurrence
+func ParseNodeGroupStackName(stackName string) (cluster, ng string, ok bool) {
+	const prefix, separator = "eksctl-", "-nodegroup-"
+	if !strings.HasPrefix(stackName, prefix) {
+		return "", "", false
+	}
+	name := strings.TrimPrefix(stackName, prefix)
+	i := strings.LastIndex(name, separator)
+	if i <= 0 || i+len(separator) == len(name) {
+		return "", "", false
+	}
+	return name[:i], name[i+len(separator):], true
 }
 
 // createNodeGroupTask creates the nodegroup
@@ -63,14 +148,7 @@ func (c *StackCollection) createNodeGroupTask(errs chan error, ng *api.NodeGroup
 		return err
 	}
 
-	if ng.Tags == nil {
-		ng.Tags = make(map[string]string)
-	}
-	ng.Tags[api.NodeGroupNameTag] = ng.Name
-	ng.Tags[api.OldNodeGroupNameTag] = ng.Name
-	ng.Tags[api.NodeGroupTypeTag] = string(api.NodeGroupTypeUnmanaged)
-
-	return c.CreateStack(name, stack, ng.Tags, nil, errs)
+	return c.createNodeGroupStack(name, stack, ng.NodeGroupBase, api.NodeGroupTypeUnmanaged, errs)
 }
 
 func (c *StackCollection) createManagedNodeGroupTask(errorCh chan error, ng *api.ManagedNodeGroup, forceAddCNIPolicy bool, vpcImporter vpc.Importer) error {
@@ -82,7 +160,42 @@ func (c *StackCollection) createManagedNodeGroupTask(errorCh chan error, ng *api
 		return err
 	}
 
-	return c.CreateStack(name, stack, ng.Tags, nil, errorCh)
+	return c.createNodeGroupStack(name, stack, ng.NodeGroupBase, api.NodeGroupTypeManaged, errorCh)
+}
+
+// createNodeGroupStack creates the stack of the nodegroup, tagged with the tags of the nodegroup and the
+// nodegroup tags eksctl sets. When the nodegroup opts into protection from replacement, the stack policy
+// protecting it is set once the stack is created, before the result is sent to errs
+func (c *StackCollection) createNodeGroupStack(name string, resourceSet builder.ResourceSet, ng *api.NodeGroupBase, nodeGroupType api.NodeGroupType, errs chan error) error {
+	eksctlTags := map[string]string{
+		api.NodeGroupNameTag: ng.Name,
+		api.NodeGroupTypeTag: string(nodeGroupType),
+	}
+	if nodeGroupType == api.NodeGroupTypeUnmanaged {
+		eksctlTags[api.OldNodeGroupNameTag] = ng.Name
+	}
+	stack, err := c.createStackRequest(name, resourceSet, ng.Tags, eksctlTags, nil)
+	if err != nil {
+		return err
+	}
+
+	if !api.IsEnabled(ng.ProtectFromReplacement) {
+		go c.waitUntilStackIsCreated(stack, resourceSet, errs)
+		return nil
+	}
+
+	stackErrs := make(chan error)
+	go c.waitUntilStackIsCreated(stack, resourceSet, stackErrs)
+	go func() {
+		defer close(errs)
+		err := <-stackErrs
+		if err == nil {
+			logger.Info("protecting nodegroup %q from replacement", ng.Name)
+			err = c.SetNodeGroupStackPolicy(&api.NodeGroup{NodeGroupBase: ng}, false)
+		}
+		errs <- err
+	}()
+	return nil
 }
 
 // DescribeNodeGroupStacks calls DescribeStacks and filters out nodegroups
@@ -109,7 +222,41 @@ func (c *StackCollection) DescribeNodeGroupStacks() ([]*Stack, error) {
 	return nodeGroupStacks, nil
 }
 
-// ListNodeGroupStacks returns a list of NodeGroupStacks
+// describeNodeGroupStacksNamed describes only the stack(s) of the nodegroup with the given name, so that
+// the stacks of other nodegroups are not described
+func (c *StackCollection) describeNodeGroupStacksNamed(name string) ([]*Stack, error) {
+	stacks, err := c.ListStacksMatching(c.nodeGroupStackNameRegex(name))
+	if err != nil {
+		return nil, err
+	}
+
+	nodeGroupStacks := []*Stack{}
+	for _, s := range stacks {
+		if *s.StackStatus == cfn.StackStatusDeleteComplete {
+			continue
+		}
+		if c.GetNodeGroupName(s) == name {
+			nodeGroupStacks = append(nodeGroupStacks, s)
+		}
+	}
+	return nodeGroupStacks, nil
+}
+
+// nodeGroupStackNameRegex returns the regex matching the stack name of the nodegroup, taking into account
+// the names GetNodeGroupName gives to legacy stacks
+func (c *StackCollection) nodeGroupStackNameRegex(name string) string {
+	clusterName := regexp.QuoteMeta(c.spec.Metadata.Name)
+	switch name {
+	case "legacy-nodegroup-0":
+		return fmt.Sprintf("^(eksctl|EKS)-%s-nodegroup-0$", clusterName)
+	case "legacy-default":
+		return fmt.Sprintf("^(eksctl|EKS)-%s-DefaultNodeGroup$", clusterName)
+	}
+	return "^" + regexp.QuoteMeta(c.makeNodeGroupStackName(name)) + "$"
+}
+
+// ListNodeGroupStacks returns a list of NodeGroupStacks, carrying the name and status of each stack so that
+// the stacks need not be described again
 func (c *StackCollection) ListNodeGroupStacks() ([]NodeGroupStack, error) {
 	stacks, err := c.DescribeNodeGroupStacks()
 	if err != nil {
@@ -124,6 +271,8 @@ func (c *StackCollection) ListNodeGroupStacks() ([]NodeGroupStack, error) {
 		nodeGroupStacks = append(nodeGroupStacks, NodeGroupStack{
 			NodeGroupName: c.GetNodeGroupName(stack),
 			Type:          nodeGroupType,
+			StackName:     aws.StringValue(stack.StackName),
+			StackStatus:   aws.StringValue(stack.StackStatus),
 		})
 	}
 	return nodeGroupStacks, nil
@@ -161,41 +310,150 @@ func (c *StackCollection) DescribeNodeGroupStacksAndResources() (map[string]Stac
 	return allResources, nil
 }
 
-// ScaleNodeGroup will scale an existing nodegroup
-func (c *StackCollection) ScaleNodeGroup(ng *api.NodeGroup) error {
+// ScaleNodeGroup will scale an existing nodegroup. In dry-run mode the stack is not updated, and the
+// changes scaling would make to its resources are returned instead
+func (c *StackCollection) ScaleNodeGroup(ng *api.NodeGroup, dryRun bool) ([]StackChange, error) {
 	template, description, err := c.ScaleNodeGroupTemplate(ng)
 	if err != nil {
-		return nil
+		return nil, err
 	}
 
 	if template == "" {
-		return nil
+		return nil, nil
 	}
 
-	return c.UpdateStack(c.makeNodeGroupStackName(ng.Name), c.MakeChangeSetName("scale-nodegroup"), description, TemplateBody(template), nil)
+	return c.updateStackWithCheck(c.makeNodeGroupStackName(ng.Name), c.MakeChangeSetName("scale-nodegroup"), description, TemplateBody(template), nil, dryRun, c.nodeGroupReplacementCheck(ng))
+}
+
+// ScaleNodeGroups scales several existing nodegroups, updating up to 8 of their stacks concurrently.
+// Nodegroups that are already at the requested size are skipped. All nodegroups are attempted, and the
+// returned error names each nodegroup that failed to scale
+func (c *StackCollection) ScaleNodeGroups(ngs []*api.NodeGroup) error {
+	type scaleUpdate struct {
+		ng                    *api.NodeGroup
+		template, description string
+	}
+
+	var (
+		updates []scaleUpdate
+		mu      sync.Mutex
+		failed  = map[string]error{}
+	)
+	// the templates are computed sequentially as ScaleNodeGroupTemplate sets the cluster status of the spec
+	for _, ng := range ngs {
+		template, description, err := c.ScaleNodeGroupTemplate(ng)
+		if err != nil {
+			failed[ng.Name] = err
+			continue
+		}
+		if template == "" {
+			continue
+		}
+		updates = append(updates, scaleUpdate{ng: ng, template: template, description: description})
+	}
+
+	updateCh := make(chan scaleUpdate)
+	wg := &sync.WaitGroup{}
+	for i := 0; i < scaleNodeGroupsConcurrency && i < len(updates); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for u := range updateCh {
+				_, err := c.updateStackWithCheck(c.makeNodeGroupStackName(u.ng.Name), c.MakeChangeSetName("scale-nodegroup"), u.description, TemplateBody(u.template), nil, false, c.nodeGroupReplacementCheck(u.ng))
+				if err != nil {
+					mu.Lock()
+					failed[u.ng.Name] = err
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+	for _, u := range updates {
+		updateCh <- u
+	}
+	close(updateCh)
+	wg.Wait()
+
+	for name, err := range failed {
+		logger.Critical("failed to scale nodegroup %q: %v", name, err)
+	}
+	return combineErrors(failed, fmt.Sprintf("failed to scale %d of %d nodegroup(s)", len(failed), len(ngs)))
+}
+
+// combineErrors returns a single error starting with summary and listing the error of each failed item,
+// ordered by name, or nil when no item failed
+func combineErrors(failed map[string]error, summary string) error {
+	if len(failed) == 0 {
+		return nil
+	}
+	var names, messages []string
+	for name := range failed {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		messages = append(messages, fmt.Sprintf("%s: %v", name, failed[name]))
+	}
+	return errors.Errorf("%s: %s", summary, strings.Join(messages, "; "))
 }
 
 func (c *StackCollection) ScaleNodeGroupTemplate(ng *api.NodeGroup) (string, string, error) {
-	clusterName := c.MakeClusterStackName()
-	c.spec.Status = &api.ClusterStatus{StackName: clusterName}
+	stack, template, err := c.getNodeGroupStackAndTemplate(ng)
+	if err != nil {
+		return "", "", err
+	}
+	return c.scaleNodeGroupTemplate(ng, stack, template)
+}
+
+// ScaleNodeGroupByDelta returns the template of the nodegroup's stack with the desired capacity changed by delta,
+// which may be negative. The min and max size of the stack are kept, and the new desired capacity must be within them
+func (c *StackCollection) ScaleNodeGroupByDelta(ng *api.NodeGroup, delta int) (string, error) {
+	stack, template, err := c.getNodeGroupStackAndTemplate(ng)
+	if err != nil {
+		return "", err
+	}
+
+	ngPaths, err := getScalingPaths(template, stack.Tags)
+	if err != nil {
+		return "", err
+	}
+	desiredCapacity := int(gjson.Get(template, ngPaths.DesiredCapacity).Int()) + delta
+
+	// copy the base so that the scaling config of ng is left untouched
+	base := *ng.NodeGroupBase
+	base.ScalingConfig = &api.ScalingConfig{DesiredCapacity: &desiredCapacity}
+	template, _, err = c.scaleNodeGroupTemplate(&api.NodeGroup{NodeGroupBase: &base}, stack, template)
+	return template, err
+}
+
+func (c *StackCollection) getNodeGroupStackAndTemplate(ng *api.NodeGroup) (*Stack, string, error) {
+	c.spec.Status = &api.ClusterStatus{StackName: c.MakeClusterStackName()}
 	name := c.makeNodeGroupStackName(ng.Name)
 
 	stack, err := c.DescribeStack(&Stack{StackName: &name})
 	if err != nil {
-		return "", "", errors.Wrapf(err, "error describing nodegroup stack %s", name)
+		return nil, "", errors.Wrapf(err, "error describing nodegroup stack %s", name)
 	}
 
 	// Get current stack
 	template, err := c.GetStackTemplate(name)
 	if err != nil {
-		return "", "", errors.Wrapf(err, "error getting stack template %s", name)
+		return nil, "", errors.Wrapf(err, "error getting stack template %s", name)
 	}
+	return stack, template, nil
+}
+
+func (c *StackCollection) scaleNodeGroupTemplate(ng *api.NodeGroup, stack *Stack, template string) (string, string, error) {
+	clusterName := c.MakeClusterStackName()
 	logger.Debug("stack template (pre-scale change): %s", template)
 
-	var descriptionBuffer bytes.Buffer
+	var (
+		descriptionBuffer bytes.Buffer
+		err               error
+	)
 	descriptionBuffer.WriteString("scaling nodegroup")
 
-	ngPaths, err := getNodeGroupPaths(stack.Tags)
+	ngPaths, err := getScalingPaths(template, stack.Tags)
 	if err != nil {
 		return "", "", err
 	}
@@ -205,41 +463,35 @@ func (c *StackCollection) ScaleNodeGroupTemplate(ng *api.NodeGroup) (string, str
 		minSizePath         = ngPaths.MinSize
 	)
 
-	// TODO rewrite this using types
-	// Get the current values
-	currentCapacity := gjson.Get(template, desiredCapacityPath).Int()
-	currentMaxSize := gjson.Get(template, maxSizePath).Int()
-	currentMinSize := gjson.Get(template, minSizePath).Int()
-
-	desiredCapacity := currentCapacity
-	if ng.DesiredCapacity != nil {
-		desiredCapacity = int64(*ng.DesiredCapacity)
-	}
-
-	desiredMinSize := currentMinSize
-	if ng.MinSize != nil {
-		desiredMinSize = int64(*ng.MinSize)
-	}
+	current := scalingFromTemplate(template, ngPaths)
+	desired := current.desiredBy(ng)
+	var (
+		currentCapacity, currentMinSize, currentMaxSize = current.DesiredCapacity, current.MinSize, current.MaxSize
+		desiredCapacity, desiredMinSize, desiredMaxSize = desired.DesiredCapacity, desired.MinSize, desired.MaxSize
+	)
 
-	desiredMaxSize := currentMaxSize
-	if ng.MaxSize != nil {
-		desiredMaxSize = int64(*ng.MaxSize)
-	}
+	labels, labelsChanged := mergeTemplateLabels(template, ngPaths.Labels, ng.Labels)
+	taints, taintsChanged := mergeTemplateTaints(template, ngPaths.Taints, ng.Taints)
 
-	if desiredCapacity == currentCapacity && desiredMinSize == currentMinSize && desiredMaxSize == currentMaxSize {
+	if desiredCapacity == currentCapacity && desiredMinSize == currentMinSize && desiredMaxSize == currentMaxSize &&
+		!labelsChanged && !taintsChanged {
 		logger.Info("no change for nodegroup %q in cluster %q: nodes-min %d, desired %d, nodes-max %d", ng.Name,
 			clusterName, currentMinSize, desiredCapacity, currentMaxSize)
 		return "", "", nil
 	}
 
+	// the bounds are checked against the resulting min size, so that e.g. managed nodegroups can be
+	// scaled to zero by setting the min size and desired capacity to 0 at once
 	if desiredCapacity < desiredMinSize {
-		logger.Warning("the desired nodes %d is less than the nodes-min/minSize %d", desiredCapacity, desiredMinSize)
-		return "", "", errors.Errorf("the desired nodes %d is less than the nodes-min/minSize %d", desiredCapacity, desiredMinSize)
+		err := &ErrDesiredBelowMin{Desired: int(desiredCapacity), Min: int(desiredMinSize)}
+		logger.Warning(err.Error())
+		return "", "", err
 	}
 
 	if desiredCapacity > desiredMaxSize {
-		logger.Warning("the desired nodes %d is greater than the nodes-max/maxSize %d", desiredCapacity, desiredMaxSize)
-		return "", "", errors.Errorf("the desired nodes %d is greater than the nodes-max/maxSize %d", desiredCapacity, desiredMaxSize)
+		err := &ErrDesiredExceedsMax{Desired: int(desiredCapacity), Max: int(desiredMaxSize)}
+		logger.Warning(err.Error())
+		return "", "", err
 	}
 
 	// Set the new values
@@ -247,7 +499,7 @@ func (c *StackCollection) ScaleNodeGroupTemplate(ng *api.NodeGroup) (string, str
 		if newVal == oldVal {
 			return nil
 		}
-		template, err = sjson.Set(template, path, fmt.Sprintf("%d", newVal))
+		template, err = setTemplateSize(template, path, newVal)
 		if err != nil {
 			return errors.Wrapf(err, "error setting %s", fieldName)
 		}
@@ -266,55 +518,308 @@ func (c *StackCollection) ScaleNodeGroupTemplate(ng *api.NodeGroup) (string, str
 	if err := updateField(maxSizePath, "max size", desiredMaxSize, currentMaxSize); err != nil {
 		return "", "", err
 	}
+
+	if labelsChanged {
+		if template, err = sjson.Set(template, ngPaths.Labels, labels); err != nil {
+			return "", "", errors.Wrap(err, "error setting labels")
+		}
+		descriptionBuffer.WriteString(", updating labels")
+	}
+
+	if taintsChanged {
+		if template, err = sjson.Set(template, ngPaths.Taints, taints); err != nil {
+			return "", "", errors.Wrap(err, "error setting taints")
+		}
+		descriptionBuffer.WriteString(", updating taints")
+	}
 	logger.Debug("stack template (post-scale change): %s", template)
 	return template, descriptionBuffer.String(), nil
 }
 
-// GetNodeGroupSummaries returns a list of summaries for the nodegroups of a cluster
+// setTemplateSize sets the size at path in the template, keeping its JSON type: the sizes of managed
+// nodegroups are numbers, the ones of Auto Scaling groups are strings
+func setTemplateSize(template, path string, size int64) (string, error) {
+	var value interface{} = fmt.Sprintf("%d", size)
+	if gjson.Get(template, path).Type == gjson.Number {
+		value = size
+	}
+	return sjson.Set(template, path, value)
+}
+
+// mergeTemplateLabels returns the labels at path in the template with the given labels added or updated, and
+// whether any label changed. Labels of the template that are not given are kept, as the labels eksctl sets by
+// default are usually not part of the config. Nothing changes when path is empty
+func mergeTemplateLabels(template, path string, labels map[string]string) (map[string]string, bool) {
+	if path == "" || len(labels) == 0 {
+		return nil, false
+	}
+	merged := map[string]string{}
+	gjson.Get(template, path).ForEach(func(key, value gjson.Result) bool {
+		merged[key.String()] = value.String()
+		return true
+	})
+	changed := false
+	for key, value := range labels {
+		if current, ok := merged[key]; !ok || current != value {
+			merged[key] = value
+			changed = true
+		}
+	}
+	return merged, changed
+}
+
+// cfnTaintEffects maps the Kubernetes taint effects to the ones of the taints of AWS::EKS::Nodegroup resources
+var cfnTaintEffects = map[string]string{
+	string(corev1.TaintEffectNoSchedule):       "NO_SCHEDULE",
+	string(corev1.TaintEffectPreferNoSchedule): "PREFER_NO_SCHEDULE",
+	string(corev1.TaintEffectNoExecute):        "NO_EXECUTE",
+}
+
+// mergeTemplateTaints returns the taints at path in the template with the given taints added, or updated when a
+// taint with the same key and effect exists, and whether any taint changed. Nothing changes when path is empty
+func mergeTemplateTaints(template, path string, taints []api.NodeGroupTaint) ([]map[string]string, bool) {
+	if path == "" || len(taints) == 0 {
+		return nil, false
+	}
+	var merged []map[string]string
+	for _, taint := range gjson.Get(template, path).Array() {
+		merged = append(merged, map[string]string{
+			"Key":    taint.Get("Key").String(),
+			"Value":  taint.Get("Value").String(),
+			"Effect": taint.Get("Effect").String(),
+		})
+	}
+	changed := false
+	for _, taint := range taints {
+		effect := taint.Effect
+		if cfnEffect, ok := cfnTaintEffects[effect]; ok {
+			effect = cfnEffect
+		}
+		found := false
+		for _, current := range merged {
+			if current["Key"] == taint.Key && current["Effect"] == effect {
+				found = true
+				if current["Value"] != taint.Value {
+					current["Value"] = taint.Value
+					changed = true
+				}
+				break
+			}
+		}
+		if !found {
+			merged = append(merged, map[string]string{"Key": taint.Key, "Value": taint.Value, "Effect": effect})
+			changed = true
+		}
+	}
+	return merged, changed
+}
+
+// SyncNodeGroupBoundsToCloudFormation updates the min and max size in the nodegroup's stack template to the
+// live values, so that changes made outside of eksctl are not reverted by the next stack update.
+// The desired capacity in the template is set to the live one, e.g. after the cluster autoscaler scaled
+// the nodegroup, moved within the new bounds if needed
+func (c *StackCollection) SyncNodeGroupBoundsToCloudFormation(ng *api.NodeGroup) error {
+	stack, err := c.DescribeNodeGroupStack(ng.Name)
+	if err != nil {
+		return errors.Wrapf(err, "describing stack of nodegroup %q", ng.Name)
+	}
+
+	template, err := c.GetStackTemplate(*stack.StackName)
+	if err != nil {
+		return errors.Wrapf(err, "error getting stack template %s", *stack.StackName)
+	}
+	ngPaths, err := getScalingPaths(template, stack.Tags)
+	if err != nil {
+		return err
+	}
+
+	var liveMinSize, liveMaxSize, liveCapacity int64
+	if ngPaths.NodeGroupType == api.NodeGroupTypeManaged {
+		liveMinSize, liveMaxSize, liveCapacity, err = c.getManagedNodeGroupSizes(ng.Name)
+	} else {
+		liveMinSize, liveMaxSize, liveCapacity, err = c.getAutoScalingGroupSizes(stack)
+	}
+	if err != nil {
+		return err
+	}
+
+	desiredCapacity := liveCapacity
+	if desiredCapacity < liveMinSize {
+		desiredCapacity = liveMinSize
+	} else if desiredCapacity > liveMaxSize {
+		desiredCapacity = liveMaxSize
+	}
+
+	var changes []string
+	updateField := func(path, fieldName string, newVal int64) error {
+		oldVal := gjson.Get(template, path).Int()
+		if newVal == oldVal {
+			return nil
+		}
+		if template, err = setTemplateSize(template, path, newVal); err != nil {
+			return errors.Wrapf(err, "error setting %s", fieldName)
+		}
+		changes = append(changes, fmt.Sprintf("%s from %d to %d", fieldName, oldVal, newVal))
+		return nil
+	}
+
+	if err := updateField(ngPaths.MinSize, "min size", liveMinSize); err != nil {
+		return err
+	}
+	if err := updateField(ngPaths.MaxSize, "max size", liveMaxSize); err != nil {
+		return err
+	}
+	if gjson.Get(template, ngPaths.DesiredCapacity).Exists() {
+		if err := updateField(ngPaths.DesiredCapacity, "desired capacity", desiredCapacity); err != nil {
+			return err
+		}
+	}
+
+	if len(changes) == 0 {
+		logger.Info("the stack of nodegroup %q is in sync: nodes-min %d, nodes-max %d", ng.Name, liveMinSize, liveMaxSize)
+		return nil
+	}
+
+	description := fmt.Sprintf("syncing nodegroup bounds, %s", strings.Join(changes, ", "))
+	logger.Info("updating the stack of nodegroup %q: %s", ng.Name, strings.Join(changes, ", "))
+	_, err = c.updateStackWithCheck(*stack.StackName, c.MakeChangeSetName("sync-nodegroup-bounds"), description, TemplateBody(template), nil, false, c.nodeGroupReplacementCheck(ng))
+	return err
+}
+
+// getAutoScalingGroupSizes returns the min size, max size and desired capacity of the nodegroup's Auto Scaling group
+func (c *StackCollection) getAutoScalingGroupSizes(stack *Stack) (int64, int64, int64, error) {
+	asgName, err := c.GetNodeGroupAutoScalingGroupName(stack)
+	if err != nil {
+		return 0, 0, 0, errors.Wrapf(err, "getting Auto Scaling group of stack %s", *stack.StackName)
+	}
+	asgs, err := c.asgAPI.DescribeAutoScalingGroups(&autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: aws.StringSlice([]string{asgName}),
+	})
+	if err != nil {
+		return 0, 0, 0, errors.Wrapf(err, "describing Auto Scaling group %q", asgName)
+	}
+	if len(asgs.AutoScalingGroups) == 0 {
+		return 0, 0, 0, errors.Errorf("Auto Scaling group %q not found", asgName)
+	}
+	asg := asgs.AutoScalingGroups[0]
+	return aws.Int64Value(asg.MinSize), aws.Int64Value(asg.MaxSize), aws.Int64Value(asg.DesiredCapacity), nil
+}
+
+// getManagedNodeGroupSizes returns the min and max size of the managed nodegroup and the desired capacity of
+// its Auto Scaling groups, which is what the cluster autoscaler changes. The desired size of the nodegroup is
+// used when it has no Auto Scaling groups yet
+func (c *StackCollection) getManagedNodeGroupSizes(name string) (int64, int64, int64, error) {
+	res, err := c.eksAPI.DescribeNodegroup(&eks.DescribeNodegroupInput{
+		ClusterName:   aws.String(c.spec.Metadata.Name),
+		NodegroupName: aws.String(name),
+	})
+	if err != nil {
+		return 0, 0, 0, errors.Wrapf(err, "describing managed nodegroup %q", name)
+	}
+	if res.Nodegroup.ScalingConfig == nil {
+		return 0, 0, 0, errors.Errorf("managed nodegroup %q has no scaling config", name)
+	}
+	scalingConfig := res.Nodegroup.ScalingConfig
+	minSize, maxSize := aws.Int64Value(scalingConfig.MinSize), aws.Int64Value(scalingConfig.MaxSize)
+
+	asgNames := managedNodeGroupAutoScalingGroupNames(res.Nodegroup)
+	if len(asgNames) == 0 {
+		return minSize, maxSize, aws.Int64Value(scalingConfig.DesiredSize), nil
+	}
+
+	asgs, err := c.asgAPI.DescribeAutoScalingGroups(&autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: aws.StringSlice(asgNames),
+	})
+	if err != nil {
+		return 0, 0, 0, errors.Wrapf(err, "describing Auto Scaling groups of managed nodegroup %q", name)
+	}
+	var desiredCapacity int64
+	for _, asg := range asgs.AutoScalingGroups {
+		desiredCapacity += aws.Int64Value(asg.DesiredCapacity)
+	}
+	return minSize, maxSize, desiredCapacity, nil
+}
+
+// GetNodeGroupSummaries returns a list of summaries for the nodegroups of a cluster, or only for the nodegroup
+// with the given name when name is not empty
 func (c *StackCollection) GetNodeGroupSummaries(name string) ([]*NodeGroupSummary, error) {
-	stacks, err := c.DescribeNodeGroupStacks()
+	var (
+		stacks []*Stack
+		err    error
+	)
+	if name == "" {
+		stacks, err = c.DescribeNodeGroupStacks()
+	} else {
+		stacks, err = c.describeNodeGroupStacksNamed(name)
+	}
 	if err != nil {
 		return nil, errors.Wrap(err, "getting nodegroup stacks")
 	}
 
 	// Create an empty array here so that an object is returned rather than null
 	summaries := []*NodeGroupSummary{}
-	for _, s := range stacks {
-		ngPaths, err := getNodeGroupPaths(s.Tags)
+	if len(stacks) > 0 {
+		partiallyDeleted, err := c.isClusterPartiallyDeleted()
 		if err != nil {
 			return nil, err
 		}
-
-		summary, err := c.mapStackToNodeGroupSummary(s, ngPaths)
-
-		if err != nil {
-			return nil, errors.Wrap(err, "mapping stack to nodegroup summary")
+		if partiallyDeleted {
+			logger.Warning("the stack and control plane of cluster %q no longer exist, skipping its %d nodegroup stack(s)",
+				c.spec.Metadata.Name, len(stacks))
+			return summaries, nil
 		}
+	}
 
-		asgName, err := c.GetAutoScalingGroupName(s)
+	templates := templateCache{}
+	for _, s := range stacks {
+		summary, err := c.getNodeGroupSummary(s, templates)
 		if err != nil {
-			return nil, errors.Wrap(err, "getting autoscalinggroupname")
+			return nil, err
 		}
 
-		summary.AutoScalingGroupName = asgName
-
-		if name == "" {
-			summaries = append(summaries, summary)
-		} else if summary.Name == name {
-			summaries = append(summaries, summary)
-		}
+		summaries = append(summaries, summary)
 	}
 
+	if err := c.setAvailabilityZoneSubnets(summaries); err != nil {
+		return nil, err
+	}
 	return summaries, nil
 }
 
+// isClusterPartiallyDeleted returns true when both the stack and the control plane of the cluster are gone while
+// nodegroup stacks remain. Clusters not created by eksctl never had a cluster stack, so they are told apart by
+// their control plane still existing
+func (c *StackCollection) isClusterPartiallyDeleted() (bool, error) {
+	_, err := c.DescribeStack(&Stack{StackName: aws.String(c.MakeClusterStackName())})
+	if err == nil {
+		return false, nil
+	}
+	if !isStackDoesNotExistError(err) {
+		return false, errors.Wrap(err, "checking for the cluster stack")
+	}
+
+	_, err = c.eksAPI.DescribeCluster(&eks.DescribeClusterInput{
+		Name: &c.spec.Metadata.Name,
+	})
+	if err == nil {
+		return false, nil
+	}
+	if awsErr, ok := errors.Cause(err).(awserr.Error); ok && awsErr.Code() == eks.ErrCodeResourceNotFoundException {
+		return true, nil
+	}
+	return false, errors.Wrapf(err, "describing cluster %q", c.spec.Metadata.Name)
+}
+
 func (c *StackCollection) GetAutoScalingGroupName(s *Stack) (string, error) {
 
 	nodeGroupType, err := GetNodeGroupType(s.Tags)
 	if err != nil {
 		return "", err
 	}
+	return c.getAutoScalingGroupName(s, nodeGroupType)
+}
 
+func (c *StackCollection) getAutoScalingGroupName(s *Stack, nodeGroupType api.NodeGroupType) (string, error) {
 	switch nodeGroupType {
 	case api.NodeGroupTypeManaged:
 		res, err := c.GetManagedNodeGroupAutoScalingGroupName(s)
@@ -361,16 +866,31 @@ func (c *StackCollection) GetManagedNodeGroupAutoScalingGroupName(s *Stack) (str
 		logger.Warning("couldn't get managed nodegroup details for stack %q", *s.StackName)
 		return "", nil
 	}
+	return strings.Join(managedNodeGroupAutoScalingGroupNames(res.Nodegroup), ","), nil
+}
 
-	asgs := []string{}
-
-	if res.Nodegroup.Resources != nil {
-		for _, v := range res.Nodegroup.Resources.AutoScalingGroups {
-			asgs = append(asgs, aws.StringValue(v.Name))
+// managedNodeGroupAutoScalingGroupNames returns the names of the Auto Scaling groups EKS created for a managed
+// nodegroup
+func managedNodeGroupAutoScalingGroupNames(nodeGroup *eks.Nodegroup) []string {
+	asgNames := []string{}
+	if nodeGroup.Resources != nil {
+		for _, asg := range nodeGroup.Resources.AutoScalingGroups {
+			asgNames = append(asgNames, aws.StringValue(asg.Name))
 		}
 	}
-	return strings.Join(asgs, ","), nil
+	return asgNames
+}
 
+// GetManagedNodeGroup returns the full EKS representation of the managed nodegroup
+func (c *StackCollection) GetManagedNodeGroup(ng *api.NodeGroup) (*eks.Nodegroup, error) {
+	res, err := c.eksAPI.DescribeNodegroup(&eks.DescribeNodegroupInput{
+		ClusterName:   aws.String(c.spec.Metadata.Name),
+		NodegroupName: aws.String(ng.Name),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "describing managed nodegroup %q", ng.Name)
+	}
+	return res.Nodegroup, nil
 }
 
 // DescribeNodeGroupStack gets the specified nodegroup stack
@@ -379,6 +899,24 @@ func (c *StackCollection) DescribeNodeGroupStack(nodeGroupName string) (*Stack,
 	return c.DescribeStack(&Stack{StackName: &stackName})
 }
 
+// NodeGroupStackExists reports whether the stack of the nodegroup exists, describing only that stack
+func (c *StackCollection) NodeGroupStackExists(ngName string) (bool, error) {
+	if _, err := c.DescribeNodeGroupStack(ngName); err != nil {
+		if isStackDoesNotExistError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// isStackDoesNotExistError reports whether the error is the one CloudFormation returns when describing a stack
+// that does not exist
+func isStackDoesNotExistError(err error) bool {
+	awsErr, ok := errors.Cause(err).(awserr.Error)
+	return ok && awsErr.Code() == "ValidationError" && strings.Contains(awsErr.Message(), "does not exist")
+}
+
 // GetNodeGroupStackType returns the nodegroup stack type
 func (c *StackCollection) GetNodeGroupStackType(name string) (api.NodeGroupType, error) {
 	stack, err := c.DescribeNodeGroupStack(name)
@@ -388,6 +926,254 @@ func (c *StackCollection) GetNodeGroupStackType(name string) (api.NodeGroupType,
 	return GetNodeGroupType(stack.Tags)
 }
 
+// GetNodeGroupKubeletVersion returns the kubelet version reported by the nodes of the nodegroup,
+// if the nodes run different versions all of them are returned as a comma-separated list
+func (c *StackCollection) GetNodeGroupKubeletVersion(ng *api.NodeGroup, kubeClient kubernetes.Interface) (string, error) {
+	nodes, err := kubeClient.CoreV1().Nodes().List(context.TODO(), ng.ListOptions())
+	if err != nil {
+		return "", errors.Wrapf(err, "listing nodes of nodegroup %q", ng.Name)
+	}
+	if len(nodes.Items) == 0 {
+		return "", fmt.Errorf("no nodes found for nodegroup %q", ng.Name)
+	}
+
+	versions := sets.NewString()
+	for _, node := range nodes.Items {
+		versions.Insert(node.Status.NodeInfo.KubeletVersion)
+	}
+	return strings.Join(versions.List(), ","), nil
+}
+
+// GetNodeGroupPodCapacity returns the number of pods running on the nodes of the nodegroup and the
+// number of pods the nodes can hold in total, based on the allocatable pods reported by each node
+func (c *StackCollection) GetNodeGroupPodCapacity(ng *api.NodeGroup, kubeClient kubernetes.Interface) (used, total int, err error) {
+	nodes, err := kubeClient.CoreV1().Nodes().List(context.TODO(), ng.ListOptions())
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, "listing nodes of nodegroup %q", ng.Name)
+	}
+
+	for _, node := range nodes.Items {
+		if maxPods, ok := node.Status.Allocatable[corev1.ResourcePods]; ok {
+			total += int(maxPods.Value())
+		}
+
+		pods, err := kubeClient.CoreV1().Pods(metav1.NamespaceAll).List(context.TODO(), metav1.ListOptions{
+			FieldSelector: fields.OneTermEqualSelector("spec.nodeName", node.Name).String(),
+		})
+		if err != nil {
+			return 0, 0, errors.Wrapf(err, "listing pods on node %q of nodegroup %q", node.Name, ng.Name)
+		}
+		for _, pod := range pods.Items {
+			// terminated pods don't take up a pod slot on the node
+			if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+				continue
+			}
+			used++
+		}
+	}
+	return used, total, nil
+}
+
+// CheckNodeGroupAMIArchitecture compares the architecture of the AMI in the latest version of the
+// nodegroup's launch template with the architectures supported by the nodegroup's instance types
+func (c *StackCollection) CheckNodeGroupAMIArchitecture(ng *api.NodeGroup) error {
+	res, err := c.cloudformationAPI.DescribeStackResource(&cfn.DescribeStackResourceInput{
+		StackName:         aws.String(c.makeNodeGroupStackName(ng.Name)),
+		LogicalResourceId: aws.String("NodeGroupLaunchTemplate"),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "getting launch template of nodegroup %q", ng.Name)
+	}
+
+	versions, err := c.ec2API.DescribeLaunchTemplateVersions(&ec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateId: res.StackResourceDetail.PhysicalResourceId,
+		Versions:         aws.StringSlice([]string{"$Latest"}),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "describing launch template %q", aws.StringValue(res.StackResourceDetail.PhysicalResourceId))
+	}
+	if len(versions.LaunchTemplateVersions) == 0 || versions.LaunchTemplateVersions[0].LaunchTemplateData == nil {
+		return fmt.Errorf("no launch template version found for nodegroup %q", ng.Name)
+	}
+	launchTemplateData := versions.LaunchTemplateVersions[0].LaunchTemplateData
+	imageID := aws.StringValue(launchTemplateData.ImageId)
+	if imageID == "" {
+		return fmt.Errorf("launch template of nodegroup %q has no AMI set", ng.Name)
+	}
+
+	images, err := c.ec2API.DescribeImages(&ec2.DescribeImagesInput{
+		ImageIds: aws.StringSlice([]string{imageID}),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "describing AMI %q", imageID)
+	}
+	if len(images.Images) == 0 {
+		return fmt.Errorf("AMI %q of nodegroup %q not found", imageID, ng.Name)
+	}
+	architecture := aws.StringValue(images.Images[0].Architecture)
+
+	instanceTypes := sets.NewString(ng.InstanceTypeList()...)
+	if launchTemplateData.InstanceType != nil {
+		instanceTypes.Insert(*launchTemplateData.InstanceType)
+	}
+	instanceTypes.Delete("")
+	instanceTypeInfo, err := c.ec2API.DescribeInstanceTypes(&ec2.DescribeInstanceTypesInput{
+		InstanceTypes: aws.StringSlice(instanceTypes.List()),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "describing instance types %v", instanceTypes.List())
+	}
+
+	for _, it := range instanceTypeInfo.InstanceTypes {
+		var supportedArchitectures []string
+		if it.ProcessorInfo != nil {
+			supportedArchitectures = aws.StringValueSlice(it.ProcessorInfo.SupportedArchitectures)
+		}
+		if !sets.NewString(supportedArchitectures...).Has(architecture) {
+			return fmt.Errorf("AMI %q of nodegroup %q has architecture %s, which is not supported by instance type %s (supported architectures: %s)",
+				imageID, ng.Name, architecture, aws.StringValue(it.InstanceType), strings.Join(supportedArchitectures, ", "))
+		}
+	}
+	return nil
+}
+
+// RollbackNodeGroup updates the nodegroup stack with the template it had before its last update.
+// CloudFormation does not keep previous templates, so they are retrieved from the CloudTrail
+// history of the stack, which only covers the last 90 days
+func (c *StackCollection) RollbackNodeGroup(ng *api.NodeGroup) error {
+	stack, err := c.DescribeNodeGroupStack(ng.Name)
+	if err != nil {
+		return errors.Wrapf(err, "describing stack of nodegroup %q", ng.Name)
+	}
+
+	currentTemplate, err := c.GetStackTemplate(*stack.StackName)
+	if err != nil {
+		return errors.Wrapf(err, "error getting CloudFormation template for stack %s", *stack.StackName)
+	}
+
+	events, err := c.LookupCloudTrailEvents(stack)
+	if err != nil {
+		return err
+	}
+
+	stackEvents, err := c.DescribeStackEvents(stack)
+	if err != nil {
+		return err
+	}
+
+	previousTemplate, err := findPreviousTemplate(events, stackEvents, currentTemplate)
+	if err != nil {
+		return err
+	}
+	if previousTemplate == "" {
+		return fmt.Errorf("no previous template found for nodegroup %q in the update history of stack %s", ng.Name, *stack.StackName)
+	}
+
+	description := fmt.Sprintf("rolling back nodegroup %q to its previous template", ng.Name)
+	return c.UpdateStack(*stack.StackName, c.MakeChangeSetName("rollback-nodegroup"), description, TemplateBody(previousTemplate), nil)
+}
+
+// stackTemplateSubmission is a template that was applied to a stack, either directly or by executing a change set
+type stackTemplateSubmission struct {
+	templateBody string
+	time         time.Time
+}
+
+// findPreviousTemplate looks for the template applied before the current one in the CloudTrail events of a
+// stack. Only templates of stack creations, updates and executed change sets that reached CREATE_COMPLETE or
+// UPDATE_COMPLETE, according to the stack events, are considered, so that change sets that were never executed
+// and updates that were rolled back are skipped
+func findPreviousTemplate(events []*cloudtrail.Event, stackEvents []*cfn.StackEvent, currentTemplate string) (string, error) {
+	var current interface{}
+	if err := json.Unmarshal([]byte(currentTemplate), &current); err != nil {
+		return "", errors.Wrap(err, "parsing current template")
+	}
+
+	type cloudTrailEvent struct {
+		RequestParameters struct {
+			ChangeSetName string `json:"changeSetName"`
+			TemplateBody  string `json:"templateBody"`
+		} `json:"requestParameters"`
+		ResponseElements struct {
+			ID string `json:"id"`
+		} `json:"responseElements"`
+	}
+
+	parsedEvents := make([]cloudTrailEvent, len(events))
+	changeSetTemplates := map[string]string{}
+	for i, event := range events {
+		if err := json.Unmarshal([]byte(aws.StringValue(event.CloudTrailEvent)), &parsedEvents[i]); err != nil {
+			return "", errors.Wrapf(err, "parsing CloudTrail event %s", aws.StringValue(event.EventId))
+		}
+		if aws.StringValue(event.EventName) == "CreateChangeSet" {
+			// change sets can be executed by name or by ARN
+			changeSetTemplates[parsedEvents[i].RequestParameters.ChangeSetName] = parsedEvents[i].RequestParameters.TemplateBody
+			if id := parsedEvents[i].ResponseElements.ID; id != "" {
+				changeSetTemplates[id] = parsedEvents[i].RequestParameters.TemplateBody
+			}
+		}
+	}
+
+	var submissions []stackTemplateSubmission
+	for i, event := range events {
+		var templateBody string
+		switch aws.StringValue(event.EventName) {
+		case "CreateStack", "UpdateStack":
+			templateBody = parsedEvents[i].RequestParameters.TemplateBody
+		case "ExecuteChangeSet":
+			templateBody = changeSetTemplates[parsedEvents[i].RequestParameters.ChangeSetName]
+		default:
+			continue
+		}
+		if templateBody != "" && stackUpdateCompleted(stackEvents, aws.TimeValue(event.EventTime)) {
+			submissions = append(submissions, stackTemplateSubmission{templateBody: templateBody, time: aws.TimeValue(event.EventTime)})
+		}
+	}
+	sort.SliceStable(submissions, func(i, j int) bool {
+		return submissions[i].time.After(submissions[j].time)
+	})
+
+	foundCurrent := false
+	for _, s := range submissions {
+		var template interface{}
+		if json.Unmarshal([]byte(s.templateBody), &template) != nil {
+			continue
+		}
+
+		isCurrent := reflect.DeepEqual(template, current)
+		if foundCurrent && !isCurrent {
+			return s.templateBody, nil
+		}
+		foundCurrent = foundCurrent || isCurrent
+	}
+	return "", nil
+}
+
+// stackUpdateCompleted reports whether the first final status the stack reached after the given time, according to
+// its events, is CREATE_COMPLETE or UPDATE_COMPLETE
+func stackUpdateCompleted(stackEvents []*cfn.StackEvent, since time.Time) bool {
+	var first *cfn.StackEvent
+	for _, e := range stackEvents {
+		isStackEvent := aws.StringValue(e.ResourceType) == "AWS::CloudFormation::Stack" && aws.StringValue(e.LogicalResourceId) == aws.StringValue(e.StackName)
+		if !isStackEvent || aws.TimeValue(e.Timestamp).Before(since) {
+			continue
+		}
+		switch aws.StringValue(e.ResourceStatus) {
+		case cfn.ResourceStatusCreateComplete, cfn.ResourceStatusUpdateComplete,
+			cfn.ResourceStatusCreateFailed, cfn.StackStatusRollbackComplete, cfn.StackStatusRollbackFailed,
+			cfn.StackStatusUpdateRollbackComplete, cfn.StackStatusUpdateRollbackFailed:
+			if first == nil || aws.TimeValue(e.Timestamp).Before(aws.TimeValue(first.Timestamp)) {
+				first = e
+			}
+		}
+	}
+	if first == nil {
+		return false
+	}
+	status := aws.StringValue(first.ResourceStatus)
+	return status == cfn.ResourceStatusCreateComplete || status == cfn.ResourceStatusUpdateComplete
+}
+
 // GetNodeGroupType returns the nodegroup type
 func GetNodeGroupType(tags []*cfn.Tag) (api.NodeGroupType, error) {
 	var nodeGroupType api.NodeGroupType
@@ -410,6 +1196,45 @@ func GetNodeGroupType(tags []*cfn.Tag) (api.NodeGroupType, error) {
 	return nodeGroupType, nil
 }
 
+// GetNodeGroupTypeFromTemplate returns the nodegroup type like GetNodeGroupType, but falls back to the
+// stack's template for legacy stacks tagged only with the cluster name, classifying them by their
+// Auto Scaling group or managed nodegroup resource
+func GetNodeGroupTypeFromTemplate(tags []*cfn.Tag, template string) (api.NodeGroupType, error) {
+	if GetNodegroupTagName(tags) == "" && hasTag(tags, api.ClusterNameTag) {
+		return nodeGroupTypeFromTemplate(template)
+	}
+	return GetNodeGroupType(tags)
+}
+
+// nodeGroupTypeFromTemplate returns the type of the nodegroup resource in the template
+func nodeGroupTypeFromTemplate(template string) (api.NodeGroupType, error) {
+	var nodeGroupType api.NodeGroupType
+	gjson.Get(template, resourcesRootPath).ForEach(func(_, resource gjson.Result) bool {
+		switch resource.Get("Type").String() {
+		case "AWS::AutoScaling::AutoScalingGroup":
+			nodeGroupType = api.NodeGroupTypeUnmanaged
+		case "AWS::EKS::Nodegroup":
+			nodeGroupType = api.NodeGroupTypeManaged
+		default:
+			return true
+		}
+		return false
+	})
+	if nodeGroupType == "" {
+		return "", errors.New("failed to find the nodegroup name tag or a nodegroup resource in the template")
+	}
+	return nodeGroupType, nil
+}
+
+func hasTag(tags []*cfn.Tag, key string) bool {
+	for _, tag := range tags {
+		if *tag.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
 // GetEksctlVersionFromTags returns the eksctl version used to create or update the stack
 func GetEksctlVersionFromTags(tags []*cfn.Tag) (semver.Version, bool, error) {
 	for _, tag := range tags {
@@ -424,42 +1249,109 @@ func GetEksctlVersionFromTags(tags []*cfn.Tag) (semver.Version, bool, error) {
 	return semver.Version{}, false, nil
 }
 
+// ScalingValues holds the scaling values of a nodegroup
+type ScalingValues struct {
+	DesiredCapacity, MinSize, MaxSize int64
+}
+
+// scalingFromTemplate returns the scaling values set in the nodegroup stack template
+func scalingFromTemplate(template string, ngPaths *nodeGroupPaths) ScalingValues {
+	return ScalingValues{
+		DesiredCapacity: gjson.Get(template, ngPaths.DesiredCapacity).Int(),
+		MinSize:         gjson.Get(template, ngPaths.MinSize).Int(),
+		MaxSize:         gjson.Get(template, ngPaths.MaxSize).Int(),
+	}
+}
+
+// desiredBy returns the scaling values with the ones set in the scaling config of ng applied
+func (s ScalingValues) desiredBy(ng *api.NodeGroup) ScalingValues {
+	if ng.ScalingConfig == nil {
+		return s
+	}
+	if ng.DesiredCapacity != nil {
+		s.DesiredCapacity = int64(*ng.DesiredCapacity)
+	}
+	if ng.MinSize != nil {
+		s.MinSize = int64(*ng.MinSize)
+	}
+	if ng.MaxSize != nil {
+		s.MaxSize = int64(*ng.MaxSize)
+	}
+	return s
+}
+
 type nodeGroupPaths struct {
+	NodeGroupType   api.NodeGroupType
 	InstanceType    string
+	InstanceTypes   string
 	DesiredCapacity string
 	MinSize         string
 	MaxSize         string
+	// Labels and Taints are empty for unmanaged nodegroups, whose labels and taints are set in the user data
+	Labels string
+	Taints string
+}
+
+// getScalingPaths returns the nodegroup paths for the template, detecting managed nodegroups by their
+// AWS::EKS::Nodegroup resource with a ScalingConfig, as the stacks of older nodegroups may not be tagged with their type
+func getScalingPaths(template string, tags []*cfn.Tag) (*nodeGroupPaths, error) {
+	var managedNodeGroupID string
+	gjson.Get(template, resourcesRootPath).ForEach(func(logicalID, resource gjson.Result) bool {
+		if resource.Get("Type").String() == "AWS::EKS::Nodegroup" && resource.Get("Properties.ScalingConfig").Exists() {
+			managedNodeGroupID = logicalID.String()
+			return false
+		}
+		return true
+	})
+	if managedNodeGroupID != "" {
+		return managedNodeGroupPaths(managedNodeGroupID), nil
+	}
+	return getNodeGroupPaths(tags, template)
 }
 
-func getNodeGroupPaths(tags []*cfn.Tag) (*nodeGroupPaths, error) {
-	nodeGroupType, err := GetNodeGroupType(tags)
+func managedNodeGroupPaths(logicalID string) *nodeGroupPaths {
+	makePath := func(fieldPath string) string {
+		return fmt.Sprintf("%s.%s.Properties.%s", resourcesRootPath, logicalID, fieldPath)
+	}
+	makeScalingPath := func(field string) string {
+		return makePath(fmt.Sprintf("ScalingConfig.%s", field))
+	}
+	return &nodeGroupPaths{
+		NodeGroupType:   api.NodeGroupTypeManaged,
+		InstanceType:    makePath("InstanceTypes.0"),
+		InstanceTypes:   makePath("InstanceTypes"),
+		DesiredCapacity: makeScalingPath("DesiredSize"),
+		MinSize:         makeScalingPath("MinSize"),
+		MaxSize:         makeScalingPath("MaxSize"),
+		Labels:          makePath("Labels"),
+		Taints:          makePath("Taints"),
+	}
+}
+
+func getNodeGroupPaths(tags []*cfn.Tag, template string) (*nodeGroupPaths, error) {
+	nodeGroupType, err := GetNodeGroupTypeFromTemplate(tags, template)
 	if err != nil {
 		return nil, err
 	}
+	return nodeGroupPathsForType(nodeGroupType)
+}
 
+func nodeGroupPathsForType(nodeGroupType api.NodeGroupType) (*nodeGroupPaths, error) {
 	switch nodeGroupType {
 	case api.NodeGroupTypeManaged:
-		makePath := func(fieldPath string) string {
-			return fmt.Sprintf("%s.ManagedNodeGroup.Properties.%s", resourcesRootPath, fieldPath)
-		}
-		makeScalingPath := func(field string) string {
-			return makePath(fmt.Sprintf("ScalingConfig.%s", field))
-
-		}
-		return &nodeGroupPaths{
-			InstanceType:    makePath("InstanceTypes.0"),
-			DesiredCapacity: makeScalingPath("DesiredSize"),
-			MinSize:         makeScalingPath("MinSize"),
-			MaxSize:         makeScalingPath("MaxSize"),
-		}, nil
+		return managedNodeGroupPaths("ManagedNodeGroup"), nil
 
 		// Tag may not exist for existing nodegroups
 	case api.NodeGroupTypeUnmanaged, "":
+		// the sizes of Auto Scaling groups with a MixedInstancesPolicy are set on the group as well, the
+		// policy only holds the instance type overrides and the on-demand/spot distribution
 		makePath := func(field string) string {
 			return fmt.Sprintf("%s.NodeGroup.Properties.%s", resourcesRootPath, field)
 		}
 		return &nodeGroupPaths{
+			NodeGroupType:   api.NodeGroupTypeUnmanaged,
 			InstanceType:    resourcesRootPath + ".NodeGroupLaunchTemplate.Properties.LaunchTemplateData.InstanceType",
+			InstanceTypes:   makePath("MixedInstancesPolicy.LaunchTemplate.Overrides.#.InstanceType"),
 			DesiredCapacity: makePath("DesiredCapacity"),
 			MinSize:         makePath("MinSize"),
 			MaxSize:         makePath("MaxSize"),
@@ -471,48 +1363,387 @@ func getNodeGroupPaths(tags []*cfn.Tag) (*nodeGroupPaths, error) {
 
 }
 
-func (c *StackCollection) mapStackToNodeGroupSummary(stack *Stack, ngPaths *nodeGroupPaths) (*NodeGroupSummary, error) {
-	template, err := c.GetStackTemplate(*stack.StackName)
+// GetNodeGroupsByTag returns the summaries of the nodegroups of a cluster grouped by the value
+// of the given stack tag, nodegroups without the tag are grouped under the empty key
+func (c *StackCollection) GetNodeGroupsByTag(tagKey string) (map[string][]*NodeGroupSummary, error) {
+	stacks, err := c.DescribeNodeGroupStacks()
+	if err != nil {
+		return nil, errors.Wrap(err, "getting nodegroup stacks")
+	}
+
+	summariesByTag := map[string][]*NodeGroupSummary{}
+	var summaries []*NodeGroupSummary
+	templates := templateCache{}
+	for _, s := range stacks {
+		summary, err := c.getNodeGroupSummary(s, templates)
+		if err != nil {
+			return nil, err
+		}
+		summaries = append(summaries, summary)
+
+		var tagValue string
+		for _, tag := range s.Tags {
+			if *tag.Key == tagKey {
+				tagValue = *tag.Value
+				break
+			}
+		}
+		summariesByTag[tagValue] = append(summariesByTag[tagValue], summary)
+	}
+
+	if err := c.setAvailabilityZoneSubnets(summaries); err != nil {
+		return nil, err
+	}
+	return summariesByTag, nil
+}
+
+// FindNodeGroupsUsingInstanceTypes returns the summaries of the nodegroups using any of the given
+// instance types, including the instance types of mixed instances nodegroups
+func (c *StackCollection) FindNodeGroupsUsingInstanceTypes(instanceTypes []string) ([]*NodeGroupSummary, error) {
+	stacks, err := c.DescribeNodeGroupStacks()
+	if err != nil {
+		return nil, errors.Wrap(err, "getting nodegroup stacks")
+	}
+
+	wanted := sets.NewString(instanceTypes...)
+	summaries := []*NodeGroupSummary{}
+	templates := templateCache{}
+	for _, s := range stacks {
+		template, err := c.getCachedStackTemplate(templates, *s.StackName)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error getting CloudFormation template for stack %s", *s.StackName)
+		}
+
+		ngPaths, err := getNodeGroupPaths(s.Tags, template)
+		if err != nil {
+			return nil, err
+		}
+
+		used := templateInstanceTypes(template, ngPaths)
+		if !used.HasAny(wanted.List()...) {
+			continue
+		}
+
+		summary, err := c.getNodeGroupSummary(s, templates)
+		if err != nil {
+			return nil, err
+		}
+		summaries = append(summaries, summary)
+	}
+
+	if err := c.setAvailabilityZoneSubnets(summaries); err != nil {
+		return nil, err
+	}
+	return summaries, nil
+}
+
+// templateInstanceTypes returns the instance type and the instance types of mixed instances set in the template
+func templateInstanceTypes(template string, ngPaths *nodeGroupPaths) sets.String {
+	instanceTypes := sets.NewString(gjson.Get(template, ngPaths.InstanceType).String())
+	for _, instanceType := range gjson.Get(template, ngPaths.InstanceTypes).Array() {
+		instanceTypes.Insert(instanceType.String())
+	}
+	instanceTypes.Delete("")
+	return instanceTypes
+}
+
+func (c *StackCollection) getNodeGroupSummary(s *Stack, templates templateCache) (*NodeGroupSummary, error) {
+	template, err := c.getCachedStackTemplate(templates, *s.StackName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting CloudFormation template for stack %s", *s.StackName)
+	}
+
+	nodeGroupType, err := GetNodeGroupTypeFromTemplate(s.Tags, template)
+	if err != nil {
+		return nil, err
+	}
+	ngPaths, err := nodeGroupPathsForType(nodeGroupType)
+	if err != nil {
+		return nil, err
+	}
+
+	// the managed nodegroup and its Auto Scaling groups are described once and shared by all the details
+	// of the summary
+	var (
+		nodeGroup *eks.Nodegroup
+		asgName   string
+	)
+	if nodeGroupType == api.NodeGroupTypeManaged {
+		if nodeGroup = c.describeManagedNodeGroup(s); nodeGroup != nil {
+			asgName = strings.Join(managedNodeGroupAutoScalingGroupNames(nodeGroup), ",")
+		}
+	} else if asgName, err = c.GetNodeGroupAutoScalingGroupName(s); err != nil {
+		return nil, errors.Wrap(err, "getting autoscalinggroupname")
+	}
+
+	summary, err := c.mapStackToNodeGroupSummary(s, nodeGroupType, ngPaths, template, nodeGroup)
+	if err != nil {
+		return nil, errors.Wrap(err, "mapping stack to nodegroup summary")
+	}
+
+	summary.AutoScalingGroupName = asgName
+	var asgs []*autoscaling.Group
+	if asgName != "" {
+		if asgs, err = c.describeAutoScalingGroups(strings.Split(asgName, ",")); err != nil {
+			logger.Warning("couldn't describe the Auto Scaling group(s) of nodegroup %q: %v", summary.Name, err)
+		}
+	}
+	summary.HealthStatus = nodeGroupHealthStatus(asgs)
+	if nodeGroup != nil {
+		summary.LaunchTemplateID, summary.LaunchTemplateVersion = managedNodeGroupLaunchTemplate(nodeGroup)
+		summary.subnetIDs = aws.StringValueSlice(nodeGroup.Subnets)
+		if nodeGroup.CreatedAt != nil {
+			summary.CreationTime = nodeGroup.CreatedAt
+		}
+		if gjson.Get(template, managedAMITypePath).Exists() && len(asgs) > 0 {
+			summary.ImageID = c.getManagedNodeGroupImageID(asgs[0])
+		}
+	} else if nodeGroupType != api.NodeGroupTypeManaged && len(asgs) > 0 {
+		summary.LaunchTemplateID, summary.LaunchTemplateVersion = autoScalingGroupLaunchTemplate(asgs[0])
+		summary.subnetIDs = autoScalingGroupSubnets(asgs)
+	}
+	return summary, nil
+}
+
+// setAvailabilityZoneSubnets sets the AvailabilityZoneSubnets of the summaries, describing the subnets of all the
+// nodegroups in a single call
+func (c *StackCollection) setAvailabilityZoneSubnets(summaries []*NodeGroupSummary) error {
+	var subnetIDs []string
+	seen := sets.NewString()
+	for _, summary := range summaries {
+		for _, subnetID := range summary.subnetIDs {
+			if !seen.Has(subnetID) {
+				seen.Insert(subnetID)
+				subnetIDs = append(subnetIDs, subnetID)
+			}
+		}
+	}
+
+	subnetAZs, err := vpc.SubnetAvailabilityZones(c.ec2API, subnetIDs)
 	if err != nil {
-		return nil, errors.Wrapf(err, "error getting CloudFormation template for stack %s", *stack.StackName)
+		return err
+	}
+	for _, summary := range summaries {
+		if summary.AvailabilityZoneSubnets, err = vpc.SubnetsByAvailabilityZone(summary.subnetIDs, subnetAZs); err != nil {
+			return errors.Wrapf(err, "resolving subnets of nodegroup %q", summary.Name)
+		}
 	}
+	return nil
+}
 
+// autoScalingGroupSubnets returns the subnets the Auto Scaling groups launch instances into
+func autoScalingGroupSubnets(asgs []*autoscaling.Group) []string {
+	var subnetIDs []string
+	for _, asg := range asgs {
+		for _, subnetID := range strings.Split(aws.StringValue(asg.VPCZoneIdentifier), ",") {
+			if subnetID = strings.TrimSpace(subnetID); subnetID != "" {
+				subnetIDs = append(subnetIDs, subnetID)
+			}
+		}
+	}
+	return subnetIDs
+}
+
+// describeManagedNodeGroup returns the EKS nodegroup of the stack of a managed nodegroup, or nil when it
+// cannot be described
+func (c *StackCollection) describeManagedNodeGroup(stack *Stack) *eks.Nodegroup {
+	res, err := c.eksAPI.DescribeNodegroup(&eks.DescribeNodegroupInput{
+		ClusterName:   aws.String(getClusterNameTag(stack)),
+		NodegroupName: aws.String(c.GetNodeGroupName(stack)),
+	})
+	if err != nil {
+		logger.Warning("couldn't get managed nodegroup details for stack %q", *stack.StackName)
+		return nil
+	}
+	return res.Nodegroup
+}
+
+// managedNodeGroupLaunchTemplate returns the ID and version of the launch template of a managed nodegroup,
+// which are empty when the nodegroup does not use a launch template
+func managedNodeGroupLaunchTemplate(nodeGroup *eks.Nodegroup) (string, string) {
+	if nodeGroup.LaunchTemplate == nil {
+		return "", ""
+	}
+	return aws.StringValue(nodeGroup.LaunchTemplate.Id), aws.StringValue(nodeGroup.LaunchTemplate.Version)
+}
+
+// autoScalingGroupLaunchTemplate returns the ID and version of the launch template the Auto Scaling group
+// launches instances from, which are empty when it uses a launch configuration
+func autoScalingGroupLaunchTemplate(asg *autoscaling.Group) (string, string) {
+	launchTemplate := asg.LaunchTemplate
+	if launchTemplate == nil && asg.MixedInstancesPolicy != nil && asg.MixedInstancesPolicy.LaunchTemplate != nil {
+		launchTemplate = asg.MixedInstancesPolicy.LaunchTemplate.LaunchTemplateSpecification
+	}
+	if launchTemplate == nil {
+		return "", ""
+	}
+	return aws.StringValue(launchTemplate.LaunchTemplateId), aws.StringValue(launchTemplate.Version)
+}
+
+func (c *StackCollection) mapStackToNodeGroupSummary(stack *Stack, nodeGroupType api.NodeGroupType, ngPaths *nodeGroupPaths, template string, nodeGroup *eks.Nodegroup) (*NodeGroupSummary, error) {
 	summary := &NodeGroupSummary{
 		StackName:       *stack.StackName,
 		Cluster:         getClusterNameTag(stack),
 		Name:            c.GetNodeGroupName(stack),
+		NodeGroupType:   nodeGroupType,
 		Status:          *stack.StackStatus,
-		MaxSize:         int(gjson.Get(template, ngPaths.MaxSize).Int()),
-		MinSize:         int(gjson.Get(template, ngPaths.MinSize).Int()),
-		DesiredCapacity: int(gjson.Get(template, ngPaths.DesiredCapacity).Int()),
+		MaxSize:         intFromTemplate(template, ngPaths.MaxSize),
+		MinSize:         intFromTemplate(template, ngPaths.MinSize),
+		DesiredCapacity: intFromTemplate(template, ngPaths.DesiredCapacity),
 		InstanceType:    gjson.Get(template, ngPaths.InstanceType).String(),
-		ImageID:         gjson.Get(template, imageIDPath).String(),
+		ImageID:         imageIDFromTemplate(template),
 		CreationTime:    stack.CreationTime,
 	}
 
-	nodeGroupType, err := GetNodeGroupType(stack.Tags)
-	if err != nil {
-		return nil, err
+	if nodeGroupType == api.NodeGroupTypeUnmanaged {
+		if roleARN, ok := nodeGroupInstanceRoleARN(stack); ok {
+			summary.NodeInstanceRoleARN = roleARN
+		} else {
+			logger.Warning("error collecting Cloudformation outputs for stack %s: no output %q", *stack.StackName, outputs.NodeGroupInstanceRoleARN)
+		}
 	}
 
-	var nodeInstanceRoleARN string
-	if nodeGroupType == api.NodeGroupTypeUnmanaged {
-		nodeInstanceRoleARNCollector := func(s string) error {
-			nodeInstanceRoleARN = s
-			return nil
+	summary.RemoteAccess = getNodeGroupRemoteAccess(nodeGroupType, template, nodeGroup)
+	summary.SSHKeyExists = SSHKeyExists(summary.RemoteAccess, c.ec2API)
+
+	return summary, nil
+}
+
+// legacyNodeGroupInstanceRoleARNKeys are the keys of the instance role ARN output of nodegroup stacks created
+// by older versions of eksctl
+var legacyNodeGroupInstanceRoleARNKeys = []string{"NodeInstanceRoleARN", "InstanceRoleArn"}
+
+// nodeGroupInstanceRoleARN returns the ARN of the instance role of a nodegroup stack, accepting the output
+// keys of older stacks
+func nodeGroupInstanceRoleARN(stack *Stack) (string, bool) {
+	for _, key := range append([]string{outputs.NodeGroupInstanceRoleARN}, legacyNodeGroupInstanceRoleARNKeys...) {
+		if roleARN, ok := stackOutput(stack, key); ok {
+			return roleARN, true
 		}
-		collectors := map[string]outputs.Collector{
-			outputs.NodeGroupInstanceRoleARN: nodeInstanceRoleARNCollector,
+	}
+	return "", false
+}
+
+// imageIDFromTemplate returns the AMI of the nodegroup's launch template or launch configuration, which is empty
+// when the nodegroup uses the default AMI of managed nodegroups or a launch template eksctl did not create
+func imageIDFromTemplate(template string) string {
+	paths := []string{imageIDPath, managedImageIDPath}
+	gjson.Get(template, resourcesRootPath).ForEach(func(logicalID, resource gjson.Result) bool {
+		if resource.Get("Type").String() == "AWS::AutoScaling::LaunchConfiguration" {
+			paths = append(paths, fmt.Sprintf("%s.%s.Properties.ImageId", resourcesRootPath, logicalID.String()))
 		}
-		collectorSet := outputs.NewCollectorSet(collectors)
-		if err := collectorSet.MustCollect(*stack); err != nil {
-			logger.Warning(errors.Wrapf(err, "error collecting Cloudformation outputs for stack %s", *stack.StackName).Error())
+		return true
+	})
+	for _, path := range paths {
+		// the image ID may be a reference that is only resolved by CloudFormation
+		if imageID := gjson.Get(template, path); imageID.Type == gjson.String {
+			return imageID.String()
 		}
 	}
+	return ""
+}
 
-	summary.NodeInstanceRoleARN = nodeInstanceRoleARN
+// getManagedNodeGroupImageID returns the AMI the Auto Scaling group of a managed nodegroup using the default AMI
+// launches instances with, as found in the launch template EKS created for it. Failures are only logged, as the
+// image ID is informational
+func (c *StackCollection) getManagedNodeGroupImageID(asg *autoscaling.Group) string {
+	asgName := aws.StringValue(asg.AutoScalingGroupName)
+	launchTemplate := asg.LaunchTemplate
+	if launchTemplate == nil && asg.MixedInstancesPolicy != nil && asg.MixedInstancesPolicy.LaunchTemplate != nil {
+		launchTemplate = asg.MixedInstancesPolicy.LaunchTemplate.LaunchTemplateSpecification
+	}
+	if launchTemplate == nil {
+		return ""
+	}
 
-	return summary, nil
+	versions, err := c.ec2API.DescribeLaunchTemplateVersions(&ec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateId: launchTemplate.LaunchTemplateId,
+		Versions:         []*string{launchTemplate.Version},
+	})
+	if err != nil || len(versions.LaunchTemplateVersions) == 0 || versions.LaunchTemplateVersions[0].LaunchTemplateData == nil {
+		logger.Warning("couldn't get the launch template of Auto Scaling group %q: %v", asgName, err)
+		return ""
+	}
+	return aws.StringValue(versions.LaunchTemplateVersions[0].LaunchTemplateData.ImageId)
+}
+
+// intFromTemplate returns the number at path in the template, or nil when the template has no value at path
+func intFromTemplate(template, path string) *int {
+	value := gjson.Get(template, path)
+	if !value.Exists() {
+		return nil
+	}
+	return aws.Int(int(value.Int()))
+}
+
+// getNodeGroupRemoteAccess returns the SSH access of a nodegroup. The SSH access of a managed nodegroup is read
+// from the remote access config of nodeGroup, which is nil when it couldn't be described, and otherwise from
+// the dedicated security group of its launch template
+func getNodeGroupRemoteAccess(nodeGroupType api.NodeGroupType, template string, nodeGroup *eks.Nodegroup) *RemoteAccessInfo {
+	if nodeGroupType != api.NodeGroupTypeManaged {
+		return remoteAccessFromTemplate(template, "SG", "NodeGroupLaunchTemplate")
+	}
+
+	if nodeGroup != nil && nodeGroup.RemoteAccess != nil {
+		return MakeRemoteAccessInfo(nodeGroup.RemoteAccess)
+	}
+	// managed nodegroups with a launch template get SSH access through a dedicated security group
+	return remoteAccessFromTemplate(template, "SSH", "LaunchTemplate")
+}
+
+// MakeRemoteAccessInfo returns the SSH access described by the remote access config of a managed nodegroup
+func MakeRemoteAccessInfo(remoteAccess *eks.RemoteAccessConfig) *RemoteAccessInfo {
+	if remoteAccess == nil {
+		return &RemoteAccessInfo{}
+	}
+	info := &RemoteAccessInfo{
+		Enabled:              true,
+		SSHKeyName:           aws.StringValue(remoteAccess.Ec2SshKey),
+		SourceSecurityGroups: aws.StringValueSlice(remoteAccess.SourceSecurityGroups),
+	}
+	if len(info.SourceSecurityGroups) == 0 {
+		// EKS opens port 22 to the internet when no source security groups are specified
+		info.SourceCIDRs = []string{"0.0.0.0/0"}
+	}
+	return info
+}
+
+// SSHKeyExists reports whether the EC2 key pair of the remote access config exists
+func SSHKeyExists(remoteAccess *RemoteAccessInfo, ec2API ec2iface.EC2API) bool {
+	if remoteAccess == nil || remoteAccess.SSHKeyName == "" {
+		return false
+	}
+	exists, err := client.KeyExistsInEC2(remoteAccess.SSHKeyName, ec2API)
+	if err != nil {
+		logger.Warning("couldn't check EC2 key pair %q: %v", remoteAccess.SSHKeyName, err)
+		return false
+	}
+	if !exists {
+		logger.Warning("EC2 key pair %q does not exist, new nodes will fail to launch", remoteAccess.SSHKeyName)
+	}
+	return exists
+}
+
+func remoteAccessFromTemplate(template, securityGroupResource, launchTemplateResource string) *RemoteAccessInfo {
+	info := &RemoteAccessInfo{
+		SSHKeyName: gjson.Get(template, fmt.Sprintf("%s.%s.Properties.LaunchTemplateData.KeyName", resourcesRootPath, launchTemplateResource)).String(),
+	}
+	ingressRules := gjson.Get(template, fmt.Sprintf("%s.%s.Properties.SecurityGroupIngress", resourcesRootPath, securityGroupResource))
+	for _, rule := range ingressRules.Array() {
+		if rule.Get("FromPort").Int() != 22 || rule.Get("ToPort").Int() != 22 {
+			continue
+		}
+		info.Enabled = true
+		for _, cidrField := range []string{"CidrIp", "CidrIpv6"} {
+			if cidr := rule.Get(cidrField).String(); cidr != "" {
+				info.SourceCIDRs = append(info.SourceCIDRs, cidr)
+			}
+		}
+		if sg := rule.Get("SourceSecurityGroupId"); sg.Type == gjson.String {
+			info.SourceSecurityGroups = append(info.SourceSecurityGroups, sg.String())
+		}
+	}
+	return info
 }
 
 // GetNodeGroupName will return nodegroup name based on tags
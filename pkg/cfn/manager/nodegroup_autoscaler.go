@@ -0,0 +1,101 @@
+package manager
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+const (
+	// autoscalerEnabledTag is the tag cluster-autoscaler's auto-discovery selects Auto Scaling groups by
+	autoscalerEnabledTag = "k8s.io/cluster-autoscaler/enabled"
+	// autoscalerPausedTag holds the value of autoscalerEnabledTag while cluster-autoscaler is paused
+	autoscalerPausedTag = "alpha.eksctl.io/cluster-autoscaler-paused"
+)
+
+// SetNodeGroupAutoscalerPaused stops or resumes cluster-autoscaler managing the nodegroup, returning whether it
+// was paused before. Pausing removes the k8s.io/cluster-autoscaler/enabled tag from the nodegroup's Auto Scaling
+// group, so that cluster-autoscaler's auto-discovery no longer picks it up, and saves the tag as
+// alpha.eksctl.io/cluster-autoscaler-paused for resuming to restore it. Auto Scaling groups passed to
+// cluster-autoscaler with --nodes are not affected, and cluster-autoscaler only notices the change on its next
+// auto-discovery. Setting the state the nodegroup is already in is a no-op
+func (c *StackCollection) SetNodeGroupAutoscalerPaused(ng *api.NodeGroup, paused bool) (bool, error) {
+	stack, err := c.DescribeNodeGroupStack(ng.Name)
+	if err != nil {
+		return false, errors.Wrapf(err, "describing stack of nodegroup %q", ng.Name)
+	}
+
+	asgName, err := c.GetAutoScalingGroupName(stack)
+	if err != nil {
+		return false, errors.Wrapf(err, "getting Auto Scaling group of nodegroup %q", ng.Name)
+	}
+
+	asgs, err := c.asgAPI.DescribeAutoScalingGroups(&autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: aws.StringSlice([]string{asgName}),
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "describing Auto Scaling group %q", asgName)
+	}
+	if len(asgs.AutoScalingGroups) == 0 {
+		return false, errors.Errorf("Auto Scaling group %q of nodegroup %q not found", asgName, ng.Name)
+	}
+
+	var enabledTag, pausedTag *autoscaling.TagDescription
+	for _, tag := range asgs.AutoScalingGroups[0].Tags {
+		switch aws.StringValue(tag.Key) {
+		case autoscalerEnabledTag:
+			enabledTag = tag
+		case autoscalerPausedTag:
+			pausedTag = tag
+		}
+	}
+
+	wasPaused := pausedTag != nil
+	if wasPaused == paused {
+		logger.Debug("cluster-autoscaler management of nodegroup %q is already paused=%t", ng.Name, paused)
+		return wasPaused, nil
+	}
+
+	// the paused tag carries the value and propagation of the enabled tag, so that it is restored as it was
+	from, to := enabledTag, autoscalerPausedTag
+	if !paused {
+		from, to = pausedTag, autoscalerEnabledTag
+	} else if enabledTag == nil {
+		return wasPaused, errors.Errorf("Auto Scaling group %q of nodegroup %q has no %s tag, it is not managed by cluster-autoscaler auto-discovery", asgName, ng.Name, autoscalerEnabledTag)
+	}
+
+	if _, err := c.asgAPI.CreateOrUpdateTags(&autoscaling.CreateOrUpdateTagsInput{
+		Tags: []*autoscaling.Tag{
+			{
+				ResourceId:        aws.String(asgName),
+				ResourceType:      aws.String("auto-scaling-group"),
+				Key:               aws.String(to),
+				Value:             from.Value,
+				PropagateAtLaunch: from.PropagateAtLaunch,
+			},
+		},
+	}); err != nil {
+		return wasPaused, errors.Wrapf(err, "tagging Auto Scaling group %q", asgName)
+	}
+	if _, err := c.asgAPI.DeleteTags(&autoscaling.DeleteTagsInput{
+		Tags: []*autoscaling.Tag{
+			{
+				ResourceId:   aws.String(asgName),
+				ResourceType: aws.String("auto-scaling-group"),
+				Key:          from.Key,
+			},
+		},
+	}); err != nil {
+		return wasPaused, errors.Wrapf(err, "untagging Auto Scaling group %q", asgName)
+	}
+
+	if paused {
+		logger.Info("paused cluster-autoscaler management of nodegroup %q", ng.Name)
+	} else {
+		logger.Info("resumed cluster-autoscaler management of nodegroup %q", ng.Name)
+	}
+	return wasPaused, nil
+}
@@ -0,0 +1,119 @@
+package manager
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("StackCollection SetNodeGroupAutoscalerPaused", func() {
+	var (
+		p  *mockprovider.MockProvider
+		sc *StackCollection
+		ng *api.NodeGroup
+	)
+
+	mockASGTags := func(tags ...*autoscaling.TagDescription) {
+		p.MockASG().On("DescribeAutoScalingGroups", mock.MatchedBy(func(input *autoscaling.DescribeAutoScalingGroupsInput) bool {
+			return *input.AutoScalingGroupNames[0] == "asg-ng-1"
+		})).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+			AutoScalingGroups: []*autoscaling.Group{{Tags: tags}},
+		}, nil)
+		p.MockASG().On("CreateOrUpdateTags", mock.Anything).Return(&autoscaling.CreateOrUpdateTagsOutput{}, nil)
+		p.MockASG().On("DeleteTags", mock.Anything).Return(&autoscaling.DeleteTagsOutput{}, nil)
+	}
+
+	tag := func(key, value string) *autoscaling.TagDescription {
+		return &autoscaling.TagDescription{Key: aws.String(key), Value: aws.String(value), PropagateAtLaunch: aws.Bool(true)}
+	}
+
+	BeforeEach(func() {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		ng = cfg.NewNodeGroup()
+		ng.Name = "ng-1"
+		p = mockprovider.NewMockProvider()
+		sc = NewStackCollection(p, cfg)
+
+		p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(&cfn.DescribeStacksOutput{
+			Stacks: []*cfn.Stack{
+				{
+					StackName:   aws.String("eksctl-test-cluster-nodegroup-ng-1"),
+					StackStatus: aws.String(cfn.StackStatusCreateComplete),
+					Tags: []*cfn.Tag{
+						{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("ng-1")},
+						{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(string(api.NodeGroupTypeUnmanaged))},
+					},
+				},
+			},
+		}, nil)
+		p.MockCloudFormation().On("DescribeStackResource", mock.Anything).Return(&cfn.DescribeStackResourceOutput{
+			StackResourceDetail: &cfn.StackResourceDetail{PhysicalResourceId: aws.String("asg-ng-1")},
+		}, nil)
+	})
+
+	It("moves the auto-discovery tag aside when pausing", func() {
+		mockASGTags(tag(autoscalerEnabledTag, "true"))
+
+		wasPaused, err := sc.SetNodeGroupAutoscalerPaused(ng, true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(wasPaused).To(BeFalse())
+		Expect(p.MockASG().AssertCalled(GinkgoT(), "CreateOrUpdateTags", &autoscaling.CreateOrUpdateTagsInput{
+			Tags: []*autoscaling.Tag{
+				{
+					ResourceId:        aws.String("asg-ng-1"),
+					ResourceType:      aws.String("auto-scaling-group"),
+					Key:               aws.String(autoscalerPausedTag),
+					Value:             aws.String("true"),
+					PropagateAtLaunch: aws.Bool(true),
+				},
+			},
+		})).To(BeTrue())
+		Expect(p.MockASG().AssertCalled(GinkgoT(), "DeleteTags", &autoscaling.DeleteTagsInput{
+			Tags: []*autoscaling.Tag{
+				{
+					ResourceId:   aws.String("asg-ng-1"),
+					ResourceType: aws.String("auto-scaling-group"),
+					Key:          aws.String(autoscalerEnabledTag),
+				},
+			},
+		})).To(BeTrue())
+	})
+
+	It("restores the auto-discovery tag when resuming", func() {
+		mockASGTags(tag(autoscalerPausedTag, "true"))
+
+		wasPaused, err := sc.SetNodeGroupAutoscalerPaused(ng, false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(wasPaused).To(BeTrue())
+		Expect(p.MockASG().AssertCalled(GinkgoT(), "CreateOrUpdateTags", mock.MatchedBy(func(input *autoscaling.CreateOrUpdateTagsInput) bool {
+			return *input.Tags[0].Key == autoscalerEnabledTag && *input.Tags[0].Value == "true"
+		}))).To(BeTrue())
+		Expect(p.MockASG().AssertCalled(GinkgoT(), "DeleteTags", mock.MatchedBy(func(input *autoscaling.DeleteTagsInput) bool {
+			return *input.Tags[0].Key == autoscalerPausedTag
+		}))).To(BeTrue())
+	})
+
+	It("does nothing when the nodegroup is already paused", func() {
+		mockASGTags(tag(autoscalerPausedTag, "true"))
+
+		wasPaused, err := sc.SetNodeGroupAutoscalerPaused(ng, true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(wasPaused).To(BeTrue())
+		Expect(p.MockASG().AssertNotCalled(GinkgoT(), "CreateOrUpdateTags", mock.Anything)).To(BeTrue())
+		Expect(p.MockASG().AssertNotCalled(GinkgoT(), "DeleteTags", mock.Anything)).To(BeTrue())
+	})
+
+	It("fails to pause nodegroups that are not auto-discovered", func() {
+		mockASGTags()
+
+		_, err := sc.SetNodeGroupAutoscalerPaused(ng, true)
+		Expect(err).To(MatchError(`Auto Scaling group "asg-ng-1" of nodegroup "ng-1" has no k8s.io/cluster-autoscaler/enabled tag, it is not managed by cluster-autoscaler auto-discovery`))
+	})
+})
@@ -0,0 +1,66 @@
+package manager
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/tidwall/gjson"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/vpc"
+)
+
+// cniPolicyName is the name of the managed policy of the VPC CNI plugin
+const cniPolicyName = "AmazonEKS_CNI_Policy"
+
+// NodeGroupChangeSet returns the changes updating the stack of the unmanaged nodegroup to the template generated
+// from ng would make, without updating it. The changes are found by creating a ChangeSet against the live
+// stack, which is deleted afterwards. ng must have been defaulted like for nodegroup creation, and the cluster
+// status must be set to generate the user data of the nodes
+func (c *StackCollection) NodeGroupChangeSet(ng *api.NodeGroup) ([]StackChange, error) {
+	nodeGroupType, err := c.GetNodeGroupStackType(ng.Name)
+	if err != nil {
+		return nil, err
+	}
+	if nodeGroupType == api.NodeGroupTypeManaged {
+		return nil, fmt.Errorf("nodegroup %q is a managed nodegroup", ng.Name)
+	}
+
+	// the CNI policy is forced on the instance role of nodegroups created while aws-node did not use IRSA; keep
+	// it if the stack has it, so that it does not show up as a change
+	currentTemplate, err := c.GetStackTemplate(c.makeNodeGroupStackName(ng.Name))
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting template of nodegroup %q", ng.Name)
+	}
+	forceAddCNIPolicy := instanceRoleHasCNIPolicy(currentTemplate)
+
+	resourceSet := builder.NewNodeGroupResourceSet(c.ec2API, c.iamAPI, c.spec, ng, true, forceAddCNIPolicy, vpc.NewStackConfigImporter(c.MakeClusterStackName()))
+	if err := resourceSet.AddAllResources(); err != nil {
+		return nil, errors.Wrapf(err, "generating template of nodegroup %q", ng.Name)
+	}
+	template, err := resourceSet.RenderJSON()
+	if err != nil {
+		return nil, errors.Wrapf(err, "rendering template of nodegroup %q", ng.Name)
+	}
+	return c.nodeGroupChangeSet(ng.Name, template)
+}
+
+func (c *StackCollection) nodeGroupChangeSet(ngName string, template []byte) ([]StackChange, error) {
+	name := c.makeNodeGroupStackName(ngName)
+	description := fmt.Sprintf("comparing stack %q with the config of nodegroup %q", name, ngName)
+	return c.updateStack(name, c.MakeChangeSetName("diff-nodegroup"), description, TemplateBody(template), nil, true)
+}
+
+// instanceRoleHasCNIPolicy returns whether the instance role created by the nodegroup stack template has the
+// AmazonEKS_CNI_Policy managed policy attached
+func instanceRoleHasCNIPolicy(template string) bool {
+	policyARNs := gjson.Get(template, resourcesRootPath+"."+nodeGroupInstanceRoleResourceID+".Properties.ManagedPolicyArns")
+	for _, policyARN := range policyARNs.Array() {
+		if strings.Contains(policyARN.Raw, ":policy/"+cniPolicyName) {
+			return true
+		}
+	}
+	return false
+}
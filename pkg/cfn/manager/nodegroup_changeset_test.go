@@ -0,0 +1,108 @@
+package manager
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/awstesting"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("StackCollection NodeGroupChangeSet", func() {
+	var (
+		p         *mockprovider.MockProvider
+		sc        *StackCollection
+		stackTags []*cfn.Tag
+	)
+
+	BeforeEach(func() {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		p = mockprovider.NewMockProvider()
+		sc = NewStackCollection(p, cfg)
+		stackTags = []*cfn.Tag{{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(string(api.NodeGroupTypeUnmanaged))}}
+
+		p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(func(*cfn.DescribeStacksInput) *cfn.DescribeStacksOutput {
+			return &cfn.DescribeStacksOutput{Stacks: []*cfn.Stack{{
+				StackName:   aws.String("eksctl-test-cluster-nodegroup-ng-1"),
+				StackStatus: aws.String(cfn.StackStatusCreateComplete),
+				Tags:        stackTags,
+			}}}
+		}, nil)
+	})
+
+	It("returns the changes of the ChangeSet and deletes it", func() {
+		changeSetCreated := &cfn.DescribeChangeSetOutput{Status: aws.String(cfn.ChangeSetStatusCreateComplete)}
+		p.MockCloudFormation().On("CreateChangeSet", mock.Anything).Return(nil, nil)
+		p.MockCloudFormation().On("DescribeChangeSetRequest", mock.Anything).
+			Return(awstesting.NewClient(nil).NewRequest(&request.Operation{Name: "Operation"}, nil, changeSetCreated), nil)
+		p.MockCloudFormation().On("DescribeChangeSet", mock.Anything).Return(&cfn.DescribeChangeSetOutput{
+			Changes: []*cfn.Change{
+				{
+					ResourceChange: &cfn.ResourceChange{
+						Action:            aws.String(cfn.ChangeActionModify),
+						LogicalResourceId: aws.String("NodeGroup"),
+						ResourceType:      aws.String("AWS::AutoScaling::AutoScalingGroup"),
+						Replacement:       aws.String(cfn.ReplacementFalse),
+					},
+				},
+				{
+					ResourceChange: &cfn.ResourceChange{
+						Action:            aws.String(cfn.ChangeActionModify),
+						LogicalResourceId: aws.String("NodeGroupLaunchTemplate"),
+						ResourceType:      aws.String("AWS::EC2::LaunchTemplate"),
+						Replacement:       aws.String(cfn.ReplacementConditional),
+					},
+				},
+				{
+					ResourceChange: &cfn.ResourceChange{
+						Action:            aws.String(cfn.ChangeActionModify),
+						LogicalResourceId: aws.String("SG"),
+						ResourceType:      aws.String("AWS::EC2::SecurityGroup"),
+						Replacement:       aws.String(cfn.ReplacementTrue),
+					},
+				},
+			},
+		}, nil)
+		p.MockCloudFormation().On("DeleteChangeSet", mock.Anything).Return(nil, nil)
+
+		changes, err := sc.nodeGroupChangeSet("ng-1", []byte(`{"Resources":{}}`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(Equal([]StackChange{
+			{LogicalResourceID: "NodeGroup", ResourceType: "AWS::AutoScaling::AutoScalingGroup", Action: cfn.ChangeActionModify},
+			{LogicalResourceID: "NodeGroupLaunchTemplate", ResourceType: "AWS::EC2::LaunchTemplate", Action: cfn.ChangeActionModify, Replacement: true},
+			{LogicalResourceID: "SG", ResourceType: "AWS::EC2::SecurityGroup", Action: cfn.ChangeActionModify, Replacement: true},
+		}))
+		p.MockCloudFormation().AssertCalled(GinkgoT(), "DeleteChangeSet", mock.Anything)
+		p.MockCloudFormation().AssertNotCalled(GinkgoT(), "ExecuteChangeSet", mock.Anything)
+	})
+
+	It("refuses managed nodegroups", func() {
+		stackTags = []*cfn.Tag{{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(string(api.NodeGroupTypeManaged))}}
+		ng := api.NewNodeGroup()
+		ng.Name = "ng-1"
+
+		_, err := sc.NodeGroupChangeSet(ng)
+		Expect(err).To(MatchError(`nodegroup "ng-1" is a managed nodegroup`))
+		p.MockCloudFormation().AssertNotCalled(GinkgoT(), "CreateChangeSet", mock.Anything)
+	})
+	It("finds whether the instance role of the stack has the CNI policy", func() {
+		template := func(policies ...string) string {
+			var policyARNs []string
+			for _, policy := range policies {
+				policyARNs = append(policyARNs, `{"Fn::Sub":"arn:${AWS::Partition}:iam::aws:policy/`+policy+`"}`)
+			}
+			return `{"Resources":{"NodeInstanceRole":{"Type":"AWS::IAM::Role","Properties":{"ManagedPolicyArns":[` + strings.Join(policyARNs, ",") + `]}}}}`
+		}
+		Expect(instanceRoleHasCNIPolicy(template("AmazonEKSWorkerNodePolicy", "AmazonEKS_CNI_Policy"))).To(BeTrue())
+		Expect(instanceRoleHasCNIPolicy(template("AmazonEKSWorkerNodePolicy", "AmazonEC2ContainerRegistryReadOnly"))).To(BeFalse())
+		Expect(instanceRoleHasCNIPolicy(`{"Resources":{}}`)).To(BeFalse())
+	})
+})
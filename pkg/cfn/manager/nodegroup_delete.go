@@ -0,0 +1,106 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// DeleteNodeGroupStacks deletes the stacks of all nodegroups of the cluster, deleting up to parallelism stacks
+// at once, and waits for all deletions to complete. The cluster stack is never deleted. No more deletions are
+// started once ctx is done. The returned error names each stack that failed to be deleted
+func (c *StackCollection) DeleteNodeGroupStacks(ctx context.Context, parallelism int) error {
+	stacks, err := c.DescribeNodeGroupStacks()
+	if err != nil {
+		return errors.Wrap(err, "getting nodegroup stacks")
+	}
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	var (
+		mu     sync.Mutex
+		failed = map[string]error{}
+	)
+	setFailed := func(name string, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		logger.Critical("failed to delete stack %q: %v", name, err)
+		failed[name] = err
+	}
+
+	clusterStackName := c.MakeClusterStackName()
+	stackCh := make(chan *Stack)
+	wg := &sync.WaitGroup{}
+	for i := 0; i < parallelism && i < len(stacks); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for s := range stackCh {
+				if ctx.Err() != nil {
+					setFailed(*s.StackName, ctx.Err())
+					continue
+				}
+				deleted, err := c.DeleteStackBySpec(s)
+				if err != nil {
+					setFailed(*s.StackName, err)
+					continue
+				}
+				if err := c.doWaitUntilStackIsDeleted(deleted); err != nil {
+					setFailed(*s.StackName, err)
+				}
+			}
+		}()
+	}
+	for _, s := range stacks {
+		if *s.StackName == clusterStackName {
+			continue
+		}
+		stackCh <- s
+	}
+	close(stackCh)
+	wg.Wait()
+
+	return combineErrors(failed, fmt.Sprintf("failed to delete %d of %d nodegroup stack(s)", len(failed), len(stacks)))
+}
+
+// ListOrphanedNodeGroupStacks returns the nodegroup stacks of any cluster that failed to be deleted or rolled
+// back, and whose cluster stack no longer exists, e.g. after a cluster deletion that left its nodegroup stacks
+// behind. Stacks of clusters that still exist are not returned
+func (c *StackCollection) ListOrphanedNodeGroupStacks() ([]*Stack, error) {
+	stacks, err := c.ListStacksMatching(nodeGroupStackRegex, cfn.StackStatusDeleteFailed, cfn.StackStatusRollbackFailed)
+	if err != nil {
+		return nil, errors.Wrap(err, "listing failed nodegroup stacks")
+	}
+	if len(stacks) == 0 {
+		return nil, nil
+	}
+
+	clusterStackNames, err := c.ListClusterStackNames()
+	if err != nil {
+		return nil, errors.Wrap(err, "listing cluster stacks")
+	}
+	existingClusterStacks := sets.NewString(clusterStackNames...)
+
+	var orphaned []*Stack
+	for _, s := range stacks {
+		clusterName := getClusterNameTag(s)
+		if clusterName == "" {
+			var ok bool
+			if clusterName, _, ok = ParseNodeGroupStackName(*s.StackName); !ok {
+				continue
+			}
+		}
+		if existingClusterStacks.Has(MakeClusterStackNameFromName(clusterName)) {
+			continue
+		}
+		logger.Debug("nodegroup stack %q of cluster %q is orphaned", *s.StackName, clusterName)
+		orphaned = append(orphaned, s)
+	}
+	return orphaned, nil
+}
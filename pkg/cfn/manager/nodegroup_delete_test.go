@@ -0,0 +1,171 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/awstesting"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("StackCollection DeleteNodeGroupStacks", func() {
+	const clusterStackName = "eksctl-test-cluster-cluster"
+
+	var (
+		p  *mockprovider.MockProvider
+		sc *StackCollection
+	)
+
+	nodeGroupStackNames := []string{
+		"eksctl-test-cluster-nodegroup-ng-1",
+		"eksctl-test-cluster-nodegroup-ng-2",
+		"eksctl-test-cluster-nodegroup-ng-3",
+	}
+
+	newStack := func(name string) *cfn.Stack {
+		tags := []*cfn.Tag{{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")}}
+		if name != clusterStackName {
+			tags = append(tags, &cfn.Tag{Key: aws.String(api.NodeGroupNameTag), Value: aws.String(name[len("eksctl-test-cluster-nodegroup-"):])})
+		}
+		return &cfn.Stack{
+			StackName:   aws.String(name),
+			StackId:     aws.String(name + "-id"),
+			StackStatus: aws.String(cfn.StackStatusCreateComplete),
+			Tags:        tags,
+		}
+	}
+
+	deletedStacks := func() []string {
+		var names []string
+		for _, call := range p.MockCloudFormation().Calls {
+			if call.Method == "DeleteStack" {
+				names = append(names, *call.Arguments.Get(0).(*cfn.DeleteStackInput).StackName)
+			}
+		}
+		return names
+	}
+
+	BeforeEach(func() {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		p = mockprovider.NewMockProvider()
+		sc = NewStackCollection(p, cfg)
+
+		p.MockCloudFormation().On("ListStacksPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(p *cfn.ListStacksOutput, last bool) (shouldContinue bool))
+			out := &cfn.ListStacksOutput{}
+			for _, name := range append([]string{clusterStackName}, nodeGroupStackNames...) {
+				out.StackSummaries = append(out.StackSummaries, &cfn.StackSummary{StackName: aws.String(name), StackId: aws.String(name + "-id")})
+			}
+			consume(out, true)
+		}).Return(nil)
+		p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(func(input *cfn.DescribeStacksInput) *cfn.DescribeStacksOutput {
+			name := *input.StackName
+			if len(name) > len("-id") && name[len(name)-len("-id"):] == "-id" {
+				name = name[:len(name)-len("-id")]
+			}
+			return &cfn.DescribeStacksOutput{Stacks: []*cfn.Stack{newStack(name)}}
+		}, nil)
+		p.MockCloudFormation().On("DescribeStacksRequest", mock.Anything).Return(func(input *cfn.DescribeStacksInput) *request.Request {
+			deleted := &cfn.DescribeStacksOutput{
+				Stacks: []*cfn.Stack{{StackName: input.StackName, StackStatus: aws.String(cfn.StackStatusDeleteComplete)}},
+			}
+			return awstesting.NewClient(nil).NewRequest(&request.Operation{Name: "Operation"}, nil, deleted)
+		}, nil)
+	})
+
+	It("deletes every nodegroup stack and names the ones that failed", func() {
+		p.MockCloudFormation().On("DeleteStack", mock.MatchedBy(func(input *cfn.DeleteStackInput) bool {
+			return *input.StackName == "eksctl-test-cluster-nodegroup-ng-2-id"
+		})).Return(nil, fmt.Errorf("access denied"))
+		p.MockCloudFormation().On("DeleteStack", mock.Anything).Return(&cfn.DeleteStackOutput{}, nil)
+
+		err := sc.DeleteNodeGroupStacks(context.Background(), 2)
+		Expect(err).To(MatchError(`failed to delete 1 of 3 nodegroup stack(s): eksctl-test-cluster-nodegroup-ng-2: ` +
+			`not able to delete stack "eksctl-test-cluster-nodegroup-ng-2": access denied`))
+		Expect(deletedStacks()).To(ConsistOf(
+			"eksctl-test-cluster-nodegroup-ng-1-id",
+			"eksctl-test-cluster-nodegroup-ng-2-id",
+			"eksctl-test-cluster-nodegroup-ng-3-id",
+		))
+	})
+
+	It("does not start deleting stacks once the context is done", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := sc.DeleteNodeGroupStacks(ctx, 2)
+		Expect(err).To(MatchError(ContainSubstring("failed to delete 3 of 3 nodegroup stack(s)")))
+		Expect(deletedStacks()).To(BeEmpty())
+	})
+})
+
+var _ = Describe("StackCollection ListOrphanedNodeGroupStacks", func() {
+	var (
+		p  *mockprovider.MockProvider
+		sc *StackCollection
+	)
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		sc = NewStackCollection(p, api.NewClusterConfig())
+
+		p.MockCloudFormation().On("ListStacksPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			input := args[0].(*cfn.ListStacksInput)
+			consume := args[1].(func(p *cfn.ListStacksOutput, last bool) (shouldContinue bool))
+			var names []string
+			if len(input.StackStatusFilter) == 2 {
+				names = []string{
+					"eksctl-deleted-cluster-nodegroup-ng-1",
+					"eksctl-existing-cluster-nodegroup-ng-1",
+					"eksctl-untagged-nodegroup-ng-1",
+				}
+			} else {
+				names = []string{
+					"eksctl-existing-cluster-cluster",
+					"eksctl-existing-cluster-nodegroup-ng-2",
+				}
+			}
+			out := &cfn.ListStacksOutput{}
+			for _, name := range names {
+				out.StackSummaries = append(out.StackSummaries, &cfn.StackSummary{StackName: aws.String(name)})
+			}
+			consume(out, true)
+		}).Return(nil)
+		p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(func(input *cfn.DescribeStacksInput) *cfn.DescribeStacksOutput {
+			stack := &cfn.Stack{
+				StackName:   input.StackName,
+				StackStatus: aws.String(cfn.StackStatusDeleteFailed),
+			}
+			if clusterName, _, ok := ParseNodeGroupStackName(*input.StackName); ok && clusterName != "untagged" {
+				stack.Tags = []*cfn.Tag{{Key: aws.String(api.ClusterNameTag), Value: aws.String(clusterName)}}
+			}
+			return &cfn.DescribeStacksOutput{Stacks: []*cfn.Stack{stack}}
+		}, nil)
+	})
+
+	It("only returns the failed nodegroup stacks of clusters without a cluster stack", func() {
+		stacks, err := sc.ListOrphanedNodeGroupStacks()
+		Expect(err).NotTo(HaveOccurred())
+
+		var names []string
+		for _, s := range stacks {
+			names = append(names, *s.StackName)
+		}
+		Expect(names).To(ConsistOf("eksctl-deleted-cluster-nodegroup-ng-1", "eksctl-untagged-nodegroup-ng-1"))
+
+		listCall := p.MockCloudFormation().Calls[0]
+		Expect(listCall.Arguments.Get(0).(*cfn.ListStacksInput).StackStatusFilter).To(ConsistOf(
+			aws.String(cfn.StackStatusDeleteFailed),
+			aws.String(cfn.StackStatusRollbackFailed),
+		))
+	})
+})
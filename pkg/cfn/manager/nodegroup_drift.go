@@ -0,0 +1,151 @@
+package manager
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// DriftStatus is the drift status of a nodegroup stack
+type DriftStatus string
+
+// Values for DriftStatus
+const (
+	// DriftStatusInSync means no resource of the stack differs from the template
+	DriftStatusInSync DriftStatus = cfn.StackDriftStatusInSync
+	// DriftStatusDrifted means at least one resource of the stack was changed outside of CloudFormation
+	DriftStatusDrifted DriftStatus = cfn.StackDriftStatusDrifted
+	// DriftStatusNotChecked means CloudFormation could not check the stack for drift
+	DriftStatusNotChecked DriftStatus = cfn.StackDriftStatusNotChecked
+	// DriftStatusUnknown means the drift detection failed for some resources of the stack, e.g. resources that do
+	// not support drift detection, and none of the others drifted
+	DriftStatusUnknown DriftStatus = cfn.StackDriftStatusUnknown
+)
+
+// driftDetectionPollInterval is how often the status of a drift detection is checked
+var driftDetectionPollInterval = 5 * time.Second
+
+// DriftResult is the outcome of detecting drift on a nodegroup stack
+type DriftResult struct {
+	NodeGroupName string
+	Status        DriftStatus
+	// DriftedResources are the resources that were modified or deleted outside of CloudFormation
+	DriftedResources []ResourceDrift
+}
+
+// ResourceDrift is a resource of a nodegroup stack that drifted from the template
+type ResourceDrift struct {
+	LogicalResourceID  string
+	PhysicalResourceID string
+	ResourceType       string
+	// Status is either MODIFIED or DELETED
+	Status        string
+	PropertyDiffs []PropertyDiff
+}
+
+// PropertyDiff is a property of a resource whose actual value differs from the expected one
+type PropertyDiff struct {
+	PropertyPath   string
+	ExpectedValue  string
+	ActualValue    string
+	DifferenceType string
+}
+
+// DetectNodeGroupDrift runs CloudFormation drift detection on the nodegroup's stack and waits for it to
+// complete, e.g. to find changes made to the Auto Scaling group in the console, which the next stack update
+// would revert
+func (c *StackCollection) DetectNodeGroupDrift(ng *api.NodeGroup) (*DriftResult, error) {
+	name := c.makeNodeGroupStackName(ng.Name)
+
+	detection, err := c.cloudformationAPI.DetectStackDrift(&cfn.DetectStackDriftInput{
+		StackName: aws.String(name),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "starting drift detection of stack %q", name)
+	}
+
+	status, err := c.waitForStackDriftDetection(name, detection.StackDriftDetectionId)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &DriftResult{
+		NodeGroupName: ng.Name,
+		Status:        DriftStatus(aws.StringValue(status.StackDriftStatus)),
+	}
+	if aws.StringValue(status.DetectionStatus) == cfn.StackDriftDetectionStatusDetectionFailed {
+		// the results of the resources that were checked are still available, so drifted resources are
+		// reported, and otherwise the drift of the stack is unknown
+		logger.Warning("drift detection of stack %q failed for some resources: %s", name, aws.StringValue(status.DetectionStatusReason))
+		if result.Status != DriftStatusDrifted {
+			result.Status = DriftStatusUnknown
+		}
+	}
+	if result.Status != DriftStatusDrifted {
+		return result, nil
+	}
+
+	input := &cfn.DescribeStackResourceDriftsInput{
+		StackName: aws.String(name),
+		StackResourceDriftStatusFilters: aws.StringSlice([]string{
+			cfn.StackResourceDriftStatusModified,
+			cfn.StackResourceDriftStatusDeleted,
+		}),
+	}
+	pager := func(p *cfn.DescribeStackResourceDriftsOutput, _ bool) bool {
+		for _, drift := range p.StackResourceDrifts {
+			resource := ResourceDrift{
+				LogicalResourceID:  aws.StringValue(drift.LogicalResourceId),
+				PhysicalResourceID: aws.StringValue(drift.PhysicalResourceId),
+				ResourceType:       aws.StringValue(drift.ResourceType),
+				Status:             aws.StringValue(drift.StackResourceDriftStatus),
+			}
+			for _, diff := range drift.PropertyDifferences {
+				resource.PropertyDiffs = append(resource.PropertyDiffs, PropertyDiff{
+					PropertyPath:   aws.StringValue(diff.PropertyPath),
+					ExpectedValue:  aws.StringValue(diff.ExpectedValue),
+					ActualValue:    aws.StringValue(diff.ActualValue),
+					DifferenceType: aws.StringValue(diff.DifferenceType),
+				})
+			}
+			result.DriftedResources = append(result.DriftedResources, resource)
+		}
+		return true
+	}
+	if err := c.cloudformationAPI.DescribeStackResourceDriftsPages(input, pager); err != nil {
+		return nil, errors.Wrapf(err, "describing drifted resources of stack %q", name)
+	}
+	return result, nil
+}
+
+func (c *StackCollection) waitForStackDriftDetection(name string, detectionID *string) (*cfn.DescribeStackDriftDetectionStatusOutput, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.waitTimeout)
+	defer cancel()
+
+	logger.Info("waiting for drift detection of stack %q", name)
+	for {
+		status, err := c.cloudformationAPI.DescribeStackDriftDetectionStatus(&cfn.DescribeStackDriftDetectionStatusInput{
+			StackDriftDetectionId: detectionID,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "describing drift detection of stack %q", name)
+		}
+
+		switch aws.StringValue(status.DetectionStatus) {
+		case cfn.StackDriftDetectionStatusDetectionComplete, cfn.StackDriftDetectionStatusDetectionFailed:
+			return status, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, errors.Errorf("timed out (after %s) waiting for drift detection of stack %q", c.waitTimeout, name)
+		case <-time.After(driftDetectionPollInterval):
+		}
+	}
+}
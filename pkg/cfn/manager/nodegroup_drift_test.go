@@ -0,0 +1,157 @@
+package manager
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("StackCollection DetectNodeGroupDrift", func() {
+	const stackName = "eksctl-test-cluster-nodegroup-ng-1"
+
+	var (
+		p  *mockprovider.MockProvider
+		sc *StackCollection
+		ng *api.NodeGroup
+
+		originalPollInterval time.Duration
+	)
+
+	detectionStatus := func(status, driftStatus string) *cfn.DescribeStackDriftDetectionStatusOutput {
+		return &cfn.DescribeStackDriftDetectionStatusOutput{
+			StackDriftDetectionId: aws.String("detection-1"),
+			DetectionStatus:       aws.String(status),
+			StackDriftStatus:      aws.String(driftStatus),
+		}
+	}
+
+	BeforeEach(func() {
+		originalPollInterval = driftDetectionPollInterval
+		driftDetectionPollInterval = time.Millisecond
+
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		ng = cfg.NewNodeGroup()
+		ng.Name = "ng-1"
+		p = mockprovider.NewMockProvider()
+		sc = NewStackCollection(p, cfg)
+
+		p.MockCloudFormation().On("DetectStackDrift", &cfn.DetectStackDriftInput{
+			StackName: aws.String(stackName),
+		}).Return(&cfn.DetectStackDriftOutput{StackDriftDetectionId: aws.String("detection-1")}, nil)
+	})
+
+	AfterEach(func() {
+		driftDetectionPollInterval = originalPollInterval
+	})
+
+	It("reports the property differences of drifted resources once detection completes", func() {
+		p.MockCloudFormation().On("DescribeStackDriftDetectionStatus", mock.Anything).
+			Return(detectionStatus(cfn.StackDriftDetectionStatusDetectionInProgress, ""), nil).Once()
+		p.MockCloudFormation().On("DescribeStackDriftDetectionStatus", mock.Anything).
+			Return(detectionStatus(cfn.StackDriftDetectionStatusDetectionComplete, cfn.StackDriftStatusDrifted), nil)
+		p.MockCloudFormation().On("DescribeStackResourceDriftsPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(p *cfn.DescribeStackResourceDriftsOutput, last bool) (shouldContinue bool))
+			consume(&cfn.DescribeStackResourceDriftsOutput{
+				StackResourceDrifts: []*cfn.StackResourceDrift{
+					{
+						LogicalResourceId:        aws.String("NodeGroup"),
+						PhysicalResourceId:       aws.String("asg-ng-1"),
+						ResourceType:             aws.String("AWS::AutoScaling::AutoScalingGroup"),
+						StackResourceDriftStatus: aws.String(cfn.StackResourceDriftStatusModified),
+						PropertyDifferences: []*cfn.PropertyDifference{
+							{
+								PropertyPath:   aws.String("/MaxSize"),
+								ExpectedValue:  aws.String("4"),
+								ActualValue:    aws.String("10"),
+								DifferenceType: aws.String(cfn.DifferenceTypeNotEqual),
+							},
+						},
+					},
+				},
+			}, true)
+		}).Return(nil)
+
+		result, err := sc.DetectNodeGroupDrift(ng)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(&DriftResult{
+			NodeGroupName: "ng-1",
+			Status:        DriftStatusDrifted,
+			DriftedResources: []ResourceDrift{
+				{
+					LogicalResourceID:  "NodeGroup",
+					PhysicalResourceID: "asg-ng-1",
+					ResourceType:       "AWS::AutoScaling::AutoScalingGroup",
+					Status:             cfn.StackResourceDriftStatusModified,
+					PropertyDiffs: []PropertyDiff{
+						{PropertyPath: "/MaxSize", ExpectedValue: "4", ActualValue: "10", DifferenceType: cfn.DifferenceTypeNotEqual},
+					},
+				},
+			},
+		}))
+		Expect(p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "DescribeStackDriftDetectionStatus", 2)).To(BeTrue())
+	})
+
+	It("does not describe resources of stacks in sync", func() {
+		p.MockCloudFormation().On("DescribeStackDriftDetectionStatus", mock.Anything).
+			Return(detectionStatus(cfn.StackDriftDetectionStatusDetectionComplete, cfn.StackDriftStatusInSync), nil)
+
+		result, err := sc.DetectNodeGroupDrift(ng)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(&DriftResult{NodeGroupName: "ng-1", Status: DriftStatusInSync}))
+		Expect(p.MockCloudFormation().AssertNotCalled(GinkgoT(), "DescribeStackResourceDriftsPages", mock.Anything, mock.Anything)).To(BeTrue())
+	})
+
+	It("reports unknown drift when the detection fails for some resources", func() {
+		status := detectionStatus(cfn.StackDriftDetectionStatusDetectionFailed, cfn.StackDriftStatusInSync)
+		status.DetectionStatusReason = aws.String("Failed to detect drift on resource [NodeGroupPolicy]")
+		p.MockCloudFormation().On("DescribeStackDriftDetectionStatus", mock.Anything).Return(status, nil)
+
+		result, err := sc.DetectNodeGroupDrift(ng)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(&DriftResult{NodeGroupName: "ng-1", Status: DriftStatusUnknown}))
+		Expect(p.MockCloudFormation().AssertNotCalled(GinkgoT(), "DescribeStackResourceDriftsPages", mock.Anything, mock.Anything)).To(BeTrue())
+	})
+
+	It("reports the drifted resources when the detection fails for other resources", func() {
+		status := detectionStatus(cfn.StackDriftDetectionStatusDetectionFailed, cfn.StackDriftStatusDrifted)
+		status.DetectionStatusReason = aws.String("Failed to detect drift on resource [NodeGroupPolicy]")
+		p.MockCloudFormation().On("DescribeStackDriftDetectionStatus", mock.Anything).Return(status, nil)
+		p.MockCloudFormation().On("DescribeStackResourceDriftsPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(p *cfn.DescribeStackResourceDriftsOutput, last bool) (shouldContinue bool))
+			consume(&cfn.DescribeStackResourceDriftsOutput{
+				StackResourceDrifts: []*cfn.StackResourceDrift{
+					{
+						LogicalResourceId:        aws.String("SG"),
+						PhysicalResourceId:       aws.String("sg-1"),
+						ResourceType:             aws.String("AWS::EC2::SecurityGroup"),
+						StackResourceDriftStatus: aws.String(cfn.StackResourceDriftStatusDeleted),
+					},
+				},
+			}, true)
+		}).Return(nil)
+
+		result, err := sc.DetectNodeGroupDrift(ng)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Status).To(Equal(DriftStatusDrifted))
+		Expect(result.DriftedResources).To(Equal([]ResourceDrift{
+			{LogicalResourceID: "SG", PhysicalResourceID: "sg-1", ResourceType: "AWS::EC2::SecurityGroup", Status: cfn.StackResourceDriftStatusDeleted},
+		}))
+	})
+
+	It("stops waiting when the detection does not complete in time", func() {
+		sc.waitTimeout = 10 * time.Millisecond
+		p.MockCloudFormation().On("DescribeStackDriftDetectionStatus", mock.Anything).
+			Return(detectionStatus(cfn.StackDriftDetectionStatusDetectionInProgress, ""), nil)
+
+		_, err := sc.DetectNodeGroupDrift(ng)
+		Expect(err).To(MatchError(`timed out (after 10ms) waiting for drift detection of stack "eksctl-test-cluster-nodegroup-ng-1"`))
+	})
+})
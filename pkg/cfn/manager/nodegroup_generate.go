@@ -0,0 +1,200 @@
+package manager
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	"github.com/tidwall/gjson"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cloudconfig"
+)
+
+// nodeGroupConfigResourceTypes are the resource types of a nodegroup stack that GenerateNodeGroupConfig
+// reconstructs or that eksctl recreates from the nodegroup config
+var nodeGroupConfigResourceTypes = map[string]bool{
+	"AWS::AutoScaling::AutoScalingGroup":    true,
+	"AWS::AutoScaling::LaunchConfiguration": true,
+	"AWS::EC2::LaunchTemplate":              true,
+	"AWS::EC2::SecurityGroup":               true,
+	"AWS::EC2::SecurityGroupIngress":        true,
+	"AWS::EC2::SecurityGroupEgress":         true,
+	"AWS::IAM::InstanceProfile":             true,
+	"AWS::IAM::Role":                        true,
+	"AWS::IAM::Policy":                      true,
+}
+
+// GenerateNodeGroupConfig reconstructs the config of the unmanaged nodegroup of an existing stack, which need
+// not have been created by eksctl, from the Auto Scaling group of its template and the launch template or
+// launch configuration it uses. The instance types, AMI, scaling config, labels and instance role are
+// reconstructed; anything else the stack defines is listed in a warning instead of failing
+func (c *StackCollection) GenerateNodeGroupConfig(stackName string) (*api.NodeGroup, error) {
+	stack, err := c.DescribeStack(&Stack{StackName: aws.String(stackName)})
+	if err != nil {
+		return nil, err
+	}
+	template, err := c.GetStackTemplate(stackName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting template of stack %q", stackName)
+	}
+	if nodeGroupType, err := GetNodeGroupTypeFromTemplate(stack.Tags, template); err == nil && nodeGroupType == api.NodeGroupTypeManaged {
+		return nil, fmt.Errorf("stack %q is the stack of a managed nodegroup, only unmanaged nodegroups are supported", stackName)
+	}
+
+	var asgIDs []string
+	gjson.Get(template, resourcesRootPath).ForEach(func(logicalID, resource gjson.Result) bool {
+		if resource.Get("Type").String() == "AWS::AutoScaling::AutoScalingGroup" {
+			asgIDs = append(asgIDs, logicalID.String())
+		}
+		return true
+	})
+	if len(asgIDs) == 0 {
+		return nil, fmt.Errorf("stack %q has no Auto Scaling group to reconstruct a nodegroup from", stackName)
+	}
+
+	var unsupported []string
+	if len(asgIDs) > 1 {
+		unsupported = append(unsupported, fmt.Sprintf("Auto Scaling groups %s (only %s is reconstructed)", strings.Join(asgIDs[1:], ", "), asgIDs[0]))
+	}
+	asg := gjson.Get(template, resourcesRootPath+"."+asgIDs[0])
+	asgPath := resourcesRootPath + "." + asgIDs[0] + ".Properties"
+
+	ng := api.NewNodeGroup()
+	ng.Name = c.GetNodeGroupName(stack)
+	if ng.Name == "" {
+		if _, name, ok := ParseNodeGroupStackName(stackName); ok {
+			ng.Name = name
+		} else {
+			ng.Name = stackName
+		}
+	}
+	ng.ScalingConfig = &api.ScalingConfig{
+		DesiredCapacity: intFromTemplate(template, asgPath+".DesiredCapacity"),
+		MinSize:         intFromTemplate(template, asgPath+".MinSize"),
+		MaxSize:         intFromTemplate(template, asgPath+".MaxSize"),
+	}
+
+	launchDataPath, ok := launchDataPathFromTemplate(template, asg)
+	if !ok {
+		unsupported = append(unsupported, fmt.Sprintf("launch template of Auto Scaling group %s (instance type, AMI and labels)", asgIDs[0]))
+	} else {
+		ng.InstanceType = gjson.Get(template, launchDataPath+".InstanceType").String()
+		if imageID := gjson.Get(template, launchDataPath+".ImageId"); imageID.Type == gjson.String {
+			ng.AMI = imageID.String()
+		} else {
+			unsupported = append(unsupported, "AMI (not set to an image ID)")
+		}
+		labels, err := labelsFromUserData(gjson.Get(template, launchDataPath+".UserData"))
+		if err != nil {
+			unsupported = append(unsupported, fmt.Sprintf("labels (%v)", err))
+		}
+		ng.Labels = labels
+	}
+
+	if policy := asg.Get("Properties.MixedInstancesPolicy"); policy.Exists() {
+		ng.InstanceType = "mixed"
+		ng.InstancesDistribution = instancesDistributionFromTemplate(policy)
+	}
+
+	if roleARN, ok := nodeGroupInstanceRoleARN(stack); ok {
+		ng.IAM.InstanceRoleARN = roleARN
+	} else {
+		unsupported = append(unsupported, "IAM instance role (not an output of the stack)")
+	}
+
+	gjson.Get(template, resourcesRootPath).ForEach(func(logicalID, resource gjson.Result) bool {
+		if resourceType := resource.Get("Type").String(); !nodeGroupConfigResourceTypes[resourceType] {
+			unsupported = append(unsupported, fmt.Sprintf("resource %s of type %s", logicalID.String(), resourceType))
+		}
+		return true
+	})
+
+	if len(unsupported) > 0 {
+		logger.Warning("could not reconstruct the following from stack %q: %s", stackName, strings.Join(unsupported, "; "))
+	}
+	return ng, nil
+}
+
+// launchDataPathFromTemplate returns the path to the launch data of the Auto Scaling group asg, which is the
+// launch template data or the properties of the launch configuration it references
+func launchDataPathFromTemplate(template string, asg gjson.Result) (string, bool) {
+	for _, path := range []string{
+		"Properties.LaunchTemplate.LaunchTemplateId.Ref",
+		"Properties.MixedInstancesPolicy.LaunchTemplate.LaunchTemplateSpecification.LaunchTemplateId.Ref",
+	} {
+		if ref := asg.Get(path); ref.Type == gjson.String {
+			return fmt.Sprintf("%s.%s.Properties.LaunchTemplateData", resourcesRootPath, ref.String()), true
+		}
+	}
+	if ref := asg.Get("Properties.LaunchConfigurationName.Ref"); ref.Type == gjson.String {
+		return fmt.Sprintf("%s.%s.Properties", resourcesRootPath, ref.String()), true
+	}
+	return "", false
+}
+
+// labelsFromUserData returns the node labels eksctl sets in the kubelet environment file of the cloud config
+func labelsFromUserData(userData gjson.Result) (map[string]string, error) {
+	if userData.Type != gjson.String {
+		return nil, errors.New("user data is not a literal")
+	}
+	config, err := cloudconfig.DecodeCloudConfig(userData.String())
+	if err != nil {
+		return nil, errors.Wrap(err, "decoding user data")
+	}
+	for _, file := range config.WriteFiles {
+		if !strings.HasSuffix(file.Path, "/kubelet.env") {
+			continue
+		}
+		for _, line := range strings.Split(file.Content, "\n") {
+			if !strings.HasPrefix(line, "NODE_LABELS=") {
+				continue
+			}
+			labels := map[string]string{}
+			for _, kv := range strings.Split(strings.TrimPrefix(line, "NODE_LABELS="), ",") {
+				if parts := strings.SplitN(kv, "=", 2); len(parts) == 2 {
+					labels[parts[0]] = parts[1]
+				}
+			}
+			return labels, nil
+		}
+	}
+	return nil, errors.New("user data does not set node labels")
+}
+
+func instancesDistributionFromTemplate(policy gjson.Result) *api.NodeGroupInstancesDistribution {
+	distribution := &api.NodeGroupInstancesDistribution{}
+	for _, instanceType := range policy.Get("LaunchTemplate.Overrides.#.InstanceType").Array() {
+		distribution.InstanceTypes = append(distribution.InstanceTypes, instanceType.String())
+	}
+
+	// the values of the instances distribution are strings in templates created by eksctl
+	optionalInt := func(field string) *int {
+		if value := policy.Get("InstancesDistribution." + field); value.Exists() {
+			return aws.Int(int(value.Int()))
+		}
+		return nil
+	}
+	distribution.OnDemandBaseCapacity = optionalInt("OnDemandBaseCapacity")
+	distribution.OnDemandPercentageAboveBaseCapacity = optionalInt("OnDemandPercentageAboveBaseCapacity")
+	distribution.SpotInstancePools = optionalInt("SpotInstancePools")
+	if value := policy.Get("InstancesDistribution.SpotMaxPrice"); value.Exists() {
+		distribution.MaxPrice = aws.Float64(value.Float())
+	}
+	if value := policy.Get("InstancesDistribution.SpotAllocationStrategy"); value.Exists() {
+		distribution.SpotAllocationStrategy = aws.String(value.String())
+	}
+	return distribution
+}
+
+// stackOutput returns the value of the output key of the stack
+func stackOutput(stack *Stack, key string) (string, bool) {
+	for _, output := range stack.Outputs {
+		if aws.StringValue(output.OutputKey) == key {
+			return aws.StringValue(output.OutputValue), true
+		}
+	}
+	return "", false
+}
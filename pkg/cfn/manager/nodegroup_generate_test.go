@@ -0,0 +1,164 @@
+package manager
+
+import (
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cloudconfig"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("StackCollection GenerateNodeGroupConfig", func() {
+	const stackName = "eksctl-test-cluster-nodegroup-ng-1"
+
+	var (
+		p         *mockprovider.MockProvider
+		sc        *StackCollection
+		resources map[string]interface{}
+		stack     *cfn.Stack
+	)
+
+	mockStack := func() {
+		template, err := json.Marshal(map[string]interface{}{"Resources": resources})
+		Expect(err).NotTo(HaveOccurred())
+		p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(&cfn.DescribeStacksOutput{Stacks: []*cfn.Stack{stack}}, nil)
+		p.MockCloudFormation().On("GetTemplate", mock.Anything).Return(&cfn.GetTemplateOutput{TemplateBody: aws.String(string(template))}, nil)
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		sc = NewStackCollection(p, cfg)
+
+		config := cloudconfig.New()
+		config.AddFile(cloudconfig.File{
+			Path:    "/etc/eksctl/kubelet.env",
+			Content: "NODE_LABELS=tier=backend\nNODE_TAINTS=\nCLUSTER_NAME=test-cluster",
+		})
+		userData, err := config.Encode()
+		Expect(err).NotTo(HaveOccurred())
+
+		resources = map[string]interface{}{
+			"NodeGroup": map[string]interface{}{
+				"Type": "AWS::AutoScaling::AutoScalingGroup",
+				"Properties": map[string]interface{}{
+					"DesiredCapacity": "3",
+					"MinSize":         "1",
+					"MaxSize":         "5",
+					"LaunchTemplate": map[string]interface{}{
+						"LaunchTemplateId": map[string]interface{}{"Ref": "NodeGroupLaunchTemplate"},
+					},
+				},
+			},
+			"NodeGroupLaunchTemplate": map[string]interface{}{
+				"Type": "AWS::EC2::LaunchTemplate",
+				"Properties": map[string]interface{}{
+					"LaunchTemplateData": map[string]interface{}{
+						"InstanceType": "m5.large",
+						"ImageId":      "ami-123",
+						"UserData":     userData,
+					},
+				},
+			},
+			"NodeInstanceRole": map[string]interface{}{
+				"Type": "AWS::IAM::Role",
+			},
+		}
+		stack = &cfn.Stack{
+			StackName:   aws.String(stackName),
+			StackStatus: aws.String(cfn.StackStatusCreateComplete),
+			Tags:        []*cfn.Tag{{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("ng-1")}},
+			Outputs: []*cfn.Output{{
+				OutputKey:   aws.String("InstanceRoleARN"),
+				OutputValue: aws.String("arn:aws:iam::123456789012:role/node-role"),
+			}},
+		}
+	})
+
+	It("reconstructs the nodegroup from its stack", func() {
+		mockStack()
+
+		ng, err := sc.GenerateNodeGroupConfig(stackName)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ng.Name).To(Equal("ng-1"))
+		Expect(ng.InstanceType).To(Equal("m5.large"))
+		Expect(ng.AMI).To(Equal("ami-123"))
+		Expect(*ng.DesiredCapacity).To(Equal(3))
+		Expect(*ng.MinSize).To(Equal(1))
+		Expect(*ng.MaxSize).To(Equal(5))
+		Expect(ng.Labels).To(Equal(map[string]string{"tier": "backend"}))
+		Expect(ng.IAM.InstanceRoleARN).To(Equal("arn:aws:iam::123456789012:role/node-role"))
+	})
+
+	It("reconstructs the instances distribution of a mixed instances policy", func() {
+		asgProps := resources["NodeGroup"].(map[string]interface{})["Properties"].(map[string]interface{})
+		delete(asgProps, "LaunchTemplate")
+		asgProps["MixedInstancesPolicy"] = map[string]interface{}{
+			"LaunchTemplate": map[string]interface{}{
+				"LaunchTemplateSpecification": map[string]interface{}{
+					"LaunchTemplateId": map[string]interface{}{"Ref": "NodeGroupLaunchTemplate"},
+				},
+				"Overrides": []interface{}{
+					map[string]interface{}{"InstanceType": "m5.large"},
+					map[string]interface{}{"InstanceType": "m5a.large"},
+				},
+			},
+			"InstancesDistribution": map[string]interface{}{
+				"OnDemandBaseCapacity":   "1",
+				"SpotAllocationStrategy": "capacity-optimized",
+			},
+		}
+		mockStack()
+
+		ng, err := sc.GenerateNodeGroupConfig(stackName)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ng.InstanceType).To(Equal("mixed"))
+		Expect(ng.InstancesDistribution.InstanceTypes).To(Equal([]string{"m5.large", "m5a.large"}))
+		Expect(*ng.InstancesDistribution.OnDemandBaseCapacity).To(Equal(1))
+		Expect(*ng.InstancesDistribution.SpotAllocationStrategy).To(Equal("capacity-optimized"))
+		Expect(ng.InstancesDistribution.OnDemandPercentageAboveBaseCapacity).To(BeNil())
+		Expect(ng.Labels).To(Equal(map[string]string{"tier": "backend"}))
+	})
+
+	It("does not fail on what cannot be reconstructed", func() {
+		resources["NodeGroupLaunchTemplate"].(map[string]interface{})["Properties"].(map[string]interface{})["LaunchTemplateData"] = map[string]interface{}{
+			"InstanceType": "m5.large",
+			"ImageId":      map[string]interface{}{"Ref": "ImageIdParameter"},
+			"UserData":     map[string]interface{}{"Fn::Base64": "#!/bin/bash"},
+		}
+		resources["Queue"] = map[string]interface{}{"Type": "AWS::SQS::Queue"}
+		stack.Outputs = nil
+		mockStack()
+
+		ng, err := sc.GenerateNodeGroupConfig(stackName)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ng.InstanceType).To(Equal("m5.large"))
+		Expect(ng.AMI).To(BeEmpty())
+		Expect(ng.Labels).To(BeEmpty())
+		Expect(ng.IAM.InstanceRoleARN).To(BeEmpty())
+	})
+
+	It("fails for stacks without an Auto Scaling group", func() {
+		resources = map[string]interface{}{"Queue": map[string]interface{}{"Type": "AWS::SQS::Queue"}}
+		mockStack()
+
+		_, err := sc.GenerateNodeGroupConfig(stackName)
+		Expect(err).To(MatchError(`stack "eksctl-test-cluster-nodegroup-ng-1" has no Auto Scaling group to reconstruct a nodegroup from`))
+	})
+
+	It("refuses the stacks of managed nodegroups", func() {
+		resources["ManagedNodeGroup"] = map[string]interface{}{"Type": "AWS::EKS::Nodegroup"}
+		stack.Tags = append(stack.Tags, &cfn.Tag{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(string(api.NodeGroupTypeManaged))})
+		mockStack()
+
+		_, err := sc.GenerateNodeGroupConfig(stackName)
+		Expect(err).To(MatchError(ContainSubstring("only unmanaged nodegroups are supported")))
+	})
+})
@@ -0,0 +1,164 @@
+package manager
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// InstanceHealth holds the EC2 status checks of an instance of a nodegroup
+type InstanceHealth struct {
+	InstanceID       string
+	AvailabilityZone string
+	// LifecycleState is the state of the instance in the Auto Scaling group, e.g. InService
+	LifecycleState string
+	// InstanceState is the EC2 state of the instance, e.g. running
+	InstanceState string
+	// SystemStatus and InstanceStatus are the results of the EC2 system and instance
+	// status checks, e.g. ok or impaired
+	SystemStatus   string
+	InstanceStatus string
+}
+
+// Healthy returns true when both EC2 status checks of the instance passed
+func (h InstanceHealth) Healthy() bool {
+	return h.SystemStatus == ec2.SummaryStatusOk && h.InstanceStatus == ec2.SummaryStatusOk
+}
+
+// Values for NodeGroupHealthStatus.Status
+const (
+	// NodeGroupHealthy means all desired instances of the nodegroup are in service
+	NodeGroupHealthy = "Healthy"
+	// NodeGroupDegraded means fewer instances of the nodegroup are in service than desired
+	NodeGroupDegraded = "Degraded"
+)
+
+// NodeGroupHealthStatus compares the desired capacity of the Auto Scaling groups of a nodegroup
+// with the number of their instances that are in service
+type NodeGroupHealthStatus struct {
+	Status           string
+	DesiredCapacity  int
+	HealthyInstances int
+}
+
+// describeAutoScalingGroups describes the Auto Scaling groups with the given names
+func (c *StackCollection) describeAutoScalingGroups(asgNames []string) ([]*autoscaling.Group, error) {
+	asgs, err := c.asgAPI.DescribeAutoScalingGroups(&autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: aws.StringSlice(asgNames),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "describing Auto Scaling group(s) %s", strings.Join(asgNames, ", "))
+	}
+	if len(asgs.AutoScalingGroups) == 0 {
+		return nil, errors.Errorf("Auto Scaling group(s) %s not found", strings.Join(asgNames, ", "))
+	}
+	return asgs.AutoScalingGroups, nil
+}
+
+// nodeGroupHealthStatus returns the health of the nodegroup's Auto Scaling groups, or nil when there are none
+func nodeGroupHealthStatus(asgs []*autoscaling.Group) *NodeGroupHealthStatus {
+	if len(asgs) == 0 {
+		return nil
+	}
+
+	health := &NodeGroupHealthStatus{Status: NodeGroupHealthy}
+	for _, asg := range asgs {
+		health.DesiredCapacity += int(aws.Int64Value(asg.DesiredCapacity))
+		for _, instance := range asg.Instances {
+			if aws.StringValue(instance.LifecycleState) == autoscaling.LifecycleStateInService {
+				health.HealthyInstances++
+			}
+		}
+	}
+	if health.HealthyInstances < health.DesiredCapacity {
+		health.Status = NodeGroupDegraded
+	}
+	return health
+}
+
+// GetNodeGroupInstanceHealth returns the EC2 status checks of each instance of the nodegroup's
+// Auto Scaling group, surfacing hardware issues on instances the Auto Scaling group considers healthy
+func (c *StackCollection) GetNodeGroupInstanceHealth(ng *api.NodeGroup) ([]InstanceHealth, error) {
+	stack, err := c.DescribeNodeGroupStack(ng.Name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "describing stack of nodegroup %q", ng.Name)
+	}
+
+	asgName, err := c.GetNodeGroupAutoScalingGroupName(stack)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting Auto Scaling group of nodegroup %q", ng.Name)
+	}
+
+	asgs, err := c.describeAutoScalingGroups([]string{asgName})
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting Auto Scaling group of nodegroup %q", ng.Name)
+	}
+
+	var (
+		instanceIDs []string
+		health      []InstanceHealth
+	)
+	for _, instance := range asgs[0].Instances {
+		instanceIDs = append(instanceIDs, aws.StringValue(instance.InstanceId))
+		health = append(health, InstanceHealth{
+			InstanceID:       aws.StringValue(instance.InstanceId),
+			AvailabilityZone: aws.StringValue(instance.AvailabilityZone),
+			LifecycleState:   aws.StringValue(instance.LifecycleState),
+		})
+	}
+	if len(instanceIDs) == 0 {
+		return health, nil
+	}
+
+	statuses, err := c.describeInstanceStatus(instanceIDs)
+	if err != nil {
+		return nil, err
+	}
+	for i := range health {
+		status, ok := statuses[health[i].InstanceID]
+		if !ok {
+			continue
+		}
+		if status.InstanceState != nil {
+			health[i].InstanceState = aws.StringValue(status.InstanceState.Name)
+		}
+		if status.SystemStatus != nil {
+			health[i].SystemStatus = aws.StringValue(status.SystemStatus.Status)
+		}
+		if status.InstanceStatus != nil {
+			health[i].InstanceStatus = aws.StringValue(status.InstanceStatus.Status)
+		}
+	}
+	return health, nil
+}
+
+func (c *StackCollection) describeInstanceStatus(instanceIDs []string) (map[string]*ec2.InstanceStatus, error) {
+	statuses := map[string]*ec2.InstanceStatus{}
+	var nextToken *string
+
+	for {
+		output, err := c.ec2API.DescribeInstanceStatus(&ec2.DescribeInstanceStatusInput{
+			InstanceIds: aws.StringSlice(instanceIDs),
+			// report instances that are not running too
+			IncludeAllInstances: aws.Bool(true),
+			NextToken:           nextToken,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "error describing instance status")
+		}
+
+		for _, status := range output.InstanceStatuses {
+			statuses[aws.StringValue(status.InstanceId)] = status
+		}
+		if nextToken = output.NextToken; nextToken == nil {
+			break
+		}
+	}
+	return statuses, nil
+}
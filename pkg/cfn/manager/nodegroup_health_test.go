@@ -0,0 +1,147 @@
+package manager
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("StackCollection GetNodeGroupInstanceHealth", func() {
+	var (
+		ng *api.NodeGroup
+		p  *mockprovider.MockProvider
+		sc *StackCollection
+	)
+
+	BeforeEach(func() {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		ng = cfg.NewNodeGroup()
+		ng.Name = "ng-1"
+
+		p = mockprovider.NewMockProvider()
+		sc = NewStackCollection(p, cfg)
+
+		p.MockCloudFormation().On("DescribeStacks", mock.MatchedBy(func(input *cfn.DescribeStacksInput) bool {
+			return *input.StackName == "eksctl-test-cluster-nodegroup-ng-1"
+		})).Return(&cfn.DescribeStacksOutput{
+			Stacks: []*cfn.Stack{{StackName: aws.String("eksctl-test-cluster-nodegroup-ng-1")}},
+		}, nil)
+		p.MockCloudFormation().On("DescribeStackResource", mock.Anything).Return(&cfn.DescribeStackResourceOutput{
+			StackResourceDetail: &cfn.StackResourceDetail{PhysicalResourceId: aws.String("asg-1")},
+		}, nil)
+	})
+
+	It("reports the EC2 status checks of the nodegroup instances", func() {
+		p.MockASG().On("DescribeAutoScalingGroups", mock.MatchedBy(func(input *autoscaling.DescribeAutoScalingGroupsInput) bool {
+			return *input.AutoScalingGroupNames[0] == "asg-1"
+		})).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+			AutoScalingGroups: []*autoscaling.Group{
+				{
+					Instances: []*autoscaling.Instance{
+						{InstanceId: aws.String("i-1"), AvailabilityZone: aws.String("us-west-2a"), LifecycleState: aws.String("InService")},
+						{InstanceId: aws.String("i-2"), AvailabilityZone: aws.String("us-west-2b"), LifecycleState: aws.String("InService")},
+					},
+				},
+			},
+		}, nil)
+		p.MockEC2().On("DescribeInstanceStatus", mock.MatchedBy(func(input *ec2.DescribeInstanceStatusInput) bool {
+			return len(input.InstanceIds) == 2 && *input.IncludeAllInstances
+		})).Return(&ec2.DescribeInstanceStatusOutput{
+			InstanceStatuses: []*ec2.InstanceStatus{
+				{
+					InstanceId:     aws.String("i-1"),
+					InstanceState:  &ec2.InstanceState{Name: aws.String(ec2.InstanceStateNameRunning)},
+					SystemStatus:   &ec2.InstanceStatusSummary{Status: aws.String(ec2.SummaryStatusOk)},
+					InstanceStatus: &ec2.InstanceStatusSummary{Status: aws.String(ec2.SummaryStatusOk)},
+				},
+				{
+					InstanceId:     aws.String("i-2"),
+					InstanceState:  &ec2.InstanceState{Name: aws.String(ec2.InstanceStateNameRunning)},
+					SystemStatus:   &ec2.InstanceStatusSummary{Status: aws.String(ec2.SummaryStatusImpaired)},
+					InstanceStatus: &ec2.InstanceStatusSummary{Status: aws.String(ec2.SummaryStatusOk)},
+				},
+			},
+		}, nil)
+
+		health, err := sc.GetNodeGroupInstanceHealth(ng)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(health).To(Equal([]InstanceHealth{
+			{
+				InstanceID:       "i-1",
+				AvailabilityZone: "us-west-2a",
+				LifecycleState:   "InService",
+				InstanceState:    "running",
+				SystemStatus:     "ok",
+				InstanceStatus:   "ok",
+			},
+			{
+				InstanceID:       "i-2",
+				AvailabilityZone: "us-west-2b",
+				LifecycleState:   "InService",
+				InstanceState:    "running",
+				SystemStatus:     "impaired",
+				InstanceStatus:   "ok",
+			},
+		}))
+		Expect(health[0].Healthy()).To(BeTrue())
+		Expect(health[1].Healthy()).To(BeFalse())
+	})
+
+	It("returns no instances for an empty Auto Scaling group", func() {
+		p.MockASG().On("DescribeAutoScalingGroups", mock.Anything).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+			AutoScalingGroups: []*autoscaling.Group{{}},
+		}, nil)
+
+		health, err := sc.GetNodeGroupInstanceHealth(ng)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(health).To(BeEmpty())
+		Expect(p.MockEC2().AssertNotCalled(GinkgoT(), "DescribeInstanceStatus", mock.Anything)).To(BeTrue())
+	})
+
+	It("sums the desired capacity and instances in service of all Auto Scaling groups of a nodegroup", func() {
+		p.MockASG().On("DescribeAutoScalingGroups", &autoscaling.DescribeAutoScalingGroupsInput{
+			AutoScalingGroupNames: aws.StringSlice([]string{"asg-1", "asg-2"}),
+		}).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+			AutoScalingGroups: []*autoscaling.Group{
+				{
+					DesiredCapacity: aws.Int64(1),
+					Instances: []*autoscaling.Instance{
+						{InstanceId: aws.String("i-1"), LifecycleState: aws.String(autoscaling.LifecycleStateInService)},
+					},
+				},
+				{
+					DesiredCapacity: aws.Int64(1),
+					Instances: []*autoscaling.Instance{
+						{InstanceId: aws.String("i-2"), LifecycleState: aws.String(autoscaling.LifecycleStateInService)},
+					},
+				},
+			},
+		}, nil)
+
+		asgs, err := sc.describeAutoScalingGroups([]string{"asg-1", "asg-2"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(nodeGroupHealthStatus(asgs)).To(Equal(&NodeGroupHealthStatus{
+			Status:           NodeGroupHealthy,
+			DesiredCapacity:  2,
+			HealthyInstances: 2,
+		}))
+	})
+
+	It("leaves the health unset when the Auto Scaling group cannot be described", func() {
+		p.MockASG().On("DescribeAutoScalingGroups", mock.Anything).Return(nil, fmt.Errorf("access denied"))
+
+		asgs, err := sc.describeAutoScalingGroups([]string{"asg-1"})
+		Expect(err).To(MatchError("describing Auto Scaling group(s) asg-1: access denied"))
+		Expect(nodeGroupHealthStatus(asgs)).To(BeNil())
+	})
+})
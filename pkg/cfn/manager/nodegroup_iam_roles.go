@@ -0,0 +1,72 @@
+package manager
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/pkg/errors"
+	"github.com/tidwall/gjson"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// nodeGroupInstanceRoleResourceID is the logical ID of the instance role eksctl creates in nodegroup stacks
+const nodeGroupInstanceRoleResourceID = "NodeInstanceRole"
+
+// NodeGroupIAMRole is the instance role of a nodegroup
+type NodeGroupIAMRole struct {
+	RoleARN string
+	// PreExisting is set for roles that were not created by eksctl but referenced by the instanceRoleARN
+	// of the nodegroup, and so are not deleted with it
+	PreExisting bool
+}
+
+// GetNodeGroupIAMRoles returns the instance roles of the nodegroups of the cluster by nodegroup name, read
+// from the same stack outputs as GetNodeGroupSummaries. The stacks of managed nodegroups have no instance
+// role output, so their role is the node role EKS reports for them
+func (c *StackCollection) GetNodeGroupIAMRoles() (map[string]NodeGroupIAMRole, error) {
+	stacks, err := c.DescribeNodeGroupStacks()
+	if err != nil {
+		return nil, errors.Wrap(err, "getting nodegroup stacks")
+	}
+
+	roles := map[string]NodeGroupIAMRole{}
+	for _, s := range stacks {
+		name := c.GetNodeGroupName(s)
+		roleARN, ok := nodeGroupInstanceRoleARN(s)
+		if !ok {
+			if nodeGroupType, err := GetNodeGroupType(s.Tags); err != nil || nodeGroupType != api.NodeGroupTypeManaged {
+				continue
+			}
+			if roleARN, err = c.managedNodeGroupRoleARN(name); err != nil {
+				return nil, err
+			}
+		}
+		template, err := c.GetStackTemplate(*s.StackName)
+		if err != nil {
+			return nil, errors.Wrapf(err, "getting template of stack %q", *s.StackName)
+		}
+		roles[name] = NodeGroupIAMRole{
+			RoleARN:     roleARN,
+			PreExisting: !instanceRoleCreatedByStack(template),
+		}
+	}
+	return roles, nil
+}
+
+// managedNodeGroupRoleARN returns the node role of the managed nodegroup
+func (c *StackCollection) managedNodeGroupRoleARN(name string) (string, error) {
+	res, err := c.eksAPI.DescribeNodegroup(&eks.DescribeNodegroupInput{
+		ClusterName:   aws.String(c.spec.Metadata.Name),
+		NodegroupName: aws.String(name),
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "describing managed nodegroup %q", name)
+	}
+	return aws.StringValue(res.Nodegroup.NodeRole), nil
+}
+
+// instanceRoleCreatedByStack returns whether the nodegroup stack template creates the instance role, which
+// it does not when the nodegroup references an existing role
+func instanceRoleCreatedByStack(template string) bool {
+	return gjson.Get(template, resourcesRootPath+"."+nodeGroupInstanceRoleResourceID+".Type").String() == "AWS::IAM::Role"
+}
@@ -0,0 +1,109 @@
+package manager
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/eks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("StackCollection GetNodeGroupIAMRoles", func() {
+	var (
+		p  *mockprovider.MockProvider
+		sc *StackCollection
+	)
+
+	BeforeEach(func() {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		p = mockprovider.NewMockProvider()
+		sc = NewStackCollection(p, cfg)
+
+		roleOutput := func(key, roleARN string) []*cfn.Output {
+			return []*cfn.Output{{OutputKey: aws.String(key), OutputValue: aws.String(roleARN)}}
+		}
+		mockStacks(p, map[string]*mockedStack{
+			"eksctl-test-cluster-nodegroup-ng-1": {
+				Tags:     nodeGroupStackTags("ng-1", api.NodeGroupTypeUnmanaged),
+				Outputs:  roleOutput(outputs.NodeGroupInstanceRoleARN, "arn:aws:iam::123456789012:role/eksctl-test-cluster-nodegroup-ng-1-NodeInstanceRole"),
+				Template: `{"Resources": {"NodeInstanceRole": {"Type": "AWS::IAM::Role"}}}`,
+			},
+			"eksctl-test-cluster-nodegroup-ng-2": {
+				Tags:     nodeGroupStackTags("ng-2", api.NodeGroupTypeUnmanaged),
+				Outputs:  roleOutput(outputs.NodeGroupInstanceRoleARN, "arn:aws:iam::123456789012:role/existing-role"),
+				Template: `{"Resources": {"NodeInstanceProfile": {"Type": "AWS::IAM::InstanceProfile"}}}`,
+			},
+			"eksctl-test-cluster-nodegroup-legacy": {
+				Tags:     nodeGroupStackTags("legacy", api.NodeGroupTypeUnmanaged),
+				Outputs:  roleOutput("NodeInstanceRoleARN", "arn:aws:iam::123456789012:role/legacy-role"),
+				Template: `{"Resources": {"NodeInstanceRole": {"Type": "AWS::IAM::Role"}}}`,
+			},
+			"eksctl-test-cluster-nodegroup-mng": {
+				Tags:     nodeGroupStackTags("mng", api.NodeGroupTypeManaged),
+				Template: `{"Resources": {"NodeInstanceRole": {"Type": "AWS::IAM::Role"}, "ManagedNodeGroup": {"Type": "AWS::EKS::Nodegroup"}}}`,
+			},
+			"eksctl-test-cluster-nodegroup-mng-existing-role": {
+				Tags:     nodeGroupStackTags("mng-existing-role", api.NodeGroupTypeManaged),
+				Template: `{"Resources": {"ManagedNodeGroup": {"Type": "AWS::EKS::Nodegroup"}}}`,
+			},
+			"eksctl-test-cluster-nodegroup-untagged": {
+				Tags:     []*cfn.Tag{{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("untagged")}},
+				Template: `{"Resources": {}}`,
+			},
+		})
+
+		nodeRoles := map[string]string{
+			"mng":               "arn:aws:iam::123456789012:role/eksctl-test-cluster-nodegroup-mng-NodeInstanceRole",
+			"mng-existing-role": "arn:aws:iam::123456789012:role/existing-managed-role",
+		}
+		p.MockEKS().On("DescribeNodegroup", mock.Anything).Return(func(input *eks.DescribeNodegroupInput) *eks.DescribeNodegroupOutput {
+			return &eks.DescribeNodegroupOutput{Nodegroup: &eks.Nodegroup{NodeRole: aws.String(nodeRoles[*input.NodegroupName])}}
+		}, nil)
+	})
+
+	It("returns the instance roles of the nodegroups and flags pre-existing ones", func() {
+		roles, err := sc.GetNodeGroupIAMRoles()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(roles).To(Equal(map[string]NodeGroupIAMRole{
+			"ng-1": {
+				RoleARN: "arn:aws:iam::123456789012:role/eksctl-test-cluster-nodegroup-ng-1-NodeInstanceRole",
+			},
+			"ng-2": {
+				RoleARN:     "arn:aws:iam::123456789012:role/existing-role",
+				PreExisting: true,
+			},
+			"legacy": {
+				RoleARN: "arn:aws:iam::123456789012:role/legacy-role",
+			},
+			"mng": {
+				RoleARN: "arn:aws:iam::123456789012:role/eksctl-test-cluster-nodegroup-mng-NodeInstanceRole",
+			},
+			"mng-existing-role": {
+				RoleARN:     "arn:aws:iam::123456789012:role/existing-managed-role",
+				PreExisting: true,
+			},
+		}))
+	})
+
+	It("gets the node role of managed nodegroups from EKS", func() {
+		_, err := sc.GetNodeGroupIAMRoles()
+		Expect(err).NotTo(HaveOccurred())
+		p.MockEKS().AssertCalled(GinkgoT(), "DescribeNodegroup", &eks.DescribeNodegroupInput{
+			ClusterName:   aws.String("test-cluster"),
+			NodegroupName: aws.String("mng"),
+		})
+		p.MockEKS().AssertNumberOfCalls(GinkgoT(), "DescribeNodegroup", 2)
+	})
+
+	It("does not get the templates of unmanaged stacks without an instance role output", func() {
+		_, err := sc.GetNodeGroupIAMRoles()
+		Expect(err).NotTo(HaveOccurred())
+		p.MockCloudFormation().AssertNotCalled(GinkgoT(), "GetTemplate", &cfn.GetTemplateInput{StackName: aws.String("eksctl-test-cluster-nodegroup-untagged")})
+	})
+})
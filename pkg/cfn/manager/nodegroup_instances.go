@@ -0,0 +1,125 @@
+package manager
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// terminatingLifecycleStates are the lifecycle states of instances that are leaving their Auto Scaling group
+var terminatingLifecycleStates = sets.NewString(
+	autoscaling.LifecycleStateTerminating,
+	autoscaling.LifecycleStateTerminatingWait,
+	autoscaling.LifecycleStateTerminatingProceed,
+	autoscaling.LifecycleStateTerminated,
+)
+
+// GetNodeGroupInstanceIDs returns the sorted IDs of the EC2 instances of the nodegroup's Auto Scaling groups,
+// leaving out the instances that are being terminated. The Auto Scaling group of an unmanaged nodegroup is
+// the NodeGroup resource of its stack, the ones of a managed nodegroup are the ones EKS created for it
+func (c *StackCollection) GetNodeGroupInstanceIDs(ng *api.NodeGroup) ([]string, error) {
+	asgs, err := c.getNodeGroupAutoScalingGroups(ng)
+	if err != nil {
+		return nil, err
+	}
+
+	var instanceIDs []string
+	for _, asg := range asgs {
+		for _, instance := range asg.Instances {
+			if !terminatingLifecycleStates.Has(aws.StringValue(instance.LifecycleState)) {
+				instanceIDs = append(instanceIDs, aws.StringValue(instance.InstanceId))
+			}
+		}
+	}
+	sort.Strings(instanceIDs)
+	return instanceIDs, nil
+}
+
+// SetInstanceProtection sets whether the instances of the nodegroup with the given IDs are protected from
+// scale-in, so that the Auto Scaling group terminates other instances when the nodegroup is scaled down.
+// None of the instances is changed if any of them is not in an Auto Scaling group of the nodegroup
+func (c *StackCollection) SetInstanceProtection(ng *api.NodeGroup, instanceIDs []string, protected bool) error {
+	asgs, err := c.getNodeGroupAutoScalingGroups(ng)
+	if err != nil {
+		return err
+	}
+
+	asgInstanceIDs := map[string][]string{}
+	var asgNames []string
+	for _, instanceID := range sets.NewString(instanceIDs...).List() {
+		asgName, ok := findInstanceAutoScalingGroup(asgs, instanceID)
+		if !ok {
+			return errors.Errorf("instance %q is not part of nodegroup %q", instanceID, ng.Name)
+		}
+		if _, ok := asgInstanceIDs[asgName]; !ok {
+			asgNames = append(asgNames, asgName)
+		}
+		asgInstanceIDs[asgName] = append(asgInstanceIDs[asgName], instanceID)
+	}
+
+	for _, asgName := range asgNames {
+		_, err := c.asgAPI.SetInstanceProtection(&autoscaling.SetInstanceProtectionInput{
+			AutoScalingGroupName: aws.String(asgName),
+			InstanceIds:          aws.StringSlice(asgInstanceIDs[asgName]),
+			ProtectedFromScaleIn: aws.Bool(protected),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "setting instance protection of nodegroup %q", ng.Name)
+		}
+	}
+	return nil
+}
+
+func findInstanceAutoScalingGroup(asgs []*autoscaling.Group, instanceID string) (string, bool) {
+	for _, asg := range asgs {
+		for _, instance := range asg.Instances {
+			if aws.StringValue(instance.InstanceId) == instanceID {
+				return aws.StringValue(asg.AutoScalingGroupName), true
+			}
+		}
+	}
+	return "", false
+}
+
+// getNodeGroupAutoScalingGroups describes the Auto Scaling groups of the nodegroup. The Auto Scaling
+// group of an unmanaged nodegroup is the NodeGroup resource of its stack, the ones of a managed nodegroup
+// are the ones EKS created for it
+func (c *StackCollection) getNodeGroupAutoScalingGroups(ng *api.NodeGroup) ([]*autoscaling.Group, error) {
+	stack, err := c.DescribeNodeGroupStack(ng.Name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "describing stack of nodegroup %q", ng.Name)
+	}
+	nodeGroupType, err := GetNodeGroupType(stack.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	var asgNames []string
+	if nodeGroupType == api.NodeGroupTypeManaged {
+		nodeGroup, err := c.GetManagedNodeGroup(ng)
+		if err != nil {
+			return nil, err
+		}
+		asgNames = managedNodeGroupAutoScalingGroupNames(nodeGroup)
+	} else {
+		asgName, err := c.GetNodeGroupAutoScalingGroupName(stack)
+		if err != nil {
+			return nil, errors.Wrapf(err, "getting Auto Scaling group of nodegroup %q", ng.Name)
+		}
+		asgNames = append(asgNames, asgName)
+	}
+	if len(asgNames) == 0 {
+		return nil, errors.Errorf("nodegroup %q has no Auto Scaling group", ng.Name)
+	}
+
+	asgs, err := c.describeAutoScalingGroups(asgNames)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting Auto Scaling group(s) of nodegroup %q", ng.Name)
+	}
+	return asgs, nil
+}
@@ -0,0 +1,177 @@
+package manager
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/eks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("StackCollection GetNodeGroupInstanceIDs", func() {
+	var (
+		ng            *api.NodeGroup
+		p             *mockprovider.MockProvider
+		sc            *StackCollection
+		nodeGroupType api.NodeGroupType
+	)
+
+	mockASGs := func(names ...string) {
+		p.MockASG().On("DescribeAutoScalingGroups", mock.MatchedBy(func(input *autoscaling.DescribeAutoScalingGroupsInput) bool {
+			return Expect(aws.StringValueSlice(input.AutoScalingGroupNames)).To(Equal(names))
+		})).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+			AutoScalingGroups: []*autoscaling.Group{
+				{
+					Instances: []*autoscaling.Instance{
+						{InstanceId: aws.String("i-2"), LifecycleState: aws.String(autoscaling.LifecycleStateInService)},
+						{InstanceId: aws.String("i-3"), LifecycleState: aws.String(autoscaling.LifecycleStateTerminating)},
+					},
+				},
+				{
+					Instances: []*autoscaling.Instance{
+						{InstanceId: aws.String("i-1"), LifecycleState: aws.String(autoscaling.LifecycleStatePending)},
+					},
+				},
+			},
+		}, nil)
+	}
+
+	BeforeEach(func() {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		ng = cfg.NewNodeGroup()
+		ng.Name = "ng-1"
+		nodeGroupType = api.NodeGroupTypeUnmanaged
+
+		p = mockprovider.NewMockProvider()
+		sc = NewStackCollection(p, cfg)
+
+		p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(func(*cfn.DescribeStacksInput) *cfn.DescribeStacksOutput {
+			return &cfn.DescribeStacksOutput{Stacks: []*cfn.Stack{{
+				StackName: aws.String("eksctl-test-cluster-nodegroup-ng-1"),
+				Tags: []*cfn.Tag{
+					{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("ng-1")},
+					{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(string(nodeGroupType))},
+				},
+			}}}
+		}, nil)
+	})
+
+	It("returns the instances of the Auto Scaling group of an unmanaged nodegroup", func() {
+		p.MockCloudFormation().On("DescribeStackResource", mock.MatchedBy(func(input *cfn.DescribeStackResourceInput) bool {
+			return *input.LogicalResourceId == "NodeGroup"
+		})).Return(&cfn.DescribeStackResourceOutput{
+			StackResourceDetail: &cfn.StackResourceDetail{PhysicalResourceId: aws.String("asg-1")},
+		}, nil)
+		mockASGs("asg-1")
+
+		instanceIDs, err := sc.GetNodeGroupInstanceIDs(ng)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(instanceIDs).To(Equal([]string{"i-1", "i-2"}))
+	})
+
+	It("returns the instances of the Auto Scaling groups of a managed nodegroup", func() {
+		nodeGroupType = api.NodeGroupTypeManaged
+		p.MockEKS().On("DescribeNodegroup", mock.Anything).Return(&eks.DescribeNodegroupOutput{
+			Nodegroup: &eks.Nodegroup{
+				Resources: &eks.NodegroupResources{
+					AutoScalingGroups: []*eks.AutoScalingGroup{{Name: aws.String("eks-asg-1")}, {Name: aws.String("eks-asg-2")}},
+				},
+			},
+		}, nil)
+		mockASGs("eks-asg-1", "eks-asg-2")
+
+		instanceIDs, err := sc.GetNodeGroupInstanceIDs(ng)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(instanceIDs).To(Equal([]string{"i-1", "i-2"}))
+		p.MockCloudFormation().AssertNotCalled(GinkgoT(), "DescribeStackResource", mock.Anything)
+	})
+
+	It("fails when a managed nodegroup has no Auto Scaling group", func() {
+		nodeGroupType = api.NodeGroupTypeManaged
+		p.MockEKS().On("DescribeNodegroup", mock.Anything).Return(&eks.DescribeNodegroupOutput{Nodegroup: &eks.Nodegroup{}}, nil)
+
+		_, err := sc.GetNodeGroupInstanceIDs(ng)
+		Expect(err).To(MatchError(`nodegroup "ng-1" has no Auto Scaling group`))
+	})
+})
+
+var _ = Describe("StackCollection SetInstanceProtection", func() {
+	var (
+		ng *api.NodeGroup
+		p  *mockprovider.MockProvider
+		sc *StackCollection
+	)
+
+	BeforeEach(func() {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		ng = cfg.NewNodeGroup()
+		ng.Name = "ng-1"
+
+		p = mockprovider.NewMockProvider()
+		sc = NewStackCollection(p, cfg)
+
+		p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(&cfn.DescribeStacksOutput{Stacks: []*cfn.Stack{{
+			StackName: aws.String("eksctl-test-cluster-nodegroup-ng-1"),
+			Tags: []*cfn.Tag{
+				{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("ng-1")},
+				{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(string(api.NodeGroupTypeManaged))},
+			},
+		}}}, nil)
+		p.MockEKS().On("DescribeNodegroup", mock.Anything).Return(&eks.DescribeNodegroupOutput{
+			Nodegroup: &eks.Nodegroup{
+				Resources: &eks.NodegroupResources{
+					AutoScalingGroups: []*eks.AutoScalingGroup{{Name: aws.String("eks-asg-1")}, {Name: aws.String("eks-asg-2")}},
+				},
+			},
+		}, nil)
+		p.MockASG().On("DescribeAutoScalingGroups", mock.Anything).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+			AutoScalingGroups: []*autoscaling.Group{
+				{
+					AutoScalingGroupName: aws.String("eks-asg-1"),
+					Instances:            []*autoscaling.Instance{{InstanceId: aws.String("i-1")}, {InstanceId: aws.String("i-2")}},
+				},
+				{
+					AutoScalingGroupName: aws.String("eks-asg-2"),
+					Instances:            []*autoscaling.Instance{{InstanceId: aws.String("i-3")}},
+				},
+			},
+		}, nil)
+		p.MockASG().On("SetInstanceProtection", mock.Anything).Return(&autoscaling.SetInstanceProtectionOutput{}, nil)
+	})
+
+	It("protects the instances in each of their Auto Scaling groups", func() {
+		Expect(sc.SetInstanceProtection(ng, []string{"i-3", "i-1", "i-2"}, true)).To(Succeed())
+
+		var inputs []*autoscaling.SetInstanceProtectionInput
+		for _, call := range p.MockASG().Calls {
+			if call.Method == "SetInstanceProtection" {
+				inputs = append(inputs, call.Arguments.Get(0).(*autoscaling.SetInstanceProtectionInput))
+			}
+		}
+		Expect(inputs).To(Equal([]*autoscaling.SetInstanceProtectionInput{
+			{
+				AutoScalingGroupName: aws.String("eks-asg-1"),
+				InstanceIds:          aws.StringSlice([]string{"i-1", "i-2"}),
+				ProtectedFromScaleIn: aws.Bool(true),
+			},
+			{
+				AutoScalingGroupName: aws.String("eks-asg-2"),
+				InstanceIds:          aws.StringSlice([]string{"i-3"}),
+				ProtectedFromScaleIn: aws.Bool(true),
+			},
+		}))
+	})
+
+	It("fails without changing any instance when an instance is not part of the nodegroup", func() {
+		err := sc.SetInstanceProtection(ng, []string{"i-1", "i-4"}, false)
+		Expect(err).To(MatchError(`instance "i-4" is not part of nodegroup "ng-1"`))
+		p.MockASG().AssertNotCalled(GinkgoT(), "SetInstanceProtection", mock.Anything)
+	})
+})
@@ -0,0 +1,63 @@
+package manager
+
+import (
+	"regexp"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+const nodeGroupStackRegex = "^eksctl-.+-nodegroup-.+$"
+
+// ClusterNodeGroup identifies an eksctl-managed nodegroup and the cluster it belongs to
+type ClusterNodeGroup struct {
+	ClusterName   string
+	NodeGroupName string
+	Type          api.NodeGroupType
+	StackName     string
+}
+
+// ListAllNodeGroups returns the eksctl-managed nodegroups of all clusters in the region of the provider,
+// grouped by cluster name and sorted by nodegroup name. Stacks missing the cluster or nodegroup name tags
+// are ignored
+func ListAllNodeGroups(provider api.ClusterProvider) (map[string][]ClusterNodeGroup, error) {
+	re := regexp.MustCompile(nodeGroupStackRegex)
+
+	nodeGroups := map[string][]ClusterNodeGroup{}
+	pager := func(p *cfn.DescribeStacksOutput, _ bool) bool {
+		for _, s := range p.Stacks {
+			if !re.MatchString(aws.StringValue(s.StackName)) {
+				continue
+			}
+			clusterName, nodeGroupName := getClusterNameTag(s), GetNodegroupTagName(s.Tags)
+			if clusterName == "" || nodeGroupName == "" {
+				continue
+			}
+			nodeGroupType, err := GetNodeGroupType(s.Tags)
+			if err != nil {
+				continue
+			}
+			nodeGroups[clusterName] = append(nodeGroups[clusterName], ClusterNodeGroup{
+				ClusterName:   clusterName,
+				NodeGroupName: nodeGroupName,
+				Type:          nodeGroupType,
+				StackName:     aws.StringValue(s.StackName),
+			})
+		}
+		return true
+	}
+	if err := provider.CloudFormation().DescribeStacksPages(&cfn.DescribeStacksInput{}, pager); err != nil {
+		return nil, errors.Wrap(err, "listing nodegroup stacks")
+	}
+
+	for _, clusterNodeGroups := range nodeGroups {
+		sort.Slice(clusterNodeGroups, func(i, j int) bool {
+			return clusterNodeGroups[i].NodeGroupName < clusterNodeGroups[j].NodeGroupName
+		})
+	}
+	return nodeGroups, nil
+}
@@ -0,0 +1,79 @@
+package manager
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("ListAllNodeGroups", func() {
+	var p *mockprovider.MockProvider
+
+	nodeGroupStack := func(clusterName, nodeGroupName string, nodeGroupType api.NodeGroupType) *cfn.Stack {
+		return &cfn.Stack{
+			StackName: aws.String("eksctl-" + clusterName + "-nodegroup-" + nodeGroupName),
+			Tags: []*cfn.Tag{
+				{Key: aws.String(api.ClusterNameTag), Value: aws.String(clusterName)},
+				{Key: aws.String(api.NodeGroupNameTag), Value: aws.String(nodeGroupName)},
+				{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(string(nodeGroupType))},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+	})
+
+	It("returns the nodegroups of all clusters grouped by cluster", func() {
+		p.MockCloudFormation().On("DescribeStacksPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(p *cfn.DescribeStacksOutput, last bool) (shouldContinue bool))
+			consume(&cfn.DescribeStacksOutput{
+				Stacks: []*cfn.Stack{
+					nodeGroupStack("prod", "ng-2", api.NodeGroupTypeManaged),
+					{StackName: aws.String("eksctl-prod-cluster")},
+					nodeGroupStack("dev", "ng-1", api.NodeGroupTypeUnmanaged),
+				},
+			}, false)
+			consume(&cfn.DescribeStacksOutput{
+				Stacks: []*cfn.Stack{
+					nodeGroupStack("prod", "ng-1", api.NodeGroupTypeUnmanaged),
+					{StackName: aws.String("eksctl-dev-nodegroup-untagged")},
+					{
+						StackName: aws.String("eksctl-dev-nodegroup-no-cluster-tag"),
+						Tags:      []*cfn.Tag{{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("no-cluster-tag")}},
+					},
+					{
+						StackName: aws.String("eksctl-dev-nodegroup-no-nodegroup-tag"),
+						Tags:      []*cfn.Tag{{Key: aws.String(api.ClusterNameTag), Value: aws.String("dev")}},
+					},
+				},
+			}, true)
+		}).Return(nil)
+
+		nodeGroups, err := ListAllNodeGroups(p)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(nodeGroups).To(Equal(map[string][]ClusterNodeGroup{
+			"dev": {
+				{ClusterName: "dev", NodeGroupName: "ng-1", Type: api.NodeGroupTypeUnmanaged, StackName: "eksctl-dev-nodegroup-ng-1"},
+			},
+			"prod": {
+				{ClusterName: "prod", NodeGroupName: "ng-1", Type: api.NodeGroupTypeUnmanaged, StackName: "eksctl-prod-nodegroup-ng-1"},
+				{ClusterName: "prod", NodeGroupName: "ng-2", Type: api.NodeGroupTypeManaged, StackName: "eksctl-prod-nodegroup-ng-2"},
+			},
+		}))
+	})
+
+	It("returns an error when the stacks cannot be listed", func() {
+		p.MockCloudFormation().On("DescribeStacksPages", mock.Anything, mock.Anything).Return(errors.New("access denied"))
+
+		_, err := ListAllNodeGroups(p)
+		Expect(err).To(MatchError("listing nodegroup stacks: access denied"))
+	})
+})
@@ -0,0 +1,131 @@
+package manager
+
+import (
+	"fmt"
+	"regexp"
+
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/vpc"
+)
+
+// MigratedManagedNodeGroupName returns the name of the managed nodegroup that replaces the unmanaged nodegroup
+// ngName when migrating it. The names must differ as both nodegroups exist during the migration
+func MigratedManagedNodeGroupName(ngName string) string {
+	return ngName + "-managed"
+}
+
+// MigrateToManagedNodeGroup replaces the unmanaged nodegroup ng with an equivalent managed nodegroup, named
+// after MigratedManagedNodeGroupName, preserving its labels and scaling config. Taints cannot be preserved as
+// managed nodegroups do not support them, so nodegroups with taints are refused. Once the managed nodegroup is
+// created, the nodes of ng are cordoned and drained by drainNodeGroup and the stack of ng is deleted.
+// The migration can be resumed: an existing managed nodegroup stack is reused, and the drain is skipped when
+// the stack of ng no longer exists
+func (c *StackCollection) MigrateToManagedNodeGroup(ng *api.NodeGroup, drainNodeGroup func(*api.NodeGroup) error) error {
+	mng, err := c.managedNodeGroupFor(ng)
+	if err != nil {
+		return err
+	}
+
+	mngStack, err := c.findStack(c.makeNodeGroupStackName(mng.Name))
+	if err != nil {
+		return err
+	}
+	if mngStack == nil {
+		vpcImporter := vpc.NewStackConfigImporter(c.MakeClusterStackName())
+		taskTree := c.NewManagedNodeGroupTask([]*api.ManagedNodeGroup{mng}, false, vpcImporter)
+		logger.Info(taskTree.Describe())
+		if errs := taskTree.DoAllSync(); len(errs) > 0 {
+			return errors.Wrapf(errs[0], "creating managed nodegroup %q", mng.Name)
+		}
+	} else if err := c.waitForMigratedStack(mngStack); err != nil {
+		return err
+	}
+
+	ngStack, err := c.findStack(c.makeNodeGroupStackName(ng.Name))
+	if err != nil {
+		return err
+	}
+	if ngStack == nil {
+		logger.Info("stack of nodegroup %q no longer exists, migration to managed nodegroup %q is complete", ng.Name, mng.Name)
+		return nil
+	}
+
+	logger.Info("draining nodegroup %q", ng.Name)
+	if err := drainNodeGroup(ng); err != nil {
+		return errors.Wrapf(err, "draining nodegroup %q", ng.Name)
+	}
+
+	logger.Info("deleting stack %q", *ngStack.StackName)
+	deleted, err := c.DeleteStackBySpec(ngStack)
+	if err != nil {
+		return err
+	}
+	if err := c.doWaitUntilStackIsDeleted(deleted); err != nil {
+		return errors.Wrapf(err, "waiting for stack %q to be deleted", *ngStack.StackName)
+	}
+	logger.Success("migrated nodegroup %q to managed nodegroup %q", ng.Name, mng.Name)
+	return nil
+}
+
+// managedNodeGroupFor returns the managed nodegroup equivalent to ng, or an error when ng uses features that
+// managed nodegroups do not support
+func (c *StackCollection) managedNodeGroupFor(ng *api.NodeGroup) (*api.ManagedNodeGroup, error) {
+	switch {
+	case len(ng.Taints) > 0:
+		return nil, fmt.Errorf("cannot migrate nodegroup %q as managed nodegroups do not support taints", ng.Name)
+	case ng.InstancesDistribution != nil:
+		return nil, fmt.Errorf("cannot migrate nodegroup %q as managed nodegroups do not support instancesDistribution", ng.Name)
+	case api.IsWindowsImage(ng.AMIFamily):
+		return nil, fmt.Errorf("cannot migrate nodegroup %q as managed nodegroups do not support %s", ng.Name, ng.AMIFamily)
+	}
+
+	// the defaults of the managed nodegroup must not change the labels, tags and other fields of ng
+	base := ng.NodeGroupBase.DeepCopy()
+	base.Name = MigratedManagedNodeGroupName(ng.Name)
+	if ng.SecurityGroups != nil {
+		// managed nodegroups use the cluster security group instead of the local and shared ones
+		base.SecurityGroups = &api.NodeGroupSGs{
+			AttachIDs:  ng.SecurityGroups.AttachIDs,
+			WithLocal:  api.Disabled(),
+			WithShared: api.Disabled(),
+		}
+	}
+	delete(base.Tags, api.OldNodeGroupNameTag)
+
+	mng := &api.ManagedNodeGroup{NodeGroupBase: base}
+	api.SetManagedNodeGroupDefaults(mng, c.spec.Metadata)
+	if err := api.ValidateManagedNodeGroup(mng, 0); err != nil {
+		return nil, errors.Wrapf(err, "cannot migrate nodegroup %q to a managed nodegroup", ng.Name)
+	}
+	return mng, nil
+}
+
+// findStack returns the non-deleted stack named name, or nil if there is none
+func (c *StackCollection) findStack(name string) (*Stack, error) {
+	stacks, err := c.ListStacksMatching(fmt.Sprintf("^%s$", regexp.QuoteMeta(name)))
+	if err != nil {
+		return nil, errors.Wrapf(err, "describing stack %q", name)
+	}
+	if len(stacks) == 0 {
+		return nil, nil
+	}
+	return stacks[0], nil
+}
+
+// waitForMigratedStack waits for a managed nodegroup stack created by a previous migration to be created
+func (c *StackCollection) waitForMigratedStack(s *Stack) error {
+	switch *s.StackStatus {
+	case cfn.StackStatusCreateComplete, cfn.StackStatusUpdateComplete:
+		logger.Info("stack %q already exists, skipping its creation", *s.StackName)
+		return nil
+	case cfn.StackStatusCreateInProgress:
+		logger.Info("waiting for stack %q to be created", *s.StackName)
+		return c.DoWaitUntilStackIsCreated(s)
+	default:
+		return fmt.Errorf("stack %q of a previous migration is in status %s; delete it before migrating again", *s.StackName, *s.StackStatus)
+	}
+}
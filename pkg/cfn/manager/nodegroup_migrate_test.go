@@ -0,0 +1,166 @@
+package manager
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/awstesting"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("StackCollection MigrateToManagedNodeGroup", func() {
+	const (
+		ngStackName  = "eksctl-test-cluster-nodegroup-ng-1"
+		mngStackName = "eksctl-test-cluster-nodegroup-ng-1-managed"
+	)
+
+	var (
+		p                 *mockprovider.MockProvider
+		sc                *StackCollection
+		ng                *api.NodeGroup
+		stackStatus       map[string]string
+		drainedNodeGroups []string
+	)
+
+	drain := func(ng *api.NodeGroup) error {
+		drainedNodeGroups = append(drainedNodeGroups, ng.Name)
+		return nil
+	}
+
+	deletedStacks := func() []string {
+		var names []string
+		for _, call := range p.MockCloudFormation().Calls {
+			if call.Method == "DeleteStack" {
+				names = append(names, *call.Arguments.Get(0).(*cfn.DeleteStackInput).StackName)
+			}
+		}
+		return names
+	}
+
+	BeforeEach(func() {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		p = mockprovider.NewMockProvider()
+		sc = NewStackCollection(p, cfg)
+
+		ng = api.NewNodeGroup()
+		ng.Name = "ng-1"
+		ng.Labels = map[string]string{"tier": "backend"}
+		drainedNodeGroups = nil
+		stackStatus = map[string]string{
+			ngStackName:  cfn.StackStatusCreateComplete,
+			mngStackName: cfn.StackStatusCreateComplete,
+		}
+
+		p.MockCloudFormation().On("ListStacksPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(p *cfn.ListStacksOutput, last bool) (shouldContinue bool))
+			out := &cfn.ListStacksOutput{}
+			for name := range stackStatus {
+				out.StackSummaries = append(out.StackSummaries, &cfn.StackSummary{StackName: aws.String(name), StackId: aws.String(name + "-id")})
+			}
+			consume(out, true)
+		}).Return(nil)
+		p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(func(input *cfn.DescribeStacksInput) *cfn.DescribeStacksOutput {
+			name := *input.StackName
+			if len(name) > len("-id") && name[len(name)-len("-id"):] == "-id" {
+				name = name[:len(name)-len("-id")]
+			}
+			return &cfn.DescribeStacksOutput{Stacks: []*cfn.Stack{{
+				StackName:   aws.String(name),
+				StackId:     aws.String(name + "-id"),
+				StackStatus: aws.String(stackStatus[name]),
+				Tags:        []*cfn.Tag{{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")}},
+			}}}
+		}, nil)
+		p.MockCloudFormation().On("DescribeStacksRequest", mock.Anything).Return(func(input *cfn.DescribeStacksInput) *request.Request {
+			deleted := &cfn.DescribeStacksOutput{
+				Stacks: []*cfn.Stack{{StackName: input.StackName, StackStatus: aws.String(cfn.StackStatusDeleteComplete)}},
+			}
+			return awstesting.NewClient(nil).NewRequest(&request.Operation{Name: "Operation"}, nil, deleted)
+		}, nil)
+		p.MockCloudFormation().On("DeleteStack", mock.Anything).Return(&cfn.DeleteStackOutput{}, nil)
+	})
+
+	It("resumes a migration whose managed nodegroup stack already exists", func() {
+		Expect(sc.MigrateToManagedNodeGroup(ng, drain)).To(Succeed())
+		Expect(drainedNodeGroups).To(Equal([]string{"ng-1"}))
+		Expect(deletedStacks()).To(Equal([]string{ngStackName + "-id"}))
+		p.MockCloudFormation().AssertNotCalled(GinkgoT(), "CreateStack", mock.Anything)
+	})
+
+	It("creates the managed nodegroup without changing the unmanaged one", func() {
+		delete(stackStatus, mngStackName)
+		api.SetNodeGroupDefaults(ng, sc.spec.Metadata)
+		ng.Tags = map[string]string{"team": "a", api.OldNodeGroupNameTag: "ng-1"}
+		p.MockCloudFormation().On("CreateStack", mock.Anything).Return(nil, fmt.Errorf("stop after the stack creation"))
+
+		err := sc.MigrateToManagedNodeGroup(ng, drain)
+		Expect(err).To(MatchError(ContainSubstring(`creating managed nodegroup "ng-1-managed"`)))
+
+		var createStackInput *cfn.CreateStackInput
+		for _, call := range p.MockCloudFormation().Calls {
+			if call.Method == "CreateStack" {
+				createStackInput = call.Arguments.Get(0).(*cfn.CreateStackInput)
+			}
+		}
+		Expect(createStackInput).NotTo(BeNil())
+		Expect(*createStackInput.StackName).To(Equal(mngStackName))
+		var template struct {
+			Resources struct {
+				ManagedNodeGroup struct {
+					Properties struct {
+						Labels map[string]string
+					}
+				}
+			}
+		}
+		Expect(json.Unmarshal([]byte(*createStackInput.TemplateBody), &template)).To(Succeed())
+		Expect(template.Resources.ManagedNodeGroup.Properties.Labels).To(HaveKeyWithValue(api.NodeGroupNameLabel, "ng-1-managed"))
+
+		Expect(ng.Name).To(Equal("ng-1"))
+		Expect(ng.Labels).To(HaveKeyWithValue(api.NodeGroupNameLabel, "ng-1"))
+		Expect(ng.Tags).To(Equal(map[string]string{"team": "a", api.OldNodeGroupNameTag: "ng-1"}))
+		Expect(*ng.SecurityGroups.WithLocal).To(BeTrue())
+		Expect(drainedNodeGroups).To(BeEmpty())
+	})
+
+	It("does nothing once the stack of the unmanaged nodegroup is deleted", func() {
+		delete(stackStatus, ngStackName)
+
+		Expect(sc.MigrateToManagedNodeGroup(ng, drain)).To(Succeed())
+		Expect(drainedNodeGroups).To(BeEmpty())
+		Expect(deletedStacks()).To(BeEmpty())
+	})
+
+	It("does not delete the unmanaged nodegroup when draining fails", func() {
+		err := sc.MigrateToManagedNodeGroup(ng, func(*api.NodeGroup) error {
+			return fmt.Errorf("pods cannot be evicted")
+		})
+		Expect(err).To(MatchError(`draining nodegroup "ng-1": pods cannot be evicted`))
+		Expect(deletedStacks()).To(BeEmpty())
+	})
+
+	It("refuses to reuse a managed nodegroup stack that failed to be created", func() {
+		stackStatus[mngStackName] = cfn.StackStatusRollbackComplete
+
+		err := sc.MigrateToManagedNodeGroup(ng, drain)
+		Expect(err).To(MatchError(ContainSubstring(`stack "eksctl-test-cluster-nodegroup-ng-1-managed" of a previous migration is in status ROLLBACK_COMPLETE`)))
+		Expect(drainedNodeGroups).To(BeEmpty())
+	})
+
+	It("refuses to migrate nodegroups with taints", func() {
+		ng.Taints = []api.NodeGroupTaint{{Key: "dedicated", Value: "gpu", Effect: "NoSchedule"}}
+
+		err := sc.MigrateToManagedNodeGroup(ng, drain)
+		Expect(err).To(MatchError(`cannot migrate nodegroup "ng-1" as managed nodegroups do not support taints`))
+		p.MockCloudFormation().AssertNotCalled(GinkgoT(), "ListStacksPages", mock.Anything, mock.Anything)
+	})
+})
@@ -0,0 +1,80 @@
+package manager
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/vpc"
+)
+
+// CheckNodeGroupInstanceTypeOfferings checks that the instance types of the nodegroup are still offered in
+// all availability zones of its Auto Scaling groups, as scaling a nodegroup whose instance type was retired
+// only fails once the stack update is executed
+func (c *StackCollection) CheckNodeGroupInstanceTypeOfferings(ng *api.NodeGroup) error {
+	stack, err := c.DescribeNodeGroupStack(ng.Name)
+	if err != nil {
+		return errors.Wrapf(err, "describing stack of nodegroup %q", ng.Name)
+	}
+	template, err := c.GetStackTemplate(*stack.StackName)
+	if err != nil {
+		return errors.Wrapf(err, "error getting CloudFormation template for stack %s", *stack.StackName)
+	}
+
+	nodeGroupType, err := GetNodeGroupTypeFromTemplate(stack.Tags, template)
+	if err != nil {
+		return err
+	}
+	ngPaths, err := nodeGroupPathsForType(nodeGroupType)
+	if err != nil {
+		return err
+	}
+	instanceTypes := templateInstanceTypes(template, ngPaths)
+	if instanceTypes.Len() == 0 {
+		// the instance type is set in a launch template eksctl did not create
+		return nil
+	}
+
+	asgNames, err := c.getAutoScalingGroupName(stack, nodeGroupType)
+	if err != nil {
+		return errors.Wrapf(err, "getting Auto Scaling group of nodegroup %q", ng.Name)
+	}
+	if asgNames == "" {
+		return nil
+	}
+	asgs, err := c.asgAPI.DescribeAutoScalingGroups(&autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: aws.StringSlice(strings.Split(asgNames, ",")),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "describing Auto Scaling group(s) %q", asgNames)
+	}
+	zones := sets.NewString()
+	for _, asg := range asgs.AutoScalingGroups {
+		zones.Insert(aws.StringValueSlice(asg.AvailabilityZones)...)
+	}
+	if zones.Len() == 0 {
+		return nil
+	}
+
+	offered, err := vpc.DescribeInstanceTypeOfferings(c.ec2API, instanceTypes.List(), zones.List())
+	if err != nil {
+		return err
+	}
+
+	var missing []string
+	for _, instanceType := range instanceTypes.List() {
+		if missingZones := zones.Difference(offered[instanceType]); missingZones.Len() > 0 {
+			missing = append(missing, fmt.Sprintf("%s in %s", instanceType, strings.Join(missingZones.List(), ", ")))
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("instance types of nodegroup %q are not offered in some of its availability zones: %s",
+			ng.Name, strings.Join(missing, "; "))
+	}
+	return nil
+}
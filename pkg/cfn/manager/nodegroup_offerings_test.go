@@ -0,0 +1,94 @@
+package manager
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("StackCollection CheckNodeGroupInstanceTypeOfferings", func() {
+	var (
+		ng *api.NodeGroup
+		p  *mockprovider.MockProvider
+		sc *StackCollection
+	)
+
+	mockOfferings := func(offerings map[string][]string) {
+		p.MockEC2().On("DescribeInstanceTypeOfferingsPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(p *ec2.DescribeInstanceTypeOfferingsOutput, last bool) (shouldContinue bool))
+			out := &ec2.DescribeInstanceTypeOfferingsOutput{}
+			for instanceType, zones := range offerings {
+				for _, zone := range zones {
+					out.InstanceTypeOfferings = append(out.InstanceTypeOfferings, &ec2.InstanceTypeOffering{
+						InstanceType: aws.String(instanceType),
+						Location:     aws.String(zone),
+						LocationType: aws.String(ec2.LocationTypeAvailabilityZone),
+					})
+				}
+			}
+			consume(out, true)
+		}).Return(nil)
+	}
+
+	BeforeEach(func() {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		ng = cfg.NewNodeGroup()
+		ng.Name = "ng-1"
+
+		p = mockprovider.NewMockProvider()
+		sc = NewStackCollection(p, cfg)
+
+		p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(&cfn.DescribeStacksOutput{
+			Stacks: []*cfn.Stack{
+				{
+					StackName:   aws.String("eksctl-test-cluster-nodegroup-ng-1"),
+					StackStatus: aws.String(cfn.StackStatusCreateComplete),
+					Tags: []*cfn.Tag{
+						{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("ng-1")},
+						{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(string(api.NodeGroupTypeUnmanaged))},
+					},
+				},
+			},
+		}, nil)
+		p.MockCloudFormation().On("GetTemplate", mock.Anything).Return(&cfn.GetTemplateOutput{
+			TemplateBody: aws.String(`{"Resources": {"NodeGroup": {"Properties": {"MixedInstancesPolicy": {"LaunchTemplate": {"Overrides": [{"InstanceType": "m5.large"}, {"InstanceType": "m4.large"}]}}}}}}`),
+		}, nil)
+		p.MockCloudFormation().On("DescribeStackResource", mock.Anything).Return(&cfn.DescribeStackResourceOutput{
+			StackResourceDetail: &cfn.StackResourceDetail{PhysicalResourceId: aws.String("asg-ng-1")},
+		}, nil)
+		p.MockASG().On("DescribeAutoScalingGroups", &autoscaling.DescribeAutoScalingGroupsInput{
+			AutoScalingGroupNames: aws.StringSlice([]string{"asg-ng-1"}),
+		}).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+			AutoScalingGroups: []*autoscaling.Group{
+				{AvailabilityZones: aws.StringSlice([]string{"us-west-2a", "us-west-2b"})},
+			},
+		}, nil)
+	})
+
+	It("succeeds when all instance types are offered in all availability zones", func() {
+		mockOfferings(map[string][]string{
+			"m5.large": {"us-west-2a", "us-west-2b"},
+			"m4.large": {"us-west-2a", "us-west-2b"},
+		})
+
+		Expect(sc.CheckNodeGroupInstanceTypeOfferings(ng)).To(Succeed())
+	})
+
+	It("names the availability zones an instance type is not offered in", func() {
+		mockOfferings(map[string][]string{
+			"m5.large": {"us-west-2a", "us-west-2b"},
+			"m4.large": {"us-west-2a"},
+		})
+
+		err := sc.CheckNodeGroupInstanceTypeOfferings(ng)
+		Expect(err).To(MatchError(`instance types of nodegroup "ng-1" are not offered in some of its availability zones: m4.large in us-west-2b`))
+	})
+})
@@ -0,0 +1,142 @@
+package manager
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	"github.com/weaveworks/eksctl/pkg/ami"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// eksOptimizedAMINamePrefixes maps the name prefixes of the EKS-optimized AMIs to their AMI family
+var eksOptimizedAMINamePrefixes = map[string]string{
+	"amazon-eks-node-":       api.NodeImageFamilyAmazonLinux2,
+	"amazon-eks-gpu-node-":   api.NodeImageFamilyAmazonLinux2,
+	"amazon-eks-arm64-node-": api.NodeImageFamilyAmazonLinux2,
+	"bottlerocket-aws-k8s-":  api.NodeImageFamilyBottlerocket,
+
+	"Windows_Server-2019-English-Core-EKS_Optimized-": api.NodeImageFamilyWindowsServer2019CoreContainer,
+	"Windows_Server-2019-English-Full-EKS_Optimized-": api.NodeImageFamilyWindowsServer2019FullContainer,
+	"Windows_Server-2004-English-Core-EKS_Optimized-": api.NodeImageFamilyWindowsServer2004CoreContainer,
+}
+
+// SetNodeGroupsOutdated sets Outdated on each summary, comparing the AMI of the nodegroup with the latest
+// EKS-optimized AMI of its AMI family for the Kubernetes version of the cluster, as recommended in the SSM
+// parameter store. Outdated is left nil when it cannot be known, e.g. for custom AMIs
+func (c *StackCollection) SetNodeGroupsOutdated(summaries []*NodeGroupSummary) error {
+	cluster, err := c.eksAPI.DescribeCluster(&eks.DescribeClusterInput{
+		Name: aws.String(c.spec.Metadata.Name),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "describing cluster %q", c.spec.Metadata.Name)
+	}
+	version := aws.StringValue(cluster.Cluster.Version)
+
+	images, err := c.describeNodeGroupImages(summaries)
+	if err != nil {
+		return err
+	}
+
+	latestImageIDs := map[string]string{}
+	for _, summary := range summaries {
+		summary.Outdated = nil
+		image, ok := images[summary.ImageID]
+		if !ok {
+			continue
+		}
+		family := eksOptimizedAMIFamily(aws.StringValue(image.Name))
+		if family == "" {
+			logger.Debug("AMI %q of nodegroup %q is not an EKS-optimized AMI", summary.ImageID, summary.Name)
+			continue
+		}
+
+		parameterName, err := ami.MakeSSMParameterName(version, summary.InstanceType, family)
+		if err != nil {
+			logger.Debug("cannot find the latest AMI of nodegroup %q: %v", summary.Name, err)
+			continue
+		}
+		latestImageID, ok := latestImageIDs[parameterName]
+		if !ok {
+			latestImageID, err = ami.NewSSMResolver(c.ssmAPI).Resolve(c.region, version, summary.InstanceType, family)
+			if err != nil {
+				return errors.Wrapf(err, "resolving the latest AMI of nodegroup %q", summary.Name)
+			}
+			latestImageIDs[parameterName] = latestImageID
+		}
+		summary.Outdated = aws.Bool(summary.ImageID != latestImageID)
+	}
+	return nil
+}
+
+// describeNodeGroupImages describes the AMIs of the nodegroups, keyed by image ID. Nodegroups whose image ID
+// is not an AMI ID, e.g. the AMI type of a managed nodegroup without a stack, are skipped. As a single AMI that
+// was deregistered or is no longer shared fails the whole call, the AMIs are then described one at a time and
+// the ones that cannot be described are left out
+func (c *StackCollection) describeNodeGroupImages(summaries []*NodeGroupSummary) (map[string]*ec2.Image, error) {
+	var imageIDs []string
+	seen := map[string]bool{}
+	for _, summary := range summaries {
+		if strings.HasPrefix(summary.ImageID, "ami-") && !seen[summary.ImageID] {
+			imageIDs = append(imageIDs, summary.ImageID)
+			seen[summary.ImageID] = true
+		}
+	}
+
+	images := map[string]*ec2.Image{}
+	if len(imageIDs) == 0 {
+		return images, nil
+	}
+	output, err := c.ec2API.DescribeImages(&ec2.DescribeImagesInput{
+		ImageIds: aws.StringSlice(imageIDs),
+	})
+	if err == nil {
+		for _, image := range output.Images {
+			images[aws.StringValue(image.ImageId)] = image
+		}
+		return images, nil
+	}
+	if !isInvalidAMIIDError(err) {
+		return nil, errors.Wrapf(err, "describing AMIs %v", imageIDs)
+	}
+
+	logger.Debug("describing AMIs %v one at a time: %v", imageIDs, err)
+	for _, imageID := range imageIDs {
+		output, err := c.ec2API.DescribeImages(&ec2.DescribeImagesInput{
+			ImageIds: aws.StringSlice([]string{imageID}),
+		})
+		if err != nil {
+			if isInvalidAMIIDError(err) {
+				logger.Debug("cannot describe AMI %q: %v", imageID, err)
+				continue
+			}
+			return nil, errors.Wrapf(err, "describing AMI %q", imageID)
+		}
+		for _, image := range output.Images {
+			images[aws.StringValue(image.ImageId)] = image
+		}
+	}
+	return images, nil
+}
+
+// isInvalidAMIIDError reports whether the error is one of the errors EC2 returns for AMIs that do not exist,
+// are not available or are malformed
+func isInvalidAMIIDError(err error) bool {
+	awsErr, ok := errors.Cause(err).(awserr.Error)
+	return ok && strings.HasPrefix(awsErr.Code(), "InvalidAMIID.")
+}
+
+// eksOptimizedAMIFamily returns the AMI family of an EKS-optimized AMI, or an empty string for other AMIs
+func eksOptimizedAMIFamily(imageName string) string {
+	for prefix, family := range eksOptimizedAMINamePrefixes {
+		if strings.HasPrefix(imageName, prefix) {
+			return family
+		}
+	}
+	return ""
+}
@@ -0,0 +1,104 @@
+package manager
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("StackCollection SetNodeGroupsOutdated", func() {
+	var (
+		p  *mockprovider.MockProvider
+		sc *StackCollection
+	)
+
+	BeforeEach(func() {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		p = mockprovider.NewMockProvider()
+		sc = NewStackCollection(p, cfg)
+
+		p.MockEKS().On("DescribeCluster", mock.Anything).Return(&eks.DescribeClusterOutput{
+			Cluster: &eks.Cluster{Name: aws.String("test-cluster"), Version: aws.String("1.19")},
+		}, nil)
+		images := map[string]*ec2.Image{
+			"ami-old":    {ImageId: aws.String("ami-old"), Name: aws.String("amazon-eks-node-1.19-v20210302")},
+			"ami-latest": {ImageId: aws.String("ami-latest"), Name: aws.String("amazon-eks-node-1.19-v20210322")},
+			"ami-custom": {ImageId: aws.String("ami-custom"), Name: aws.String("my-hardened-image")},
+		}
+		// like EC2, fail the whole call when one of the AMIs does not exist
+		p.MockEC2().On("DescribeImages", mock.Anything).Return(func(input *ec2.DescribeImagesInput) *ec2.DescribeImagesOutput {
+			output := &ec2.DescribeImagesOutput{}
+			for _, imageID := range aws.StringValueSlice(input.ImageIds) {
+				if images[imageID] == nil {
+					return nil
+				}
+				output.Images = append(output.Images, images[imageID])
+			}
+			return output
+		}, func(input *ec2.DescribeImagesInput) error {
+			for _, imageID := range aws.StringValueSlice(input.ImageIds) {
+				if images[imageID] == nil {
+					return awserr.New("InvalidAMIID.NotFound", fmt.Sprintf("The image id '[%s]' does not exist", imageID), nil)
+				}
+			}
+			return nil
+		})
+		p.MockSSM().On("GetParameter", &ssm.GetParameterInput{
+			Name: aws.String("/aws/service/eks/optimized-ami/1.19/amazon-linux-2/recommended/image_id"),
+		}).Return(&ssm.GetParameterOutput{Parameter: &ssm.Parameter{Value: aws.String("ami-latest")}}, nil)
+	})
+
+	It("marks nodegroups running an older EKS-optimized AMI as outdated", func() {
+		summaries := []*NodeGroupSummary{
+			{Name: "old", ImageID: "ami-old", InstanceType: "m5.large"},
+			{Name: "latest", ImageID: "ami-latest", InstanceType: "m5.large"},
+		}
+		Expect(sc.SetNodeGroupsOutdated(summaries)).To(Succeed())
+		Expect(summaries[0].Outdated).To(Equal(aws.Bool(true)))
+		Expect(summaries[1].Outdated).To(Equal(aws.Bool(false)))
+		Expect(p.MockSSM().AssertNumberOfCalls(GinkgoT(), "GetParameter", 1)).To(BeTrue())
+	})
+
+	It("leaves the status of custom AMIs and unknown image IDs unknown", func() {
+		summaries := []*NodeGroupSummary{
+			{Name: "custom", ImageID: "ami-custom", InstanceType: "m5.large"},
+			{Name: "managed", ImageID: "AL2_x86_64", InstanceType: "m5.large"},
+		}
+		Expect(sc.SetNodeGroupsOutdated(summaries)).To(Succeed())
+		Expect(summaries[0].Outdated).To(BeNil())
+		Expect(summaries[1].Outdated).To(BeNil())
+		p.MockSSM().AssertNotCalled(GinkgoT(), "GetParameter", mock.Anything)
+	})
+	It("describes the AMIs one at a time when one of them does not exist", func() {
+		summaries := []*NodeGroupSummary{
+			{Name: "old", ImageID: "ami-old", InstanceType: "m5.large"},
+			{Name: "deregistered", ImageID: "ami-deregistered", InstanceType: "m5.large"},
+			{Name: "latest", ImageID: "ami-latest", InstanceType: "m5.large"},
+		}
+		Expect(sc.SetNodeGroupsOutdated(summaries)).To(Succeed())
+		Expect(summaries[0].Outdated).To(Equal(aws.Bool(true)))
+		Expect(summaries[1].Outdated).To(BeNil())
+		Expect(summaries[2].Outdated).To(Equal(aws.Bool(false)))
+		Expect(p.MockEC2().AssertNumberOfCalls(GinkgoT(), "DescribeImages", 4)).To(BeTrue())
+	})
+
+	It("fails on other errors describing the AMIs", func() {
+		p.MockEC2().ExpectedCalls = nil
+		p.MockEC2().On("DescribeImages", mock.Anything).Return(nil, awserr.New("UnauthorizedOperation", "not authorized", nil))
+		summaries := []*NodeGroupSummary{
+			{Name: "old", ImageID: "ami-old", InstanceType: "m5.large"},
+		}
+		Expect(sc.SetNodeGroupsOutdated(summaries)).To(MatchError(ContainSubstring("UnauthorizedOperation")))
+	})
+})
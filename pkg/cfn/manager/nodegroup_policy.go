@@ -0,0 +1,164 @@
+package manager
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/pkg/errors"
+	"github.com/tidwall/gjson"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+const (
+	stackPolicyEffectAllow = "Allow"
+	stackPolicyEffectDeny  = "Deny"
+
+	stackPolicyActionUpdateAll     = "Update:*"
+	stackPolicyActionUpdateReplace = "Update:Replace"
+)
+
+// stackPolicy is a CloudFormation stack policy, see
+// https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/protect-stack-resources.html
+type stackPolicy struct {
+	Statement []stackPolicyStatement
+}
+
+type stackPolicyStatement struct {
+	Effect    string
+	Action    string
+	Principal string
+	Resource  []string
+}
+
+// nodeGroupResources are the logical IDs of the resources replacing which replaces the nodes of a nodegroup
+var nodeGroupResources = []string{"LogicalResourceId/NodeGroup", "LogicalResourceId/ManagedNodeGroup"}
+
+// SetNodeGroupStackPolicy sets the stack policy of the nodegroup's stack. Unless allowReplace is set, the policy
+// denies updates replacing the Auto Scaling group or managed nodegroup, so that a change replacing all nodes at once
+// fails instead. Stack policies cannot be removed, so allowing replacement sets a policy allowing all updates
+func (c *StackCollection) SetNodeGroupStackPolicy(ng *api.NodeGroup, allowReplace bool) error {
+	policy := stackPolicy{
+		Statement: []stackPolicyStatement{
+			{Effect: stackPolicyEffectAllow, Action: stackPolicyActionUpdateAll, Principal: "*", Resource: []string{"*"}},
+		},
+	}
+	if !allowReplace {
+		policy.Statement = append(policy.Statement, stackPolicyStatement{
+			Effect: stackPolicyEffectDeny, Action: stackPolicyActionUpdateReplace, Principal: "*", Resource: nodeGroupResources,
+		})
+	}
+	body, err := json.Marshal(policy)
+	if err != nil {
+		return errors.Wrap(err, "marshalling stack policy")
+	}
+	return c.SetNodeGroupStackPolicyBody(ng, string(body))
+}
+
+// SetNodeGroupStackPolicyBody sets the stack policy of the nodegroup's stack to the given policy, e.g. to restore
+// a policy read with GetNodeGroupStackPolicy as it was
+func (c *StackCollection) SetNodeGroupStackPolicyBody(ng *api.NodeGroup, policyBody string) error {
+	name := c.makeNodeGroupStackName(ng.Name)
+	if _, err := c.cloudformationAPI.SetStackPolicy(&cfn.SetStackPolicyInput{
+		StackName:       aws.String(name),
+		StackPolicyBody: aws.String(policyBody),
+	}); err != nil {
+		return errors.Wrapf(err, "setting stack policy of stack %q", name)
+	}
+	return nil
+}
+
+// GetNodeGroupStackPolicy returns the stack policy of the nodegroup's stack, or an empty string when the stack
+// has no policy
+func (c *StackCollection) GetNodeGroupStackPolicy(ng *api.NodeGroup) (string, error) {
+	name := c.makeNodeGroupStackName(ng.Name)
+	output, err := c.cloudformationAPI.GetStackPolicy(&cfn.GetStackPolicyInput{
+		StackName: aws.String(name),
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "getting stack policy of stack %q", name)
+	}
+	return aws.StringValue(output.StackPolicyBody), nil
+}
+
+// nodeGroupReplacementCheck returns a check for updateStackWithCheck failing when the changes replace the
+// nodegroup while its stack policy protects it from replacement, so that the update is not started only to
+// be rolled back by CloudFormation. The policy is only read when the changes replace the nodegroup
+func (c *StackCollection) nodeGroupReplacementCheck(ng *api.NodeGroup) func([]StackChange) error {
+	return func(changes []StackChange) error {
+		for _, change := range changes {
+			if !change.Replacement || !isNodeGroupResource(change.LogicalResourceID) {
+				continue
+			}
+			protected, err := c.IsNodeGroupProtectedFromReplacement(ng)
+			if err != nil {
+				return err
+			}
+			if protected {
+				return fmt.Errorf("the update would replace resource %q of nodegroup %q, which the stack policy of the nodegroup protects from replacement", change.LogicalResourceID, ng.Name)
+			}
+			return nil
+		}
+		return nil
+	}
+}
+
+func isNodeGroupResource(logicalID string) bool {
+	for _, resource := range nodeGroupResources {
+		if resource == "LogicalResourceId/"+logicalID {
+			return true
+		}
+	}
+	return false
+}
+
+// IsNodeGroupProtectedFromReplacement returns true when the stack policy of the nodegroup's stack denies
+// replacing the nodegroup, stacks without a policy are not protected
+func (c *StackCollection) IsNodeGroupProtectedFromReplacement(ng *api.NodeGroup) (bool, error) {
+	policyBody, err := c.GetNodeGroupStackPolicy(ng)
+	if err != nil {
+		return false, err
+	}
+	return IsNodeGroupProtectedByStackPolicy(policyBody), nil
+}
+
+// IsNodeGroupProtectedByStackPolicy returns true when a statement of the stack policy denies replacing the
+// Auto Scaling group or managed nodegroup of a nodegroup stack. The actions, resources and not resources of a
+// statement may be a single string or a list, and may contain wildcards
+func IsNodeGroupProtectedByStackPolicy(policyBody string) bool {
+	protected := false
+	gjson.Get(policyBody, "Statement").ForEach(func(_, statement gjson.Result) bool {
+		if statement.Get("Effect").String() != stackPolicyEffectDeny {
+			return true
+		}
+		if !matchesAnyStackPolicyPattern(statement.Get("Action").Array(), stackPolicyActionUpdateReplace) {
+			return true
+		}
+		for _, resource := range nodeGroupResources {
+			if resources := statement.Get("Resource"); resources.Exists() {
+				protected = matchesAnyStackPolicyPattern(resources.Array(), resource)
+			} else {
+				protected = !matchesAnyStackPolicyPattern(statement.Get("NotResource").Array(), resource)
+			}
+			if protected {
+				return false
+			}
+		}
+		return true
+	})
+	return protected
+}
+
+func matchesAnyStackPolicyPattern(patterns []gjson.Result, value string) bool {
+	for _, pattern := range patterns {
+		expr := "^" + strings.ReplaceAll(regexp.QuoteMeta(pattern.String()), `\*`, ".*") + "$"
+		if regexp.MustCompile(expr).MatchString(value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,126 @@
+package manager
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("StackCollection nodegroup stack policy", func() {
+	const stackName = "eksctl-test-cluster-nodegroup-ng-1"
+
+	var (
+		p  *mockprovider.MockProvider
+		sc *StackCollection
+		ng *api.NodeGroup
+	)
+
+	BeforeEach(func() {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		ng = cfg.NewNodeGroup()
+		ng.Name = "ng-1"
+		p = mockprovider.NewMockProvider()
+		sc = NewStackCollection(p, cfg)
+	})
+
+	setStackPolicyBody := func() string {
+		input := p.MockCloudFormation().Calls[0].Arguments.Get(0).(*cfn.SetStackPolicyInput)
+		Expect(*input.StackName).To(Equal(stackName))
+		return *input.StackPolicyBody
+	}
+
+	It("denies replacing the nodegroup resources", func() {
+		p.MockCloudFormation().On("SetStackPolicy", mock.Anything).Return(&cfn.SetStackPolicyOutput{}, nil)
+
+		Expect(sc.SetNodeGroupStackPolicy(ng, false)).To(Succeed())
+		Expect(setStackPolicyBody()).To(MatchJSON(`{"Statement": [
+			{"Effect": "Allow", "Action": "Update:*", "Principal": "*", "Resource": ["*"]},
+			{"Effect": "Deny", "Action": "Update:Replace", "Principal": "*", "Resource": ["LogicalResourceId/NodeGroup", "LogicalResourceId/ManagedNodeGroup"]}
+		]}`))
+	})
+
+	It("allows all updates when replacement is allowed", func() {
+		p.MockCloudFormation().On("SetStackPolicy", mock.Anything).Return(&cfn.SetStackPolicyOutput{}, nil)
+
+		Expect(sc.SetNodeGroupStackPolicy(ng, true)).To(Succeed())
+		Expect(setStackPolicyBody()).To(MatchJSON(`{"Statement": [
+			{"Effect": "Allow", "Action": "Update:*", "Principal": "*", "Resource": ["*"]}
+		]}`))
+	})
+
+	DescribeTable("detects protection from replacement",
+		func(policyBody *string, expected bool) {
+			p.MockCloudFormation().On("GetStackPolicy", &cfn.GetStackPolicyInput{
+				StackName: aws.String(stackName),
+			}).Return(&cfn.GetStackPolicyOutput{StackPolicyBody: policyBody}, nil)
+
+			protected, err := sc.IsNodeGroupProtectedFromReplacement(ng)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(protected).To(Equal(expected))
+		},
+		Entry("without a policy", nil, false),
+		Entry("with a policy allowing all updates", aws.String(`{"Statement": [{"Effect": "Allow", "Action": "Update:*", "Principal": "*", "Resource": "*"}]}`), false),
+		Entry("with a policy denying replacement", aws.String(`{"Statement": [{"Effect": "Deny", "Action": "Update:Replace", "Principal": "*", "Resource": "*"}]}`), true),
+		Entry("with a policy denying a list of actions", aws.String(`{"Statement": [{"Effect": "Deny", "Action": ["Update:Delete", "Update:Replace"], "Principal": "*", "Resource": "*"}]}`), true),
+		Entry("with a policy denying replacing the nodegroup", aws.String(`{"Statement": [{"Effect": "Deny", "Action": "Update:Replace", "Principal": "*", "Resource": "LogicalResourceId/NodeGroup"}]}`), true),
+		Entry("with a policy denying replacing resources matching the nodegroup", aws.String(`{"Statement": [{"Effect": "Deny", "Action": "Update:*", "Principal": "*", "Resource": ["LogicalResourceId/Managed*"]}]}`), true),
+		Entry("with a policy denying replacing other resources", aws.String(`{"Statement": [{"Effect": "Deny", "Action": "Update:Replace", "Principal": "*", "Resource": ["LogicalResourceId/SG", "LogicalResourceId/NodeGroupLaunchTemplate"]}]}`), false),
+		Entry("with a policy denying replacing all but the nodegroup", aws.String(`{"Statement": [{"Effect": "Deny", "Action": "Update:Replace", "Principal": "*", "NotResource": ["LogicalResourceId/NodeGroup", "LogicalResourceId/ManagedNodeGroup"]}]}`), false),
+		Entry("with a policy denying replacing all but other resources", aws.String(`{"Statement": [{"Effect": "Deny", "Action": "Update:Replace", "Principal": "*", "NotResource": "LogicalResourceId/SG"}]}`), true),
+	)
+
+	It("returns the stack policy as it is", func() {
+		policyBody := `{"Statement": [{"Effect": "Deny", "Action": "Update:Replace", "Principal": "*", "Resource": "LogicalResourceId/NodeGroup"}]}`
+		p.MockCloudFormation().On("GetStackPolicy", &cfn.GetStackPolicyInput{
+			StackName: aws.String(stackName),
+		}).Return(&cfn.GetStackPolicyOutput{StackPolicyBody: aws.String(policyBody)}, nil)
+
+		Expect(sc.GetNodeGroupStackPolicy(ng)).To(Equal(policyBody))
+	})
+
+	It("sets the stack policy as it is", func() {
+		policyBody := `{"Statement": [{"Effect": "Deny", "Action": "Update:Delete", "Principal": "*", "Resource": "*"}]}`
+		p.MockCloudFormation().On("SetStackPolicy", mock.Anything).Return(&cfn.SetStackPolicyOutput{}, nil)
+
+		Expect(sc.SetNodeGroupStackPolicyBody(ng, policyBody)).To(Succeed())
+		Expect(setStackPolicyBody()).To(Equal(policyBody))
+	})
+
+	Describe("nodeGroupReplacementCheck", func() {
+		mockPolicy := func(policyBody string) {
+			p.MockCloudFormation().On("GetStackPolicy", &cfn.GetStackPolicyInput{
+				StackName: aws.String(stackName),
+			}).Return(&cfn.GetStackPolicyOutput{StackPolicyBody: aws.String(policyBody)}, nil)
+		}
+		replaceNodeGroup := []StackChange{
+			{LogicalResourceID: "NodeGroupLaunchTemplate", ResourceType: "AWS::EC2::LaunchTemplate", Action: cfn.ChangeActionModify},
+			{LogicalResourceID: "NodeGroup", ResourceType: "AWS::AutoScaling::AutoScalingGroup", Action: cfn.ChangeActionModify, Replacement: true},
+		}
+
+		It("does not read the policy when the nodegroup is not replaced", func() {
+			check := sc.nodeGroupReplacementCheck(ng)
+			Expect(check([]StackChange{
+				{LogicalResourceID: "NodeGroup", ResourceType: "AWS::AutoScaling::AutoScalingGroup", Action: cfn.ChangeActionModify},
+				{LogicalResourceID: "SG", ResourceType: "AWS::EC2::SecurityGroup", Action: cfn.ChangeActionModify, Replacement: true},
+			})).To(Succeed())
+			Expect(p.MockCloudFormation().AssertNotCalled(GinkgoT(), "GetStackPolicy", mock.Anything)).To(BeTrue())
+		})
+
+		It("allows replacing unprotected nodegroups", func() {
+			mockPolicy(`{"Statement": [{"Effect": "Allow", "Action": "Update:*", "Principal": "*", "Resource": "*"}]}`)
+			Expect(sc.nodeGroupReplacementCheck(ng)(replaceNodeGroup)).To(Succeed())
+		})
+
+		It("rejects replacing protected nodegroups", func() {
+			mockPolicy(`{"Statement": [{"Effect": "Deny", "Action": "Update:Replace", "Principal": "*", "Resource": "*"}]}`)
+			Expect(sc.nodeGroupReplacementCheck(ng)(replaceNodeGroup)).To(MatchError(`the update would replace resource "NodeGroup" of nodegroup "ng-1", which the stack policy of the nodegroup protects from replacement`))
+		})
+	})
+})
@@ -0,0 +1,53 @@
+package manager
+
+import (
+	"fmt"
+	"sync"
+)
+
+// RegionErrors holds the errors of the regions that failed, keyed by region
+type RegionErrors map[string]error
+
+func (e RegionErrors) Error() string {
+	return combineErrors(e, fmt.Sprintf("failed to get nodegroups in %d region(s)", len(e))).Error()
+}
+
+// MultiRegionNodeGroupSummaries gets the nodegroup summaries of each region concurrently, using the stack manager
+// newStackManager returns for the region, and returns them keyed by region. A failing region does not abort the
+// others: its error is collected into the returned RegionErrors, which is nil when all regions succeed
+func MultiRegionNodeGroupSummaries(regions []string, newStackManager func(region string) (StackManager, error)) (map[string][]*NodeGroupSummary, error) {
+	var (
+		mu        sync.Mutex
+		wg        sync.WaitGroup
+		summaries = map[string][]*NodeGroupSummary{}
+		failed    = RegionErrors{}
+	)
+	for _, region := range regions {
+		wg.Add(1)
+		go func(region string) {
+			defer wg.Done()
+			regionSummaries, err := regionNodeGroupSummaries(region, newStackManager)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				failed[region] = err
+				return
+			}
+			summaries[region] = regionSummaries
+		}(region)
+	}
+	wg.Wait()
+
+	if len(failed) > 0 {
+		return summaries, failed
+	}
+	return summaries, nil
+}
+
+func regionNodeGroupSummaries(region string, newStackManager func(region string) (StackManager, error)) ([]*NodeGroupSummary, error) {
+	stackManager, err := newStackManager(region)
+	if err != nil {
+		return nil, err
+	}
+	return stackManager.GetNodeGroupSummaries("")
+}
@@ -0,0 +1,62 @@
+package manager
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/eks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("MultiRegionNodeGroupSummaries", func() {
+	newStackManager := func(region string) (StackManager, error) {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		cfg.Metadata.Region = region
+		p := mockprovider.NewMockProvider()
+		p.MockEKS().On("DescribeCluster", mock.Anything).Return(&eks.DescribeClusterOutput{
+			Cluster: &eks.Cluster{Name: aws.String("test-cluster")},
+		}, nil)
+
+		if region == "eu-west-1" {
+			p.MockCloudFormation().On("ListStacksPages", mock.Anything, mock.Anything).Return(fmt.Errorf("access denied"))
+			return NewStackCollection(p, cfg), nil
+		}
+		p.MockCloudFormation().On("ListStacksPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(p *cfn.ListStacksOutput, last bool) (shouldContinue bool))
+			consume(&cfn.ListStacksOutput{}, true)
+		}).Return(nil)
+		return NewStackCollection(p, cfg), nil
+	}
+
+	It("returns the summaries of every region when none fails", func() {
+		summaries, err := MultiRegionNodeGroupSummaries([]string{"us-west-2", "us-east-1"}, newStackManager)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(summaries).To(HaveLen(2))
+		Expect(summaries).To(HaveKey("us-west-2"))
+		Expect(summaries).To(HaveKey("us-east-1"))
+	})
+
+	It("collects the errors of failing regions without aborting the others", func() {
+		summaries, err := MultiRegionNodeGroupSummaries([]string{"us-west-2", "eu-west-1", "ap-south-1"}, func(region string) (StackManager, error) {
+			if region == "ap-south-1" {
+				return nil, fmt.Errorf("region is not enabled")
+			}
+			return newStackManager(region)
+		})
+		Expect(summaries).To(HaveLen(1))
+		Expect(summaries).To(HaveKey("us-west-2"))
+
+		Expect(err).To(BeAssignableToTypeOf(RegionErrors{}))
+		regionErrs := err.(RegionErrors)
+		Expect(regionErrs).To(HaveLen(2))
+		Expect(regionErrs["eu-west-1"]).To(MatchError(ContainSubstring("access denied")))
+		Expect(regionErrs["ap-south-1"]).To(MatchError("region is not enabled"))
+	})
+})
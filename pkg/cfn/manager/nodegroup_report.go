@@ -0,0 +1,167 @@
+package manager
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/kubernetes"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// CapacityReport is the capacity of all nodegroups of a cluster at a point in time
+type CapacityReport struct {
+	ClusterName string              `json:"clusterName"`
+	GeneratedAt time.Time           `json:"generatedAt"`
+	NodeGroups  []NodeGroupCapacity `json:"nodeGroups"`
+}
+
+// NodeGroupCapacity is the capacity of a single nodegroup. The sizes are read from the
+// nodegroup's Auto Scaling group, so they include changes made outside of eksctl
+type NodeGroupCapacity struct {
+	Name            string            `json:"name"`
+	Type            api.NodeGroupType `json:"type"`
+	InstanceType    string            `json:"instanceType"`
+	MinSize         int               `json:"minSize"`
+	MaxSize         int               `json:"maxSize"`
+	DesiredCapacity int               `json:"desiredCapacity"`
+	// RunningInstances is the number of instances of the Auto Scaling group that are in service
+	RunningInstances int `json:"runningInstances"`
+	// Utilization is only set when the report is generated with a Kubernetes client
+	Utilization *NodeGroupUtilization `json:"utilization,omitempty"`
+}
+
+// NodeGroupUtilization is the pod utilization of the nodes of a nodegroup
+type NodeGroupUtilization struct {
+	PodsUsed  int `json:"podsUsed"`
+	PodsTotal int `json:"podsTotal"`
+}
+
+// PodUtilization returns the fraction of pod slots in use, or 0 when the nodegroup has no nodes
+func (u *NodeGroupUtilization) PodUtilization() float64 {
+	if u == nil || u.PodsTotal == 0 {
+		return 0
+	}
+	return float64(u.PodsUsed) / float64(u.PodsTotal)
+}
+
+var capacityReportCSVHeader = []string{
+	"cluster", "nodegroup", "type", "instance type", "min size", "max size", "desired capacity",
+	"running instances", "pods used", "pods total", "pod utilization",
+}
+
+// WriteCSV writes the report as CSV with a header row. The utilization columns are left
+// empty for nodegroups without utilization
+func (r *CapacityReport) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(capacityReportCSVHeader); err != nil {
+		return err
+	}
+	for _, ng := range r.NodeGroups {
+		record := []string{
+			r.ClusterName,
+			ng.Name,
+			string(ng.Type),
+			ng.InstanceType,
+			strconv.Itoa(ng.MinSize),
+			strconv.Itoa(ng.MaxSize),
+			strconv.Itoa(ng.DesiredCapacity),
+			strconv.Itoa(ng.RunningInstances),
+			"", "", "",
+		}
+		if u := ng.Utilization; u != nil {
+			record[8] = strconv.Itoa(u.PodsUsed)
+			record[9] = strconv.Itoa(u.PodsTotal)
+			record[10] = strconv.FormatFloat(u.PodUtilization(), 'f', 2, 64)
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// GenerateNodeGroupCapacityReport returns the sizes and running instances of every nodegroup of the cluster.
+// When kubeClient is not nil, the pod utilization of each nodegroup is included too
+func (c *StackCollection) GenerateNodeGroupCapacityReport(kubeClient kubernetes.Interface) (*CapacityReport, error) {
+	stacks, err := c.DescribeNodeGroupStacks()
+	if err != nil {
+		return nil, errors.Wrap(err, "getting nodegroup stacks")
+	}
+
+	report := &CapacityReport{
+		ClusterName: c.spec.Metadata.Name,
+		GeneratedAt: time.Now().UTC(),
+		NodeGroups:  []NodeGroupCapacity{},
+	}
+	templates := templateCache{}
+	for _, s := range stacks {
+		nodeGroupType, err := GetNodeGroupType(s.Tags)
+		if err != nil {
+			return nil, err
+		}
+		summary, err := c.getNodeGroupSummary(s, templates)
+		if err != nil {
+			return nil, err
+		}
+
+		capacity := NodeGroupCapacity{
+			Name:         summary.Name,
+			Type:         nodeGroupType,
+			InstanceType: summary.InstanceType,
+		}
+		if err := c.setAutoScalingGroupCapacity(&capacity, summary.AutoScalingGroupName); err != nil {
+			return nil, err
+		}
+
+		if kubeClient != nil {
+			used, total, err := c.GetNodeGroupPodCapacity(&api.NodeGroup{NodeGroupBase: &api.NodeGroupBase{Name: summary.Name}}, kubeClient)
+			if err != nil {
+				return nil, err
+			}
+			capacity.Utilization = &NodeGroupUtilization{PodsUsed: used, PodsTotal: total}
+		}
+		report.NodeGroups = append(report.NodeGroups, capacity)
+	}
+	return report, nil
+}
+
+// setAutoScalingGroupCapacity sets the sizes and running instances of the nodegroup to the sum of the ones of its
+// Auto Scaling groups, given as a comma-separated list of names. Managed nodegroups may have several of them, or
+// none yet, in which case the capacity is left empty
+func (c *StackCollection) setAutoScalingGroupCapacity(capacity *NodeGroupCapacity, asgNames string) error {
+	if asgNames == "" {
+		logger.Warning("no Auto Scaling group found for nodegroup %q, its capacity is reported as empty", capacity.Name)
+		return nil
+	}
+	names := strings.Split(asgNames, ",")
+	asgs, err := c.asgAPI.DescribeAutoScalingGroups(&autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: aws.StringSlice(names),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "describing Auto Scaling groups %q", asgNames)
+	}
+	if len(asgs.AutoScalingGroups) != len(names) {
+		return errors.Errorf("Auto Scaling groups %q of nodegroup %q not found", asgNames, capacity.Name)
+	}
+
+	for _, asg := range asgs.AutoScalingGroups {
+		capacity.MinSize += int(aws.Int64Value(asg.MinSize))
+		capacity.MaxSize += int(aws.Int64Value(asg.MaxSize))
+		capacity.DesiredCapacity += int(aws.Int64Value(asg.DesiredCapacity))
+		for _, instance := range asg.Instances {
+			if aws.StringValue(instance.LifecycleState) == autoscaling.LifecycleStateInService {
+				capacity.RunningInstances++
+			}
+		}
+	}
+	return nil
+}
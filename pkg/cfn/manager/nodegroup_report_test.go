@@ -0,0 +1,211 @@
+package manager
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/eks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("StackCollection GenerateNodeGroupCapacityReport", func() {
+	var (
+		p      *mockprovider.MockProvider
+		sc     *StackCollection
+		stacks map[string]*mockedStack
+	)
+
+	BeforeEach(func() {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		p = mockprovider.NewMockProvider()
+		sc = NewStackCollection(p, cfg)
+
+		stacks = map[string]*mockedStack{
+			"eksctl-test-cluster-cluster": {
+				Tags: []*cfn.Tag{{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")}},
+			},
+			"eksctl-test-cluster-nodegroup-ng-1": {
+				Tags: nodeGroupStackTags("ng-1", api.NodeGroupTypeUnmanaged),
+				Template: `{"Resources":{"NodeGroup":{"Properties":{"DesiredCapacity":"1","MinSize":"1","MaxSize":"3"}},` +
+					`"NodeGroupLaunchTemplate":{"Properties":{"LaunchTemplateData":{"InstanceType":"m5.large"}}}}}`,
+			},
+		}
+		mockStacks(p, stacks)
+		p.MockCloudFormation().On("DescribeStackResource", mock.Anything).Return(&cfn.DescribeStackResourceOutput{
+			StackResourceDetail: &cfn.StackResourceDetail{PhysicalResourceId: aws.String("asg-ng-1")},
+		}, nil)
+		p.MockASG().On("DescribeAutoScalingGroups", &autoscaling.DescribeAutoScalingGroupsInput{
+			AutoScalingGroupNames: aws.StringSlice([]string{"asg-ng-1"}),
+		}).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+			AutoScalingGroups: []*autoscaling.Group{
+				{
+					MinSize:         aws.Int64(2),
+					MaxSize:         aws.Int64(5),
+					DesiredCapacity: aws.Int64(3),
+					Instances: []*autoscaling.Instance{
+						{InstanceId: aws.String("i-1"), LifecycleState: aws.String(autoscaling.LifecycleStateInService)},
+						{InstanceId: aws.String("i-2"), LifecycleState: aws.String(autoscaling.LifecycleStateInService)},
+						{InstanceId: aws.String("i-3"), LifecycleState: aws.String(autoscaling.LifecycleStatePending)},
+					},
+				},
+			},
+		}, nil)
+	})
+
+	mockManagedNodeGroup := func(asgNames ...string) {
+		stacks["eksctl-test-cluster-nodegroup-mng-1"] = &mockedStack{
+			Tags:     nodeGroupStackTags("mng-1", api.NodeGroupTypeManaged),
+			Template: `{"Resources":{"ManagedNodeGroup":{"Type":"AWS::EKS::Nodegroup","Properties":{"InstanceTypes":["m5.xlarge"]}}}}`,
+		}
+		var asgs []*eks.AutoScalingGroup
+		for _, name := range asgNames {
+			asgs = append(asgs, &eks.AutoScalingGroup{Name: aws.String(name)})
+		}
+		p.MockEKS().On("DescribeNodegroup", mock.Anything).Return(&eks.DescribeNodegroupOutput{
+			Nodegroup: &eks.Nodegroup{Resources: &eks.NodegroupResources{AutoScalingGroups: asgs}},
+		}, nil)
+	}
+
+	capacityOf := func(report *CapacityReport, name string) NodeGroupCapacity {
+		for _, capacity := range report.NodeGroups {
+			if capacity.Name == name {
+				return capacity
+			}
+		}
+		Fail(fmt.Sprintf("nodegroup %q is not in the report", name))
+		return NodeGroupCapacity{}
+	}
+
+	It("reports the live sizes and running instances of each nodegroup", func() {
+		report, err := sc.GenerateNodeGroupCapacityReport(nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.ClusterName).To(Equal("test-cluster"))
+		Expect(report.NodeGroups).To(Equal([]NodeGroupCapacity{
+			{
+				Name:             "ng-1",
+				Type:             api.NodeGroupTypeUnmanaged,
+				InstanceType:     "m5.large",
+				MinSize:          2,
+				MaxSize:          5,
+				DesiredCapacity:  3,
+				RunningInstances: 2,
+			},
+		}))
+
+		var out bytes.Buffer
+		Expect(report.WriteCSV(&out)).To(Succeed())
+		Expect(out.String()).To(Equal("cluster,nodegroup,type,instance type,min size,max size,desired capacity,running instances,pods used,pods total,pod utilization\n" +
+			"test-cluster,ng-1,unmanaged,m5.large,2,5,3,2,,,\n"))
+	})
+
+	It("sums the capacity of all the Auto Scaling groups of managed nodegroups", func() {
+		mockManagedNodeGroup("asg-mng-1a", "asg-mng-1b")
+		p.MockASG().On("DescribeAutoScalingGroups", &autoscaling.DescribeAutoScalingGroupsInput{
+			AutoScalingGroupNames: aws.StringSlice([]string{"asg-mng-1a", "asg-mng-1b"}),
+		}).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+			AutoScalingGroups: []*autoscaling.Group{
+				{
+					MinSize:         aws.Int64(1),
+					MaxSize:         aws.Int64(2),
+					DesiredCapacity: aws.Int64(1),
+					Instances: []*autoscaling.Instance{
+						{InstanceId: aws.String("i-4"), LifecycleState: aws.String(autoscaling.LifecycleStateInService)},
+					},
+				},
+				{
+					MinSize:         aws.Int64(0),
+					MaxSize:         aws.Int64(3),
+					DesiredCapacity: aws.Int64(2),
+					Instances: []*autoscaling.Instance{
+						{InstanceId: aws.String("i-5"), LifecycleState: aws.String(autoscaling.LifecycleStateInService)},
+						{InstanceId: aws.String("i-6"), LifecycleState: aws.String(autoscaling.LifecycleStateInService)},
+					},
+				},
+			},
+		}, nil)
+
+		report, err := sc.GenerateNodeGroupCapacityReport(nil)
+		Expect(err).NotTo(HaveOccurred())
+		capacity := capacityOf(report, "mng-1")
+		Expect(capacity.Type).To(Equal(api.NodeGroupTypeManaged))
+		Expect(capacity.MinSize).To(Equal(1))
+		Expect(capacity.MaxSize).To(Equal(5))
+		Expect(capacity.DesiredCapacity).To(Equal(3))
+		Expect(capacity.RunningInstances).To(Equal(3))
+	})
+
+	It("reports an empty capacity for managed nodegroups without an Auto Scaling group", func() {
+		mockManagedNodeGroup()
+
+		report, err := sc.GenerateNodeGroupCapacityReport(nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(capacityOf(report, "mng-1")).To(Equal(NodeGroupCapacity{Name: "mng-1", Type: api.NodeGroupTypeManaged, InstanceType: "m5.xlarge"}))
+		p.MockASG().AssertNotCalled(GinkgoT(), "DescribeAutoScalingGroups", &autoscaling.DescribeAutoScalingGroupsInput{
+			AutoScalingGroupNames: aws.StringSlice([]string{""}),
+		})
+	})
+
+	It("includes the pod utilization of the nodes of each nodegroup when given a Kubernetes client", func() {
+		newNode := func(name, nodeGroupName string, maxPods int64) *corev1.Node {
+			return &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   name,
+					Labels: map[string]string{api.NodeGroupNameLabel: nodeGroupName},
+				},
+				Status: corev1.NodeStatus{
+					Allocatable: corev1.ResourceList{
+						corev1.ResourcePods: *resource.NewQuantity(maxPods, resource.DecimalSI),
+					},
+				},
+			}
+		}
+		pods := []*corev1.Pod{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "pod-1", Namespace: "default"},
+				Spec:       corev1.PodSpec{NodeName: "node-1"},
+				Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "pod-2", Namespace: "default"},
+				Spec:       corev1.PodSpec{NodeName: "node-2"},
+				Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+			},
+		}
+		clientSet := fake.NewSimpleClientset(newNode("node-1", "ng-1", 4), newNode("node-2", "ng-2", 8))
+		// the fake clientset ignores field selectors, so list the pods of the selected node only here
+		clientSet.PrependReactor("list", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			selector := action.(k8stesting.ListAction).GetListRestrictions().Fields
+			podList := &corev1.PodList{}
+			for _, pod := range pods {
+				if selector.Matches(fields.Set{"spec.nodeName": pod.Spec.NodeName}) {
+					podList.Items = append(podList.Items, *pod)
+				}
+			}
+			return true, podList, nil
+		})
+
+		report, err := sc.GenerateNodeGroupCapacityReport(clientSet)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.NodeGroups[0].Utilization).To(Equal(&NodeGroupUtilization{PodsUsed: 1, PodsTotal: 4}))
+
+		var out bytes.Buffer
+		Expect(report.WriteCSV(&out)).To(Succeed())
+		Expect(out.String()).To(HaveSuffix("test-cluster,ng-1,unmanaged,m5.large,2,5,3,2,1,4,0.25\n"))
+	})
+})
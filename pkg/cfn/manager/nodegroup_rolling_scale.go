@@ -0,0 +1,153 @@
+package manager
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	"github.com/tidwall/gjson"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// inServicePollInterval is how often RollingScaleNodeGroup checks the instances of the Auto Scaling groups
+var inServicePollInterval = 10 * time.Second
+
+// RollingScaleNodeGroup scales the nodegroup like ScaleNodeGroup, but changes its desired capacity by at most
+// stepSize instances at a time, so that the Auto Scaling group does not launch a large number of instances at
+// once. After each step it waits up to stepTimeout for the number of instances in service to reach the desired
+// capacity of the step, and aborts if it does not, leaving the nodegroup at the capacity of the last step
+func (c *StackCollection) RollingScaleNodeGroup(ng *api.NodeGroup, stepSize int, stepTimeout time.Duration) error {
+	if stepSize < 1 {
+		return errors.Errorf("step size must be at least 1, got %d", stepSize)
+	}
+
+	if ng.DesiredCapacity == nil {
+		_, err := c.ScaleNodeGroup(ng, false)
+		return err
+	}
+
+	stack, template, err := c.getNodeGroupStackAndTemplate(ng)
+	if err != nil {
+		return err
+	}
+	// validate the final scaling config before scaling through the steps
+	if _, _, err := c.scaleNodeGroupTemplate(ng, stack, template); err != nil {
+		return err
+	}
+	ngPaths, err := getScalingPaths(template, stack.Tags)
+	if err != nil {
+		return err
+	}
+	currentCapacity := int(gjson.Get(template, ngPaths.DesiredCapacity).Int())
+
+	steps := rollingScaleSteps(currentCapacity, *ng.DesiredCapacity, stepSize)
+	if len(steps) <= 1 {
+		_, err := c.ScaleNodeGroup(ng, false)
+		return err
+	}
+
+	asgNames, err := c.GetAutoScalingGroupName(stack)
+	if err != nil {
+		return errors.Wrapf(err, "getting Auto Scaling group of nodegroup %q", ng.Name)
+	}
+	if asgNames == "" {
+		return errors.Errorf("no Auto Scaling group found for nodegroup %q", ng.Name)
+	}
+
+	for i, desired := range steps {
+		logger.Info("scaling nodegroup %q to %d node(s) (step %d of %d)", ng.Name, desired, i+1, len(steps))
+		if _, err := c.ScaleNodeGroup(rollingScaleStep(ng, desired, i == len(steps)-1), false); err != nil {
+			return errors.Wrapf(err, "scaling nodegroup %q to %d node(s)", ng.Name, desired)
+		}
+		if err := c.waitForInServiceInstances(asgNames, desired, stepTimeout); err != nil {
+			return errors.Wrapf(err, "nodegroup %q was left at a desired capacity of %d node(s)", ng.Name, desired)
+		}
+	}
+	return nil
+}
+
+// rollingScaleSteps returns the desired capacities to scale through, in order, to get from current to target
+// changing the capacity by at most stepSize at a time
+func rollingScaleSteps(current, target, stepSize int) []int {
+	var steps []int
+	for current != target {
+		switch {
+		case target-current > stepSize:
+			current += stepSize
+		case current-target > stepSize:
+			current -= stepSize
+		default:
+			current = target
+		}
+		steps = append(steps, current)
+	}
+	return steps
+}
+
+// rollingScaleStep returns a copy of ng scaled to desired. The min and max size of ng are only applied once they
+// allow the desired capacity of the step, and always on the last step
+func rollingScaleStep(ng *api.NodeGroup, desired int, last bool) *api.NodeGroup {
+	if last {
+		return ng
+	}
+
+	scalingConfig := &api.ScalingConfig{DesiredCapacity: &desired}
+	if ng.MinSize != nil && *ng.MinSize <= desired {
+		scalingConfig.MinSize = ng.MinSize
+	}
+	if ng.MaxSize != nil && *ng.MaxSize >= desired {
+		scalingConfig.MaxSize = ng.MaxSize
+	}
+
+	// copy the base so that the scaling config of ng is left untouched
+	base := *ng.NodeGroupBase
+	base.ScalingConfig = scalingConfig
+	return &api.NodeGroup{NodeGroupBase: &base}
+}
+
+// waitForInServiceInstances blocks until the Auto Scaling groups, given as a comma-separated list of names, have
+// exactly desired instances in service, or fails once timeout expires
+func (c *StackCollection) waitForInServiceInstances(asgNames string, desired int, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	for {
+		inService, err := c.countInServiceInstances(asgNames)
+		if err != nil {
+			return err
+		}
+		if inService == desired {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.Errorf("timed out after %s waiting for %d instance(s) to be in service, %d are in service", timeout, desired, inService)
+		case <-time.After(inServicePollInterval):
+		}
+	}
+}
+
+func (c *StackCollection) countInServiceInstances(asgNames string) (int, error) {
+	asgs, err := c.asgAPI.DescribeAutoScalingGroups(&autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: aws.StringSlice(strings.Split(asgNames, ",")),
+	})
+	if err != nil {
+		return 0, errors.Wrapf(err, "describing Auto Scaling group(s) %q", asgNames)
+	}
+
+	inService := 0
+	for _, asg := range asgs.AutoScalingGroups {
+		for _, instance := range asg.Instances {
+			if aws.StringValue(instance.LifecycleState) == autoscaling.LifecycleStateInService {
+				inService++
+			}
+		}
+	}
+	return inService, nil
+}
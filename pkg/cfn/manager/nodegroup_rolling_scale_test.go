@@ -0,0 +1,95 @@
+package manager
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("StackCollection RollingScaleNodeGroup", func() {
+	DescribeTable("steps of the desired capacity",
+		func(current, target, stepSize int, expected []int) {
+			Expect(rollingScaleSteps(current, target, stepSize)).To(Equal(expected))
+		},
+		Entry("scaling up in full steps", 2, 8, 3, []int{5, 8}),
+		Entry("scaling up with a partial last step", 2, 9, 3, []int{5, 8, 9}),
+		Entry("scaling down", 10, 1, 4, []int{6, 2, 1}),
+		Entry("a change smaller than a step", 2, 3, 5, []int{3}),
+		Entry("no change", 4, 4, 2, nil),
+	)
+
+	It("applies the min and max size of the nodegroup only once the step allows them", func() {
+		ng := api.NewNodeGroup()
+		ng.Name = "ng-1"
+		ng.MinSize = aws.Int(4)
+		ng.MaxSize = aws.Int(20)
+		ng.DesiredCapacity = aws.Int(10)
+
+		step := rollingScaleStep(ng, 3, false)
+		Expect(*step.DesiredCapacity).To(Equal(3))
+		Expect(step.MinSize).To(BeNil())
+		Expect(*step.MaxSize).To(Equal(20))
+		Expect(step.Name).To(Equal("ng-1"))
+		Expect(*ng.DesiredCapacity).To(Equal(10))
+
+		Expect(*rollingScaleStep(ng, 6, false).MinSize).To(Equal(4))
+		Expect(rollingScaleStep(ng, 10, true)).To(BeIdenticalTo(ng))
+	})
+
+	It("rejects a step size lower than 1", func() {
+		sc := NewStackCollection(mockprovider.NewMockProvider(), api.NewClusterConfig())
+		ng := api.NewNodeGroup()
+		ng.DesiredCapacity = aws.Int(3)
+		Expect(sc.RollingScaleNodeGroup(ng, 0, time.Minute)).To(MatchError("step size must be at least 1, got 0"))
+	})
+
+	Context("waiting for instances to be in service", func() {
+		var (
+			p  *mockprovider.MockProvider
+			sc *StackCollection
+
+			originalPollInterval time.Duration
+		)
+
+		asgWithInstances := func(states ...string) *autoscaling.DescribeAutoScalingGroupsOutput {
+			asg := &autoscaling.Group{AutoScalingGroupName: aws.String("asg-1")}
+			for _, state := range states {
+				asg.Instances = append(asg.Instances, &autoscaling.Instance{LifecycleState: aws.String(state)})
+			}
+			return &autoscaling.DescribeAutoScalingGroupsOutput{AutoScalingGroups: []*autoscaling.Group{asg}}
+		}
+
+		BeforeEach(func() {
+			originalPollInterval = inServicePollInterval
+			inServicePollInterval = time.Millisecond
+			p = mockprovider.NewMockProvider()
+			sc = NewStackCollection(p, api.NewClusterConfig())
+		})
+
+		AfterEach(func() {
+			inServicePollInterval = originalPollInterval
+		})
+
+		It("returns once the desired number of instances are in service", func() {
+			p.MockASG().On("DescribeAutoScalingGroups", mock.Anything).Return(asgWithInstances(autoscaling.LifecycleStateInService, autoscaling.LifecycleStatePending), nil).Once()
+			p.MockASG().On("DescribeAutoScalingGroups", mock.Anything).Return(asgWithInstances(autoscaling.LifecycleStateInService, autoscaling.LifecycleStateInService), nil)
+
+			Expect(sc.waitForInServiceInstances("asg-1", 2, time.Minute)).To(Succeed())
+		})
+
+		It("fails when the instances are not in service within the timeout", func() {
+			p.MockASG().On("DescribeAutoScalingGroups", mock.Anything).Return(asgWithInstances(autoscaling.LifecycleStateInService, autoscaling.LifecycleStatePending), nil)
+
+			err := sc.waitForInServiceInstances("asg-1", 2, 10*time.Millisecond)
+			Expect(err).To(MatchError("timed out after 10ms waiting for 2 instance(s) to be in service, 1 are in service"))
+		})
+	})
+})
@@ -0,0 +1,37 @@
+package manager
+
+import (
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// ScalingDrift compares the scaling values of a nodegroup's stack with the ones of its config
+type ScalingDrift struct {
+	// Current are the scaling values of the stack
+	Current ScalingValues
+	// Desired are the scaling values the stack would have once scaled to the config, values not set in
+	// the config are the current ones
+	Desired ScalingValues
+	// InSync is true when the stack has the desired scaling values
+	InSync bool
+}
+
+// NodeGroupScalingDrift reports whether the scaling values of the nodegroup's stack match the scaling config
+// of ng, reading them from the stack template the same way ScaleNodeGroupTemplate does. Nothing is updated
+func (c *StackCollection) NodeGroupScalingDrift(ng *api.NodeGroup) (*ScalingDrift, error) {
+	stack, template, err := c.getNodeGroupStackAndTemplate(ng)
+	if err != nil {
+		return nil, err
+	}
+	ngPaths, err := getScalingPaths(template, stack.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	current := scalingFromTemplate(template, ngPaths)
+	desired := current.desiredBy(ng)
+	return &ScalingDrift{
+		Current: current,
+		Desired: desired,
+		InSync:  current == desired,
+	}, nil
+}
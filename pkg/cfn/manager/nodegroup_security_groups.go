@@ -0,0 +1,106 @@
+package manager
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/pkg/errors"
+	"github.com/tidwall/gjson"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// nodeGroupSecurityGroupPaths are the paths to the security groups of the instances in the resources of a
+// nodegroup stack, by resource type
+var nodeGroupSecurityGroupPaths = map[string][]string{
+	"AWS::EC2::LaunchTemplate": {
+		"Properties.LaunchTemplateData.NetworkInterfaces.#.Groups",
+		"Properties.LaunchTemplateData.SecurityGroupIds",
+	},
+	"AWS::AutoScaling::LaunchConfiguration": {
+		"Properties.SecurityGroups",
+	},
+}
+
+// GetNodeGroupSecurityGroupIDs returns the IDs of the security groups attached to the nodes of the nodegroup,
+// read from the launch template or launch configuration of its stack. References to the security groups the
+// stack creates are resolved from the stack resources, and imports of the cluster stack outputs, such as the
+// shared node security group of nodegroups that opt into it, from the outputs of the cluster stack
+func (c *StackCollection) GetNodeGroupSecurityGroupIDs(ng *api.NodeGroup) ([]string, error) {
+	stackName := c.makeNodeGroupStackName(ng.Name)
+	template, err := c.GetStackTemplate(stackName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting template of stack %q", stackName)
+	}
+
+	var refs []gjson.Result
+	gjson.Get(template, resourcesRootPath).ForEach(func(_, resource gjson.Result) bool {
+		for _, path := range nodeGroupSecurityGroupPaths[resource.Get("Type").String()] {
+			for _, groups := range resource.Get(path).Array() {
+				if groups.IsArray() {
+					refs = append(refs, groups.Array()...)
+				} else {
+					refs = append(refs, groups)
+				}
+			}
+		}
+		return true
+	})
+
+	var (
+		sgIDs []string
+		seen  = map[string]bool{}
+	)
+	for _, ref := range refs {
+		sgID, err := c.resolveSecurityGroupRef(stackName, ref)
+		if err != nil {
+			return nil, errors.Wrapf(err, "resolving security groups of nodegroup %q", ng.Name)
+		}
+		if !seen[sgID] {
+			seen[sgID] = true
+			sgIDs = append(sgIDs, sgID)
+		}
+	}
+	return sgIDs, nil
+}
+
+// resolveSecurityGroupRef returns the security group ID of a literal ID, a Ref to a resource of the stack or
+// an Fn::ImportValue of a stack output in a template
+func (c *StackCollection) resolveSecurityGroupRef(stackName string, ref gjson.Result) (string, error) {
+	switch {
+	case ref.Type == gjson.String:
+		return ref.String(), nil
+
+	case ref.Get("Ref").Type == gjson.String:
+		logicalID := ref.Get("Ref").String()
+		res, err := c.cloudformationAPI.DescribeStackResource(&cfn.DescribeStackResourceInput{
+			StackName:         aws.String(stackName),
+			LogicalResourceId: aws.String(logicalID),
+		})
+		if err != nil {
+			return "", errors.Wrapf(err, "describing resource %q of stack %q", logicalID, stackName)
+		}
+		return aws.StringValue(res.StackResourceDetail.PhysicalResourceId), nil
+
+	case ref.Get("Fn::ImportValue").Type == gjson.String:
+		exportName := ref.Get("Fn::ImportValue").String()
+		parts := strings.SplitN(exportName, "::", 2)
+		if len(parts) != 2 {
+			return "", fmt.Errorf("unexpected export name %q", exportName)
+		}
+		stack, err := c.DescribeStack(&Stack{StackName: aws.String(parts[0])})
+		if err != nil {
+			return "", err
+		}
+		sgID, ok := stackOutput(stack, parts[1])
+		if !ok {
+			return "", fmt.Errorf("stack %q has no output %q", parts[0], parts[1])
+		}
+		return sgID, nil
+
+	default:
+		return "", fmt.Errorf("unsupported security group reference %s", ref.Raw)
+	}
+}
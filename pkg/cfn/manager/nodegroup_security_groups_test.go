@@ -0,0 +1,114 @@
+package manager
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("StackCollection GetNodeGroupSecurityGroupIDs", func() {
+	var (
+		p  *mockprovider.MockProvider
+		sc *StackCollection
+		ng *api.NodeGroup
+	)
+
+	mockTemplate := func(template string) {
+		p.MockCloudFormation().On("GetTemplate", &cfn.GetTemplateInput{
+			StackName: aws.String("eksctl-test-cluster-nodegroup-ng-1"),
+		}).Return(&cfn.GetTemplateOutput{TemplateBody: aws.String(template)}, nil)
+	}
+
+	BeforeEach(func() {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		p = mockprovider.NewMockProvider()
+		sc = NewStackCollection(p, cfg)
+
+		ng = api.NewNodeGroup()
+		ng.Name = "ng-1"
+
+		p.MockCloudFormation().On("DescribeStackResource", &cfn.DescribeStackResourceInput{
+			StackName:         aws.String("eksctl-test-cluster-nodegroup-ng-1"),
+			LogicalResourceId: aws.String("SG"),
+		}).Return(&cfn.DescribeStackResourceOutput{
+			StackResourceDetail: &cfn.StackResourceDetail{PhysicalResourceId: aws.String("sg-local")},
+		}, nil)
+		p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(&cfn.DescribeStacksOutput{
+			Stacks: []*cfn.Stack{{
+				StackName: aws.String("eksctl-test-cluster-cluster"),
+				Outputs: []*cfn.Output{
+					{OutputKey: aws.String(outputs.ClusterSharedNodeSecurityGroup), OutputValue: aws.String("sg-shared")},
+					{OutputKey: aws.String(outputs.ClusterDefaultSecurityGroup), OutputValue: aws.String("sg-cluster")},
+				},
+			}},
+		}, nil)
+	})
+
+	It("resolves the custom, shared and local security groups of the launch template", func() {
+		mockTemplate(`{"Resources": {
+			"NodeGroupLaunchTemplate": {
+				"Type": "AWS::EC2::LaunchTemplate",
+				"Properties": {"LaunchTemplateData": {"NetworkInterfaces": [{"Groups": [
+					"sg-custom",
+					{"Fn::ImportValue": "eksctl-test-cluster-cluster::SharedNodeSecurityGroup"},
+					{"Ref": "SG"}
+				]}]}}
+			},
+			"SG": {"Type": "AWS::EC2::SecurityGroup"}
+		}}`)
+
+		sgIDs, err := sc.GetNodeGroupSecurityGroupIDs(ng)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sgIDs).To(Equal([]string{"sg-custom", "sg-shared", "sg-local"}))
+	})
+
+	It("lists each security group once across network interfaces", func() {
+		mockTemplate(`{"Resources": {
+			"NodeGroupLaunchTemplate": {
+				"Type": "AWS::EC2::LaunchTemplate",
+				"Properties": {"LaunchTemplateData": {"NetworkInterfaces": [
+					{"Groups": ["sg-custom", {"Ref": "SG"}]},
+					{"Groups": ["sg-custom", {"Ref": "SG"}]}
+				]}}
+			}
+		}}`)
+
+		sgIDs, err := sc.GetNodeGroupSecurityGroupIDs(ng)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sgIDs).To(Equal([]string{"sg-custom", "sg-local"}))
+	})
+
+	It("resolves the security groups of legacy launch configurations", func() {
+		mockTemplate(`{"Resources": {
+			"NodeLaunchConfig": {
+				"Type": "AWS::AutoScaling::LaunchConfiguration",
+				"Properties": {"SecurityGroups": [{"Ref": "SG"}]}
+			}
+		}}`)
+
+		sgIDs, err := sc.GetNodeGroupSecurityGroupIDs(ng)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sgIDs).To(Equal([]string{"sg-local"}))
+	})
+
+	It("fails on references it cannot resolve", func() {
+		mockTemplate(`{"Resources": {
+			"NodeGroupLaunchTemplate": {
+				"Type": "AWS::EC2::LaunchTemplate",
+				"Properties": {"LaunchTemplateData": {"NetworkInterfaces": [{"Groups": [
+					{"Fn::ImportValue": "eksctl-test-cluster-cluster::Missing"}
+				]}]}}
+			}
+		}}`)
+
+		_, err := sc.GetNodeGroupSecurityGroupIDs(ng)
+		Expect(err).To(MatchError(`resolving security groups of nodegroup "ng-1": stack "eksctl-test-cluster-cluster" has no output "Missing"`))
+	})
+})
@@ -0,0 +1,85 @@
+package manager
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// UpdateNodeGroupStackTags sets the tags on the stack of the nodegroup, keeping its other tags, by updating the
+// stack with its previous template so that only the tags of its resources change. The tags that differ from
+// the ones of the stack are returned, and the stack is not updated when none differ. The tags eksctl sets on
+// nodegroup stacks cannot be changed
+func (c *StackCollection) UpdateNodeGroupStackTags(ng *api.NodeGroup, tags map[string]string) (map[string]string, error) {
+	stack, err := c.DescribeNodeGroupStack(ng.Name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "describing stack of nodegroup %q", ng.Name)
+	}
+	stackName := aws.StringValue(stack.StackName)
+
+	stackTags := make(map[string]string, len(stack.Tags))
+	var keys []string
+	for _, tag := range stack.Tags {
+		stackTags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+		keys = append(keys, aws.StringValue(tag.Key))
+	}
+
+	changed := map[string]string{}
+	for key, value := range tags {
+		if isEksctlStackTag(key) {
+			logger.Warning("ignoring tag %q of stack %q as it is reserved by eksctl", key, stackName)
+			continue
+		}
+		currentValue, ok := stackTags[key]
+		if ok && currentValue == value {
+			continue
+		}
+		if !ok {
+			keys = append(keys, key)
+		}
+		stackTags[key] = value
+		changed[key] = value
+	}
+	if len(changed) == 0 {
+		logger.Info("no change for the tags of nodegroup %q", ng.Name)
+		return nil, nil
+	}
+
+	sort.Strings(keys)
+	var changedKeys []string
+	input := &cfn.UpdateStackInput{
+		StackName:           stack.StackName,
+		UsePreviousTemplate: aws.Bool(true),
+		Capabilities:        stackCapabilitiesIAM,
+	}
+	for _, key := range keys {
+		input.Tags = append(input.Tags, newTag(key, stackTags[key]))
+		if _, ok := changed[key]; ok {
+			changedKeys = append(changedKeys, key)
+		}
+	}
+	for _, parameter := range stack.Parameters {
+		input.Parameters = append(input.Parameters, &cfn.Parameter{
+			ParameterKey:     parameter.ParameterKey,
+			UsePreviousValue: aws.Bool(true),
+		})
+	}
+	if c.roleARN != "" {
+		input.SetRoleARN(c.roleARN)
+	}
+
+	logger.Info("updating tags %s of nodegroup %q", strings.Join(changedKeys, ", "), ng.Name)
+	if _, err := c.cloudformationAPI.UpdateStack(input); err != nil {
+		return nil, errors.Wrapf(err, "updating tags of stack %q", stackName)
+	}
+	if err := c.doWaitUntilStackIsUpdated(stack); err != nil {
+		return nil, errors.Wrapf(err, "waiting for the tags of stack %q to be updated", stackName)
+	}
+	return changed, nil
+}
@@ -0,0 +1,90 @@
+package manager
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/awstesting"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("StackCollection UpdateNodeGroupStackTags", func() {
+	const stackName = "eksctl-test-cluster-nodegroup-ng-1"
+
+	var (
+		p  *mockprovider.MockProvider
+		sc *StackCollection
+		ng *api.NodeGroup
+	)
+
+	updateStackInputs := func() []*cfn.UpdateStackInput {
+		var inputs []*cfn.UpdateStackInput
+		for _, call := range p.MockCloudFormation().Calls {
+			if call.Method == "UpdateStack" {
+				inputs = append(inputs, call.Arguments.Get(0).(*cfn.UpdateStackInput))
+			}
+		}
+		return inputs
+	}
+
+	BeforeEach(func() {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		p = mockprovider.NewMockProvider()
+		sc = NewStackCollection(p, cfg)
+		ng = api.NewNodeGroup()
+		ng.Name = "ng-1"
+
+		mockStackDescriptions(p, map[string]*mockedStack{
+			stackName: {
+				Tags: []*cfn.Tag{
+					{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("ng-1")},
+					{Key: aws.String("cost-center"), Value: aws.String("1234")},
+				},
+				Parameters: []*cfn.Parameter{{ParameterKey: aws.String("Env"), ParameterValue: aws.String("prod")}},
+			},
+		})
+		p.MockCloudFormation().On("UpdateStack", mock.Anything).Return(&cfn.UpdateStackOutput{}, nil)
+		updated := &cfn.DescribeStacksOutput{
+			Stacks: []*cfn.Stack{{StackName: aws.String(stackName), StackStatus: aws.String(cfn.StackStatusUpdateComplete)}},
+		}
+		req := awstesting.NewClient(nil).NewRequest(&request.Operation{Name: "Operation"}, nil, updated)
+		p.MockCloudFormation().On("DescribeStacksRequest", mock.Anything).Return(req, updated)
+	})
+
+	It("updates the stack with its previous template and the merged tags", func() {
+		changed, err := sc.UpdateNodeGroupStackTags(ng, map[string]string{"cost-center": "5678", "team": "data"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(Equal(map[string]string{"cost-center": "5678", "team": "data"}))
+
+		inputs := updateStackInputs()
+		Expect(inputs).To(HaveLen(1))
+		Expect(*inputs[0].UsePreviousTemplate).To(BeTrue())
+		Expect(inputs[0].TemplateBody).To(BeNil())
+		Expect(inputs[0].Tags).To(Equal([]*cfn.Tag{
+			{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("ng-1")},
+			{Key: aws.String("cost-center"), Value: aws.String("5678")},
+			{Key: aws.String("team"), Value: aws.String("data")},
+		}))
+		Expect(inputs[0].Parameters).To(Equal([]*cfn.Parameter{{ParameterKey: aws.String("Env"), UsePreviousValue: aws.Bool(true)}}))
+	})
+
+	It("does not update the stack when the tags are unchanged", func() {
+		changed, err := sc.UpdateNodeGroupStackTags(ng, map[string]string{"cost-center": "1234"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeNil())
+		Expect(updateStackInputs()).To(BeEmpty())
+	})
+
+	It("ignores the tags reserved by eksctl", func() {
+		changed, err := sc.UpdateNodeGroupStackTags(ng, map[string]string{api.NodeGroupNameTag: "ng-2", api.ClusterNameTag: "other"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeNil())
+		Expect(updateStackInputs()).To(BeEmpty())
+	})
+})
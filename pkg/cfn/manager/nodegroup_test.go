@@ -1,17 +1,37 @@
 package manager
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/awstesting"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
 	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/eks"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	"github.com/stretchr/testify/mock"
+	"github.com/tidwall/gjson"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 )
 
 var _ = Describe("StackCollection NodeGroup", func() {
@@ -39,6 +59,8 @@ var _ = Describe("StackCollection NodeGroup", func() {
 `
 	const nodegroupTemplate = "{\n  \"Resources\": {\n    \"NodeGroup\": {\n      \"Type\": \"AWS::AutoScaling::AutoScalingGroup\",\n      \"Properties\": {\n        \"DesiredCapacity\": \"%d\",\n        \"MaxSize\": \"%d\",\n        \"MinSize\": \"%d\"\n      }\n    }\n  }\n}"
 
+	const managedNodegroupTemplate = "{\n  \"Resources\": {\n    \"ManagedNodeGroup\": {\n      \"Type\": \"AWS::EKS::Nodegroup\",\n      \"Properties\": {\n        \"ScalingConfig\": {\n          \"DesiredSize\": %d,\n          \"MaxSize\": %d,\n          \"MinSize\": %d\n        }\n      }\n    }\n  }\n}"
+
 	testAZs := []string{"us-west-2b", "us-west-2a", "us-west-2c"}
 
 	newClusterConfig := func(clusterName string) *api.ClusterConfig {
@@ -190,6 +212,541 @@ var _ = Describe("StackCollection NodeGroup", func() {
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(Equal("the desired nodes 0 is less than the nodes-min/minSize 1"))
 			})
+
+			It("returns the planned changes without updating the stack in dry-run mode", func() {
+				changeSetCreated := &cfn.DescribeChangeSetOutput{Status: aws.String(cfn.ChangeSetStatusCreateComplete)}
+				p.MockCloudFormation().On("CreateChangeSet", mock.Anything).Return(nil, nil)
+				p.MockCloudFormation().On("DescribeChangeSetRequest", mock.Anything).
+					Return(awstesting.NewClient(nil).NewRequest(&request.Operation{Name: "Operation"}, nil, changeSetCreated), nil)
+				p.MockCloudFormation().On("DescribeChangeSet", mock.Anything).Return(&cfn.DescribeChangeSetOutput{
+					Changes: []*cfn.Change{
+						{
+							ResourceChange: &cfn.ResourceChange{
+								Action:            aws.String(cfn.ChangeActionModify),
+								LogicalResourceId: aws.String("NodeGroup"),
+								ResourceType:      aws.String("AWS::AutoScaling::AutoScalingGroup"),
+							},
+						},
+					},
+				}, nil)
+				p.MockCloudFormation().On("DeleteChangeSet", mock.Anything).Return(nil, nil)
+
+				ng.DesiredCapacity = aws.Int(4)
+				changes, err := sc.ScaleNodeGroup(ng, true)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(changes).To(Equal([]StackChange{
+					{LogicalResourceID: "NodeGroup", ResourceType: "AWS::AutoScaling::AutoScalingGroup", Action: cfn.ChangeActionModify},
+				}))
+				Expect(p.MockCloudFormation().AssertCalled(GinkgoT(), "DeleteChangeSet", mock.Anything)).To(BeTrue())
+				Expect(p.MockCloudFormation().AssertNotCalled(GinkgoT(), "ExecuteChangeSet", mock.Anything)).To(BeTrue())
+			})
+
+			It("does not execute a ChangeSet replacing a nodegroup protected from replacement", func() {
+				changeSetCreated := &cfn.DescribeChangeSetOutput{Status: aws.String(cfn.ChangeSetStatusCreateComplete)}
+				p.MockCloudFormation().On("CreateChangeSet", mock.Anything).Return(nil, nil)
+				p.MockCloudFormation().On("DescribeChangeSetRequest", mock.Anything).
+					Return(awstesting.NewClient(nil).NewRequest(&request.Operation{Name: "Operation"}, nil, changeSetCreated), nil)
+				p.MockCloudFormation().On("DescribeChangeSet", mock.Anything).Return(&cfn.DescribeChangeSetOutput{
+					Changes: []*cfn.Change{
+						{
+							ResourceChange: &cfn.ResourceChange{
+								Action:            aws.String(cfn.ChangeActionModify),
+								LogicalResourceId: aws.String("NodeGroup"),
+								ResourceType:      aws.String("AWS::AutoScaling::AutoScalingGroup"),
+								Replacement:       aws.String(cfn.ReplacementTrue),
+							},
+						},
+					},
+				}, nil)
+				p.MockCloudFormation().On("GetStackPolicy", mock.Anything).Return(&cfn.GetStackPolicyOutput{
+					StackPolicyBody: aws.String(`{"Statement": [{"Effect": "Deny", "Action": "Update:Replace", "Principal": "*", "Resource": "*"}]}`),
+				}, nil)
+				p.MockCloudFormation().On("DeleteChangeSet", mock.Anything).Return(nil, nil)
+
+				ng.DesiredCapacity = aws.Int(4)
+				_, err := sc.ScaleNodeGroup(ng, false)
+				Expect(err).To(MatchError(ContainSubstring("which the stack policy of the nodegroup protects from replacement")))
+				Expect(p.MockCloudFormation().AssertCalled(GinkgoT(), "DeleteChangeSet", mock.Anything)).To(BeTrue())
+				Expect(p.MockCloudFormation().AssertNotCalled(GinkgoT(), "ExecuteChangeSet", mock.Anything)).To(BeTrue())
+			})
+
+			It("scales up by a delta from the current desired capacity", func() {
+				template, err := sc.ScaleNodeGroupByDelta(ng, 2)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(template).To(Equal(fmt.Sprintf(nodegroupTemplate, 5, 6, 1)))
+			})
+
+			It("scales down by a negative delta and keeps the scaling config of the nodegroup", func() {
+				maxSize := 10
+				ng.MaxSize = &maxSize
+				template, err := sc.ScaleNodeGroupByDelta(ng, -2)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(template).To(Equal(fmt.Sprintf(nodegroupTemplate, 1, 6, 1)))
+				Expect(ng.DesiredCapacity).To(BeNil())
+				Expect(*ng.MaxSize).To(Equal(10))
+			})
+
+			It("should be a no-op for a delta of zero", func() {
+				template, err := sc.ScaleNodeGroupByDelta(ng, 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(template).To(Equal(""))
+			})
+
+			It("should be a error if the delta goes beyond the CF maxSize or minSize", func() {
+				_, err := sc.ScaleNodeGroupByDelta(ng, 4)
+				Expect(err).To(MatchError("the desired nodes 7 is greater than the nodes-max/maxSize 6"))
+
+				_, err = sc.ScaleNodeGroupByDelta(ng, -3)
+				Expect(err).To(MatchError("the desired nodes 0 is less than the nodes-min/minSize 1"))
+			})
+
+			It("returns typed errors carrying the sizes", func() {
+				_, err := sc.ScaleNodeGroupByDelta(ng, 4)
+				var exceedsMax *ErrDesiredExceedsMax
+				Expect(errors.As(err, &exceedsMax)).To(BeTrue())
+				Expect(*exceedsMax).To(Equal(ErrDesiredExceedsMax{Desired: 7, Max: 6}))
+
+				_, err = sc.ScaleNodeGroupByDelta(ng, -3)
+				var belowMin *ErrDesiredBelowMin
+				Expect(errors.As(err, &belowMin)).To(BeTrue())
+				Expect(*belowMin).To(Equal(ErrDesiredBelowMin{Desired: 0, Min: 1}))
+			})
+
+			It("reports the nodegroup in sync when the config matches the stack", func() {
+				ng.DesiredCapacity = aws.Int(3)
+				drift, err := sc.NodeGroupScalingDrift(ng)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(*drift).To(Equal(ScalingDrift{
+					Current: ScalingValues{DesiredCapacity: 3, MinSize: 1, MaxSize: 6},
+					Desired: ScalingValues{DesiredCapacity: 3, MinSize: 1, MaxSize: 6},
+					InSync:  true,
+				}))
+			})
+
+			It("reports the scaling drift without updating the stack", func() {
+				ng.DesiredCapacity = aws.Int(4)
+				ng.MaxSize = aws.Int(8)
+				drift, err := sc.NodeGroupScalingDrift(ng)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(*drift).To(Equal(ScalingDrift{
+					Current: ScalingValues{DesiredCapacity: 3, MinSize: 1, MaxSize: 6},
+					Desired: ScalingValues{DesiredCapacity: 4, MinSize: 1, MaxSize: 8},
+					InSync:  false,
+				}))
+				p.MockCloudFormation().AssertNotCalled(GinkgoT(), "CreateChangeSet", mock.Anything)
+			})
+		})
+
+		Context("With an existing NodeGroup with a mixed instances policy", func() {
+			const mixedInstancesPolicy = `{"InstancesDistribution":{"OnDemandBaseCapacity":"1","SpotInstancePools":"2"},` +
+				`"LaunchTemplate":{"LaunchTemplateSpecification":{"LaunchTemplateName":{"Fn::Sub":"${AWS::StackName}"}},` +
+				`"Overrides":[{"InstanceType":"m5.large"},{"InstanceType":"m5a.large"},{"InstanceType":"m4.large"}]}}`
+			mixedInstancesTemplate := `{"Resources":{"NodeGroup":{"Type":"AWS::AutoScaling::AutoScalingGroup","Properties":` +
+				`{"DesiredCapacity":"%d","MaxSize":"%d","MinSize":"%d","MixedInstancesPolicy":` + mixedInstancesPolicy + `}}}}`
+
+			JustBeforeEach(func() {
+				cc = newClusterConfig("test-cluster")
+				ng = newNodeGroup(cc)
+				ng.Name = "12345"
+				sc = NewStackCollection(p, cc)
+
+				p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(&cfn.DescribeStacksOutput{
+					Stacks: []*Stack{
+						{
+							Tags: []*cfn.Tag{
+								{
+									Key:   aws.String(api.NodeGroupNameTag),
+									Value: aws.String("12345"),
+								},
+								{
+									Key:   aws.String(api.NodeGroupTypeTag),
+									Value: aws.String(string(api.NodeGroupTypeUnmanaged)),
+								},
+							},
+						},
+					},
+				}, nil)
+				p.MockCloudFormation().On("GetTemplate", mock.Anything).Return(&cfn.GetTemplateOutput{
+					TemplateBody: aws.String(fmt.Sprintf(mixedInstancesTemplate, 3, 6, 1)),
+				}, nil)
+			})
+
+			It("updates the sizes of the Auto Scaling group and leaves the mixed instances policy untouched", func() {
+				ng.DesiredCapacity = aws.Int(5)
+				ng.MaxSize = aws.Int(8)
+				ng.MinSize = aws.Int(2)
+				template, description, err := sc.ScaleNodeGroupTemplate(ng)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(template).To(Equal(fmt.Sprintf(mixedInstancesTemplate, 5, 8, 2)))
+				Expect(gjson.Get(template, "Resources.NodeGroup.Properties.MixedInstancesPolicy").Raw).To(Equal(mixedInstancesPolicy))
+				Expect(description).To(Equal("scaling nodegroup, desired capacity from 3 to 5, min size from 1 to 2, max size from 6 to 8"))
+			})
+		})
+
+		Context("With an existing NodeGroup with a maximum instance lifetime", func() {
+			maxInstanceLifetimeTemplate := `{"Resources":{"NodeGroup":{"Type":"AWS::AutoScaling::AutoScalingGroup","Properties":` +
+				`{"DesiredCapacity":"%d","MaxInstanceLifetime":604800,"MaxSize":"%d","MinSize":"%d"}}}}`
+
+			JustBeforeEach(func() {
+				cc = newClusterConfig("test-cluster")
+				ng = newNodeGroup(cc)
+				ng.Name = "12345"
+				sc = NewStackCollection(p, cc)
+
+				p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(&cfn.DescribeStacksOutput{
+					Stacks: []*Stack{
+						{
+							Tags: []*cfn.Tag{
+								{
+									Key:   aws.String(api.NodeGroupNameTag),
+									Value: aws.String("12345"),
+								},
+								{
+									Key:   aws.String(api.NodeGroupTypeTag),
+									Value: aws.String(string(api.NodeGroupTypeUnmanaged)),
+								},
+							},
+						},
+					},
+				}, nil)
+				p.MockCloudFormation().On("GetTemplate", mock.Anything).Return(&cfn.GetTemplateOutput{
+					TemplateBody: aws.String(fmt.Sprintf(maxInstanceLifetimeTemplate, 3, 6, 1)),
+				}, nil)
+			})
+
+			It("keeps the maximum instance lifetime of the Auto Scaling group", func() {
+				ng.DesiredCapacity = aws.Int(5)
+				template, _, err := sc.ScaleNodeGroupTemplate(ng)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(template).To(Equal(fmt.Sprintf(maxInstanceLifetimeTemplate, 5, 6, 1)))
+				Expect(gjson.Get(template, "Resources.NodeGroup.Properties.MaxInstanceLifetime").Int()).To(Equal(int64(604800)))
+			})
+
+			It("keeps the maximum instance lifetime when scaling by a delta", func() {
+				template, err := sc.ScaleNodeGroupByDelta(ng, -1)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(template).To(Equal(fmt.Sprintf(maxInstanceLifetimeTemplate, 2, 6, 1)))
+			})
+		})
+
+		Context("With an existing managed NodeGroup", func() {
+			type scaleCase struct {
+				desiredCapacity, minSize, maxSize *int
+				expectedTemplate                  string
+				expectedErr                       string
+			}
+
+			intPtr := func(i int) *int { return &i }
+
+			JustBeforeEach(func() {
+				cc = newClusterConfig("test-cluster")
+				ng = newNodeGroup(cc)
+				ng.Name = "12345"
+				sc = NewStackCollection(p, cc)
+
+				// the stack is not tagged with the nodegroup type, the managed nodegroup is detected from the template
+				p.MockCloudFormation().
+					On("DescribeStacks", mock.Anything).Return(&cfn.DescribeStacksOutput{
+					Stacks: []*Stack{
+						{
+							Tags: []*cfn.Tag{
+								{
+									Key:   aws.String(api.NodeGroupNameTag),
+									Value: aws.String("12345"),
+								},
+							},
+						},
+					},
+				}, nil).
+					On("GetTemplate", mock.Anything).Return(&cfn.GetTemplateOutput{
+					TemplateBody: aws.String(fmt.Sprintf(managedNodegroupTemplate, 3, 6, 1)),
+				}, nil)
+			})
+
+			DescribeTable("scales the ScalingConfig of the nodegroup", func(c scaleCase) {
+				ng.DesiredCapacity, ng.MinSize, ng.MaxSize = c.desiredCapacity, c.minSize, c.maxSize
+				template, _, err := sc.ScaleNodeGroupTemplate(ng)
+				if c.expectedErr != "" {
+					Expect(err).To(MatchError(c.expectedErr))
+					return
+				}
+				Expect(err).NotTo(HaveOccurred())
+				Expect(template).To(Equal(c.expectedTemplate))
+			},
+				Entry("desired capacity changed", scaleCase{
+					desiredCapacity:  intPtr(4),
+					expectedTemplate: fmt.Sprintf(managedNodegroupTemplate, 4, 6, 1),
+				}),
+				Entry("min size changed", scaleCase{
+					minSize:          intPtr(2),
+					expectedTemplate: fmt.Sprintf(managedNodegroupTemplate, 3, 6, 2),
+				}),
+				Entry("max size changed", scaleCase{
+					maxSize:          intPtr(10),
+					expectedTemplate: fmt.Sprintf(managedNodegroupTemplate, 3, 10, 1),
+				}),
+				Entry("all sizes changed", scaleCase{
+					desiredCapacity:  intPtr(4),
+					minSize:          intPtr(2),
+					maxSize:          intPtr(10),
+					expectedTemplate: fmt.Sprintf(managedNodegroupTemplate, 4, 10, 2),
+				}),
+				Entry("no-op for the existing desired capacity", scaleCase{
+					desiredCapacity: intPtr(3),
+				}),
+				Entry("no-op for the existing desired capacity, min size and max size", scaleCase{
+					desiredCapacity: intPtr(3),
+					minSize:         intPtr(1),
+					maxSize:         intPtr(6),
+				}),
+				Entry("desired capacity greater than the max size", scaleCase{
+					desiredCapacity: intPtr(10),
+					expectedErr:     "the desired nodes 10 is greater than the nodes-max/maxSize 6",
+				}),
+				Entry("desired capacity less than the min size", scaleCase{
+					desiredCapacity: intPtr(0),
+					expectedErr:     "the desired nodes 0 is less than the nodes-min/minSize 1",
+				}),
+				Entry("scaled to zero by setting the min size and desired capacity to 0", scaleCase{
+					desiredCapacity:  intPtr(0),
+					minSize:          intPtr(0),
+					expectedTemplate: fmt.Sprintf(managedNodegroupTemplate, 0, 6, 0),
+				}),
+				Entry("desired capacity of 0 with a min size still above it", scaleCase{
+					desiredCapacity: intPtr(0),
+					minSize:         intPtr(2),
+					expectedErr:     "the desired nodes 0 is less than the nodes-min/minSize 2",
+				}),
+			)
+
+			It("updates the labels when the capacity is unchanged", func() {
+				ng.DesiredCapacity = intPtr(3)
+				ng.Labels = map[string]string{"tier": "backend"}
+				template, description, err := sc.ScaleNodeGroupTemplate(ng)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(template).NotTo(BeEmpty())
+				Expect(gjson.Get(template, "Resources.ManagedNodeGroup.Properties.Labels").Raw).To(MatchJSON(`{"tier": "backend"}`))
+				Expect(gjson.Get(template, "Resources.ManagedNodeGroup.Properties.ScalingConfig.DesiredSize").Int()).To(Equal(int64(3)))
+				Expect(description).To(Equal("scaling nodegroup, updating labels"))
+			})
+
+			It("adds the taints when the capacity is unchanged", func() {
+				ng.Taints = []api.NodeGroupTaint{{Key: "dedicated", Value: "gpu", Effect: "NoSchedule"}}
+				template, description, err := sc.ScaleNodeGroupTemplate(ng)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(gjson.Get(template, "Resources.ManagedNodeGroup.Properties.Taints").Raw).To(MatchJSON(`[{"Key": "dedicated", "Value": "gpu", "Effect": "NO_SCHEDULE"}]`))
+				Expect(description).To(Equal("scaling nodegroup, updating taints"))
+			})
+		})
+
+		Context("With an existing managed NodeGroup with labels and taints", func() {
+			const labelledTemplate = `{"Resources":{"ManagedNodeGroup":{"Type":"AWS::EKS::Nodegroup","Properties":{` +
+				`"ScalingConfig":{"DesiredSize":3,"MaxSize":6,"MinSize":1},` +
+				`"Labels":{"alpha.eksctl.io/nodegroup-name":"12345","tier":"backend"},` +
+				`"Taints":[{"Key":"dedicated","Value":"gpu","Effect":"NO_SCHEDULE"}]}}}}`
+
+			JustBeforeEach(func() {
+				cc = newClusterConfig("test-cluster")
+				ng = newNodeGroup(cc)
+				ng.Name = "12345"
+				sc = NewStackCollection(p, cc)
+
+				p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(&cfn.DescribeStacksOutput{
+					Stacks: []*Stack{{Tags: []*cfn.Tag{{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("12345")}}}},
+				}, nil)
+				p.MockCloudFormation().On("GetTemplate", mock.Anything).Return(&cfn.GetTemplateOutput{
+					TemplateBody: aws.String(labelledTemplate),
+				}, nil)
+			})
+
+			It("is a no-op when the capacity, labels and taints are unchanged", func() {
+				ng.DesiredCapacity = aws.Int(3)
+				ng.Labels = map[string]string{"tier": "backend"}
+				ng.Taints = []api.NodeGroupTaint{{Key: "dedicated", Value: "gpu", Effect: "NoSchedule"}}
+				template, _, err := sc.ScaleNodeGroupTemplate(ng)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(template).To(BeEmpty())
+			})
+
+			It("keeps the existing labels when adding a label", func() {
+				ng.Labels = map[string]string{"team": "payments"}
+				template, _, err := sc.ScaleNodeGroupTemplate(ng)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(gjson.Get(template, "Resources.ManagedNodeGroup.Properties.Labels").Raw).To(MatchJSON(
+					`{"alpha.eksctl.io/nodegroup-name": "12345", "tier": "backend", "team": "payments"}`))
+			})
+
+			It("updates the value of an existing taint", func() {
+				ng.Taints = []api.NodeGroupTaint{{Key: "dedicated", Value: "ml", Effect: "NoSchedule"}}
+				template, _, err := sc.ScaleNodeGroupTemplate(ng)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(gjson.Get(template, "Resources.ManagedNodeGroup.Properties.Taints").Raw).To(MatchJSON(`[{"Key": "dedicated", "Value": "ml", "Effect": "NO_SCHEDULE"}]`))
+			})
+		})
+	})
+
+	Describe("ScaleNodeGroups", func() {
+		var ngs []*api.NodeGroup
+
+		BeforeEach(func() {
+			cc = newClusterConfig("test-cluster")
+			p = mockprovider.NewMockProvider()
+			sc = NewStackCollection(p, cc)
+
+			ngs = nil
+			for _, name := range []string{"ng-1", "ng-2", "ng-3"} {
+				ng := newNodeGroup(cc)
+				ng.Name = name
+				ng.DesiredCapacity = aws.Int(3)
+				ngs = append(ngs, ng)
+			}
+			// ng-2 is already at the desired capacity
+			ngs[1].DesiredCapacity = aws.Int(2)
+
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(func(input *cfn.DescribeStacksInput) *cfn.DescribeStacksOutput {
+				return &cfn.DescribeStacksOutput{
+					Stacks: []*cfn.Stack{
+						{
+							StackName:   input.StackName,
+							StackStatus: aws.String(cfn.StackStatusCreateComplete),
+							Tags: []*cfn.Tag{
+								{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(string(api.NodeGroupTypeUnmanaged))},
+							},
+						},
+					},
+				}
+			}, nil)
+			p.MockCloudFormation().On("GetTemplate", mock.Anything).Return(&cfn.GetTemplateOutput{
+				TemplateBody: aws.String(`{"Resources":{"NodeGroup":{"Properties":{"DesiredCapacity":"2","MinSize":"1","MaxSize":"4"}}}}`),
+			}, nil)
+
+			p.MockCloudFormation().On("CreateChangeSet", mock.MatchedBy(func(input *cfn.CreateChangeSetInput) bool {
+				return *input.StackName == "eksctl-test-cluster-nodegroup-ng-3"
+			})).Return(nil, fmt.Errorf("throttled"))
+			p.MockCloudFormation().On("CreateChangeSet", mock.Anything).Return(nil, nil)
+			p.MockCloudFormation().On("DescribeChangeSetRequest", mock.Anything).Return(func(input *cfn.DescribeChangeSetInput) *request.Request {
+				describeChangeSetFailed := &cfn.DescribeChangeSetOutput{
+					StackName: input.StackName,
+					Status:    aws.String(cfn.ChangeSetStatusFailed),
+				}
+				return awstesting.NewClient(nil).NewRequest(&request.Operation{Name: "Operation"}, nil, describeChangeSetFailed)
+			}, nil)
+			p.MockCloudFormation().On("DescribeChangeSet", mock.Anything).Return(&cfn.DescribeChangeSetOutput{
+				StatusReason: aws.String("The submitted information didn't contain changes"),
+			}, nil)
+		})
+
+		It("updates the stacks of the nodegroups that need scaling and names the ones that failed", func() {
+			err := sc.ScaleNodeGroups(ngs)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(HavePrefix("failed to scale 1 of 3 nodegroup(s): ng-3: creating ChangeSet"))
+			Expect(err.Error()).To(HaveSuffix("throttled"))
+
+			var scaledStacks []string
+			for _, call := range p.MockCloudFormation().Calls {
+				if call.Method == "CreateChangeSet" {
+					scaledStacks = append(scaledStacks, *call.Arguments.Get(0).(*cfn.CreateChangeSetInput).StackName)
+				}
+			}
+			Expect(scaledStacks).To(ConsistOf("eksctl-test-cluster-nodegroup-ng-1", "eksctl-test-cluster-nodegroup-ng-3"))
+		})
+
+		It("scales nothing when all nodegroups are already at the requested size", func() {
+			for _, ng := range ngs {
+				ng.DesiredCapacity = aws.Int(2)
+			}
+
+			Expect(sc.ScaleNodeGroups(ngs)).To(Succeed())
+			Expect(p.MockCloudFormation().AssertNotCalled(GinkgoT(), "CreateChangeSet", mock.Anything)).To(BeTrue())
+		})
+	})
+
+	Describe("NodeGroupStackExists", func() {
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			sc = NewStackCollection(p, newClusterConfig("test-cluster"))
+
+			p.MockCloudFormation().On("DescribeStacks", &cfn.DescribeStacksInput{StackName: aws.String("eksctl-test-cluster-nodegroup-ng-1")}).
+				Return(&cfn.DescribeStacksOutput{Stacks: []*Stack{{StackName: aws.String("eksctl-test-cluster-nodegroup-ng-1")}}}, nil)
+			p.MockCloudFormation().On("DescribeStacks", &cfn.DescribeStacksInput{StackName: aws.String("eksctl-test-cluster-nodegroup-ng-2")}).
+				Return(nil, awserr.New("ValidationError", "Stack with id eksctl-test-cluster-nodegroup-ng-2 does not exist", nil))
+			p.MockCloudFormation().On("DescribeStacks", &cfn.DescribeStacksInput{StackName: aws.String("eksctl-test-cluster-nodegroup-ng-3")}).
+				Return(nil, awserr.New("AccessDenied", "not authorized to perform: cloudformation:DescribeStacks", nil))
+		})
+
+		It("returns true when the stack exists", func() {
+			exists, err := sc.NodeGroupStackExists("ng-1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exists).To(BeTrue())
+		})
+
+		It("returns false when the stack does not exist", func() {
+			exists, err := sc.NodeGroupStackExists("ng-2")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exists).To(BeFalse())
+		})
+
+		It("returns other errors", func() {
+			_, err := sc.NodeGroupStackExists("ng-3")
+			Expect(err).To(MatchError(ContainSubstring("not authorized")))
+			p.MockCloudFormation().AssertNotCalled(GinkgoT(), "ListStacksPages", mock.Anything, mock.Anything)
+		})
+	})
+
+	Describe("ListNodeGroupStacks", func() {
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			sc = NewStackCollection(p, newClusterConfig("test-cluster"))
+
+			stacks := map[string]*Stack{
+				"eksctl-test-cluster-cluster": {
+					StackName:   aws.String("eksctl-test-cluster-cluster"),
+					StackStatus: aws.String(cfn.StackStatusCreateComplete),
+				},
+				"eksctl-test-cluster-nodegroup-ng-1": {
+					StackName:   aws.String("eksctl-test-cluster-nodegroup-ng-1"),
+					StackStatus: aws.String(cfn.StackStatusUpdateInProgress),
+					Tags:        []*cfn.Tag{{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("ng-1")}},
+				},
+				"eksctl-test-cluster-nodegroup-mng-1": {
+					StackName:   aws.String("eksctl-test-cluster-nodegroup-mng-1"),
+					StackStatus: aws.String(cfn.StackStatusCreateComplete),
+					Tags: []*cfn.Tag{
+						{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("mng-1")},
+						{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(string(api.NodeGroupTypeManaged))},
+					},
+				},
+			}
+			p.MockCloudFormation().On("ListStacksPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				consume := args[1].(func(p *cfn.ListStacksOutput, last bool) (shouldContinue bool))
+				out := &cfn.ListStacksOutput{}
+				for _, name := range []string{"eksctl-test-cluster-cluster", "eksctl-test-cluster-nodegroup-ng-1", "eksctl-test-cluster-nodegroup-mng-1"} {
+					out.StackSummaries = append(out.StackSummaries, &cfn.StackSummary{StackName: aws.String(name)})
+				}
+				consume(out, true)
+			}).Return(nil)
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(func(input *cfn.DescribeStacksInput) *cfn.DescribeStacksOutput {
+				return &cfn.DescribeStacksOutput{Stacks: []*Stack{stacks[*input.StackName]}}
+			}, nil)
+		})
+
+		It("returns the name, type and stack status of each nodegroup", func() {
+			nodeGroupStacks, err := sc.ListNodeGroupStacks()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nodeGroupStacks).To(ConsistOf(
+				NodeGroupStack{
+					NodeGroupName: "ng-1",
+					Type:          api.NodeGroupTypeUnmanaged,
+					StackName:     "eksctl-test-cluster-nodegroup-ng-1",
+					StackStatus:   cfn.StackStatusUpdateInProgress,
+				},
+				NodeGroupStack{
+					NodeGroupName: "mng-1",
+					Type:          api.NodeGroupTypeManaged,
+					StackName:     "eksctl-test-cluster-nodegroup-mng-1",
+					StackStatus:   cfn.StackStatusCreateComplete,
+				},
+			))
 		})
 	})
 
@@ -209,6 +766,7 @@ var _ = Describe("StackCollection NodeGroup", func() {
 				newNodeGroup(cc)
 
 				sc = NewStackCollection(p, cc)
+				p.MockEKS().On("DescribeCluster", mock.Anything).Return(&eks.DescribeClusterOutput{}, nil)
 
 				p.MockCloudFormation().On("GetTemplate", mock.MatchedBy(func(input *cfn.GetTemplateInput) bool {
 					return input.StackName != nil && *input.StackName == "eksctl-test-cluster-nodegroup-12345"
@@ -257,6 +815,9 @@ var _ = Describe("StackCollection NodeGroup", func() {
 					},
 				}, nil)
 
+				p.MockCloudFormation().On("DescribeStacks", &cfn.DescribeStacksInput{StackName: aws.String("eksctl-test-cluster-cluster")}).
+					Return(nil, awserr.New("ValidationError", "Stack with id eksctl-test-cluster-cluster does not exist", nil))
+
 				p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(nil, fmt.Errorf("DescribeStacks failed"))
 
 				p.MockCloudFormation().On("DescribeStackResource", mock.MatchedBy(func(input *cfn.DescribeStackResourceInput) bool {
@@ -269,6 +830,20 @@ var _ = Describe("StackCollection NodeGroup", func() {
 
 				p.MockCloudFormation().On("DescribeStackResource", mock.Anything).Return(nil, fmt.Errorf("DescribeStackResource failed"))
 
+				p.MockASG().On("DescribeAutoScalingGroups", mock.MatchedBy(func(input *autoscaling.DescribeAutoScalingGroupsInput) bool {
+					return *input.AutoScalingGroupNames[0] == "eksctl-test-cluster-nodegroup-123451-NodeGroup-1N68LL8H1EH27"
+				})).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+					AutoScalingGroups: []*autoscaling.Group{
+						{
+							DesiredCapacity: aws.Int64(3),
+							Instances: []*autoscaling.Instance{
+								{InstanceId: aws.String("i-1"), LifecycleState: aws.String(autoscaling.LifecycleStateInService)},
+								{InstanceId: aws.String("i-2"), LifecycleState: aws.String(autoscaling.LifecycleStateInService)},
+								{InstanceId: aws.String("i-3"), LifecycleState: aws.String(autoscaling.LifecycleStateTerminating)},
+							},
+						},
+					},
+				}, nil)
 			})
 
 			Context("With no matching stacks", func() {
@@ -310,8 +885,8 @@ var _ = Describe("StackCollection NodeGroup", func() {
 					Expect(p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "GetTemplate", 1)).To(BeTrue())
 				})
 
-				It("should have called AWS CloudFormation DescribeStacks once", func() {
-					Expect(p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "DescribeStacks", 1)).To(BeTrue())
+				It("should have called AWS CloudFormation DescribeStacks once for the nodegroup and once for the cluster", func() {
+					Expect(p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "DescribeStacks", 2)).To(BeTrue())
 				})
 
 				It("the output should equal the expectation", func() {
@@ -319,33 +894,1423 @@ var _ = Describe("StackCollection NodeGroup", func() {
 					Expect(out[0].StackName).To(Equal("eksctl-test-cluster-nodegroup-12345"))
 					Expect(out[0].NodeInstanceRoleARN).To(Equal("arn:aws:iam::1111:role/eks-nodes-base-role"))
 				})
+
+				It("should report the nodegroup as degraded when fewer instances are in service than desired", func() {
+					Expect(out[0].HealthStatus).To(Equal(&NodeGroupHealthStatus{
+						Status:           NodeGroupDegraded,
+						DesiredCapacity:  3,
+						HealthyInstances: 2,
+					}))
+				})
+
+				It("should read the sizes from the template", func() {
+					Expect(out[0].MinSize).To(Equal(aws.Int(1)))
+					Expect(out[0].MaxSize).To(Equal(aws.Int(6)))
+					Expect(out[0].DesiredCapacity).To(Equal(aws.Int(3)))
+				})
+
+				It("should fetch the template again on the next call", func() {
+					_, err := sc.GetNodeGroupSummaries("")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "GetTemplate", 2)).To(BeTrue())
+				})
 			})
 		})
 	})
 
-	Describe("GetNodeGroupType", func() {
-
-		createTags := func(tags map[string]string) []*cfn.Tag {
-			cfnTags := make([]*cfn.Tag, 0)
-			for k, v := range tags {
-				cfnTags = append(cfnTags, &cfn.Tag{
-					Key:   aws.String(k),
-					Value: aws.String(v),
-				})
+	Describe("GetNodeGroupSummaries with a nodegroup name", func() {
+		describedStacks := func() []string {
+			var names []string
+			for _, call := range p.MockCloudFormation().Calls {
+				if call.Method == "DescribeStacks" {
+					names = append(names, *call.Arguments.Get(0).(*cfn.DescribeStacksInput).StackName)
+				}
 			}
-			return cfnTags
+			return names
 		}
 
-		DescribeTable("with tag for the nodegroup type", func(inputTags map[string]string, expectedType api.NodeGroupType) {
-			ngType, err := GetNodeGroupType(createTags(inputTags))
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			sc = NewStackCollection(p, newClusterConfig("test-cluster"))
+			p.MockEKS().On("DescribeCluster", mock.Anything).Return(&eks.DescribeClusterOutput{}, nil)
+
+			p.MockCloudFormation().On("ListStacksPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				consume := args[1].(func(p *cfn.ListStacksOutput, last bool) (shouldContinue bool))
+				consume(&cfn.ListStacksOutput{
+					StackSummaries: []*cfn.StackSummary{
+						{StackName: aws.String("eksctl-test-cluster-nodegroup-ng-1")},
+						{StackName: aws.String("eksctl-test-cluster-nodegroup-ng-10")},
+					},
+				}, true)
+			}).Return(nil)
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(func(input *cfn.DescribeStacksInput) *cfn.DescribeStacksOutput {
+				return &cfn.DescribeStacksOutput{
+					Stacks: []*cfn.Stack{
+						{
+							StackName:   input.StackName,
+							StackStatus: aws.String(cfn.StackStatusCreateComplete),
+							Tags: []*cfn.Tag{
+								{
+									Key:   aws.String(api.NodeGroupNameTag),
+									Value: aws.String(strings.TrimPrefix(*input.StackName, "eksctl-test-cluster-nodegroup-")),
+								},
+							},
+						},
+					},
+				}
+			}, nil)
+			p.MockCloudFormation().On("GetTemplate", mock.Anything).Return(&cfn.GetTemplateOutput{
+				TemplateBody: aws.String(nodegroupResource),
+			}, nil)
+			p.MockCloudFormation().On("DescribeStackResource", mock.Anything).Return(&cfn.DescribeStackResourceOutput{
+				StackResourceDetail: &cfn.StackResourceDetail{
+					PhysicalResourceId: aws.String("asg-ng-1"),
+				},
+			}, nil)
+			p.MockASG().On("DescribeAutoScalingGroups", mock.Anything).Return(&autoscaling.DescribeAutoScalingGroupsOutput{}, nil)
+		})
 
-			if expectedType == "" {
-				Expect(err).To(HaveOccurred())
-			} else {
-				Expect(err).ToNot(HaveOccurred())
-				Expect(ngType).To(Equal(expectedType))
+		It("only describes the stack of the named nodegroup", func() {
+			out, err := sc.GetNodeGroupSummaries("ng-1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(HaveLen(1))
+			Expect(out[0].Name).To(Equal("ng-1"))
+			Expect(describedStacks()).To(ConsistOf("eksctl-test-cluster-cluster", "eksctl-test-cluster-nodegroup-ng-1"))
+		})
+
+		It("returns an empty slice when the nodegroup does not exist", func() {
+			out, err := sc.GetNodeGroupSummaries("ng-2")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).NotTo(BeNil())
+			Expect(out).To(BeEmpty())
+			Expect(describedStacks()).To(BeEmpty())
+		})
+	})
+
+	Describe("GetNodeGroupSummaries of a cluster without a cluster stack", func() {
+		const (
+			clusterStackName   = "eksctl-test-cluster-cluster"
+			nodeGroupStackName = "eksctl-test-cluster-nodegroup-ng-1"
+		)
+
+		var existingStacks map[string]bool
+
+		mockStacks := func(stackNames ...string) {
+			p.MockCloudFormation().On("ListStacksPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				consume := args[1].(func(p *cfn.ListStacksOutput, last bool) (shouldContinue bool))
+				out := &cfn.ListStacksOutput{}
+				for _, name := range stackNames {
+					out.StackSummaries = append(out.StackSummaries, &cfn.StackSummary{StackName: aws.String(name)})
+				}
+				consume(out, true)
+			}).Return(nil)
+			for _, name := range stackNames {
+				existingStacks[name] = true
 			}
-		},
+		}
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			sc = NewStackCollection(p, newClusterConfig("test-cluster"))
+			existingStacks = map[string]bool{}
+
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(func(input *cfn.DescribeStacksInput) *cfn.DescribeStacksOutput {
+				if !existingStacks[*input.StackName] {
+					return nil
+				}
+				tags := []*cfn.Tag{{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")}}
+				if *input.StackName == nodeGroupStackName {
+					tags = append(tags, &cfn.Tag{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("ng-1")})
+				}
+				return &cfn.DescribeStacksOutput{
+					Stacks: []*cfn.Stack{
+						{
+							StackName:   input.StackName,
+							StackStatus: aws.String(cfn.StackStatusCreateComplete),
+							Tags:        tags,
+						},
+					},
+				}
+			}, func(input *cfn.DescribeStacksInput) error {
+				if !existingStacks[*input.StackName] {
+					return awserr.New("ValidationError", fmt.Sprintf("Stack with id %s does not exist", *input.StackName), nil)
+				}
+				return nil
+			})
+			p.MockCloudFormation().On("GetTemplate", mock.Anything).Return(&cfn.GetTemplateOutput{
+				TemplateBody: aws.String(nodegroupResource),
+			}, nil)
+			p.MockCloudFormation().On("DescribeStackResource", mock.Anything).Return(&cfn.DescribeStackResourceOutput{
+				StackResourceDetail: &cfn.StackResourceDetail{
+					PhysicalResourceId: aws.String("asg-ng-1"),
+				},
+			}, nil)
+			p.MockASG().On("DescribeAutoScalingGroups", mock.Anything).Return(&autoscaling.DescribeAutoScalingGroupsOutput{}, nil)
+		})
+
+		It("returns the nodegroups when the cluster stack exists", func() {
+			mockStacks(clusterStackName, nodeGroupStackName)
+
+			out, err := sc.GetNodeGroupSummaries("")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(HaveLen(1))
+			Expect(out[0].Name).To(Equal("ng-1"))
+			Expect(p.MockEKS().AssertNotCalled(GinkgoT(), "DescribeCluster", mock.Anything)).To(BeTrue())
+		})
+
+		It("returns the nodegroups of clusters not created by eksctl", func() {
+			mockStacks(nodeGroupStackName)
+			p.MockEKS().On("DescribeCluster", mock.Anything).Return(&eks.DescribeClusterOutput{}, nil)
+
+			out, err := sc.GetNodeGroupSummaries("")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(HaveLen(1))
+		})
+
+		It("skips the nodegroups of partially deleted clusters", func() {
+			mockStacks(nodeGroupStackName)
+			p.MockEKS().On("DescribeCluster", mock.Anything).Return(nil, awserr.New(eks.ErrCodeResourceNotFoundException, "No cluster found", nil))
+
+			out, err := sc.GetNodeGroupSummaries("")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).NotTo(BeNil())
+			Expect(out).To(BeEmpty())
+			Expect(p.MockCloudFormation().AssertNotCalled(GinkgoT(), "GetTemplate", mock.Anything)).To(BeTrue())
+		})
+	})
+
+	Describe("GetNodeGroupSummaries with paginated stacks", func() {
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			sc = NewStackCollection(p, newClusterConfig("test-cluster"))
+
+			summary := func(name string) *cfn.StackSummary {
+				return &cfn.StackSummary{StackName: aws.String(name), StackId: aws.String(name + "-id")}
+			}
+			p.MockCloudFormation().On("ListStacksPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				consume := args[1].(func(p *cfn.ListStacksOutput, last bool) (shouldContinue bool))
+				Expect(consume(&cfn.ListStacksOutput{StackSummaries: []*cfn.StackSummary{
+					summary("eksctl-test-cluster-cluster"),
+					summary("eksctl-test-cluster-nodegroup-ng-1"),
+				}}, false)).To(BeTrue())
+				// ng-1 is listed again as stacks were created while paging
+				Expect(consume(&cfn.ListStacksOutput{StackSummaries: []*cfn.StackSummary{
+					summary("eksctl-test-cluster-nodegroup-ng-1"),
+					summary("eksctl-test-cluster-nodegroup-ng-2"),
+				}}, true)).To(BeTrue())
+			}).Return(nil)
+			clusterStackTags := []*cfn.Tag{{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")}}
+			mockStackDescriptions(p, map[string]*mockedStack{
+				"eksctl-test-cluster-cluster":        {Tags: clusterStackTags},
+				"eksctl-test-cluster-nodegroup-ng-1": {Tags: nodeGroupStackTags("ng-1", api.NodeGroupTypeUnmanaged), Template: nodegroupResource},
+				"eksctl-test-cluster-nodegroup-ng-2": {Tags: nodeGroupStackTags("ng-2", api.NodeGroupTypeUnmanaged), Template: nodegroupResource},
+			})
+			p.MockCloudFormation().On("DescribeStackResource", mock.Anything).Return(&cfn.DescribeStackResourceOutput{
+				StackResourceDetail: &cfn.StackResourceDetail{
+					PhysicalResourceId: aws.String("asg"),
+				},
+			}, nil)
+			p.MockASG().On("DescribeAutoScalingGroups", mock.Anything).Return(&autoscaling.DescribeAutoScalingGroupsOutput{}, nil)
+		})
+
+		It("returns the nodegroups of all pages once", func() {
+			out, err := sc.GetNodeGroupSummaries("")
+			Expect(err).NotTo(HaveOccurred())
+			var names []string
+			for _, summary := range out {
+				names = append(names, summary.Name)
+			}
+			Expect(names).To(ConsistOf("ng-1", "ng-2"))
+		})
+	})
+
+	Describe("GetNodeGroupSummaries template fields", func() {
+		mockStack := func(nodeGroupType api.NodeGroupType, template string) {
+			mockStacks(p, map[string]*mockedStack{
+				"eksctl-test-cluster-nodegroup-ng-1": {
+					Tags:     nodeGroupStackTags("ng-1", api.NodeGroupTypeManaged),
+					Template: `{"Resources": {}}`,
+				},
+			})
+			p.MockCloudFormation().On("DescribeStackResource", mock.Anything).Return(&cfn.DescribeStackResourceOutput{
+				StackResourceDetail: &cfn.StackResourceDetail{PhysicalResourceId: aws.String("asg-name")},
+			}, nil)
+			p.MockEKS().On("DescribeNodegroup", mock.Anything).Return(&eks.DescribeNodegroupOutput{
+				Nodegroup: &eks.Nodegroup{
+					Resources: &eks.NodegroupResources{
+						AutoScalingGroups: []*eks.AutoScalingGroup{{Name: aws.String("asg-name")}},
+					},
+				},
+			}, nil)
+			p.MockASG().On("DescribeAutoScalingGroups", mock.Anything).Return(&autoscaling.DescribeAutoScalingGroupsOutput{}, nil)
+		}
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			cc = newClusterConfig("test-cluster")
+			sc = NewStackCollection(p, cc)
+			p.MockEKS().On("DescribeCluster", mock.Anything).Return(&eks.DescribeClusterOutput{}, nil)
+		})
+
+		It("reads the scaling config of managed nodegroups", func() {
+			mockStack(api.NodeGroupTypeManaged, fmt.Sprintf(managedNodegroupTemplate, 2, 5, 0))
+
+			out, err := sc.GetNodeGroupSummaries("")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out[0].DesiredCapacity).To(Equal(aws.Int(2)))
+			Expect(out[0].MaxSize).To(Equal(aws.Int(5)))
+			Expect(out[0].MinSize).To(Equal(aws.Int(0)))
+		})
+
+		It("leaves the sizes unset when the template does not set them", func() {
+			mockStack(api.NodeGroupTypeUnmanaged, `{"Resources": {"NodeGroup": {"Properties": {"MaxSize": "4"}}}}`)
+
+			out, err := sc.GetNodeGroupSummaries("")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out[0].MaxSize).To(Equal(aws.Int(4)))
+			Expect(out[0].MinSize).To(BeNil())
+			Expect(out[0].DesiredCapacity).To(BeNil())
+		})
+
+		It("reads the AMI of unmanaged nodegroups from their launch template", func() {
+			mockStack(api.NodeGroupTypeUnmanaged, `{"Resources": {"NodeGroupLaunchTemplate": {"Properties": {"LaunchTemplateData": {"ImageId": "ami-1"}}}}}`)
+
+			out, err := sc.GetNodeGroupSummaries("")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out[0].ImageID).To(Equal("ami-1"))
+		})
+
+		It("reads the AMI of legacy nodegroups from their launch configuration", func() {
+			mockStack(api.NodeGroupTypeUnmanaged, `{"Resources": {"NodeLaunchConfig": {"Type": "AWS::AutoScaling::LaunchConfiguration", "Properties": {"ImageId": "ami-2"}}}}`)
+
+			out, err := sc.GetNodeGroupSummaries("")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out[0].ImageID).To(Equal("ami-2"))
+		})
+
+		It("resolves the default AMI of managed nodegroups from the launch template of their Auto Scaling group", func() {
+			p.MockASG().On("DescribeAutoScalingGroups", mock.Anything).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+				AutoScalingGroups: []*autoscaling.Group{
+					{
+						LaunchTemplate: &autoscaling.LaunchTemplateSpecification{
+							LaunchTemplateId: aws.String("lt-1"),
+							Version:          aws.String("2"),
+						},
+					},
+				},
+			}, nil)
+			mockStack(api.NodeGroupTypeManaged, `{"Resources": {"ManagedNodeGroup": {"Type": "AWS::EKS::Nodegroup", "Properties": {"AmiType": "AL2_x86_64"}}}}`)
+			p.MockEC2().On("DescribeLaunchTemplateVersions", &ec2.DescribeLaunchTemplateVersionsInput{
+				LaunchTemplateId: aws.String("lt-1"),
+				Versions:         aws.StringSlice([]string{"2"}),
+			}).Return(&ec2.DescribeLaunchTemplateVersionsOutput{
+				LaunchTemplateVersions: []*ec2.LaunchTemplateVersion{
+					{LaunchTemplateData: &ec2.ResponseLaunchTemplateData{ImageId: aws.String("ami-3")}},
+				},
+			}, nil)
+
+			out, err := sc.GetNodeGroupSummaries("")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out[0].ImageID).To(Equal("ami-3"))
+		})
+
+		It("describes managed nodegroups and their Auto Scaling groups once", func() {
+			mockStack(api.NodeGroupTypeManaged, `{"Resources": {"ManagedNodeGroup": {"Type": "AWS::EKS::Nodegroup", "Properties": {"AmiType": "AL2_x86_64"}}}}`)
+
+			_, err := sc.GetNodeGroupSummaries("")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(p.MockEKS().AssertNumberOfCalls(GinkgoT(), "DescribeNodegroup", 1)).To(BeTrue())
+			Expect(p.MockASG().AssertNumberOfCalls(GinkgoT(), "DescribeAutoScalingGroups", 1)).To(BeTrue())
+		})
+
+		It("leaves the AMI of managed nodegroups with a launch template eksctl did not create empty", func() {
+			mockStack(api.NodeGroupTypeManaged, `{"Resources": {"ManagedNodeGroup": {"Type": "AWS::EKS::Nodegroup", "Properties": {"LaunchTemplate": {"Id": "lt-custom"}}}}}`)
+
+			out, err := sc.GetNodeGroupSummaries("")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out[0].ImageID).To(BeEmpty())
+			Expect(p.MockEC2().AssertNotCalled(GinkgoT(), "DescribeLaunchTemplateVersions", mock.Anything)).To(BeTrue())
+		})
+	})
+
+	Describe("GetNodeGroupSummaries launch template", func() {
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			cc = newClusterConfig("test-cluster")
+			sc = NewStackCollection(p, cc)
+			p.MockEKS().On("DescribeCluster", mock.Anything).Return(&eks.DescribeClusterOutput{}, nil)
+		})
+
+		mockStack := func(nodeGroupType api.NodeGroupType) {
+			p.MockCloudFormation().On("ListStacksPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				consume := args[1].(func(p *cfn.ListStacksOutput, last bool) (shouldContinue bool))
+				consume(&cfn.ListStacksOutput{
+					StackSummaries: []*cfn.StackSummary{{StackName: aws.String("eksctl-test-cluster-nodegroup-ng-1")}},
+				}, true)
+			}).Return(nil)
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(&cfn.DescribeStacksOutput{
+				Stacks: []*cfn.Stack{
+					{
+						StackName:   aws.String("eksctl-test-cluster-nodegroup-ng-1"),
+						StackStatus: aws.String(cfn.StackStatusCreateComplete),
+						Tags: []*cfn.Tag{
+							{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("ng-1")},
+							{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(string(nodeGroupType))},
+						},
+					},
+				},
+			}, nil)
+			p.MockCloudFormation().On("GetTemplate", mock.Anything).Return(&cfn.GetTemplateOutput{
+				TemplateBody: aws.String(`{"Resources": {}}`),
+			}, nil)
+			p.MockCloudFormation().On("DescribeStackResource", mock.Anything).Return(&cfn.DescribeStackResourceOutput{
+				StackResourceDetail: &cfn.StackResourceDetail{PhysicalResourceId: aws.String("asg-name")},
+			}, nil)
+		}
+
+		It("reads the launch template of unmanaged nodegroups from their Auto Scaling group", func() {
+			mockStack(api.NodeGroupTypeUnmanaged)
+			p.MockASG().On("DescribeAutoScalingGroups", mock.Anything).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+				AutoScalingGroups: []*autoscaling.Group{
+					{
+						MixedInstancesPolicy: &autoscaling.MixedInstancesPolicy{
+							LaunchTemplate: &autoscaling.LaunchTemplate{
+								LaunchTemplateSpecification: &autoscaling.LaunchTemplateSpecification{
+									LaunchTemplateId: aws.String("lt-1"),
+									Version:          aws.String("3"),
+								},
+							},
+						},
+					},
+				},
+			}, nil)
+
+			out, err := sc.GetNodeGroupSummaries("")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out[0].LaunchTemplateID).To(Equal("lt-1"))
+			Expect(out[0].LaunchTemplateVersion).To(Equal("3"))
+		})
+
+		It("leaves the launch template of unmanaged nodegroups using a launch configuration empty", func() {
+			mockStack(api.NodeGroupTypeUnmanaged)
+			p.MockASG().On("DescribeAutoScalingGroups", mock.Anything).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+				AutoScalingGroups: []*autoscaling.Group{{LaunchConfigurationName: aws.String("lc-1")}},
+			}, nil)
+
+			out, err := sc.GetNodeGroupSummaries("")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out[0].LaunchTemplateID).To(BeEmpty())
+			Expect(out[0].LaunchTemplateVersion).To(BeEmpty())
+		})
+
+		It("reads the launch template of managed nodegroups from EKS", func() {
+			mockStack(api.NodeGroupTypeManaged)
+			p.MockEKS().On("DescribeNodegroup", mock.Anything).Return(&eks.DescribeNodegroupOutput{
+				Nodegroup: &eks.Nodegroup{
+					LaunchTemplate: &eks.LaunchTemplateSpecification{
+						Id:      aws.String("lt-2"),
+						Version: aws.String("7"),
+					},
+					Resources: &eks.NodegroupResources{},
+				},
+			}, nil)
+
+			out, err := sc.GetNodeGroupSummaries("")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out[0].LaunchTemplateID).To(Equal("lt-2"))
+			Expect(out[0].LaunchTemplateVersion).To(Equal("7"))
+		})
+	})
+
+	Describe("GetNodeGroupSummaries subnets", func() {
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			cc = newClusterConfig("test-cluster")
+			sc = NewStackCollection(p, cc)
+			p.MockEKS().On("DescribeCluster", mock.Anything).Return(&eks.DescribeClusterOutput{}, nil)
+
+			mockStacks(p, map[string]*mockedStack{
+				"eksctl-test-cluster-nodegroup-ng-1": {Tags: nodeGroupStackTags("ng-1", api.NodeGroupTypeManaged), Template: `{"Resources": {}}`},
+				"eksctl-test-cluster-nodegroup-ng-2": {Tags: nodeGroupStackTags("ng-2", api.NodeGroupTypeManaged), Template: `{"Resources": {}}`},
+			})
+			p.MockASG().On("DescribeAutoScalingGroups", mock.Anything).Return(&autoscaling.DescribeAutoScalingGroupsOutput{}, nil)
+			nodeGroupSubnets := map[string][]string{
+				"ng-1": {"subnet-1", "subnet-2", "subnet-3"},
+				"ng-2": {"subnet-3", "subnet-4"},
+			}
+			p.MockEKS().On("DescribeNodegroup", mock.Anything).Return(func(input *eks.DescribeNodegroupInput) *eks.DescribeNodegroupOutput {
+				return &eks.DescribeNodegroupOutput{
+					Nodegroup: &eks.Nodegroup{
+						NodegroupName: input.NodegroupName,
+						Subnets:       aws.StringSlice(nodeGroupSubnets[aws.StringValue(input.NodegroupName)]),
+						Resources:     &eks.NodegroupResources{},
+					},
+				}
+			}, nil)
+		})
+
+		It("maps the subnets of the nodegroups to their availability zones, describing them at once", func() {
+			p.MockEC2().On("DescribeSubnets", &ec2.DescribeSubnetsInput{
+				SubnetIds: aws.StringSlice([]string{"subnet-1", "subnet-2", "subnet-3", "subnet-4"}),
+			}).Return(&ec2.DescribeSubnetsOutput{
+				Subnets: []*ec2.Subnet{
+					{SubnetId: aws.String("subnet-1"), AvailabilityZone: aws.String("us-west-2a")},
+					{SubnetId: aws.String("subnet-2"), AvailabilityZone: aws.String("us-west-2b")},
+					{SubnetId: aws.String("subnet-3"), AvailabilityZone: aws.String("us-west-2a")},
+					{SubnetId: aws.String("subnet-4"), AvailabilityZone: aws.String("us-west-2c")},
+				},
+			}, nil)
+
+			out, err := sc.GetNodeGroupSummaries("")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(HaveLen(2))
+			Expect(out[0].AvailabilityZoneSubnets).To(Equal(map[string][]string{
+				"us-west-2a": {"subnet-1", "subnet-3"},
+				"us-west-2b": {"subnet-2"},
+			}))
+			Expect(out[1].AvailabilityZoneSubnets).To(Equal(map[string][]string{
+				"us-west-2a": {"subnet-3"},
+				"us-west-2c": {"subnet-4"},
+			}))
+			p.MockEC2().AssertNumberOfCalls(GinkgoT(), "DescribeSubnets", 1)
+		})
+
+		It("names the subnet whose availability zone cannot be resolved", func() {
+			p.MockEC2().On("DescribeSubnets", mock.Anything).Return(&ec2.DescribeSubnetsOutput{
+				Subnets: []*ec2.Subnet{
+					{SubnetId: aws.String("subnet-1"), AvailabilityZone: aws.String("us-west-2a")},
+					{SubnetId: aws.String("subnet-3"), AvailabilityZone: aws.String("us-west-2a")},
+					{SubnetId: aws.String("subnet-4"), AvailabilityZone: aws.String("us-west-2c")},
+				},
+			}, nil)
+
+			_, err := sc.GetNodeGroupSummaries("")
+			Expect(err).To(MatchError(`resolving subnets of nodegroup "ng-1": unable to resolve the availability zone of subnet "subnet-2"`))
+		})
+	})
+
+	Describe("GetNodeGroupSummaries creation time", func() {
+		var (
+			stackCreationTime     = time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+			nodeGroupCreationTime = time.Date(2021, 3, 1, 12, 5, 0, 0, time.UTC)
+		)
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			cc = newClusterConfig("test-cluster")
+			sc = NewStackCollection(p, cc)
+			p.MockEKS().On("DescribeCluster", mock.Anything).Return(&eks.DescribeClusterOutput{}, nil)
+		})
+
+		mockStack := func(nodeGroupType api.NodeGroupType, creationTime *time.Time) {
+			p.MockCloudFormation().On("ListStacksPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				consume := args[1].(func(p *cfn.ListStacksOutput, last bool) (shouldContinue bool))
+				consume(&cfn.ListStacksOutput{
+					StackSummaries: []*cfn.StackSummary{{StackName: aws.String("eksctl-test-cluster-nodegroup-ng-1")}},
+				}, true)
+			}).Return(nil)
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(&cfn.DescribeStacksOutput{
+				Stacks: []*cfn.Stack{
+					{
+						StackName:    aws.String("eksctl-test-cluster-nodegroup-ng-1"),
+						StackStatus:  aws.String(cfn.StackStatusCreateComplete),
+						CreationTime: creationTime,
+						Tags: []*cfn.Tag{
+							{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("ng-1")},
+							{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(string(nodeGroupType))},
+						},
+					},
+				},
+			}, nil)
+			p.MockCloudFormation().On("GetTemplate", mock.Anything).Return(&cfn.GetTemplateOutput{
+				TemplateBody: aws.String(`{"Resources": {}}`),
+			}, nil)
+			p.MockCloudFormation().On("DescribeStackResource", mock.Anything).Return(&cfn.DescribeStackResourceOutput{
+				StackResourceDetail: &cfn.StackResourceDetail{PhysicalResourceId: aws.String("asg-name")},
+			}, nil)
+			p.MockASG().On("DescribeAutoScalingGroups", mock.Anything).Return(&autoscaling.DescribeAutoScalingGroupsOutput{}, nil)
+		}
+
+		It("reads the creation time of unmanaged nodegroups from their stack", func() {
+			mockStack(api.NodeGroupTypeUnmanaged, &stackCreationTime)
+
+			out, err := sc.GetNodeGroupSummaries("")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out[0].CreationTime).To(Equal(&stackCreationTime))
+			Expect(out[0].Age(stackCreationTime.Add(time.Hour))).To(Equal(time.Hour))
+		})
+
+		It("prefers the creation time of managed nodegroups reported by EKS", func() {
+			mockStack(api.NodeGroupTypeManaged, &stackCreationTime)
+			p.MockEKS().On("DescribeNodegroup", mock.Anything).Return(&eks.DescribeNodegroupOutput{
+				Nodegroup: &eks.Nodegroup{
+					CreatedAt: &nodeGroupCreationTime,
+					Resources: &eks.NodegroupResources{},
+				},
+			}, nil)
+
+			out, err := sc.GetNodeGroupSummaries("")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out[0].CreationTime).To(Equal(&nodeGroupCreationTime))
+		})
+
+		It("falls back to the creation time of the stack when the managed nodegroup cannot be described", func() {
+			mockStack(api.NodeGroupTypeManaged, &stackCreationTime)
+			p.MockEKS().On("DescribeNodegroup", mock.Anything).Return(nil, fmt.Errorf("access denied"))
+
+			out, err := sc.GetNodeGroupSummaries("")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out[0].CreationTime).To(Equal(&stackCreationTime))
+		})
+
+		It("leaves the creation time unset when it is unknown", func() {
+			mockStack(api.NodeGroupTypeUnmanaged, nil)
+
+			out, err := sc.GetNodeGroupSummaries("")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out[0].CreationTime).To(BeNil())
+			Expect(out[0].Age(time.Now())).To(BeZero())
+		})
+	})
+
+	Describe("GetNodeGroupSummaries remote access", func() {
+		const stackName = "eksctl-test-cluster-nodegroup-ng-1"
+
+		mockStack := func(nodeGroupType api.NodeGroupType, template string) {
+			p.MockCloudFormation().On("ListStacksPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				consume := args[1].(func(p *cfn.ListStacksOutput, last bool) (shouldContinue bool))
+				consume(&cfn.ListStacksOutput{
+					StackSummaries: []*cfn.StackSummary{{StackName: aws.String(stackName)}},
+				}, true)
+			}).Return(nil)
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(&cfn.DescribeStacksOutput{
+				Stacks: []*cfn.Stack{
+					{
+						StackName:   aws.String(stackName),
+						StackStatus: aws.String(cfn.StackStatusCreateComplete),
+						Tags: []*cfn.Tag{
+							{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("ng-1")},
+							{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(string(nodeGroupType))},
+							{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")},
+						},
+					},
+				},
+			}, nil)
+			p.MockCloudFormation().On("GetTemplate", mock.Anything).Return(&cfn.GetTemplateOutput{
+				TemplateBody: aws.String(template),
+			}, nil)
+			p.MockCloudFormation().On("DescribeStackResource", mock.Anything).Return(&cfn.DescribeStackResourceOutput{
+				StackResourceDetail: &cfn.StackResourceDetail{PhysicalResourceId: aws.String("asg-name")},
+			}, nil)
+			p.MockASG().On("DescribeAutoScalingGroups", mock.Anything).Return(&autoscaling.DescribeAutoScalingGroupsOutput{}, nil)
+		}
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			cc = newClusterConfig("test-cluster")
+			sc = NewStackCollection(p, cc)
+			p.MockEKS().On("DescribeCluster", mock.Anything).Return(&eks.DescribeClusterOutput{}, nil)
+		})
+
+		It("reads the SSH ingress rules and key of unmanaged nodegroups from the template", func() {
+			mockStack(api.NodeGroupTypeUnmanaged, `{
+  "Resources": {
+    "NodeGroupLaunchTemplate": {"Properties": {"LaunchTemplateData": {"KeyName": "my-key"}}},
+    "SG": {
+      "Properties": {
+        "SecurityGroupIngress": [
+          {"FromPort": 1025, "ToPort": 65535, "IpProtocol": "tcp", "SourceSecurityGroupId": {"Fn::ImportValue": "eksctl-test-cluster-cluster::SecurityGroup"}},
+          {"FromPort": 22, "ToPort": 22, "IpProtocol": "tcp", "CidrIp": "0.0.0.0/0"},
+          {"FromPort": 22, "ToPort": 22, "IpProtocol": "tcp", "CidrIpv6": "::/0"}
+        ]
+      }
+    }
+  }
+}`)
+
+			p.MockEC2().On("DescribeKeyPairs", mock.Anything).Return(&ec2.DescribeKeyPairsOutput{
+				KeyPairs: []*ec2.KeyPairInfo{{KeyName: aws.String("my-key")}},
+			}, nil)
+
+			out, err := sc.GetNodeGroupSummaries("")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(HaveLen(1))
+			Expect(out[0].RemoteAccess).To(Equal(&RemoteAccessInfo{
+				Enabled:     true,
+				SSHKeyName:  "my-key",
+				SourceCIDRs: []string{"0.0.0.0/0", "::/0"},
+			}))
+			Expect(out[0].SSHKeyExists).To(BeTrue())
+		})
+
+		It("reports SSH as disabled for unmanaged nodegroups without SSH ingress rules", func() {
+			mockStack(api.NodeGroupTypeUnmanaged, nodegroupResource)
+
+			out, err := sc.GetNodeGroupSummaries("")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out[0].RemoteAccess.Enabled).To(BeFalse())
+			Expect(out[0].SSHKeyExists).To(BeFalse())
+		})
+
+		It("reads the remote access config of managed nodegroups from EKS", func() {
+			mockStack(api.NodeGroupTypeManaged, `{"Resources": {}}`)
+			p.MockEKS().On("DescribeNodegroup", mock.Anything).Return(&eks.DescribeNodegroupOutput{
+				Nodegroup: &eks.Nodegroup{
+					RemoteAccess: &eks.RemoteAccessConfig{
+						Ec2SshKey:            aws.String("my-key"),
+						SourceSecurityGroups: aws.StringSlice([]string{"sg-1"}),
+					},
+					Resources: &eks.NodegroupResources{},
+				},
+			}, nil)
+			p.MockEC2().On("DescribeKeyPairs", mock.Anything).Return(nil, awserr.New("InvalidKeyPair.NotFound", "not found", nil))
+
+			out, err := sc.GetNodeGroupSummaries("")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out[0].RemoteAccess).To(Equal(&RemoteAccessInfo{
+				Enabled:              true,
+				SSHKeyName:           "my-key",
+				SourceSecurityGroups: []string{"sg-1"},
+			}))
+			Expect(out[0].SSHKeyExists).To(BeFalse())
+		})
+	})
+
+	Describe("GetNodeGroupsByTag", func() {
+		var (
+			out map[string][]*NodeGroupSummary
+			err error
+		)
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			cc = newClusterConfig("test-cluster")
+			sc = NewStackCollection(p, cc)
+
+			stacks := map[string][]*cfn.Tag{
+				"eksctl-test-cluster-nodegroup-ng-1": {
+					{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("ng-1")},
+					{Key: aws.String("owner"), Value: aws.String("team-a")},
+				},
+				"eksctl-test-cluster-nodegroup-ng-2": {
+					{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("ng-2")},
+					{Key: aws.String("owner"), Value: aws.String("team-a")},
+				},
+				"eksctl-test-cluster-nodegroup-ng-3": {
+					{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("ng-3")},
+				},
+			}
+
+			p.MockCloudFormation().On("ListStacksPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				consume := args[1].(func(p *cfn.ListStacksOutput, last bool) (shouldContinue bool))
+				out := &cfn.ListStacksOutput{}
+				for _, name := range []string{"eksctl-test-cluster-nodegroup-ng-1", "eksctl-test-cluster-nodegroup-ng-2", "eksctl-test-cluster-nodegroup-ng-3"} {
+					out.StackSummaries = append(out.StackSummaries, &cfn.StackSummary{StackName: aws.String(name)})
+				}
+				consume(out, true)
+			}).Return(nil)
+
+			for name, tags := range stacks {
+				stackName, stackTags := name, tags
+				p.MockCloudFormation().On("DescribeStacks", mock.MatchedBy(func(input *cfn.DescribeStacksInput) bool {
+					return input.StackName != nil && *input.StackName == stackName
+				})).Return(&cfn.DescribeStacksOutput{
+					Stacks: []*cfn.Stack{
+						{
+							StackName:   aws.String(stackName),
+							StackStatus: aws.String(cfn.StackStatusCreateComplete),
+							Tags:        stackTags,
+						},
+					},
+				}, nil)
+			}
+
+			p.MockCloudFormation().On("GetTemplate", mock.Anything).Return(&cfn.GetTemplateOutput{
+				TemplateBody: aws.String(nodegroupResource),
+			}, nil)
+
+			p.MockCloudFormation().On("DescribeStackResource", mock.Anything).Return(&cfn.DescribeStackResourceOutput{
+				StackResourceDetail: &cfn.StackResourceDetail{
+					PhysicalResourceId: aws.String("asg-name"),
+				},
+			}, nil)
+			p.MockASG().On("DescribeAutoScalingGroups", mock.Anything).Return(&autoscaling.DescribeAutoScalingGroupsOutput{}, nil)
+		})
+
+		JustBeforeEach(func() {
+			out, err = sc.GetNodeGroupsByTag("owner")
+		})
+
+		It("groups the nodegroups by the tag value", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(HaveLen(2))
+
+			var teamA []string
+			for _, summary := range out["team-a"] {
+				teamA = append(teamA, summary.Name)
+			}
+			Expect(teamA).To(ConsistOf("ng-1", "ng-2"))
+
+			Expect(out[""]).To(HaveLen(1))
+			Expect(out[""][0].Name).To(Equal("ng-3"))
+			Expect(out[""][0].AutoScalingGroupName).To(Equal("asg-name"))
+		})
+	})
+
+	Describe("FindNodeGroupsUsingInstanceTypes", func() {
+		const (
+			launchTemplateNodeGroup = `{"Resources": {"NodeGroupLaunchTemplate": {"Properties": {"LaunchTemplateData": {"InstanceType": "%s"}}}}}`
+			mixedInstancesNodeGroup = `{"Resources": {"NodeGroup": {"Properties": {"MixedInstancesPolicy": {"LaunchTemplate": {"Overrides": [{"InstanceType": "m5.large"}, {"InstanceType": "m4.large"}]}}}}}}`
+		)
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			cc = newClusterConfig("test-cluster")
+			sc = NewStackCollection(p, cc)
+
+			templates := map[string]string{
+				"eksctl-test-cluster-nodegroup-ng-1": fmt.Sprintf(launchTemplateNodeGroup, "t2.medium"),
+				"eksctl-test-cluster-nodegroup-ng-2": mixedInstancesNodeGroup,
+				"eksctl-test-cluster-nodegroup-ng-3": fmt.Sprintf(launchTemplateNodeGroup, "m5.large"),
+			}
+
+			p.MockCloudFormation().On("ListStacksPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				consume := args[1].(func(p *cfn.ListStacksOutput, last bool) (shouldContinue bool))
+				out := &cfn.ListStacksOutput{}
+				for _, name := range []string{"eksctl-test-cluster-nodegroup-ng-1", "eksctl-test-cluster-nodegroup-ng-2", "eksctl-test-cluster-nodegroup-ng-3"} {
+					out.StackSummaries = append(out.StackSummaries, &cfn.StackSummary{StackName: aws.String(name)})
+				}
+				consume(out, true)
+			}).Return(nil)
+
+			for name, template := range templates {
+				stackName, stackTemplate := name, template
+				p.MockCloudFormation().On("DescribeStacks", mock.MatchedBy(func(input *cfn.DescribeStacksInput) bool {
+					return input.StackName != nil && *input.StackName == stackName
+				})).Return(&cfn.DescribeStacksOutput{
+					Stacks: []*cfn.Stack{
+						{
+							StackName:   aws.String(stackName),
+							StackStatus: aws.String(cfn.StackStatusCreateComplete),
+							Tags: []*cfn.Tag{
+								{Key: aws.String(api.NodeGroupNameTag), Value: aws.String(stackName[len("eksctl-test-cluster-nodegroup-"):])},
+							},
+						},
+					},
+				}, nil)
+				p.MockCloudFormation().On("GetTemplate", mock.MatchedBy(func(input *cfn.GetTemplateInput) bool {
+					return *input.StackName == stackName
+				})).Return(&cfn.GetTemplateOutput{
+					TemplateBody: aws.String(stackTemplate),
+				}, nil)
+			}
+
+			p.MockCloudFormation().On("DescribeStackResource", mock.Anything).Return(&cfn.DescribeStackResourceOutput{
+				StackResourceDetail: &cfn.StackResourceDetail{
+					PhysicalResourceId: aws.String("asg-name"),
+				},
+			}, nil)
+			p.MockASG().On("DescribeAutoScalingGroups", mock.Anything).Return(&autoscaling.DescribeAutoScalingGroupsOutput{}, nil)
+		})
+
+		It("returns the nodegroups using any of the instance types", func() {
+			out, err := sc.FindNodeGroupsUsingInstanceTypes([]string{"t2.medium", "m4.large"})
+			Expect(err).NotTo(HaveOccurred())
+
+			var names []string
+			for _, summary := range out {
+				names = append(names, summary.Name)
+			}
+			Expect(names).To(ConsistOf("ng-1", "ng-2"))
+			// the templates of matching nodegroups are not fetched again to build their summaries
+			Expect(p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "GetTemplate", 3)).To(BeTrue())
+		})
+
+		It("matches on the exact instance type", func() {
+			out, err := sc.FindNodeGroupsUsingInstanceTypes([]string{"t2"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(BeEmpty())
+		})
+	})
+
+	Describe("GetNodeGroupKubeletVersion", func() {
+		var ng *api.NodeGroup
+
+		newNode := func(name, nodeGroupName, kubeletVersion string) *corev1.Node {
+			return &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: name,
+					Labels: map[string]string{
+						api.NodeGroupNameLabel: nodeGroupName,
+					},
+				},
+				Status: corev1.NodeStatus{
+					NodeInfo: corev1.NodeSystemInfo{
+						KubeletVersion: kubeletVersion,
+					},
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			cc = newClusterConfig("test-cluster")
+			ng = newNodeGroup(cc)
+			ng.Name = "ng-1"
+			p = mockprovider.NewMockProvider()
+			sc = NewStackCollection(p, cc)
+		})
+
+		It("returns the kubelet version shared by the nodes", func() {
+			clientSet := fake.NewSimpleClientset(
+				newNode("node-1", "ng-1", "v1.19.6-eks-49a6c0"),
+				newNode("node-2", "ng-1", "v1.19.6-eks-49a6c0"),
+				newNode("node-3", "ng-2", "v1.18.9-eks-d1db3c"),
+			)
+
+			version, err := sc.GetNodeGroupKubeletVersion(ng, clientSet)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(version).To(Equal("v1.19.6-eks-49a6c0"))
+		})
+
+		It("returns all the kubelet versions when the nodes are mixed", func() {
+			clientSet := fake.NewSimpleClientset(
+				newNode("node-1", "ng-1", "v1.19.6-eks-49a6c0"),
+				newNode("node-2", "ng-1", "v1.18.9-eks-d1db3c"),
+			)
+
+			version, err := sc.GetNodeGroupKubeletVersion(ng, clientSet)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(version).To(Equal("v1.18.9-eks-d1db3c,v1.19.6-eks-49a6c0"))
+		})
+
+		It("fails when the nodegroup has no nodes", func() {
+			clientSet := fake.NewSimpleClientset(newNode("node-1", "ng-2", "v1.19.6-eks-49a6c0"))
+
+			_, err := sc.GetNodeGroupKubeletVersion(ng, clientSet)
+			Expect(err).To(MatchError(`no nodes found for nodegroup "ng-1"`))
+		})
+	})
+
+	Describe("GetNodeGroupPodCapacity", func() {
+		var ng *api.NodeGroup
+
+		newNode := func(name, nodeGroupName string, maxPods int64) *corev1.Node {
+			return &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: name,
+					Labels: map[string]string{
+						api.NodeGroupNameLabel: nodeGroupName,
+					},
+				},
+				Status: corev1.NodeStatus{
+					Allocatable: corev1.ResourceList{
+						corev1.ResourcePods: *resource.NewQuantity(maxPods, resource.DecimalSI),
+					},
+				},
+			}
+		}
+
+		newPod := func(name, nodeName string, phase corev1.PodPhase) *corev1.Pod {
+			return &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+				Spec:       corev1.PodSpec{NodeName: nodeName},
+				Status:     corev1.PodStatus{Phase: phase},
+			}
+		}
+
+		BeforeEach(func() {
+			cc = newClusterConfig("test-cluster")
+			ng = newNodeGroup(cc)
+			ng.Name = "ng-1"
+			p = mockprovider.NewMockProvider()
+			sc = NewStackCollection(p, cc)
+		})
+
+		It("sums the allocatable and running pods of the nodegroup's nodes", func() {
+			pods := []*corev1.Pod{
+				newPod("pod-1", "node-1", corev1.PodRunning),
+				newPod("pod-2", "node-2", corev1.PodPending),
+				newPod("pod-3", "node-2", corev1.PodSucceeded),
+				newPod("pod-4", "node-3", corev1.PodRunning),
+			}
+			clientSet := fake.NewSimpleClientset(
+				newNode("node-1", "ng-1", 29),
+				newNode("node-2", "ng-1", 17),
+				newNode("node-3", "ng-2", 110),
+			)
+			// the fake clientset ignores field selectors, so filter the pods by node here
+			var listedNodes []string
+			clientSet.PrependReactor("list", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				selector := action.(k8stesting.ListAction).GetListRestrictions().Fields
+				nodeName, ok := selector.RequiresExactMatch("spec.nodeName")
+				Expect(ok).To(BeTrue())
+				listedNodes = append(listedNodes, nodeName)
+
+				podList := &corev1.PodList{}
+				for _, pod := range pods {
+					if selector.Matches(fields.Set{"spec.nodeName": pod.Spec.NodeName}) {
+						podList.Items = append(podList.Items, *pod)
+					}
+				}
+				return true, podList, nil
+			})
+
+			used, total, err := sc.GetNodeGroupPodCapacity(ng, clientSet)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(used).To(Equal(2))
+			Expect(total).To(Equal(46))
+			Expect(listedNodes).To(ConsistOf("node-1", "node-2"))
+		})
+
+		It("returns no capacity when the nodegroup has no nodes", func() {
+			clientSet := fake.NewSimpleClientset(newNode("node-1", "ng-2", 29))
+
+			used, total, err := sc.GetNodeGroupPodCapacity(ng, clientSet)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(used).To(BeZero())
+			Expect(total).To(BeZero())
+		})
+	})
+
+	Describe("CheckNodeGroupAMIArchitecture", func() {
+		var ng *api.NodeGroup
+
+		mockAMI := func(architecture string) {
+			p.MockCloudFormation().On("DescribeStackResource", mock.MatchedBy(func(input *cfn.DescribeStackResourceInput) bool {
+				return *input.StackName == "eksctl-test-cluster-nodegroup-ng-1" && *input.LogicalResourceId == "NodeGroupLaunchTemplate"
+			})).Return(&cfn.DescribeStackResourceOutput{
+				StackResourceDetail: &cfn.StackResourceDetail{PhysicalResourceId: aws.String("lt-1234")},
+			}, nil)
+			p.MockEC2().On("DescribeLaunchTemplateVersions", mock.MatchedBy(func(input *ec2.DescribeLaunchTemplateVersionsInput) bool {
+				return *input.LaunchTemplateId == "lt-1234" && *input.Versions[0] == "$Latest"
+			})).Return(&ec2.DescribeLaunchTemplateVersionsOutput{
+				LaunchTemplateVersions: []*ec2.LaunchTemplateVersion{
+					{
+						LaunchTemplateData: &ec2.ResponseLaunchTemplateData{
+							ImageId:      aws.String("ami-1234"),
+							InstanceType: aws.String("t2.medium"),
+						},
+					},
+				},
+			}, nil)
+			p.MockEC2().On("DescribeImages", mock.MatchedBy(func(input *ec2.DescribeImagesInput) bool {
+				return len(input.ImageIds) == 1 && *input.ImageIds[0] == "ami-1234"
+			})).Return(&ec2.DescribeImagesOutput{
+				Images: []*ec2.Image{{ImageId: aws.String("ami-1234"), Architecture: aws.String(architecture)}},
+			}, nil)
+			p.MockEC2().On("DescribeInstanceTypes", mock.MatchedBy(func(input *ec2.DescribeInstanceTypesInput) bool {
+				return len(input.InstanceTypes) == 1 && *input.InstanceTypes[0] == "t2.medium"
+			})).Return(&ec2.DescribeInstanceTypesOutput{
+				InstanceTypes: []*ec2.InstanceTypeInfo{
+					{
+						InstanceType: aws.String("t2.medium"),
+						ProcessorInfo: &ec2.ProcessorInfo{
+							SupportedArchitectures: aws.StringSlice([]string{"i386", "x86_64"}),
+						},
+					},
+				},
+			}, nil)
+		}
+
+		BeforeEach(func() {
+			cc = newClusterConfig("test-cluster")
+			ng = newNodeGroup(cc)
+			ng.Name = "ng-1"
+			p = mockprovider.NewMockProvider()
+			sc = NewStackCollection(p, cc)
+		})
+
+		It("succeeds when the AMI architecture is supported by the instance types", func() {
+			mockAMI("x86_64")
+
+			Expect(sc.CheckNodeGroupAMIArchitecture(ng)).To(Succeed())
+		})
+
+		It("fails when the AMI architecture is not supported by an instance type", func() {
+			mockAMI("arm64")
+
+			err := sc.CheckNodeGroupAMIArchitecture(ng)
+			Expect(err).To(MatchError(`AMI "ami-1234" of nodegroup "ng-1" has architecture arm64, which is not supported by instance type t2.medium (supported architectures: i386, x86_64)`))
+		})
+	})
+
+	Describe("GetManagedNodeGroup", func() {
+		var ng *api.NodeGroup
+
+		BeforeEach(func() {
+			cc = newClusterConfig("test-cluster")
+			ng = newNodeGroup(cc)
+			ng.Name = "ng-1"
+			p = mockprovider.NewMockProvider()
+			sc = NewStackCollection(p, cc)
+		})
+
+		It("returns the nodegroup described by EKS", func() {
+			nodegroup := &eks.Nodegroup{
+				NodegroupName: aws.String("ng-1"),
+				RemoteAccess:  &eks.RemoteAccessConfig{Ec2SshKey: aws.String("key")},
+				Resources: &eks.NodegroupResources{
+					AutoScalingGroups: []*eks.AutoScalingGroup{{Name: aws.String("asg-name")}},
+				},
+			}
+			p.MockEKS().On("DescribeNodegroup", mock.MatchedBy(func(input *eks.DescribeNodegroupInput) bool {
+				return *input.ClusterName == "test-cluster" && *input.NodegroupName == "ng-1"
+			})).Return(&eks.DescribeNodegroupOutput{Nodegroup: nodegroup}, nil)
+
+			out, err := sc.GetManagedNodeGroup(ng)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(Equal(nodegroup))
+		})
+
+		It("fails when the nodegroup cannot be described", func() {
+			p.MockEKS().On("DescribeNodegroup", mock.Anything).Return(nil, fmt.Errorf("not found"))
+
+			_, err := sc.GetManagedNodeGroup(ng)
+			Expect(err).To(MatchError(`describing managed nodegroup "ng-1": not found`))
+		})
+	})
+
+	Describe("RollbackNodeGroup", func() {
+		const stackName = "eksctl-test-cluster-nodegroup-ng-1"
+
+		var (
+			ng          *api.NodeGroup
+			events      []*cloudtrail.Event
+			stackEvents []*cfn.StackEvent
+		)
+
+		created := time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC)
+		at := func(minutes int) *time.Time {
+			return aws.Time(created.Add(time.Duration(minutes) * time.Minute))
+		}
+
+		templateWithMaxSize := func(maxSize int) string {
+			return fmt.Sprintf(`{"Resources":{"NodeGroup":{"Properties":{"MaxSize":"%d"}}}}`, maxSize)
+		}
+
+		newEvent := func(eventName, changeSetName, templateBody string, minutes int) *cloudtrail.Event {
+			cloudTrailEvent, err := json.Marshal(map[string]interface{}{
+				"requestParameters": map[string]string{"changeSetName": changeSetName, "templateBody": templateBody},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			return &cloudtrail.Event{
+				EventId:         aws.String(fmt.Sprintf("%s-%d", eventName, minutes)),
+				EventName:       aws.String(eventName),
+				EventTime:       at(minutes),
+				CloudTrailEvent: aws.String(string(cloudTrailEvent)),
+			}
+		}
+
+		newStackEvent := func(status string, minutes int) *cfn.StackEvent {
+			return &cfn.StackEvent{
+				StackName:         aws.String(stackName),
+				LogicalResourceId: aws.String(stackName),
+				ResourceType:      aws.String("AWS::CloudFormation::Stack"),
+				ResourceStatus:    aws.String(status),
+				Timestamp:         at(minutes),
+			}
+		}
+
+		BeforeEach(func() {
+			cc = newClusterConfig("test-cluster")
+			ng = newNodeGroup(cc)
+			ng.Name = "ng-1"
+			p = mockprovider.NewMockProvider()
+			sc = NewStackCollection(p, cc)
+
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(&cfn.DescribeStacksOutput{
+				Stacks: []*cfn.Stack{
+					{
+						StackName:   aws.String(stackName),
+						StackId:     aws.String(stackName + "-id"),
+						StackStatus: aws.String(cfn.StackStatusUpdateRollbackComplete),
+					},
+				},
+			}, nil)
+			p.MockCloudFormation().On("GetTemplate", mock.Anything).Return(&cfn.GetTemplateOutput{
+				TemplateBody: aws.String("{\n  \"Resources\": {\"NodeGroup\": {\"Properties\": {\"MaxSize\": \"4\"}}}\n}"),
+			}, nil)
+			p.MockCloudTrail().On("LookupEventsPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				consume := args[1].(func(p *cloudtrail.LookupEventsOutput, last bool) (shouldContinue bool))
+				consume(&cloudtrail.LookupEventsOutput{Events: events}, true)
+			}).Return(nil)
+			p.MockCloudFormation().On("DescribeStackEventsPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				consume := args[1].(func(p *cfn.DescribeStackEventsOutput, last bool) (shouldContinue bool))
+				consume(&cfn.DescribeStackEventsOutput{StackEvents: stackEvents}, true)
+			}).Return(nil)
+
+			describeChangeSetFailed := &cfn.DescribeChangeSetOutput{
+				StackName: aws.String(stackName),
+				Status:    aws.String(cfn.ChangeSetStatusFailed),
+			}
+			p.MockCloudFormation().On("CreateChangeSet", mock.Anything).Return(nil, nil)
+			req := awstesting.NewClient(nil).NewRequest(&request.Operation{Name: "Operation"}, nil, describeChangeSetFailed)
+			p.MockCloudFormation().On("DescribeChangeSetRequest", mock.Anything).Return(req, describeChangeSetFailed)
+			p.MockCloudFormation().On("DescribeChangeSet", mock.Anything).Return(&cfn.DescribeChangeSetOutput{
+				StackName:    aws.String(stackName),
+				StatusReason: aws.String("The submitted information didn't contain changes"),
+			}, nil)
+		})
+
+		rolledBackTemplate := func() string {
+			var createChangeSetInput *cfn.CreateChangeSetInput
+			for _, call := range p.MockCloudFormation().Calls {
+				if call.Method == "CreateChangeSet" {
+					createChangeSetInput = call.Arguments.Get(0).(*cfn.CreateChangeSetInput)
+				}
+			}
+			Expect(createChangeSetInput).NotTo(BeNil())
+			return *createChangeSetInput.TemplateBody
+		}
+
+		It("updates the stack with the template preceding the current one", func() {
+			events = []*cloudtrail.Event{
+				newEvent("ExecuteChangeSet", "cs-8", "", 41),
+				newEvent("CreateChangeSet", "cs-8", templateWithMaxSize(8), 40),
+				newEvent("ExecuteChangeSet", "cs-4", "", 31),
+				newEvent("CreateChangeSet", "cs-4", templateWithMaxSize(4), 30),
+				newEvent("CreateStack", "", templateWithMaxSize(2), 0),
+			}
+			stackEvents = []*cfn.StackEvent{
+				newStackEvent(cfn.StackStatusUpdateRollbackComplete, 44),
+				newStackEvent(cfn.StackStatusUpdateRollbackInProgress, 43),
+				newStackEvent(cfn.StackStatusUpdateInProgress, 42),
+				newStackEvent(cfn.StackStatusUpdateComplete, 33),
+				newStackEvent(cfn.StackStatusUpdateInProgress, 32),
+				newStackEvent(cfn.StackStatusCreateComplete, 2),
+				newStackEvent(cfn.StackStatusCreateInProgress, 1),
+			}
+
+			Expect(sc.RollbackNodeGroup(ng)).To(Succeed())
+			Expect(rolledBackTemplate()).To(Equal(templateWithMaxSize(2)))
+		})
+
+		It("skips change sets that were not executed and updates that were rolled back", func() {
+			events = []*cloudtrail.Event{
+				newEvent("ExecuteChangeSet", "cs-4", "", 31),
+				newEvent("CreateChangeSet", "cs-4", templateWithMaxSize(4), 30),
+				newEvent("CreateChangeSet", "cs-3", templateWithMaxSize(3), 20),
+				newEvent("ExecuteChangeSet", "cs-5", "", 11),
+				newEvent("CreateChangeSet", "cs-5", templateWithMaxSize(5), 10),
+				newEvent("CreateStack", "", templateWithMaxSize(2), 0),
+			}
+			stackEvents = []*cfn.StackEvent{
+				newStackEvent(cfn.StackStatusUpdateComplete, 33),
+				newStackEvent(cfn.StackStatusUpdateInProgress, 32),
+				newStackEvent(cfn.StackStatusUpdateRollbackComplete, 13),
+				newStackEvent(cfn.StackStatusUpdateRollbackInProgress, 12),
+				newStackEvent(cfn.StackStatusUpdateInProgress, 12),
+				newStackEvent(cfn.StackStatusCreateComplete, 2),
+				newStackEvent(cfn.StackStatusCreateInProgress, 1),
+			}
+
+			Expect(sc.RollbackNodeGroup(ng)).To(Succeed())
+			Expect(rolledBackTemplate()).To(Equal(templateWithMaxSize(2)))
+		})
+
+		It("fails when no previous template can be found", func() {
+			events = []*cloudtrail.Event{
+				newEvent("CreateStack", "", templateWithMaxSize(4), 0),
+			}
+			stackEvents = []*cfn.StackEvent{
+				newStackEvent(cfn.StackStatusCreateComplete, 2),
+				newStackEvent(cfn.StackStatusCreateInProgress, 1),
+			}
+
+			err := sc.RollbackNodeGroup(ng)
+			Expect(err).To(MatchError(`no previous template found for nodegroup "ng-1" in the update history of stack eksctl-test-cluster-nodegroup-ng-1`))
+			Expect(p.MockCloudFormation().AssertNotCalled(GinkgoT(), "CreateChangeSet", mock.Anything)).To(BeTrue())
+		})
+
+		It("fails when the only previous template belongs to an update that was rolled back", func() {
+			events = []*cloudtrail.Event{
+				newEvent("UpdateStack", "", templateWithMaxSize(4), 20),
+				newEvent("UpdateStack", "", templateWithMaxSize(6), 10),
+				newEvent("CreateStack", "", templateWithMaxSize(4), 0),
+			}
+			stackEvents = []*cfn.StackEvent{
+				newStackEvent(cfn.StackStatusUpdateComplete, 22),
+				newStackEvent(cfn.StackStatusUpdateInProgress, 21),
+				newStackEvent(cfn.StackStatusUpdateRollbackComplete, 12),
+				newStackEvent(cfn.StackStatusUpdateInProgress, 11),
+				newStackEvent(cfn.StackStatusCreateComplete, 2),
+			}
+
+			err := sc.RollbackNodeGroup(ng)
+			Expect(err).To(MatchError(`no previous template found for nodegroup "ng-1" in the update history of stack eksctl-test-cluster-nodegroup-ng-1`))
+		})
+	})
+
+	Describe("SyncNodeGroupBoundsToCloudFormation", func() {
+		const stackName = "eksctl-test-cluster-nodegroup-ng-1"
+
+		var (
+			ng           *api.NodeGroup
+			stackTags    []*cfn.Tag
+			templateBody string
+		)
+
+		BeforeEach(func() {
+			cc = newClusterConfig("test-cluster")
+			ng = newNodeGroup(cc)
+			ng.Name = "ng-1"
+			p = mockprovider.NewMockProvider()
+			sc = NewStackCollection(p, cc)
+
+			stackTags = []*cfn.Tag{
+				{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("ng-1")},
+				{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(string(api.NodeGroupTypeUnmanaged))},
+			}
+			templateBody = `{"Resources":{"NodeGroup":{"Properties":{"DesiredCapacity":"1","MinSize":"1","MaxSize":"4"}}}}`
+
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(func(*cfn.DescribeStacksInput) *cfn.DescribeStacksOutput {
+				return &cfn.DescribeStacksOutput{
+					Stacks: []*cfn.Stack{
+						{
+							StackName:   aws.String(stackName),
+							StackStatus: aws.String(cfn.StackStatusCreateComplete),
+							Tags:        stackTags,
+						},
+					},
+				}
+			}, nil)
+			p.MockCloudFormation().On("DescribeStackResource", mock.Anything).Return(&cfn.DescribeStackResourceOutput{
+				StackResourceDetail: &cfn.StackResourceDetail{PhysicalResourceId: aws.String("asg-ng-1")},
+			}, nil)
+			p.MockCloudFormation().On("GetTemplate", mock.Anything).Return(func(*cfn.GetTemplateInput) *cfn.GetTemplateOutput {
+				return &cfn.GetTemplateOutput{TemplateBody: aws.String(templateBody)}
+			}, nil)
+
+			describeChangeSetFailed := &cfn.DescribeChangeSetOutput{
+				StackName: aws.String(stackName),
+				Status:    aws.String(cfn.ChangeSetStatusFailed),
+			}
+			p.MockCloudFormation().On("CreateChangeSet", mock.Anything).Return(nil, nil)
+			req := awstesting.NewClient(nil).NewRequest(&request.Operation{Name: "Operation"}, nil, describeChangeSetFailed)
+			p.MockCloudFormation().On("DescribeChangeSetRequest", mock.Anything).Return(req, describeChangeSetFailed)
+			p.MockCloudFormation().On("DescribeChangeSet", mock.Anything).Return(&cfn.DescribeChangeSetOutput{
+				StackName:    aws.String(stackName),
+				StatusReason: aws.String("The submitted information didn't contain changes"),
+			}, nil)
+		})
+
+		mockASGSizes := func(minSize, maxSize, desiredCapacity int64) {
+			p.MockASG().On("DescribeAutoScalingGroups", mock.MatchedBy(func(input *autoscaling.DescribeAutoScalingGroupsInput) bool {
+				return *input.AutoScalingGroupNames[0] == "asg-ng-1"
+			})).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+				AutoScalingGroups: []*autoscaling.Group{
+					{MinSize: aws.Int64(minSize), MaxSize: aws.Int64(maxSize), DesiredCapacity: aws.Int64(desiredCapacity)},
+				},
+			}, nil)
+		}
+
+		getCreateChangeSetInput := func() *cfn.CreateChangeSetInput {
+			var createChangeSetInput *cfn.CreateChangeSetInput
+			for _, call := range p.MockCloudFormation().Calls {
+				if call.Method == "CreateChangeSet" {
+					createChangeSetInput = call.Arguments.Get(0).(*cfn.CreateChangeSetInput)
+				}
+			}
+			return createChangeSetInput
+		}
+
+		It("updates the stack with the bounds of the Auto Scaling group", func() {
+			mockASGSizes(2, 10, 2)
+
+			Expect(sc.SyncNodeGroupBoundsToCloudFormation(ng)).To(Succeed())
+
+			createChangeSetInput := getCreateChangeSetInput()
+			Expect(createChangeSetInput).NotTo(BeNil())
+			Expect(*createChangeSetInput.TemplateBody).To(MatchJSON(`{"Resources":{"NodeGroup":{"Properties":{"DesiredCapacity":"2","MinSize":"2","MaxSize":"10"}}}}`))
+			Expect(*createChangeSetInput.Description).To(ContainSubstring("min size from 1 to 2, max size from 4 to 10, desired capacity from 1 to 2"))
+		})
+
+		It("keeps the desired capacity the Auto Scaling group was scaled to", func() {
+			// e.g. by the cluster autoscaler, which a stack update must not scale back down
+			mockASGSizes(1, 10, 6)
+
+			Expect(sc.SyncNodeGroupBoundsToCloudFormation(ng)).To(Succeed())
+
+			createChangeSetInput := getCreateChangeSetInput()
+			Expect(createChangeSetInput).NotTo(BeNil())
+			Expect(*createChangeSetInput.TemplateBody).To(MatchJSON(`{"Resources":{"NodeGroup":{"Properties":{"DesiredCapacity":"6","MinSize":"1","MaxSize":"10"}}}}`))
+			Expect(*createChangeSetInput.Description).To(ContainSubstring("max size from 4 to 10, desired capacity from 1 to 6"))
+		})
+
+		It("uses the desired capacity of the Auto Scaling groups of managed nodegroups", func() {
+			stackTags[1].Value = aws.String(string(api.NodeGroupTypeManaged))
+			templateBody = `{"Resources":{"ManagedNodeGroup":{"Type":"AWS::EKS::Nodegroup","Properties":{"ScalingConfig":{"DesiredSize":1,"MinSize":1,"MaxSize":4}}}}}`
+
+			p.MockEKS().On("DescribeNodegroup", mock.Anything).Return(&eks.DescribeNodegroupOutput{
+				Nodegroup: &eks.Nodegroup{
+					ScalingConfig: &eks.NodegroupScalingConfig{MinSize: aws.Int64(1), MaxSize: aws.Int64(4), DesiredSize: aws.Int64(1)},
+					Resources: &eks.NodegroupResources{
+						AutoScalingGroups: []*eks.AutoScalingGroup{{Name: aws.String("asg-ng-1")}},
+					},
+				},
+			}, nil)
+			mockASGSizes(1, 4, 3)
+
+			Expect(sc.SyncNodeGroupBoundsToCloudFormation(ng)).To(Succeed())
+
+			createChangeSetInput := getCreateChangeSetInput()
+			Expect(createChangeSetInput).NotTo(BeNil())
+			Expect(*createChangeSetInput.TemplateBody).To(MatchJSON(`{"Resources":{"ManagedNodeGroup":{"Type":"AWS::EKS::Nodegroup","Properties":{"ScalingConfig":{"DesiredSize":3,"MinSize":1,"MaxSize":4}}}}}`))
+		})
+
+		It("keeps the sizes of managed nodegroups as numbers", func() {
+			// the stacks of older managed nodegroups are not tagged with their type
+			stackTags = stackTags[:1]
+			templateBody = `{"Resources":{"ManagedNodeGroup":{"Type":"AWS::EKS::Nodegroup","Properties":{"ScalingConfig":{"DesiredSize":1,"MinSize":1,"MaxSize":4}}}}}`
+
+			p.MockEKS().On("DescribeNodegroup", &eks.DescribeNodegroupInput{
+				ClusterName:   aws.String("test-cluster"),
+				NodegroupName: aws.String("ng-1"),
+			}).Return(&eks.DescribeNodegroupOutput{
+				Nodegroup: &eks.Nodegroup{
+					ScalingConfig: &eks.NodegroupScalingConfig{MinSize: aws.Int64(2), MaxSize: aws.Int64(10), DesiredSize: aws.Int64(1)},
+				},
+			}, nil)
+
+			Expect(sc.SyncNodeGroupBoundsToCloudFormation(ng)).To(Succeed())
+
+			createChangeSetInput := getCreateChangeSetInput()
+			Expect(createChangeSetInput).NotTo(BeNil())
+			Expect(*createChangeSetInput.TemplateBody).To(MatchJSON(`{"Resources":{"ManagedNodeGroup":{"Type":"AWS::EKS::Nodegroup","Properties":{"ScalingConfig":{"DesiredSize":2,"MinSize":2,"MaxSize":10}}}}}`))
+			Expect(p.MockASG().AssertNotCalled(GinkgoT(), "DescribeAutoScalingGroups", mock.Anything)).To(BeTrue())
+		})
+
+		It("does not update the stack when it is in sync", func() {
+			mockASGSizes(1, 4, 1)
+
+			Expect(sc.SyncNodeGroupBoundsToCloudFormation(ng)).To(Succeed())
+			Expect(p.MockCloudFormation().AssertNotCalled(GinkgoT(), "CreateChangeSet", mock.Anything)).To(BeTrue())
+		})
+	})
+
+	Describe("GetNodeGroupType", func() {
+
+		createTags := func(tags map[string]string) []*cfn.Tag {
+			cfnTags := make([]*cfn.Tag, 0)
+			for k, v := range tags {
+				cfnTags = append(cfnTags, &cfn.Tag{
+					Key:   aws.String(k),
+					Value: aws.String(v),
+				})
+			}
+			return cfnTags
+		}
+
+		DescribeTable("with tag for the nodegroup type", func(inputTags map[string]string, expectedType api.NodeGroupType) {
+			ngType, err := GetNodeGroupType(createTags(inputTags))
+
+			if expectedType == "" {
+				Expect(err).To(HaveOccurred())
+			} else {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ngType).To(Equal(expectedType))
+			}
+		},
 
 			Entry("finds the type of a managed nodegroup",
 				map[string]string{
@@ -418,5 +2383,95 @@ var _ = Describe("StackCollection NodeGroup", func() {
 				},
 				api.NodeGroupType("")),
 		)
+
+		DescribeTable("GetNodeGroupTypeFromTemplate with the template as a fallback", func(inputTags map[string]string, template string, expectedType api.NodeGroupType) {
+			ngType, err := GetNodeGroupTypeFromTemplate(createTags(inputTags), template)
+
+			if expectedType == "" {
+				Expect(err).To(HaveOccurred())
+			} else {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ngType).To(Equal(expectedType))
+			}
+		},
+
+			Entry("classifies stacks with only the cluster name tag and an Auto Scaling group as un-managed",
+				map[string]string{
+					api.ClusterNameTag: "test-cluster",
+				},
+				`{"Resources": {"SG": {"Type": "AWS::EC2::SecurityGroup"}, "NodeGroup": {"Type": "AWS::AutoScaling::AutoScalingGroup"}}}`,
+				api.NodeGroupTypeUnmanaged),
+
+			Entry("classifies stacks with only the cluster name tag and a managed nodegroup as managed",
+				map[string]string{
+					api.ClusterNameTag: "test-cluster",
+				},
+				`{"Resources": {"ManagedNodeGroup": {"Type": "AWS::EKS::Nodegroup"}}}`,
+				api.NodeGroupTypeManaged),
+
+			Entry("doesn't return the type if the template has no nodegroup resource",
+				map[string]string{
+					api.ClusterNameTag: "test-cluster",
+				},
+				`{"Resources": {"SG": {"Type": "AWS::EC2::SecurityGroup"}}}`,
+				api.NodeGroupType("")),
+
+			Entry("doesn't return the type if the stack has no cluster name tag",
+				map[string]string{
+					"some-other-tag": "ng-1",
+				},
+				`{"Resources": {"NodeGroup": {"Type": "AWS::AutoScaling::AutoScalingGroup"}}}`,
+				api.NodeGroupType("")),
+
+			Entry("prefers the type tag of stacks with a nodegroup name tag",
+				map[string]string{
+					api.NodeGroupNameTag: "mng-1",
+					api.NodeGroupTypeTag: "managed",
+				},
+				`{"Resources": {"NodeGroup": {"Type": "AWS::AutoScaling::AutoScalingGroup"}}}`,
+				api.NodeGroupTypeManaged),
+		)
+	})
+	Describe("ParseNodeGroupStackName", func() {
+		DescribeTable("splits the stack name into the cluster and nodegroup names", func(stackName, cluster, ng string, ok bool) {
+			parsedCluster, parsedNG, parsed := ParseNodeGroupStackName(stackName)
+			Expect(parsed).To(Equal(ok))
+			Expect(parsedCluster).To(Equal(cluster))
+			Expect(parsedNG).To(Equal(ng))
+			if ok {
+				Expect(ResolveNodeGroupStackName(cluster, ng)).To(Equal(stackName))
+			}
+		},
+			Entry("nodegroup stack", "eksctl-test-cluster-nodegroup-ng-1", "test-cluster", "ng-1", true),
+			Entry("cluster name containing nodegroup", "eksctl-my-nodegroup-cluster-nodegroup-ng-1", "my-nodegroup-cluster", "ng-1", true),
+			Entry("cluster name ending in nodegroup", "eksctl-test-nodegroup-nodegroup-ng-1", "test-nodegroup", "ng-1", true),
+			Entry("cluster stack", "eksctl-test-cluster-cluster", "", "", false),
+			Entry("stack not created by eksctl", "my-stack-nodegroup-ng-1", "", "", false),
+			Entry("missing nodegroup name", "eksctl-test-cluster-nodegroup-", "", "", false),
+		)
+	})
+	Describe("nodeGroupInstanceRoleARN", func() {
+		const roleARN = "arn:aws:iam::1111:role/eks-nodes-base-role"
+
+		DescribeTable("reads the instance role ARN from the outputs of the stack", func(key string) {
+			stack := &Stack{Outputs: []*cfn.Output{
+				{OutputKey: aws.String("InstanceProfileARN"), OutputValue: aws.String("arn:aws:iam::1111:instance-profile/eks-nodes")},
+				{OutputKey: aws.String(key), OutputValue: aws.String(roleARN)},
+			}}
+			arn, ok := nodeGroupInstanceRoleARN(stack)
+			Expect(ok).To(BeTrue())
+			Expect(arn).To(Equal(roleARN))
+		},
+			Entry("current key", "InstanceRoleARN"),
+			Entry("legacy NodeInstanceRoleARN key", "NodeInstanceRoleARN"),
+			Entry("legacy InstanceRoleArn key", "InstanceRoleArn"),
+		)
+
+		It("returns false when the stack has no instance role ARN output", func() {
+			_, ok := nodeGroupInstanceRoleARN(&Stack{Outputs: []*cfn.Output{
+				{OutputKey: aws.String("InstanceProfileARN"), OutputValue: aws.String("arn:aws:iam::1111:instance-profile/eks-nodes")},
+			}})
+			Expect(ok).To(BeFalse())
+		})
 	})
 })
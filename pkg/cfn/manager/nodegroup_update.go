@@ -0,0 +1,312 @@
+package manager
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+)
+
+// NodeGroupUpdateResult is the outcome of updating the config of a single nodegroup
+type NodeGroupUpdateResult struct {
+	NodeGroupName string
+	// UpdateID is the ID of the EKS update, empty when nothing was changed
+	UpdateID string
+	// Skipped is true when the nodegroup was left untouched, Note explains why
+	Skipped bool
+	Note    string
+	Error   error
+}
+
+// ApplyToAllNodeGroups applies the labels and taints returned by fn to every managed nodegroup of the cluster.
+// Only labels and taints that are missing or have a different value are updated, so applying the same ones
+// twice is a no-op. Unmanaged nodegroups cannot be updated in place and are skipped. Labels alone are updated
+// with UpdateNodegroupConfig, while taints are set in the nodegroup stack, together with the labels, as the
+// EKS API version in use does not support updating them
+func (c *StackCollection) ApplyToAllNodeGroups(fn func(ng *NodeGroupSummary) (labels map[string]string, taints []api.NodeGroupTaint)) ([]NodeGroupUpdateResult, error) {
+	summaries, err := c.GetNodeGroupSummaries("")
+	if err != nil {
+		return nil, errors.Wrap(err, "getting nodegroup summaries")
+	}
+
+	var results []NodeGroupUpdateResult
+	for _, summary := range summaries {
+		if summary.NodeGroupType != api.NodeGroupTypeManaged {
+			results = append(results, NodeGroupUpdateResult{
+				NodeGroupName: summary.Name,
+				Skipped:       true,
+				Note:          "unmanaged nodegroups cannot be updated in place, the nodegroup must be recreated",
+			})
+			continue
+		}
+
+		var result NodeGroupUpdateResult
+		labels, taints := fn(summary)
+		if len(taints) > 0 {
+			result = c.updateManagedNodeGroupStackConfig(summary, labels, taints)
+		} else {
+			result = c.updateManagedNodeGroupConfig(summary, labels)
+		}
+		if result.Error != nil {
+			logger.Warning("failed to update nodegroup %q: %v", summary.Name, result.Error)
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// updateManagedNodeGroupStackConfig merges the labels and taints into the stack of the managed nodegroup, and
+// updates the stack when any of them changed
+func (c *StackCollection) updateManagedNodeGroupStackConfig(summary *NodeGroupSummary, labels map[string]string, taints []api.NodeGroupTaint) NodeGroupUpdateResult {
+	result := NodeGroupUpdateResult{NodeGroupName: summary.Name}
+
+	template, err := c.GetStackTemplate(summary.StackName)
+	if err != nil {
+		result.Error = errors.Wrapf(err, "getting template of managed nodegroup %q", summary.Name)
+		return result
+	}
+	ngPaths, err := nodeGroupPathsForType(api.NodeGroupTypeManaged)
+	if err != nil {
+		result.Error = err
+		return result
+	}
+
+	mergedLabels, labelsChanged := mergeTemplateLabels(template, ngPaths.Labels, labels)
+	mergedTaints, taintsChanged := mergeTemplateTaints(template, ngPaths.Taints, taints)
+	if !labelsChanged && !taintsChanged {
+		result.Skipped = true
+		result.Note = "labels and taints are already up to date"
+		return result
+	}
+	if labelsChanged {
+		if template, err = sjson.Set(template, ngPaths.Labels, mergedLabels); err != nil {
+			result.Error = errors.Wrapf(err, "setting labels of managed nodegroup %q", summary.Name)
+			return result
+		}
+	}
+	if taintsChanged {
+		if template, err = sjson.Set(template, ngPaths.Taints, mergedTaints); err != nil {
+			result.Error = errors.Wrapf(err, "setting taints of managed nodegroup %q", summary.Name)
+			return result
+		}
+	}
+
+	if err := c.UpdateStack(summary.StackName, c.MakeChangeSetName("update-nodegroup"), "updating labels and taints", TemplateBody(template), nil); err != nil {
+		result.Error = errors.Wrapf(err, "updating labels and taints of managed nodegroup %q", summary.Name)
+		return result
+	}
+	result.Note = "labels and taints were updated through the nodegroup stack"
+	return result
+}
+
+func (c *StackCollection) updateManagedNodeGroupConfig(summary *NodeGroupSummary, labels map[string]string) NodeGroupUpdateResult {
+	result := NodeGroupUpdateResult{NodeGroupName: summary.Name}
+
+	res, err := c.eksAPI.DescribeNodegroup(&eks.DescribeNodegroupInput{
+		ClusterName:   aws.String(c.spec.Metadata.Name),
+		NodegroupName: aws.String(summary.Name),
+	})
+	if err != nil {
+		result.Error = errors.Wrapf(err, "describing managed nodegroup %q", summary.Name)
+		return result
+	}
+
+	changedLabels := map[string]string{}
+	for k, v := range labels {
+		if current, ok := res.Nodegroup.Labels[k]; !ok || aws.StringValue(current) != v {
+			changedLabels[k] = v
+		}
+	}
+	if len(changedLabels) == 0 {
+		result.Skipped = true
+		result.Note = "labels are already up to date"
+		return result
+	}
+
+	out, err := c.eksAPI.UpdateNodegroupConfig(&eks.UpdateNodegroupConfigInput{
+		ClusterName:   aws.String(c.spec.Metadata.Name),
+		NodegroupName: aws.String(summary.Name),
+		Labels:        &eks.UpdateLabelsPayload{AddOrUpdateLabels: aws.StringMap(changedLabels)},
+	})
+	if err != nil {
+		result.Error = errors.Wrapf(err, "updating labels of managed nodegroup %q", summary.Name)
+		return result
+	}
+	if out.Update != nil {
+		result.UpdateID = aws.StringValue(out.Update.Id)
+	}
+	return result
+}
+
+// reservedLabelDomains are the label domains that EKS does not allow to be set on managed nodegroups
+var reservedLabelDomains = []string{"k8s.io", "kubernetes.io", "eks.amazonaws.com"}
+
+// UpdateManagedNodeGroupLabels updates the labels of the managed nodegroup ng in place to match ng.Labels, adding
+// or updating the labels that differ and removing the ones ng no longer has. It is a no-op when the labels are
+// unchanged. The labels eksctl sets by default are expected to be in ng.Labels, as they are once defaults are set.
+// The labels of nodegroups created by eksctl are set in the nodegroup stack, so that the next stack update does
+// not revert them, and CloudFormation applies them in place. Other nodegroups are updated with UpdateNodegroupConfig
+func (c *StackCollection) UpdateManagedNodeGroupLabels(ng *api.ManagedNodeGroup) error {
+	for key := range ng.Labels {
+		if isReservedLabel(key) {
+			return fmt.Errorf("label %q of managed nodegroup %q is in a namespace reserved by EKS (%s)", key, ng.Name, strings.Join(reservedLabelDomains, ", "))
+		}
+	}
+
+	hasStack, err := c.NodeGroupStackExists(ng.Name)
+	if err != nil {
+		return errors.Wrapf(err, "describing stack of managed nodegroup %q", ng.Name)
+	}
+	if hasStack {
+		var property map[string]interface{}
+		if len(ng.Labels) > 0 {
+			property = map[string]interface{}{}
+			for k, v := range ng.Labels {
+				property[k] = v
+			}
+		}
+		return c.updateManagedNodeGroupProperty(ng.Name, "labels", managedNodeGroupPaths("ManagedNodeGroup").Labels, property)
+	}
+
+	res, err := c.eksAPI.DescribeNodegroup(&eks.DescribeNodegroupInput{
+		ClusterName:   aws.String(c.spec.Metadata.Name),
+		NodegroupName: aws.String(ng.Name),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "describing managed nodegroup %q", ng.Name)
+	}
+
+	payload := &eks.UpdateLabelsPayload{}
+	changedLabels := map[string]string{}
+	for k, v := range ng.Labels {
+		if current, ok := res.Nodegroup.Labels[k]; !ok || aws.StringValue(current) != v {
+			changedLabels[k] = v
+		}
+	}
+	if len(changedLabels) > 0 {
+		payload.AddOrUpdateLabels = aws.StringMap(changedLabels)
+	}
+	var removedLabels []string
+	for k := range res.Nodegroup.Labels {
+		if _, ok := ng.Labels[k]; !ok {
+			removedLabels = append(removedLabels, k)
+		}
+	}
+	if len(removedLabels) > 0 {
+		sort.Strings(removedLabels)
+		payload.RemoveLabels = aws.StringSlice(removedLabels)
+	}
+
+	if len(changedLabels) == 0 && len(removedLabels) == 0 {
+		logger.Info("labels of managed nodegroup %q are already up to date", ng.Name)
+		return nil
+	}
+
+	if _, err := c.eksAPI.UpdateNodegroupConfig(&eks.UpdateNodegroupConfigInput{
+		ClusterName:   aws.String(c.spec.Metadata.Name),
+		NodegroupName: aws.String(ng.Name),
+		Labels:        payload,
+	}); err != nil {
+		return errors.Wrapf(err, "updating labels of managed nodegroup %q", ng.Name)
+	}
+	logger.Info("updated labels of managed nodegroup %q: %d added or updated, %d removed", ng.Name, len(changedLabels), len(removedLabels))
+	return nil
+}
+
+func isReservedLabel(key string) bool {
+	parts := strings.SplitN(key, "/", 2)
+	if len(parts) != 2 {
+		return false
+	}
+	for _, domain := range reservedLabelDomains {
+		if parts[0] == domain || strings.HasSuffix(parts[0], "."+domain) {
+			return true
+		}
+	}
+	return false
+}
+
+// UpdateManagedNodeGroupUpdateConfig sets the update config of the managed nodegroup ng to ng.UpdateConfig, or
+// removes it when unset. The EKS API version in use does not support update configs, so the nodegroup stack is
+// updated instead and CloudFormation applies the change in place with UpdateNodegroupConfig. It is a no-op when
+// the update config is unchanged
+func (c *StackCollection) UpdateManagedNodeGroupUpdateConfig(ng *api.ManagedNodeGroup) error {
+	var property map[string]interface{}
+	if ng.UpdateConfig != nil {
+		property = builder.UpdateConfigProperty(ng.UpdateConfig)
+	}
+	return c.updateManagedNodeGroupProperty(ng.Name, "update config", builder.ManagedNodeGroupUpdateConfigPath, property)
+}
+
+// UpdateManagedNodeGroupNodeRepairConfig sets the node repair config of the managed nodegroup ng to
+// ng.NodeRepairConfig, or removes it when unset, in the same way as UpdateManagedNodeGroupUpdateConfig
+func (c *StackCollection) UpdateManagedNodeGroupNodeRepairConfig(ng *api.ManagedNodeGroup) error {
+	var property map[string]interface{}
+	if ng.NodeRepairConfig != nil {
+		property = builder.NodeRepairConfigProperty(ng.NodeRepairConfig)
+	}
+	return c.updateManagedNodeGroupProperty(ng.Name, "node repair config", builder.ManagedNodeGroupNodeRepairConfigPath, property)
+}
+
+// updateManagedNodeGroupProperty sets the property at path in the stack of the managed nodegroup, or removes
+// it when property is nil, unless it is unchanged
+func (c *StackCollection) updateManagedNodeGroupProperty(ngName, description, path string, property map[string]interface{}) error {
+	template, err := c.GetManagedNodeGroupTemplate(ngName)
+	if err != nil {
+		return errors.Wrapf(err, "getting template of managed nodegroup %q", ngName)
+	}
+
+	current := gjson.Get(template, path)
+	upToDate, err := propertyEqual(current, property)
+	if err != nil {
+		return err
+	}
+	if upToDate {
+		logger.Info("%s of managed nodegroup %q is already up to date", description, ngName)
+		return nil
+	}
+
+	var updated string
+	if property == nil {
+		updated, err = sjson.Delete(template, path)
+	} else {
+		updated, err = sjson.Set(template, path, property)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "setting %s of managed nodegroup %q", description, ngName)
+	}
+
+	if err := c.UpdateNodeGroupStack(ngName, updated); err != nil {
+		return errors.Wrapf(err, "updating %s of managed nodegroup %q", description, ngName)
+	}
+	return nil
+}
+
+// propertyEqual reports whether the property of the template is property, a nil property being equal
+// to a missing one
+func propertyEqual(current gjson.Result, property map[string]interface{}) (bool, error) {
+	if property == nil || !current.Exists() {
+		return property == nil && !current.Exists(), nil
+	}
+	data, err := json.Marshal(property)
+	if err != nil {
+		return false, err
+	}
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return false, err
+	}
+	return reflect.DeepEqual(current.Value(), value), nil
+}
@@ -0,0 +1,354 @@
+package manager
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/awstesting"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/eks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("StackCollection ApplyToAllNodeGroups", func() {
+	const managedTemplate = `{"Resources": {"ManagedNodeGroup": {"Type": "AWS::EKS::Nodegroup", "Properties": {` +
+		`"ScalingConfig": {"MinSize": 1, "MaxSize": 3, "DesiredSize": 2}, ` +
+		`"Labels": {"alpha.eksctl.io/nodegroup-name": "managed"}, ` +
+		`"Taints": [{"Key": "gpu", "Value": "true", "Effect": "NO_SCHEDULE"}]}}}}`
+
+	var (
+		p  *mockprovider.MockProvider
+		sc *StackCollection
+	)
+
+	tierLabel := func(*NodeGroupSummary) (map[string]string, []api.NodeGroupTaint) {
+		return map[string]string{"tier": "web"}, nil
+	}
+
+	mockNodeGroupLabels := func(labels map[string]string) {
+		p.MockEKS().On("DescribeNodegroup", mock.MatchedBy(func(input *eks.DescribeNodegroupInput) bool {
+			return *input.ClusterName == "test-cluster" && *input.NodegroupName == "managed"
+		})).Return(&eks.DescribeNodegroupOutput{
+			Nodegroup: &eks.Nodegroup{
+				Labels:    aws.StringMap(labels),
+				Resources: &eks.NodegroupResources{},
+			},
+		}, nil)
+	}
+
+	updatedTemplates := func() []string {
+		var templates []string
+		for _, call := range p.MockCloudFormation().Calls {
+			if call.Method == "CreateChangeSet" {
+				templates = append(templates, *call.Arguments.Get(0).(*cfn.CreateChangeSetInput).TemplateBody)
+			}
+		}
+		return templates
+	}
+
+	BeforeEach(func() {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		p = mockprovider.NewMockProvider()
+		sc = NewStackCollection(p, cfg)
+
+		mockStacks(p, map[string]*mockedStack{
+			"eksctl-test-cluster-cluster": {
+				Tags: []*cfn.Tag{{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")}},
+			},
+			"eksctl-test-cluster-nodegroup-managed": {
+				Tags:     nodeGroupStackTags("managed", api.NodeGroupTypeManaged),
+				Template: managedTemplate,
+			},
+			"eksctl-test-cluster-nodegroup-unmanaged": {
+				Tags:     nodeGroupStackTags("unmanaged", api.NodeGroupTypeUnmanaged),
+				Template: `{"Resources": {"NodeGroup": {"Type": "AWS::AutoScaling::AutoScalingGroup", "Properties": {"MinSize": "1", "MaxSize": "2"}}}}`,
+			},
+		})
+		p.MockCloudFormation().On("DescribeStackResource", mock.Anything).Return(&cfn.DescribeStackResourceOutput{
+			StackResourceDetail: &cfn.StackResourceDetail{PhysicalResourceId: aws.String("asg-unmanaged")},
+		}, nil)
+		p.MockASG().On("DescribeAutoScalingGroups", mock.Anything).Return(&autoscaling.DescribeAutoScalingGroupsOutput{}, nil)
+	})
+
+	It("updates the labels of managed nodegroups and skips unmanaged ones", func() {
+		mockNodeGroupLabels(map[string]string{"tier": "batch", "team": "a"})
+		p.MockEKS().On("UpdateNodegroupConfig", mock.MatchedBy(func(input *eks.UpdateNodegroupConfigInput) bool {
+			return *input.NodegroupName == "managed" && len(input.Labels.AddOrUpdateLabels) == 1 &&
+				*input.Labels.AddOrUpdateLabels["tier"] == "web"
+		})).Return(&eks.UpdateNodegroupConfigOutput{Update: &eks.Update{Id: aws.String("update-1")}}, nil)
+
+		results, err := sc.ApplyToAllNodeGroups(tierLabel)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(results).To(ConsistOf(
+			NodeGroupUpdateResult{NodeGroupName: "managed", UpdateID: "update-1"},
+			NodeGroupUpdateResult{
+				NodeGroupName: "unmanaged",
+				Skipped:       true,
+				Note:          "unmanaged nodegroups cannot be updated in place, the nodegroup must be recreated",
+			},
+		))
+	})
+
+	It("does not update nodegroups that already have the labels", func() {
+		mockNodeGroupLabels(map[string]string{"tier": "web"})
+
+		results, err := sc.ApplyToAllNodeGroups(tierLabel)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(results).To(ContainElement(NodeGroupUpdateResult{
+			NodeGroupName: "managed",
+			Skipped:       true,
+			Note:          "labels are already up to date",
+		}))
+		Expect(p.MockEKS().AssertNotCalled(GinkgoT(), "UpdateNodegroupConfig", mock.Anything)).To(BeTrue())
+	})
+
+	It("sets the taints and labels of managed nodegroups in their stack", func() {
+		mockNodeGroupLabels(nil)
+		changeSetCreated := &cfn.DescribeChangeSetOutput{Status: aws.String(cfn.ChangeSetStatusCreateComplete)}
+		stackUpdated := &cfn.DescribeStacksOutput{
+			Stacks: []*cfn.Stack{{StackStatus: aws.String(cfn.StackStatusUpdateComplete)}},
+		}
+		p.MockCloudFormation().On("CreateChangeSet", mock.Anything).Return(nil, nil)
+		p.MockCloudFormation().On("DescribeChangeSetRequest", mock.Anything).
+			Return(awstesting.NewClient(nil).NewRequest(&request.Operation{Name: "Operation"}, nil, changeSetCreated), changeSetCreated)
+		p.MockCloudFormation().On("DescribeChangeSet", mock.Anything).Return(changeSetCreated, nil)
+		p.MockCloudFormation().On("ExecuteChangeSet", mock.Anything).Return(nil, nil)
+		p.MockCloudFormation().On("DescribeStacksRequest", mock.Anything).
+			Return(awstesting.NewClient(nil).NewRequest(&request.Operation{Name: "Operation"}, nil, stackUpdated), stackUpdated)
+
+		results, err := sc.ApplyToAllNodeGroups(func(*NodeGroupSummary) (map[string]string, []api.NodeGroupTaint) {
+			return map[string]string{"tier": "web"}, []api.NodeGroupTaint{{Key: "dedicated", Value: "web", Effect: "NoSchedule"}}
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(results).To(ContainElement(NodeGroupUpdateResult{
+			NodeGroupName: "managed",
+			Note:          "labels and taints were updated through the nodegroup stack",
+		}))
+		Expect(updatedTemplates()).To(ConsistOf(MatchJSON(`{"Resources": {"ManagedNodeGroup": {"Type": "AWS::EKS::Nodegroup", "Properties": {` +
+			`"ScalingConfig": {"MinSize": 1, "MaxSize": 3, "DesiredSize": 2}, ` +
+			`"Labels": {"alpha.eksctl.io/nodegroup-name": "managed", "tier": "web"}, ` +
+			`"Taints": [{"Key": "gpu", "Value": "true", "Effect": "NO_SCHEDULE"}, {"Key": "dedicated", "Value": "web", "Effect": "NO_SCHEDULE"}]}}}}`)))
+		p.MockCloudFormation().AssertCalled(GinkgoT(), "ExecuteChangeSet", mock.Anything)
+		Expect(p.MockEKS().AssertNotCalled(GinkgoT(), "UpdateNodegroupConfig", mock.Anything)).To(BeTrue())
+	})
+
+	It("does not update the stack when the taints and labels are already set", func() {
+		mockNodeGroupLabels(nil)
+
+		results, err := sc.ApplyToAllNodeGroups(func(*NodeGroupSummary) (map[string]string, []api.NodeGroupTaint) {
+			return map[string]string{"alpha.eksctl.io/nodegroup-name": "managed"}, []api.NodeGroupTaint{{Key: "gpu", Value: "true", Effect: "NoSchedule"}}
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(results).To(ContainElement(NodeGroupUpdateResult{
+			NodeGroupName: "managed",
+			Skipped:       true,
+			Note:          "labels and taints are already up to date",
+		}))
+		Expect(updatedTemplates()).To(BeEmpty())
+	})
+
+	It("passes the summaries of the managed nodegroups", func() {
+		mockNodeGroupLabels(map[string]string{"tier": "web"})
+
+		var summaries []*NodeGroupSummary
+		_, err := sc.ApplyToAllNodeGroups(func(ng *NodeGroupSummary) (map[string]string, []api.NodeGroupTaint) {
+			summaries = append(summaries, ng)
+			return tierLabel(ng)
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(summaries).To(HaveLen(1))
+		Expect(summaries[0].StackName).To(Equal("eksctl-test-cluster-nodegroup-managed"))
+		Expect(summaries[0].Cluster).To(Equal("test-cluster"))
+		Expect(summaries[0].Name).To(Equal("managed"))
+		Expect(summaries[0].NodeGroupType).To(Equal(api.NodeGroupTypeManaged))
+		Expect(summaries[0].Status).To(Equal(cfn.StackStatusCreateComplete))
+		Expect(summaries[0].MinSize).To(Equal(aws.Int(1)))
+		Expect(summaries[0].MaxSize).To(Equal(aws.Int(3)))
+		Expect(summaries[0].DesiredCapacity).To(Equal(aws.Int(2)))
+	})
+})
+
+var _ = Describe("StackCollection UpdateManagedNodeGroupLabels", func() {
+	const stackName = "eksctl-test-cluster-nodegroup-managed"
+
+	var (
+		p      *mockprovider.MockProvider
+		sc     *StackCollection
+		ng     *api.ManagedNodeGroup
+		stacks map[string]*mockedStack
+	)
+
+	updatedTemplates := func() []string {
+		var templates []string
+		for _, call := range p.MockCloudFormation().Calls {
+			if call.Method == "CreateChangeSet" {
+				templates = append(templates, *call.Arguments.Get(0).(*cfn.CreateChangeSetInput).TemplateBody)
+			}
+		}
+		return templates
+	}
+
+	BeforeEach(func() {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		p = mockprovider.NewMockProvider()
+		sc = NewStackCollection(p, cfg)
+
+		ng = api.NewManagedNodeGroup()
+		ng.Name = "managed"
+		ng.Labels = map[string]string{"tier": "web", "team": "a"}
+
+		stacks = map[string]*mockedStack{}
+		mockStackDescriptions(p, stacks)
+		p.MockCloudFormation().On("CreateChangeSet", mock.Anything).Return(nil, fmt.Errorf("stop after the change set"))
+		p.MockEKS().On("DescribeNodegroup", &eks.DescribeNodegroupInput{
+			ClusterName:   aws.String("test-cluster"),
+			NodegroupName: aws.String("managed"),
+		}).Return(&eks.DescribeNodegroupOutput{
+			Nodegroup: &eks.Nodegroup{
+				Labels: aws.StringMap(map[string]string{"tier": "batch", "team": "a", "zone": "z1", "env": "dev"}),
+			},
+		}, nil)
+	})
+
+	It("adds, updates and removes only the labels that changed", func() {
+		p.MockEKS().On("UpdateNodegroupConfig", mock.Anything).Return(&eks.UpdateNodegroupConfigOutput{}, nil)
+
+		Expect(sc.UpdateManagedNodeGroupLabels(ng)).To(Succeed())
+		p.MockEKS().AssertCalled(GinkgoT(), "UpdateNodegroupConfig", &eks.UpdateNodegroupConfigInput{
+			ClusterName:   aws.String("test-cluster"),
+			NodegroupName: aws.String("managed"),
+			Labels: &eks.UpdateLabelsPayload{
+				AddOrUpdateLabels: aws.StringMap(map[string]string{"tier": "web"}),
+				RemoveLabels:      aws.StringSlice([]string{"env", "zone"}),
+			},
+		})
+	})
+
+	It("does not update the nodegroup when the labels are unchanged", func() {
+		ng.Labels = map[string]string{"tier": "batch", "team": "a", "zone": "z1", "env": "dev"}
+
+		Expect(sc.UpdateManagedNodeGroupLabels(ng)).To(Succeed())
+		p.MockEKS().AssertNotCalled(GinkgoT(), "UpdateNodegroupConfig", mock.Anything)
+	})
+
+	It("rejects labels in namespaces reserved by EKS", func() {
+		for _, key := range []string{"eks.amazonaws.com/capacityType", "node.kubernetes.io/role", "k8s.io/owner"} {
+			ng.Labels = map[string]string{key: "x"}
+			Expect(sc.UpdateManagedNodeGroupLabels(ng)).To(MatchError(ContainSubstring(fmt.Sprintf("label %q of managed nodegroup \"managed\" is in a namespace reserved by EKS", key))))
+		}
+		p.MockEKS().AssertNotCalled(GinkgoT(), "DescribeNodegroup", mock.Anything)
+	})
+
+	Context("when the nodegroup was created by eksctl", func() {
+		BeforeEach(func() {
+			stacks[stackName] = &mockedStack{
+				Tags:     nodeGroupStackTags("managed", api.NodeGroupTypeManaged),
+				Template: `{"Resources":{"ManagedNodeGroup":{"Type":"AWS::EKS::Nodegroup","Properties":{"Labels":{"tier":"batch","env":"dev"}}}}}`,
+			}
+		})
+
+		It("sets the labels in the nodegroup stack", func() {
+			Expect(sc.UpdateManagedNodeGroupLabels(ng)).To(MatchError(ContainSubstring("stop after the change set")))
+			Expect(updatedTemplates()).To(ConsistOf(MatchJSON(
+				`{"Resources":{"ManagedNodeGroup":{"Type":"AWS::EKS::Nodegroup","Properties":{"Labels":{"tier":"web","team":"a"}}}}}`,
+			)))
+			p.MockEKS().AssertNotCalled(GinkgoT(), "UpdateNodegroupConfig", mock.Anything)
+		})
+
+		It("does not update the stack when the labels are unchanged", func() {
+			ng.Labels = map[string]string{"tier": "batch", "env": "dev"}
+
+			Expect(sc.UpdateManagedNodeGroupLabels(ng)).To(Succeed())
+			Expect(updatedTemplates()).To(BeEmpty())
+		})
+	})
+})
+
+var _ = Describe("StackCollection UpdateManagedNodeGroupUpdateConfig and UpdateManagedNodeGroupNodeRepairConfig", func() {
+	const stackName = "eksctl-test-cluster-nodegroup-managed"
+
+	var (
+		p        *mockprovider.MockProvider
+		sc       *StackCollection
+		ng       *api.ManagedNodeGroup
+		template string
+	)
+
+	updatedTemplates := func() []string {
+		var templates []string
+		for _, call := range p.MockCloudFormation().Calls {
+			if call.Method == "CreateChangeSet" {
+				templates = append(templates, *call.Arguments.Get(0).(*cfn.CreateChangeSetInput).TemplateBody)
+			}
+		}
+		return templates
+	}
+
+	BeforeEach(func() {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		p = mockprovider.NewMockProvider()
+		sc = NewStackCollection(p, cfg)
+
+		ng = api.NewManagedNodeGroup()
+		ng.Name = "managed"
+		template = `{"Resources":{"ManagedNodeGroup":{"Type":"AWS::EKS::Nodegroup","Properties":{"UpdateConfig":{"MaxUnavailable":1}}}}}`
+
+		p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(&cfn.DescribeStacksOutput{
+			Stacks: []*cfn.Stack{
+				{
+					StackName:   aws.String(stackName),
+					StackStatus: aws.String(cfn.StackStatusCreateComplete),
+					Tags: []*cfn.Tag{
+						{Key: aws.String(api.NodeGroupTypeTag), Value: aws.String(string(api.NodeGroupTypeManaged))},
+					},
+				},
+			},
+		}, nil)
+		p.MockCloudFormation().On("GetTemplate", mock.Anything).Return(func(*cfn.GetTemplateInput) *cfn.GetTemplateOutput {
+			return &cfn.GetTemplateOutput{TemplateBody: aws.String(template)}
+		}, nil)
+		p.MockCloudFormation().On("CreateChangeSet", mock.Anything).Return(nil, fmt.Errorf("stop after the change set"))
+	})
+
+	It("updates the stack with the new update config", func() {
+		ng.UpdateConfig = &api.NodeGroupUpdateConfig{MaxUnavailablePercentage: aws.Int(20)}
+
+		Expect(sc.UpdateManagedNodeGroupUpdateConfig(ng)).To(MatchError(ContainSubstring("stop after the change set")))
+		Expect(updatedTemplates()).To(ConsistOf(MatchJSON(
+			`{"Resources":{"ManagedNodeGroup":{"Type":"AWS::EKS::Nodegroup","Properties":{"UpdateConfig":{"MaxUnavailablePercentage":20}}}}}`,
+		)))
+	})
+
+	It("removes the update config when it is unset", func() {
+		Expect(sc.UpdateManagedNodeGroupUpdateConfig(ng)).To(MatchError(ContainSubstring("stop after the change set")))
+		Expect(updatedTemplates()).To(ConsistOf(MatchJSON(
+			`{"Resources":{"ManagedNodeGroup":{"Type":"AWS::EKS::Nodegroup","Properties":{}}}}`,
+		)))
+	})
+
+	It("updates the stack with the new node repair config", func() {
+		ng.NodeRepairConfig = &api.NodeRepairConfig{Enabled: api.Enabled()}
+
+		Expect(sc.UpdateManagedNodeGroupNodeRepairConfig(ng)).To(MatchError(ContainSubstring("stop after the change set")))
+		Expect(updatedTemplates()).To(ConsistOf(MatchJSON(
+			`{"Resources":{"ManagedNodeGroup":{"Type":"AWS::EKS::Nodegroup","Properties":{"UpdateConfig":{"MaxUnavailable":1},"NodeRepairConfig":{"Enabled":true}}}}}`,
+		)))
+	})
+
+	It("does not update the stack when the update config is unchanged", func() {
+		ng.UpdateConfig = &api.NodeGroupUpdateConfig{MaxUnavailable: aws.Int(1)}
+
+		Expect(sc.UpdateManagedNodeGroupUpdateConfig(ng)).To(Succeed())
+		Expect(updatedTemplates()).To(BeEmpty())
+	})
+})
@@ -0,0 +1,63 @@
+package manager
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// stackStatusPollInterval is how often WaitForNodeGroupStack checks the status of the stack
+var stackStatusPollInterval = 10 * time.Second
+
+// WaitForNodeGroupStack blocks until the nodegroup's stack reaches desiredStatus. It fails as soon as the
+// stack rolls back or another operation fails, with the reason of the first resource that failed, and when
+// ctx is done
+func (c *StackCollection) WaitForNodeGroupStack(ctx context.Context, ng *api.NodeGroup, desiredStatus string) error {
+	name := c.makeNodeGroupStackName(ng.Name)
+
+	for {
+		stack, err := c.DescribeStack(&Stack{StackName: &name})
+		if err != nil {
+			return errors.Wrapf(err, "describing stack %q", name)
+		}
+
+		status := aws.StringValue(stack.StackStatus)
+		if status == desiredStatus {
+			return nil
+		}
+		if isStackFailureStatus(status) {
+			return c.makeStackFailureError(stack)
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.Wrapf(ctx.Err(), "waiting for stack %q to reach status %s, last status %s", name, desiredStatus, status)
+		case <-time.After(stackStatusPollInterval):
+		}
+	}
+}
+
+func isStackFailureStatus(status string) bool {
+	return strings.Contains(status, "ROLLBACK") || strings.HasSuffix(status, "_FAILED")
+}
+
+// makeStackFailureError returns an error naming the status of the stack and, when the stack events can be
+// described, the resource that caused it to fail
+func (c *StackCollection) makeStackFailureError(stack *Stack) error {
+	stackName, status := aws.StringValue(stack.StackName), aws.StringValue(stack.StackStatus)
+	events, err := c.DescribeStackEvents(stack)
+	if err != nil {
+		return errors.Errorf("stack %q entered status %s, and its events could not be described: %v", stackName, status, err)
+	}
+	failure := firstResourceFailure(events)
+	if failure == nil {
+		return errors.Errorf("stack %q entered status %s", stackName, status)
+	}
+	return errors.Errorf("stack %q entered status %s, resource %s (%s) failed with: %s", stackName, status,
+		aws.StringValue(failure.LogicalResourceId), aws.StringValue(failure.ResourceType), aws.StringValue(failure.ResourceStatusReason))
+}
@@ -0,0 +1,112 @@
+package manager
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("StackCollection WaitForNodeGroupStack", func() {
+	const stackName = "eksctl-test-cluster-nodegroup-ng-1"
+
+	var (
+		p  *mockprovider.MockProvider
+		sc *StackCollection
+		ng *api.NodeGroup
+
+		originalPollInterval time.Duration
+	)
+
+	stackWithStatus := func(status string) *cfn.DescribeStacksOutput {
+		return &cfn.DescribeStacksOutput{
+			Stacks: []*cfn.Stack{{StackName: aws.String(stackName), StackStatus: aws.String(status)}},
+		}
+	}
+
+	BeforeEach(func() {
+		originalPollInterval = stackStatusPollInterval
+		stackStatusPollInterval = time.Millisecond
+
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		ng = cfg.NewNodeGroup()
+		ng.Name = "ng-1"
+		p = mockprovider.NewMockProvider()
+		sc = NewStackCollection(p, cfg)
+	})
+
+	AfterEach(func() {
+		stackStatusPollInterval = originalPollInterval
+	})
+
+	It("returns once the stack reaches the desired status", func() {
+		p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(stackWithStatus(cfn.StackStatusUpdateInProgress), nil).Twice()
+		p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(stackWithStatus(cfn.StackStatusUpdateComplete), nil)
+
+		Expect(sc.WaitForNodeGroupStack(context.Background(), ng, cfn.StackStatusUpdateComplete)).To(Succeed())
+		Expect(p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "DescribeStacks", 3)).To(BeTrue())
+	})
+
+	It("fails as soon as the stack rolls back, with the reason of the failed resource", func() {
+		p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(stackWithStatus(cfn.StackStatusUpdateRollbackInProgress), nil)
+		p.MockCloudFormation().On("DescribeStackEventsPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(p *cfn.DescribeStackEventsOutput, last bool) (shouldContinue bool))
+			consume(&cfn.DescribeStackEventsOutput{StackEvents: []*cfn.StackEvent{
+				{
+					StackName:            aws.String(stackName),
+					LogicalResourceId:    aws.String("NodeGroup"),
+					ResourceType:         aws.String("AWS::AutoScaling::AutoScalingGroup"),
+					ResourceStatus:       aws.String(cfn.ResourceStatusUpdateFailed),
+					ResourceStatusReason: aws.String("max size cannot be lower than desired capacity"),
+				},
+				{
+					StackName:         aws.String(stackName),
+					LogicalResourceId: aws.String(stackName),
+					ResourceType:      aws.String("AWS::CloudFormation::Stack"),
+					ResourceStatus:    aws.String(cfn.StackStatusUpdateInProgress),
+				},
+			}}, true)
+		}).Return(nil)
+
+		err := sc.WaitForNodeGroupStack(context.Background(), ng, cfn.StackStatusUpdateComplete)
+		Expect(err).To(MatchError(`stack "eksctl-test-cluster-nodegroup-ng-1" entered status UPDATE_ROLLBACK_IN_PROGRESS, ` +
+			"resource NodeGroup (AWS::AutoScaling::AutoScalingGroup) failed with: max size cannot be lower than desired capacity"))
+		Expect(p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "DescribeStacks", 1)).To(BeTrue())
+	})
+
+	It("does not fail on failure events with missing fields", func() {
+		p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(stackWithStatus(cfn.StackStatusRollbackInProgress), nil)
+		p.MockCloudFormation().On("DescribeStackEventsPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(p *cfn.DescribeStackEventsOutput, last bool) (shouldContinue bool))
+			consume(&cfn.DescribeStackEventsOutput{StackEvents: []*cfn.StackEvent{
+				{
+					StackName:            aws.String(stackName),
+					LogicalResourceId:    aws.String("NodeGroup"),
+					ResourceStatus:       aws.String(cfn.ResourceStatusCreateFailed),
+					ResourceStatusReason: aws.String("resource creation cancelled"),
+				},
+			}}, true)
+		}).Return(nil)
+
+		err := sc.WaitForNodeGroupStack(context.Background(), ng, cfn.StackStatusCreateComplete)
+		Expect(err).To(MatchError(`stack "eksctl-test-cluster-nodegroup-ng-1" entered status ROLLBACK_IN_PROGRESS, ` +
+			"resource NodeGroup () failed with: resource creation cancelled"))
+	})
+
+	It("stops waiting when the context is done", func() {
+		p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(stackWithStatus(cfn.StackStatusUpdateInProgress), nil)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		err := sc.WaitForNodeGroupStack(ctx, ng, cfn.StackStatusUpdateComplete)
+		Expect(err).To(MatchError(ContainSubstring("last status UPDATE_IN_PROGRESS: context deadline exceeded")))
+	})
+})
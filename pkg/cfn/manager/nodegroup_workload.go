@@ -0,0 +1,181 @@
+package manager
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+	v1helper "k8s.io/kubernetes/pkg/apis/core/v1/helper"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// WorkloadImpact describes the workloads that would be disrupted by deleting a nodegroup
+type WorkloadImpact struct {
+	NodeGroupName string
+	// Nodes is the number of nodes of the nodegroup
+	Nodes     int
+	Workloads []WorkloadSummary
+}
+
+// WorkloadSummary groups the pods of a single controller running on a nodegroup
+type WorkloadSummary struct {
+	Namespace string
+	// ControllerKind and ControllerName are empty for pods without a controller
+	ControllerKind string
+	ControllerName string
+	Pods           []string
+	// UnschedulablePods are the pods that don't fit any schedulable node outside the nodegroup,
+	// because of their node selector, required node affinity or the taints of the nodes
+	UnschedulablePods []string
+}
+
+// CanReschedule returns true when all pods of the workload fit a node outside the nodegroup
+func (w WorkloadSummary) CanReschedule() bool {
+	return len(w.UnschedulablePods) == 0
+}
+
+// GetNodeGroupWorkloadImpact lists the pods running on the nodes of the nodegroup grouped by namespace and
+// controller, flagging the pods that cannot be rescheduled onto the remaining nodes of the cluster. Pods
+// of DaemonSets are left out
+func (c *StackCollection) GetNodeGroupWorkloadImpact(ng *api.NodeGroup, kubeClient kubernetes.Interface) (*WorkloadImpact, error) {
+	nodes, err := kubeClient.CoreV1().Nodes().List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "listing nodes of nodegroup %q", ng.Name)
+	}
+
+	selector, err := labels.Parse(ng.ListOptions().LabelSelector)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parsing label selector of nodegroup %q", ng.Name)
+	}
+
+	var (
+		nodeNames  = sets.NewString()
+		otherNodes []corev1.Node
+	)
+	for _, node := range nodes.Items {
+		if selector.Matches(labels.Set(node.Labels)) {
+			nodeNames.Insert(node.Name)
+		} else if !node.Spec.Unschedulable {
+			otherNodes = append(otherNodes, node)
+		}
+	}
+
+	impact := &WorkloadImpact{
+		NodeGroupName: ng.Name,
+		Nodes:         nodeNames.Len(),
+	}
+	if nodeNames.Len() == 0 {
+		return impact, nil
+	}
+
+	pods, err := kubeClient.CoreV1().Pods(metav1.NamespaceAll).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "listing pods of nodegroup %q", ng.Name)
+	}
+
+	workloads := map[string]*WorkloadSummary{}
+	for _, pod := range pods.Items {
+		if !nodeNames.Has(pod.Spec.NodeName) {
+			continue
+		}
+		// terminated pods are not disrupted by the deletion
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+
+		var controllerKind, controllerName string
+		if owner := metav1.GetControllerOf(&pod); owner != nil {
+			controllerKind, controllerName = owner.Kind, owner.Name
+		}
+		// DaemonSet pods run on every node and are not rescheduled, the remaining nodes already have theirs
+		if controllerKind == "DaemonSet" {
+			continue
+		}
+		key := pod.Namespace + "/" + controllerKind + "/" + controllerName
+		workload, ok := workloads[key]
+		if !ok {
+			workload = &WorkloadSummary{
+				Namespace:      pod.Namespace,
+				ControllerKind: controllerKind,
+				ControllerName: controllerName,
+			}
+			workloads[key] = workload
+		}
+
+		workload.Pods = append(workload.Pods, pod.Name)
+		if !fitsAnyNode(&pod, otherNodes) {
+			workload.UnschedulablePods = append(workload.UnschedulablePods, pod.Name)
+		}
+	}
+
+	for _, workload := range workloads {
+		sort.Strings(workload.Pods)
+		sort.Strings(workload.UnschedulablePods)
+		impact.Workloads = append(impact.Workloads, *workload)
+	}
+	sort.Slice(impact.Workloads, func(i, j int) bool {
+		a, b := impact.Workloads[i], impact.Workloads[j]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		if a.ControllerKind != b.ControllerKind {
+			return a.ControllerKind < b.ControllerKind
+		}
+		return a.ControllerName < b.ControllerName
+	})
+	return impact, nil
+}
+
+// fitsAnyNode returns true when the pod's node selector, required node affinity and tolerations
+// allow it to be scheduled on at least one of the nodes. Resource requests are not taken into account
+func fitsAnyNode(pod *corev1.Pod, nodes []corev1.Node) bool {
+	for i := range nodes {
+		if fitsNode(pod, &nodes[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+func fitsNode(pod *corev1.Pod, node *corev1.Node) bool {
+	nodeLabels := labels.Set(node.Labels)
+	if len(pod.Spec.NodeSelector) > 0 && !labels.SelectorFromSet(pod.Spec.NodeSelector).Matches(nodeLabels) {
+		return false
+	}
+
+	if affinity := pod.Spec.Affinity; affinity != nil && affinity.NodeAffinity != nil {
+		if required := affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution; required != nil {
+			nodeFields := fields.Set{"metadata.name": node.Name}
+			if !v1helper.MatchNodeSelectorTerms(required.NodeSelectorTerms, nodeLabels, nodeFields) {
+				return false
+			}
+		}
+	}
+
+	for i := range node.Spec.Taints {
+		taint := &node.Spec.Taints[i]
+		if taint.Effect == corev1.TaintEffectPreferNoSchedule {
+			continue
+		}
+		if !toleratesTaint(pod.Spec.Tolerations, taint) {
+			return false
+		}
+	}
+	return true
+}
+
+func toleratesTaint(tolerations []corev1.Toleration, taint *corev1.Taint) bool {
+	for i := range tolerations {
+		if tolerations[i].ToleratesTaint(taint) {
+			return true
+		}
+	}
+	return false
+}
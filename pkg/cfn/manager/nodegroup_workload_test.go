@@ -0,0 +1,122 @@
+package manager
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("StackCollection GetNodeGroupWorkloadImpact", func() {
+	var (
+		ng *api.NodeGroup
+		sc *StackCollection
+	)
+
+	newNode := func(name, nodeGroupName string, taints ...corev1.Taint) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+				Labels: map[string]string{
+					api.NodeGroupNameLabel: nodeGroupName,
+				},
+			},
+			Spec: corev1.NodeSpec{Taints: taints},
+		}
+	}
+
+	newPod := func(name, nodeName, ownerKind, ownerName string) *corev1.Pod {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       corev1.PodSpec{NodeName: nodeName},
+			Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+		}
+		if ownerKind != "" {
+			isController := true
+			pod.OwnerReferences = []metav1.OwnerReference{
+				{Kind: ownerKind, Name: ownerName, Controller: &isController},
+			}
+		}
+		return pod
+	}
+
+	BeforeEach(func() {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		ng = cfg.NewNodeGroup()
+		ng.Name = "ng-1"
+		sc = NewStackCollection(mockprovider.NewMockProvider(), cfg)
+	})
+
+	It("groups the pods of the nodegroup by controller and flags pods that cannot be rescheduled", func() {
+		pinned := newPod("db-0", "node-1", "StatefulSet", "db")
+		pinned.Spec.NodeSelector = map[string]string{api.NodeGroupNameLabel: "ng-1"}
+
+		tainted := newPod("gpu-1", "node-2", "ReplicaSet", "gpu")
+		tainted.Spec.Tolerations = []corev1.Toleration{{Key: "gpu", Operator: corev1.TolerationOpExists}}
+
+		clientSet := fake.NewSimpleClientset(
+			newNode("node-1", "ng-1"),
+			newNode("node-2", "ng-1"),
+			newNode("node-3", "ng-2", corev1.Taint{Key: "gpu", Effect: corev1.TaintEffectNoSchedule}),
+			newPod("web-1", "node-1", "ReplicaSet", "web"),
+			newPod("web-2", "node-2", "ReplicaSet", "web"),
+			newPod("standalone", "node-1", "", ""),
+			newPod("other", "node-3", "ReplicaSet", "other"),
+			newPod("aws-node-1", "node-1", "DaemonSet", "aws-node"),
+			newPod("aws-node-3", "node-3", "DaemonSet", "aws-node"),
+			pinned,
+			tainted,
+		)
+
+		impact, err := sc.GetNodeGroupWorkloadImpact(ng, clientSet)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(impact.NodeGroupName).To(Equal("ng-1"))
+		Expect(impact.Nodes).To(Equal(2))
+		Expect(impact.Workloads).To(Equal([]WorkloadSummary{
+			{
+				Namespace:         "default",
+				Pods:              []string{"standalone"},
+				UnschedulablePods: []string{"standalone"},
+			},
+			{
+				Namespace:      "default",
+				ControllerKind: "ReplicaSet",
+				ControllerName: "gpu",
+				Pods:           []string{"gpu-1"},
+			},
+			{
+				Namespace:         "default",
+				ControllerKind:    "ReplicaSet",
+				ControllerName:    "web",
+				Pods:              []string{"web-1", "web-2"},
+				UnschedulablePods: []string{"web-1", "web-2"},
+			},
+			{
+				Namespace:         "default",
+				ControllerKind:    "StatefulSet",
+				ControllerName:    "db",
+				Pods:              []string{"db-0"},
+				UnschedulablePods: []string{"db-0"},
+			},
+		}))
+		Expect(impact.Workloads[1].CanReschedule()).To(BeTrue())
+		Expect(impact.Workloads[2].CanReschedule()).To(BeFalse())
+	})
+
+	It("returns no workloads when the nodegroup has no nodes", func() {
+		clientSet := fake.NewSimpleClientset(
+			newNode("node-1", "ng-2"),
+			newPod("web-1", "node-1", "ReplicaSet", "web"),
+		)
+
+		impact, err := sc.GetNodeGroupWorkloadImpact(ng, clientSet)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(impact.Nodes).To(BeZero())
+		Expect(impact.Workloads).To(BeEmpty())
+	})
+})
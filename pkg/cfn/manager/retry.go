@@ -0,0 +1,49 @@
+package manager
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/cloudformation/cloudformationiface"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+)
+
+// withReadRetries calls read until it succeeds, fails with an error that is neither throttling nor retryable,
+// or the read retry policy is done, waiting between attempts as the policy says. read must be safe to call
+// again, e.g. reset anything collected from pages
+func (c *StackCollection) withReadRetries(read func() error) error {
+	policy := c.readRetryPolicy.Clone()
+	for {
+		err := read()
+		if err == nil || !isRetryableReadError(err) || policy.Done() {
+			return err
+		}
+		delay := policy.Duration()
+		logger.Debug("CloudFormation request failed, retrying in %s: %v", delay, err)
+		time.Sleep(delay)
+	}
+}
+
+// isRetryableReadError returns whether the error is an AWS error the SDK would have retried, i.e. throttling or
+// a retryable error such as a failure to send the request
+func isRetryableReadError(err error) bool {
+	awsErr, ok := errors.Cause(err).(awserr.Error)
+	return ok && (request.IsErrorThrottle(awsErr) || request.IsErrorRetryable(awsErr))
+}
+
+// withoutRetries returns a copy of the CloudFormation client that does not retry failed requests, so that the
+// requests retried by withReadRetries are not also retried by the retryer of the session. Other
+// implementations, e.g. mocks, are returned as they are
+func withoutRetries(cfnAPI cloudformationiface.CloudFormationAPI) cloudformationiface.CloudFormationAPI {
+	cfnClient, ok := cfnAPI.(*cloudformation.CloudFormation)
+	if !ok {
+		return cfnAPI
+	}
+	readClient := *cfnClient.Client
+	readClient.Retryer = client.NoOpRetryer{}
+	return &cloudformation.CloudFormation{Client: &readClient}
+}
@@ -0,0 +1,76 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+)
+
+// ContinueUpdateRollback continues rolling back the update of a stack in status UPDATE_ROLLBACK_FAILED. The rollback
+// of skipResources, given as logical IDs, is skipped and CloudFormation marks them as UPDATE_COMPLETE, which is
+// needed when they cannot be rolled back, e.g. because they were modified outside CloudFormation. It waits until
+// the stack leaves the failed state, and returns an error naming the resulting status unless the rollback completed
+func (c *StackCollection) ContinueUpdateRollback(stackName string, skipResources []string) error {
+	stack, err := c.DescribeStack(&Stack{StackName: &stackName})
+	if err != nil {
+		return errors.Wrapf(err, "describing stack %q", stackName)
+	}
+	if status := aws.StringValue(stack.StackStatus); status != cfn.StackStatusUpdateRollbackFailed {
+		return fmt.Errorf("stack %q is in status %s, the update rollback can only be continued in status %s", stackName, status, cfn.StackStatusUpdateRollbackFailed)
+	}
+
+	input := &cfn.ContinueUpdateRollbackInput{
+		StackName: &stackName,
+	}
+	if len(skipResources) > 0 {
+		input.ResourcesToSkip = aws.StringSlice(skipResources)
+	}
+	if c.roleARN != "" {
+		input.RoleARN = aws.String(c.roleARN)
+	}
+	if _, err := c.cloudformationAPI.ContinueUpdateRollback(input); err != nil {
+		return errors.Wrapf(err, "continuing the update rollback of stack %q", stackName)
+	}
+
+	logger.Info("waiting for the update rollback of stack %q to complete", stackName)
+	ctx, cancel := context.WithTimeout(context.Background(), c.waitTimeout)
+	defer cancel()
+	return c.waitForUpdateRollback(ctx, stackName)
+}
+
+// waitForUpdateRollback waits for the update rollback of the stack to end. The stack is only considered to have
+// failed again once it has left the UPDATE_ROLLBACK_FAILED status it is in when the rollback is continued
+func (c *StackCollection) waitForUpdateRollback(ctx context.Context, name string) error {
+	rollbackStarted := false
+	for {
+		s, err := c.DescribeStack(&Stack{StackName: &name})
+		if err != nil {
+			return errors.Wrapf(err, "describing stack %q", name)
+		}
+
+		switch status := aws.StringValue(s.StackStatus); status {
+		case cfn.StackStatusUpdateRollbackComplete:
+			logger.Success("stack %q is in status %s", name, status)
+			return nil
+		case cfn.StackStatusUpdateRollbackInProgress, cfn.StackStatusUpdateRollbackCompleteCleanupInProgress:
+			rollbackStarted = true
+		case cfn.StackStatusUpdateRollbackFailed:
+			if rollbackStarted {
+				return c.makeStackFailureError(s)
+			}
+		default:
+			return fmt.Errorf("stack %q entered unexpected status %s while rolling back", name, status)
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.Wrapf(ctx.Err(), "waiting for the update rollback of stack %q, last status %s", name, aws.StringValue(s.StackStatus))
+		case <-time.After(stackStatusPollInterval):
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package manager
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("StackCollection ContinueUpdateRollback", func() {
+	const stackName = "eksctl-test-cluster-nodegroup-ng-1"
+
+	var (
+		p  *mockprovider.MockProvider
+		sc *StackCollection
+
+		originalPollInterval time.Duration
+	)
+
+	stackWithStatus := func(status string) *cfn.DescribeStacksOutput {
+		return &cfn.DescribeStacksOutput{
+			Stacks: []*cfn.Stack{{StackName: aws.String(stackName), StackStatus: aws.String(status)}},
+		}
+	}
+
+	BeforeEach(func() {
+		originalPollInterval = stackStatusPollInterval
+		stackStatusPollInterval = time.Millisecond
+
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		p = mockprovider.NewMockProvider()
+		sc = NewStackCollection(p, cfg)
+	})
+
+	AfterEach(func() {
+		stackStatusPollInterval = originalPollInterval
+	})
+
+	It("continues the rollback skipping the given resources and waits for it to complete", func() {
+		p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(stackWithStatus(cfn.StackStatusUpdateRollbackFailed), nil).Twice()
+		p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(stackWithStatus(cfn.StackStatusUpdateRollbackInProgress), nil).Once()
+		p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(stackWithStatus(cfn.StackStatusUpdateRollbackComplete), nil)
+		p.MockCloudFormation().On("ContinueUpdateRollback", mock.Anything).Return(&cfn.ContinueUpdateRollbackOutput{}, nil)
+
+		Expect(sc.ContinueUpdateRollback(stackName, []string{"NodeGroup"})).To(Succeed())
+		Expect(p.MockCloudFormation().AssertCalled(GinkgoT(), "ContinueUpdateRollback", &cfn.ContinueUpdateRollbackInput{
+			StackName:       aws.String(stackName),
+			ResourcesToSkip: aws.StringSlice([]string{"NodeGroup"}),
+		})).To(BeTrue())
+	})
+
+	It("refuses stacks that are not in UPDATE_ROLLBACK_FAILED", func() {
+		p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(stackWithStatus(cfn.StackStatusUpdateComplete), nil)
+
+		err := sc.ContinueUpdateRollback(stackName, nil)
+		Expect(err).To(MatchError(`stack "eksctl-test-cluster-nodegroup-ng-1" is in status UPDATE_COMPLETE, the update rollback can only be continued in status UPDATE_ROLLBACK_FAILED`))
+		p.MockCloudFormation().AssertNotCalled(GinkgoT(), "ContinueUpdateRollback", mock.Anything)
+	})
+
+	It("surfaces the failure when the rollback fails again", func() {
+		p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(stackWithStatus(cfn.StackStatusUpdateRollbackFailed), nil).Once()
+		p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(stackWithStatus(cfn.StackStatusUpdateRollbackInProgress), nil).Once()
+		p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(stackWithStatus(cfn.StackStatusUpdateRollbackFailed), nil)
+		p.MockCloudFormation().On("ContinueUpdateRollback", mock.Anything).Return(&cfn.ContinueUpdateRollbackOutput{}, nil)
+		p.MockCloudFormation().On("DescribeStackEventsPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(p *cfn.DescribeStackEventsOutput, last bool) (shouldContinue bool))
+			consume(&cfn.DescribeStackEventsOutput{StackEvents: []*cfn.StackEvent{{
+				StackName:            aws.String(stackName),
+				LogicalResourceId:    aws.String("NodeGroup"),
+				ResourceType:         aws.String("AWS::AutoScaling::AutoScalingGroup"),
+				ResourceStatus:       aws.String(cfn.ResourceStatusUpdateFailed),
+				ResourceStatusReason: aws.String("Auto Scaling group not found"),
+			}}}, true)
+		}).Return(nil)
+
+		err := sc.ContinueUpdateRollback(stackName, nil)
+		Expect(err).To(MatchError(`stack "eksctl-test-cluster-nodegroup-ng-1" entered status UPDATE_ROLLBACK_FAILED, ` +
+			`resource NodeGroup (AWS::AutoScaling::AutoScalingGroup) failed with: Auto Scaling group not found`))
+	})
+})
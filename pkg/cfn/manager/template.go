@@ -14,7 +14,11 @@ func (c *StackCollection) GetStackTemplate(stackName string) (string, error) {
 		StackName: aws.String(stackName),
 	}
 
-	output, err := c.cloudformationAPI.GetTemplate(input)
+	var output *cloudformation.GetTemplateOutput
+	err := c.withReadRetries(func() (err error) {
+		output, err = c.cloudformationReadAPI.GetTemplate(input)
+		return err
+	})
 	if err != nil {
 		return "", err
 	}
@@ -22,6 +26,24 @@ func (c *StackCollection) GetStackTemplate(stackName string) (string, error) {
 	return ensureJSONResponse([]byte(*output.TemplateBody))
 }
 
+// templateCache holds the templates fetched during a single pass over the stacks of a cluster, keyed by
+// stack name, so that each template is only fetched once. A new cache must be used for every pass, as
+// templates change when stacks are updated
+type templateCache map[string]string
+
+// getCachedStackTemplate gets the template of the stack from the cache, or fetches it and caches it
+func (c *StackCollection) getCachedStackTemplate(templates templateCache, stackName string) (string, error) {
+	if template, ok := templates[stackName]; ok {
+		return template, nil
+	}
+	template, err := c.GetStackTemplate(stackName)
+	if err != nil {
+		return "", err
+	}
+	templates[stackName] = template
+	return template, nil
+}
+
 func ensureJSONResponse(templateBody []byte) (string, error) {
 	//since json is valid yaml we just need to check the response is valid yaml
 	template, err := goformation.ParseYAML(templateBody)
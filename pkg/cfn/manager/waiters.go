@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/request"
 	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/builder"
@@ -19,6 +21,26 @@ const (
 	changesetStatus = "Status"
 )
 
+var (
+	// stackFailureStatuses are the statuses of a stack whose create or update failed, the waiters stop as
+	// soon as the rollback starts, so these include the in-progress rollback statuses
+	stackFailureStatuses = sets.NewString(
+		cfn.StackStatusCreateFailed,
+		cfn.StackStatusRollbackInProgress,
+		cfn.StackStatusRollbackFailed,
+		cfn.StackStatusRollbackComplete,
+		cfn.StackStatusUpdateRollbackInProgress,
+		cfn.StackStatusUpdateRollbackFailed,
+		cfn.StackStatusUpdateRollbackCompleteCleanupInProgress,
+		cfn.StackStatusUpdateRollbackComplete,
+	)
+	// stackOperationStartStatuses are the statuses of the stack event that starts a create or update
+	stackOperationStartStatuses = sets.NewString(
+		cfn.StackStatusCreateInProgress,
+		cfn.StackStatusUpdateInProgress,
+	)
+)
+
 // cloudformation.WaitUntilStackCreateComplete doesn't detect in-progress status early enough,
 // so this is custom version that is more suitable for our use, as there is no way to add any
 // custom acceptors
@@ -43,7 +65,11 @@ func (c *StackCollection) waitWithAcceptors(i *Stack, acceptors []request.Waiter
 			logger.Debug("describeErr=%v", err)
 		} else {
 			logger.Critical("unexpected status %q while %s", *s.StackStatus, msg)
-			c.troubleshootStackFailureCause(i, desiredStatus)
+			failure := c.troubleshootStackFailureCause(i, desiredStatus)
+			if failure != nil && stackFailureStatuses.Has(*s.StackStatus) {
+				return errors.Errorf("%s: stack ended in status %q, resource %s (%s) failed with: %s", msg, *s.StackStatus,
+					aws.StringValue(failure.LogicalResourceId), aws.StringValue(failure.ResourceType), aws.StringValue(failure.ResourceStatusReason))
+			}
 		}
 		return nil
 	}
@@ -89,12 +115,14 @@ func (c *StackCollection) waitWithAcceptorsChangeSet(i *Stack, changesetName str
 	return waiters.Wait(*i.StackName, msg, acceptors, newRequest, c.waitTimeout, troubleshoot)
 }
 
-func (c *StackCollection) troubleshootStackFailureCause(i *Stack, desiredStatus string) {
+// troubleshootStackFailureCause logs the events of the stack and returns the first resource failure of the
+// latest stack operation, if any
+func (c *StackCollection) troubleshootStackFailureCause(i *Stack, desiredStatus string) *cfn.StackEvent {
 	logger.Info("fetching stack events in attempt to troubleshoot the root cause of the failure")
 	events, err := c.DescribeStackEvents(i)
 	if err != nil {
 		logger.Critical("cannot fetch stack events: %v", err)
-		return
+		return nil
 	}
 	for _, e := range events {
 		msg := fmt.Sprintf("%s/%s: %s", *e.ResourceType, *e.LogicalResourceId, *e.ResourceStatus)
@@ -124,6 +152,27 @@ func (c *StackCollection) troubleshootStackFailureCause(i *Stack, desiredStatus
 			logger.Info(msg)
 		}
 	}
+	return firstResourceFailure(events)
+}
+
+// firstResourceFailure returns the earliest failed resource event since the latest stack create or update
+// started, which is usually the cause of the stack failing, e.g. an EC2 capacity error. Later failures
+// tend to be resources cancelled because of it. Stack events are ordered newest first
+func firstResourceFailure(events []*cfn.StackEvent) *cfn.StackEvent {
+	var failure *cfn.StackEvent
+	for _, e := range events {
+		isStackEvent := aws.StringValue(e.ResourceType) == "AWS::CloudFormation::Stack" && aws.StringValue(e.LogicalResourceId) == aws.StringValue(e.StackName)
+		if isStackEvent {
+			if stackOperationStartStatuses.Has(aws.StringValue(e.ResourceStatus)) {
+				break
+			}
+			continue
+		}
+		if strings.HasSuffix(aws.StringValue(e.ResourceStatus), "_FAILED") && e.ResourceStatusReason != nil {
+			failure = e
+		}
+	}
+	return failure
 }
 
 // DoWaitUntilStackIsCreated blocks until the given stack's
@@ -0,0 +1,106 @@
+package manager
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/awstesting"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("StackCollection waiters", func() {
+	const stackName = "eksctl-test-cluster-nodegroup-ng-1"
+
+	newEvent := func(logicalID, resourceType, status, reason string) *cfn.StackEvent {
+		e := &cfn.StackEvent{
+			StackName:         aws.String(stackName),
+			LogicalResourceId: aws.String(logicalID),
+			ResourceType:      aws.String(resourceType),
+			ResourceStatus:    aws.String(status),
+		}
+		if reason != "" {
+			e.ResourceStatusReason = aws.String(reason)
+		}
+		return e
+	}
+
+	// newest first, as returned by DescribeStackEvents
+	events := []*cfn.StackEvent{
+		newEvent(stackName, "AWS::CloudFormation::Stack", cfn.ResourceStatusDeleteComplete, ""),
+		newEvent("NodeGroup", "AWS::AutoScaling::AutoScalingGroup", cfn.ResourceStatusDeleteComplete, ""),
+		newEvent(stackName, "AWS::CloudFormation::Stack", cfn.StackStatusRollbackInProgress, "The following resource(s) failed to create: [NodeGroup, NodeInstanceRole]"),
+		newEvent("NodeInstanceRole", "AWS::IAM::Role", cfn.ResourceStatusCreateFailed, "Resource creation cancelled"),
+		newEvent("NodeGroup", "AWS::AutoScaling::AutoScalingGroup", cfn.ResourceStatusCreateFailed, "We currently do not have sufficient m5.large capacity in the Availability Zone you requested"),
+		newEvent("NodeGroup", "AWS::AutoScaling::AutoScalingGroup", cfn.ResourceStatusCreateInProgress, ""),
+		newEvent(stackName, "AWS::CloudFormation::Stack", cfn.StackStatusCreateInProgress, "User Initiated"),
+		// from a previous stack that had the same name
+		newEvent("NodeGroup", "AWS::AutoScaling::AutoScalingGroup", cfn.ResourceStatusCreateFailed, "an older failure"),
+	}
+
+	It("finds the earliest resource failure of the latest stack operation", func() {
+		failure := firstResourceFailure(events)
+		Expect(failure).NotTo(BeNil())
+		Expect(*failure.LogicalResourceId).To(Equal("NodeGroup"))
+		Expect(*failure.ResourceStatusReason).To(HavePrefix("We currently do not have sufficient m5.large capacity"))
+
+		Expect(firstResourceFailure(events[:2])).To(BeNil())
+	})
+
+	mockFailedStack := func(p *mockprovider.MockProvider, stackStatus string, events []*cfn.StackEvent) {
+		describeStacksOutput := &cfn.DescribeStacksOutput{
+			Stacks: []*cfn.Stack{
+				{
+					StackName:   aws.String(stackName),
+					StackStatus: aws.String(stackStatus),
+				},
+			},
+		}
+		req := awstesting.NewClient(nil).NewRequest(&request.Operation{Name: "Operation"}, nil, describeStacksOutput)
+		p.MockCloudFormation().On("DescribeStacksRequest", mock.Anything).Return(req, describeStacksOutput)
+		p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(describeStacksOutput, nil)
+		p.MockCloudFormation().On("DescribeStackEventsPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(p *cfn.DescribeStackEventsOutput, last bool) (shouldContinue bool))
+			consume(&cfn.DescribeStackEventsOutput{StackEvents: events}, true)
+		}).Return(nil)
+	}
+
+	It("returns the resource failure when the stack fails to be created", func() {
+		p := mockprovider.NewMockProvider()
+		sc := NewStackCollection(p, api.NewClusterConfig())
+		mockFailedStack(p, cfn.StackStatusRollbackComplete, events)
+
+		err := sc.DoWaitUntilStackIsCreated(&Stack{StackName: aws.String(stackName)})
+		Expect(err).To(MatchError(`waiting for CloudFormation stack "eksctl-test-cluster-nodegroup-ng-1": stack ended in status "ROLLBACK_COMPLETE", ` +
+			"resource NodeGroup (AWS::AutoScaling::AutoScalingGroup) failed with: We currently do not have sufficient m5.large capacity in the Availability Zone you requested"))
+	})
+
+	It("returns the resource failure while the stack is rolling back", func() {
+		p := mockprovider.NewMockProvider()
+		sc := NewStackCollection(p, api.NewClusterConfig())
+		// the waiter stops as soon as the rollback starts
+		mockFailedStack(p, cfn.StackStatusRollbackInProgress, events[2:])
+
+		err := sc.DoWaitUntilStackIsCreated(&Stack{StackName: aws.String(stackName)})
+		Expect(err).To(MatchError(`waiting for CloudFormation stack "eksctl-test-cluster-nodegroup-ng-1": stack ended in status "ROLLBACK_IN_PROGRESS", ` +
+			"resource NodeGroup (AWS::AutoScaling::AutoScalingGroup) failed with: We currently do not have sufficient m5.large capacity in the Availability Zone you requested"))
+	})
+
+	It("returns the resource failure while an update is rolling back", func() {
+		p := mockprovider.NewMockProvider()
+		sc := NewStackCollection(p, api.NewClusterConfig())
+		mockFailedStack(p, cfn.StackStatusUpdateRollbackInProgress, []*cfn.StackEvent{
+			newEvent(stackName, "AWS::CloudFormation::Stack", cfn.StackStatusUpdateRollbackInProgress, "The following resource(s) failed to update: [NodeGroup]"),
+			newEvent("NodeGroup", "AWS::AutoScaling::AutoScalingGroup", cfn.ResourceStatusUpdateFailed, "Max bound, 2, must be greater than or equal to min bound, 3"),
+			newEvent(stackName, "AWS::CloudFormation::Stack", cfn.StackStatusUpdateInProgress, "User Initiated"),
+		})
+
+		err := sc.doWaitUntilStackIsUpdated(&Stack{StackName: aws.String(stackName)})
+		Expect(err).To(MatchError(`waiting for CloudFormation stack "eksctl-test-cluster-nodegroup-ng-1": stack ended in status "UPDATE_ROLLBACK_IN_PROGRESS", ` +
+			"resource NodeGroup (AWS::AutoScaling::AutoScalingGroup) failed with: Max bound, 2, must be greater than or equal to min bound, 3"))
+	})
+})
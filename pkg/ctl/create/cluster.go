@@ -313,6 +313,10 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 		}
 
 		for _, ng := range cfg.NodeGroups {
+			if err := ctl.ConfigureCustomNetworking(cfg, clientSet, ng); err != nil {
+				return err
+			}
+
 			// authorise nodes to join
 			if err = authconfigmap.AddNodeGroup(clientSet, ng); err != nil {
 				return err
@@ -392,6 +396,11 @@ func createOrImportVPC(cmd *cmdutils.Cmd, cfg *api.ClusterConfig, params *cmduti
 			return nil
 		}
 		// default: create dedicated VPC
+		for _, ng := range cfg.NodeGroups {
+			if len(ng.PodSubnets) > 0 {
+				return fmt.Errorf("nodegroup %q has pod subnets, which can only be used with an existing VPC", ng.Name)
+			}
+		}
 		if err := ctl.SetAvailabilityZones(cfg, params.AvailabilityZones); err != nil {
 			return err
 		}
@@ -462,6 +471,10 @@ func createOrImportVPC(cmd *cmdutils.Cmd, cfg *api.ClusterConfig, params *cmduti
 		return err
 	}
 
+	if err := vpc.ValidatePodSubnetsForNodeGroups(cfg, ctl.Provider); err != nil {
+		return err
+	}
+
 	logger.Success("using existing %s", cfg.SubnetInfo())
 	logger.Warning(customNetworkingNotice)
 	return nil
@@ -23,12 +23,13 @@ func getNodeGroupCmd(cmd *cmdutils.Cmd) {
 	cmd.ClusterConfig = cfg
 
 	params := &getCmdParams{}
+	var outdated bool
 
 	cmd.SetDescription("nodegroup", "Get nodegroup(s)", "", "ng", "nodegroups")
 
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
-		return doGetNodeGroup(cmd, ng, params)
+		return doGetNodeGroup(cmd, ng, params, outdated)
 	}
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
@@ -36,13 +37,14 @@ func getNodeGroupCmd(cmd *cmdutils.Cmd) {
 		fs.StringVarP(&ng.Name, "name", "n", "", "Name of the nodegroup")
 		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
 		cmdutils.AddCommonFlagsForGetCmd(fs, &params.chunkSize, &params.output)
+		fs.BoolVar(&outdated, "outdated", false, "Show whether nodegroups run an AMI older than the latest EKS-optimized AMI for the cluster version")
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
 	})
 
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
 }
 
-func doGetNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, params *getCmdParams) error {
+func doGetNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, params *getCmdParams, outdated bool) error {
 	cfg := cmd.ClusterConfig
 
 	// TODO: move this into a loader when --config-file gets added to this command
@@ -86,6 +88,12 @@ func doGetNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, params *getCmdParams)
 		summaries = append(summaries, summary)
 	}
 
+	if outdated {
+		if err := ctl.NewStackManager(cfg).SetNodeGroupsOutdated(summaries); err != nil {
+			return err
+		}
+	}
+
 	printer, err := printers.NewPrinter(params.output)
 	if err != nil {
 		return err
@@ -102,6 +110,14 @@ func doGetNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, params *getCmdParams)
 			return errors.Errorf("nodegroup with name %v not found", ng.Name)
 		}
 		addSummaryTableColumns(printer.(*printers.TablePrinter))
+		if outdated {
+			printer.(*printers.TablePrinter).AddColumn("OUTDATED", func(s *manager.NodeGroupSummary) string {
+				if s.Outdated == nil {
+					return "unknown"
+				}
+				return strconv.FormatBool(*s.Outdated)
+			})
+		}
 	}
 
 	return printer.PrintObjWithKind("nodegroups", summaries, os.Stdout)
@@ -118,16 +134,19 @@ func addSummaryTableColumns(printer *printers.TablePrinter) {
 		return s.Status
 	})
 	printer.AddColumn("CREATED", func(s *manager.NodeGroupSummary) string {
+		if s.CreationTime == nil {
+			return ""
+		}
 		return s.CreationTime.Format(time.RFC3339)
 	})
 	printer.AddColumn("MIN SIZE", func(s *manager.NodeGroupSummary) string {
-		return strconv.Itoa(s.MinSize)
+		return formatSize(s.MinSize)
 	})
 	printer.AddColumn("MAX SIZE", func(s *manager.NodeGroupSummary) string {
-		return strconv.Itoa(s.MaxSize)
+		return formatSize(s.MaxSize)
 	})
 	printer.AddColumn("DESIRED CAPACITY", func(s *manager.NodeGroupSummary) string {
-		return strconv.Itoa(s.DesiredCapacity)
+		return formatSize(s.DesiredCapacity)
 	})
 	printer.AddColumn("INSTANCE TYPE", func(s *manager.NodeGroupSummary) string {
 		return s.InstanceType
@@ -139,3 +158,11 @@ func addSummaryTableColumns(printer *printers.TablePrinter) {
 		return s.AutoScalingGroupName
 	})
 }
+
+// formatSize formats a nodegroup size, leaving sizes the nodegroup does not set empty
+func formatSize(size *int) string {
+	if size == nil {
+		return ""
+	}
+	return strconv.Itoa(*size)
+}
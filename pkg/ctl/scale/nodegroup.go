@@ -11,21 +11,23 @@ import (
 )
 
 func scaleNodeGroupCmd(cmd *cmdutils.Cmd) {
-	scaleNodeGroupWithRunFunc(cmd, func(cmd *cmdutils.Cmd, ng *api.NodeGroup) error {
-		return doScaleNodeGroup(cmd, ng)
+	scaleNodeGroupWithRunFunc(cmd, func(cmd *cmdutils.Cmd, ng *api.NodeGroup, force bool) error {
+		return doScaleNodeGroup(cmd, ng, force)
 	})
 }
 
-func scaleNodeGroupWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd, ng *api.NodeGroup) error) {
+func scaleNodeGroupWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd, ng *api.NodeGroup, force bool) error) {
 	cfg := api.NewClusterConfig()
 	ng := cfg.NewNodeGroup()
 	cmd.ClusterConfig = cfg
 
+	var force bool
+
 	cmd.SetDescription("nodegroup", "Scale a nodegroup", "", "ng")
 
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
-		return runFunc(cmd, ng)
+		return runFunc(cmd, ng, force)
 	}
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
@@ -49,6 +51,9 @@ func scaleNodeGroupWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd
 			}
 		})
 
+		fs.BoolVar(&cmd.Plan, "dry-run", false, "show the CloudFormation changes scaling would make without applying them")
+		fs.BoolVar(&force, "force", false, "scale even if the instance types of the nodegroup are not offered in all of its availability zones")
+
 		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
 	})
@@ -56,7 +61,7 @@ func scaleNodeGroupWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, true)
 }
 
-func doScaleNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup) error {
+func doScaleNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, force bool) error {
 	if err := cmdutils.NewScaleNodeGroupLoader(cmd, ng).Load(); err != nil {
 		return err
 	}
@@ -67,5 +72,5 @@ func doScaleNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup) error {
 		return err
 	}
 
-	return nodegroup.New(cfg, ctl, nil).Scale(ng)
+	return nodegroup.New(cfg, ctl, nil).Scale(ng, cmd.Plan, force)
 }
@@ -18,7 +18,7 @@ var _ = Describe("scale", func() {
 				cmd := newMockEmptyCmd(args...)
 				count := 0
 				cmdutils.AddResourceCmd(cmdutils.NewGrouping(), cmd.parentCmd, func(cmd *cmdutils.Cmd) {
-					scaleNodeGroupWithRunFunc(cmd, func(cmd *cmdutils.Cmd, ng *v1alpha5.NodeGroup) error {
+					scaleNodeGroupWithRunFunc(cmd, func(cmd *cmdutils.Cmd, ng *v1alpha5.NodeGroup, force bool) error {
 						if len(ng.Name) != 0 {
 							Expect(ng.Name).To(Or(Equal("nodeGroup"), Equal("")))
 						} else {
@@ -43,6 +43,8 @@ var _ = Describe("scale", func() {
 			Entry("with config file and name flags", "nodegroup", "--name", "nodeGroup", "-f", "dummyConfigFile.yaml"),
 			Entry("without --nodes-min flags", "nodegroup", "--cluster", "clusterName", "--name", "nodeGroup", "--nodes", "2", "--nodes-max", "3"),
 			Entry("without --nodes-max flags", "nodegroup", "--cluster", "clusterName", "--name", "nodeGroup", "--nodes", "2", "--nodes-min", "1"),
+			Entry("with --dry-run", "nodegroup", "--cluster", "clusterName", "--name", "nodeGroup", "--nodes", "2", "--dry-run"),
+			Entry("with --force", "nodegroup", "--cluster", "clusterName", "--name", "nodeGroup", "--nodes", "2", "--force"),
 		)
 
 		DescribeTable("invalid flags or arguments",
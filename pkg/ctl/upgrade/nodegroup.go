@@ -5,6 +5,7 @@ import (
 
 	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
 
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"github.com/weaveworks/eksctl/pkg/managed"
@@ -13,7 +14,12 @@ import (
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 )
 
-const upgradeNodegroupTimeout = 45 * time.Minute
+const (
+	upgradeNodegroupTimeout = 45 * time.Minute
+	// defaultNodesReadyTimeout is how long the new nodes of an upgraded nodegroup have to become ready
+	// before the upgrade is rolled back
+	defaultNodesReadyTimeout = 10 * time.Minute
+)
 
 func upgradeNodeGroupCmd(cmd *cmdutils.Cmd) {
 	cfg := api.NewClusterConfig()
@@ -21,10 +27,20 @@ func upgradeNodeGroupCmd(cmd *cmdutils.Cmd) {
 
 	cmd.SetDescription("nodegroup", "Upgrade nodegroup", "")
 
-	var options managed.UpgradeOptions
+	var (
+		options          managed.UpgradeOptions
+		allowReplacement bool
+		rollbackUnready  bool
+		readyTimeout     time.Duration
+	)
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
-		return upgradeNodeGroup(cmd, options)
+		if !rollbackUnready {
+			readyTimeout = 0
+		} else if readyTimeout <= 0 {
+			return errors.New("--nodes-ready-timeout must be greater than 0")
+		}
+		return upgradeNodeGroup(cmd, options, allowReplacement, readyTimeout)
 	}
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
@@ -33,6 +49,9 @@ func upgradeNodeGroupCmd(cmd *cmdutils.Cmd) {
 		fs.StringVar(&options.KubernetesVersion, "kubernetes-version", "", "Kubernetes version")
 		fs.StringVar(&options.LaunchTemplateVersion, "launch-template-version", "", "Launch template version")
 		fs.BoolVar(&options.ForceUpgrade, "force-upgrade", false, "Force the update if the existing node group's pods are unable to be drained due to a pod disruption budget issue")
+		fs.BoolVar(&allowReplacement, "allow-replacement", false, "Allow the upgrade to replace a nodegroup whose stack policy protects it from replacement")
+		fs.BoolVar(&rollbackUnready, "rollback-unready-nodes", false, "Roll back the launch template version of the nodegroup if the new nodes do not become ready after the upgrade, requires --launch-template-version")
+		fs.DurationVar(&readyTimeout, "nodes-ready-timeout", defaultNodesReadyTimeout, "How long the new nodes have to become ready before the upgrade is rolled back, used with --rollback-unready-nodes")
 
 		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
 		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
@@ -47,7 +66,7 @@ func upgradeNodeGroupCmd(cmd *cmdutils.Cmd) {
 
 }
 
-func upgradeNodeGroup(cmd *cmdutils.Cmd, options managed.UpgradeOptions) error {
+func upgradeNodeGroup(cmd *cmdutils.Cmd, options managed.UpgradeOptions, allowReplacement bool, readyTimeout time.Duration) error {
 	cfg := cmd.ClusterConfig
 	if cfg.Metadata.Name == "" {
 		return cmdutils.ErrMustBeSet(cmdutils.ClusterNameFlag(cmd))
@@ -79,6 +98,6 @@ func upgradeNodeGroup(cmd *cmdutils.Cmd, options managed.UpgradeOptions) error {
 		return err
 	}
 
-	return nodegroup.New(cfg, ctl, clientSet).Upgrade(options.NodegroupName, options.KubernetesVersion, options.LaunchTemplateVersion, options.ForceUpgrade)
+	return nodegroup.New(cfg, ctl, clientSet).Upgrade(options.NodegroupName, options.KubernetesVersion, options.LaunchTemplateVersion, options.ForceUpgrade, allowReplacement, readyTimeout)
 
 }
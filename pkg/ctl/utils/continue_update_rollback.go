@@ -0,0 +1,64 @@
+package utils
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+func continueUpdateRollbackCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	var (
+		stackName     string
+		skipResources []string
+	)
+
+	cmd.SetDescription("continue-update-rollback", "Continue rolling back a stack in status UPDATE_ROLLBACK_FAILED", "")
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doContinueUpdateRollback(cmd, stackName, skipResources)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&cfg.Metadata.Name, "cluster", "", "EKS cluster name")
+		fs.StringVar(&stackName, "stack", "", "Name of the CloudFormation stack")
+		fs.StringSliceVar(&skipResources, "skip-resources", nil, "Logical IDs of the resources whose rollback is skipped, e.g. resources modified outside CloudFormation")
+
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doContinueUpdateRollback(cmd *cmdutils.Cmd, stackName string, skipResources []string) error {
+	cfg := cmd.ClusterConfig
+
+	if cfg.Metadata.Name == "" {
+		return cmdutils.ErrMustBeSet(cmdutils.ClusterNameFlag(cmd))
+	}
+
+	if stackName != "" && cmd.NameArg != "" {
+		return cmdutils.ErrFlagAndArg("--stack", stackName, cmd.NameArg)
+	}
+
+	if cmd.NameArg != "" {
+		stackName = cmd.NameArg
+	}
+
+	if stackName == "" {
+		return cmdutils.ErrMustBeSet("--stack")
+	}
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+
+	return ctl.NewStackManager(cfg).ContinueUpdateRollback(stackName, skipResources)
+}
@@ -166,6 +166,45 @@ func (n *NodeGroupDrainer) toggleCordon(cordon bool, nodes *corev1.NodeList) {
 
 }
 
+// CordonNodeGroup marks the nodes of the nodegroup unschedulable without evicting their pods, and returns
+// how many nodes it cordoned. Nodes that are already cordoned are left untouched, so it is safe to repeat
+func CordonNodeGroup(clientSet kubernetes.Interface, ng eks.KubeNodeGroup) (int, error) {
+	return setNodeGroupCordon(clientSet, ng, true)
+}
+
+// UncordonNodeGroup marks the nodes of the nodegroup schedulable again, and returns how many nodes it
+// uncordoned
+func UncordonNodeGroup(clientSet kubernetes.Interface, ng eks.KubeNodeGroup) (int, error) {
+	return setNodeGroupCordon(clientSet, ng, false)
+}
+
+func setNodeGroupCordon(clientSet kubernetes.Interface, ng eks.KubeNodeGroup, cordon bool) (int, error) {
+	nodes, err := clientSet.CoreV1().Nodes().List(context.TODO(), ng.ListOptions())
+	if err != nil {
+		return 0, errors.Wrapf(err, "listing nodes of nodegroup %q", ng.NameString())
+	}
+
+	updated := 0
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		c := NewCordonHelper(node, cordon)
+		if !c.IsUpdateRequired() {
+			logger.Debug("no need to %s node %q", cordonStatus(cordon), node.Name)
+			continue
+		}
+		err, patchErr := c.PatchOrReplace(clientSet)
+		if patchErr != nil {
+			logger.Warning(patchErr.Error())
+		}
+		if err != nil {
+			return updated, errors.Wrapf(err, "failed to %s node %q", cordonStatus(cordon), node.Name)
+		}
+		updated++
+	}
+	logger.Info("%sed %d of %d node(s) of nodegroup %q", cordonStatus(cordon), updated, len(nodes.Items), ng.NameString())
+	return updated, nil
+}
+
 func (n *NodeGroupDrainer) evictPods(node string) (int, error) {
 	list, errs := n.evictor.GetPodsForEviction(node)
 	if len(errs) > 0 {
@@ -16,6 +16,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	. "github.com/onsi/ginkgo"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/drain"
 	"github.com/weaveworks/eksctl/pkg/eks/mocks"
 	"k8s.io/client-go/kubernetes/fake"
@@ -219,4 +220,64 @@ var _ = Describe("Drain", func() {
 			Expect(fakeEvictor.EvictOrDeletePodCallCount()).To(BeZero())
 		})
 	})
+
+	Describe("CordonNodeGroup", func() {
+		var ng *api.NodeGroup
+
+		createNode := func(name, nodeGroupName string, unschedulable bool) {
+			_, err := fakeClientSet.CoreV1().Nodes().Create(context.TODO(), &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   name,
+					Labels: map[string]string{api.NodeGroupNameLabel: nodeGroupName},
+				},
+				Spec: corev1.NodeSpec{
+					Unschedulable: unschedulable,
+				},
+			}, metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		unschedulable := func(name string) bool {
+			node, err := fakeClientSet.CoreV1().Nodes().Get(context.TODO(), name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			return node.Spec.Unschedulable
+		}
+
+		BeforeEach(func() {
+			ng = api.NewNodeGroup()
+			ng.Name = "ng-1"
+			createNode("node-1", "ng-1", false)
+			createNode("node-2", "ng-1", true)
+			createNode("node-3", "ng-2", false)
+		})
+
+		It("cordons the nodes of the nodegroup that are not cordoned yet", func() {
+			cordoned, err := drain.CordonNodeGroup(fakeClientSet, ng)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cordoned).To(Equal(1))
+			Expect(unschedulable("node-1")).To(BeTrue())
+			Expect(unschedulable("node-2")).To(BeTrue())
+			Expect(unschedulable("node-3")).To(BeFalse())
+		})
+
+		It("is idempotent", func() {
+			_, err := drain.CordonNodeGroup(fakeClientSet, ng)
+			Expect(err).NotTo(HaveOccurred())
+
+			cordoned, err := drain.CordonNodeGroup(fakeClientSet, ng)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cordoned).To(BeZero())
+		})
+
+		It("uncordons the nodes of the nodegroup", func() {
+			_, err := drain.CordonNodeGroup(fakeClientSet, ng)
+			Expect(err).NotTo(HaveOccurred())
+
+			uncordoned, err := drain.UncordonNodeGroup(fakeClientSet, ng)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(uncordoned).To(Equal(2))
+			Expect(unschedulable("node-1")).To(BeFalse())
+			Expect(unschedulable("node-2")).To(BeFalse())
+		})
+	})
 })
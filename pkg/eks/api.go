@@ -294,15 +294,18 @@ func (c *ClusterProvider) checkAuth() error {
 	return nil
 }
 
-// ResolveAMI ensures that the node AMI is set and is available
+// ResolveAMI ensures that the node AMI is set and is available. An AMI parameter override takes
+// precedence over the auto and auto-ssm resolvers
 func ResolveAMI(provider api.ClusterProvider, version string, ng *api.NodeGroup) error {
 	var resolver ami.Resolver
-	switch ng.AMI {
-	case api.NodeImageResolverAuto:
+	switch {
+	case ng.AMIParameterOverride != "":
+		resolver = ami.NewSSMParameterResolver(provider.SSM(), ng.AMIParameterOverride)
+	case ng.AMI == api.NodeImageResolverAuto:
 		resolver = ami.NewAutoResolver(provider.EC2())
-	case api.NodeImageResolverAutoSSM:
+	case ng.AMI == api.NodeImageResolverAutoSSM:
 		resolver = ami.NewSSMResolver(provider.SSM())
-	case "":
+	case ng.AMI == "":
 		resolver = ami.NewMultiResolver(
 			ami.NewSSMResolver(provider.SSM()),
 			ami.NewAutoResolver(provider.EC2()),
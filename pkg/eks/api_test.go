@@ -1,6 +1,8 @@
 package eks_test
 
 import (
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -38,6 +40,15 @@ var _ = Describe("eksctl API", func() {
 			Expect(cfg.NodeGroups).To(HaveLen(1))
 		})
 
+		It("should load durations in a YAML config", func() {
+			cfg, err := LoadConfigFromFile("testdata/durations.yaml")
+			Expect(err).ToNot(HaveOccurred())
+			ng := cfg.NodeGroups[0]
+			Expect(ng.MaxInstanceLifetime.Duration).To(Equal(7 * 24 * time.Hour))
+			Expect(ng.BootstrapTimeout.Duration).To(Equal(15 * time.Minute))
+			Expect(ng.Canary.ValidationTimeout.Duration).To(Equal(10 * time.Minute))
+		})
+
 		It("should error when version is a float, not a string", func() {
 			_, err := LoadConfigFromFile("testdata/bad-type-1.yaml")
 			Expect(err).To(HaveOccurred())
@@ -127,6 +138,19 @@ var _ = Describe("eksctl API", func() {
 
 			testEnsureAMI(Equal("ami-auto"))
 		})
+
+		It("should resolve the AMI from the AMI parameter override", func() {
+			ng.AMIParameterOverride = "/org/hardened-ami/eks/image_id"
+			provider.MockSSM().On("GetParameter", &ssm.GetParameterInput{
+				Name: aws.String("/org/hardened-ami/eks/image_id"),
+			}).Return(&ssm.GetParameterOutput{
+				Parameter: &ssm.Parameter{
+					Value: aws.String("ami-hardened"),
+				},
+			}, nil)
+
+			testEnsureAMI(Equal("ami-hardened"))
+		})
 	})
 
 })
@@ -0,0 +1,99 @@
+package eks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes"
+
+	addons "github.com/weaveworks/eksctl/pkg/addons/default"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/vpc"
+)
+
+// eniConfigLabelDefEnv is the environment variable of the VPC CNI setting the node label it reads the name
+// of the ENIConfig to use for the node's pods from
+const eniConfigLabelDefEnv = "ENI_CONFIG_LABEL_DEF"
+
+// ENIConfigName returns the name of the ENIConfig of a nodegroup in an availability zone, which matches the
+// api.ENIConfigLabel value the bootstrap helper script sets on the nodes
+func ENIConfigName(nodeGroupName, az string) string {
+	return fmt.Sprintf("%s-%s", nodeGroupName, az)
+}
+
+// ConfigureCustomNetworking creates an ENIConfig for each pod subnet of the nodegroup. The nodes of the nodegroup
+// are labelled with the ENIConfig of their availability zone at bootstrap, which the VPC CNI reads when its
+// ENI_CONFIG_LABEL_DEF is set to that label. It's a no-op for nodegroups without pod subnets
+func (c *ClusterProvider) ConfigureCustomNetworking(spec *api.ClusterConfig, clientSet kubernetes.Interface, ng *api.NodeGroup) error {
+	if len(ng.PodSubnets) == 0 {
+		return nil
+	}
+
+	subnetsByAZ, err := vpc.PodSubnetsByAZ(c.Provider.EC2(), ng)
+	if err != nil {
+		return err
+	}
+
+	rawClient, err := c.NewRawClient(spec)
+	if err != nil {
+		return err
+	}
+	for az, subnetID := range subnetsByAZ {
+		resource, err := rawClient.NewRawResource(newENIConfig(ng.Name, az, subnetID))
+		if err != nil {
+			return errors.Wrapf(err, "creating ENIConfig of nodegroup %q", ng.Name)
+		}
+		status, err := resource.CreateOrReplace(false)
+		if err != nil {
+			return errors.Wrapf(err, "creating ENIConfig of nodegroup %q", ng.Name)
+		}
+		logger.Info(status)
+	}
+
+	return checkENIConfigLabelDef(clientSet, ng)
+}
+
+func newENIConfig(nodeGroupName, az, subnetID string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "crd.k8s.amazonaws.com/v1alpha1",
+			"kind":       "ENIConfig",
+			"metadata": map[string]interface{}{
+				"name": ENIConfigName(nodeGroupName, az),
+				"labels": map[string]interface{}{
+					api.NodeGroupNameLabel: nodeGroupName,
+				},
+			},
+			"spec": map[string]interface{}{
+				"subnet": subnetID,
+			},
+		},
+	}
+}
+
+// checkENIConfigLabelDef warns when the VPC CNI doesn't read the ENIConfig of nodes from the label set at bootstrap
+func checkENIConfigLabelDef(clientSet kubernetes.Interface, ng *api.NodeGroup) error {
+	awsNode, err := clientSet.AppsV1().DaemonSets(metav1.NamespaceSystem).Get(context.TODO(), addons.AWSNode, metav1.GetOptions{})
+	if err != nil {
+		if apierrs.IsNotFound(err) {
+			logger.Warning("%q was not found", addons.AWSNode)
+			return nil
+		}
+		return errors.Wrapf(err, "getting %q", addons.AWSNode)
+	}
+
+	for _, container := range awsNode.Spec.Template.Spec.Containers {
+		for _, env := range container.Env {
+			if env.Name == eniConfigLabelDefEnv && env.Value == api.ENIConfigLabel {
+				return nil
+			}
+		}
+	}
+	logger.Warning("%q doesn't set %s=%s, the ENIConfigs of nodegroup %q will not be used", addons.AWSNode, eniConfigLabelDefEnv, api.ENIConfigLabel, ng.Name)
+	return nil
+}
@@ -357,6 +357,25 @@ func (c *ClusterProvider) ListClusters(chunkSize int, listAllRegions bool) ([]*a
 	return c.listClusters(int64(chunkSize))
 }
 
+// MultiRegionNodeGroupSummaries gets the summaries of the nodegroups of the cluster named clusterName in each of
+// the regions concurrently. See manager.MultiRegionNodeGroupSummaries for how errors are reported
+func (c *ClusterProvider) MultiRegionNodeGroupSummaries(clusterName string, regions []string) (map[string][]*manager.NodeGroupSummary, error) {
+	return manager.MultiRegionNodeGroupSummaries(regions, func(region string) (manager.StackManager, error) {
+		ctl, err := New(&api.ProviderConfig{
+			Region:      region,
+			Profile:     c.Provider.Profile(),
+			WaitTimeout: c.Provider.WaitTimeout(),
+		}, nil)
+		if err != nil {
+			return nil, errors.Wrapf(err, "creating provider in %q region", region)
+		}
+		spec := api.NewClusterConfig()
+		spec.Metadata.Name = clusterName
+		spec.Metadata.Region = region
+		return ctl.NewStackManager(spec), nil
+	})
+}
+
 func (c *ClusterProvider) listClusters(chunkSize int64) ([]*api.ClusterConfig, error) {
 	allClusters := []*api.ClusterConfig{}
 
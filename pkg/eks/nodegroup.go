@@ -209,11 +209,15 @@ type KubeNodeGroup interface {
 
 // WaitForNodes waits till the nodes are ready
 func (c *ClusterProvider) WaitForNodes(clientSet kubernetes.Interface, ng KubeNodeGroup) error {
-	minSize := ng.Size()
+	return c.WaitForReadyNodes(clientSet, ng, ng.Size(), c.Provider.WaitTimeout())
+}
+
+// WaitForReadyNodes waits till at least minSize nodes of the nodegroup are ready, or the timeout expires
+func (c *ClusterProvider) WaitForReadyNodes(clientSet kubernetes.Interface, ng KubeNodeGroup, minSize int, waitTimeout time.Duration) error {
 	if minSize == 0 {
 		return nil
 	}
-	timer := time.After(c.Provider.WaitTimeout())
+	timer := time.After(waitTimeout)
 	timeout := false
 	readyNodes := sets.NewString()
 	watcher, err := clientSet.CoreV1().Nodes().Watch(context.TODO(), ng.ListOptions())
@@ -249,7 +253,7 @@ func (c *ClusterProvider) WaitForNodes(clientSet kubernetes.Interface, ng KubeNo
 	}
 	watcher.Stop()
 	if timeout {
-		return fmt.Errorf("timed out (after %s) waiting for at least %d nodes to join the cluster and become ready in %q", c.Provider.WaitTimeout(), minSize, ng.NameString())
+		return fmt.Errorf("timed out (after %s) waiting for at least %d nodes to join the cluster and become ready in %q", waitTimeout, minSize, ng.NameString())
 	}
 
 	if _, err = getNodes(clientSet, ng); err != nil {
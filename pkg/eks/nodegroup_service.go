@@ -7,6 +7,8 @@ import (
 	"github.com/aws/amazon-ec2-instance-selector/v2/pkg/selector"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	"github.com/weaveworks/eksctl/pkg/ami"
@@ -32,6 +34,14 @@ const defaultCPUArch = "x86_64"
 
 // Normalize normalizes nodegroups
 func (m *NodeGroupService) Normalize(nodePools []api.NodePool) error {
+	var baseNodeGroups []*api.NodeGroupBase
+	for _, np := range nodePools {
+		baseNodeGroups = append(baseNodeGroups, np.BaseNodeGroup())
+	}
+	if err := ssh.ValidateKeyPairs(baseNodeGroups, m.provider.EC2()); err != nil {
+		return err
+	}
+
 	for _, np := range nodePools {
 		switch ng := np.(type) {
 		case *api.NodeGroup:
@@ -42,6 +52,14 @@ func (m *NodeGroupService) Normalize(nodePools []api.NodePool) error {
 				}
 			}
 			logger.Info("nodegroup %q will use %q [%s/%s]", ng.Name, ng.AMI, ng.AMIFamily, m.cluster.Metadata.Version)
+			if ng.InstanceStorePolicy != "" && ng.InstanceStorePolicy != api.InstanceStorePolicyNone {
+				m.checkInstanceStoreSupport(ng)
+			}
+			if ng.CapacityReservation != nil && ng.CapacityReservation.ID != "" {
+				if err := ValidateCapacityReservation(m.provider.EC2(), m.cluster, ng); err != nil {
+					return err
+				}
+			}
 		}
 
 		ng := np.BaseNodeGroup()
@@ -65,6 +83,103 @@ func (m *NodeGroupService) Normalize(nodePools []api.NodePool) error {
 	return nil
 }
 
+// checkInstanceStoreSupport warns about the instance types of the nodegroup that have no instance store
+// volumes for its instance store policy to mount
+func (m *NodeGroupService) checkInstanceStoreSupport(ng *api.NodeGroup) {
+	output, err := m.provider.EC2().DescribeInstanceTypes(&ec2.DescribeInstanceTypesInput{
+		InstanceTypes: aws.StringSlice(ng.InstanceTypeList()),
+	})
+	if err != nil {
+		logger.Warning("unable to check whether the instance types of nodegroup %q have instance store volumes: %v", ng.Name, err)
+		return
+	}
+	for _, instanceType := range output.InstanceTypes {
+		if !aws.BoolValue(instanceType.InstanceStorageSupported) {
+			logger.Warning("instance type %s of nodegroup %q has no instance store volumes, instanceStorePolicy %s will have no effect on its nodes",
+				aws.StringValue(instanceType.InstanceType), ng.Name, ng.InstanceStorePolicy)
+		}
+	}
+}
+
+// ValidateCapacityReservation checks that the capacity reservation targeted by the nodegroup is active and
+// matches its instance type and availability zone, so that the nodes can be launched into it. For nodegroups
+// that set their subnets, the availability zones of the subnets are checked instead
+func ValidateCapacityReservation(ec2API ec2iface.EC2API, clusterConfig *api.ClusterConfig, ng *api.NodeGroup) error {
+	id := ng.CapacityReservation.ID
+	output, err := ec2API.DescribeCapacityReservations(&ec2.DescribeCapacityReservationsInput{
+		CapacityReservationIds: aws.StringSlice([]string{id}),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "describing capacity reservation %q of nodegroup %q", id, ng.Name)
+	}
+	if len(output.CapacityReservations) != 1 {
+		return errors.Errorf("capacity reservation %q of nodegroup %q not found", id, ng.Name)
+	}
+
+	reservation := output.CapacityReservations[0]
+	if state := aws.StringValue(reservation.State); state != ec2.CapacityReservationStateActive {
+		return errors.Errorf("capacity reservation %q of nodegroup %q is %s, it must be %s", id, ng.Name, state, ec2.CapacityReservationStateActive)
+	}
+	if instanceType := aws.StringValue(reservation.InstanceType); instanceType != ng.InstanceType {
+		return errors.Errorf("capacity reservation %q is for instance type %s, but nodegroup %q uses %s", id, instanceType, ng.Name, ng.InstanceType)
+	}
+
+	az := aws.StringValue(reservation.AvailabilityZone)
+	if len(ng.Subnets) == 0 {
+		if len(ng.AvailabilityZones) != 1 || ng.AvailabilityZones[0] != az {
+			return errors.Errorf("capacity reservation %q is in availability zone %s, nodegroup %q must set availabilityZones to [%s] to launch its nodes into it", id, az, ng.Name, az)
+		}
+		return nil
+	}
+
+	subnetAZs, err := resolveSubnetAZs(ec2API, clusterConfig, ng.Subnets)
+	if err != nil {
+		return errors.Wrapf(err, "resolving the availability zones of the subnets of nodegroup %q", ng.Name)
+	}
+	for _, subnet := range ng.Subnets {
+		if subnetAZ := subnetAZs[subnet]; subnetAZ != az {
+			return errors.Errorf("capacity reservation %q is in availability zone %s, but subnet %s of nodegroup %q is in %s", id, az, subnet, ng.Name, subnetAZ)
+		}
+	}
+	return nil
+}
+
+// resolveSubnetAZs returns the availability zones of the subnets, given by name or ID, by subnet. The subnets of
+// the cluster's VPC config are looked up first, and the other ones are described in a single call
+func resolveSubnetAZs(ec2API ec2iface.EC2API, clusterConfig *api.ClusterConfig, subnets []string) (map[string]string, error) {
+	var clusterSubnets []api.AZSubnetMapping
+	if clusterConfig.VPC != nil && clusterConfig.VPC.Subnets != nil {
+		clusterSubnets = append(clusterSubnets, clusterConfig.VPC.Subnets.Private, clusterConfig.VPC.Subnets.Public)
+	}
+
+	subnetAZs := map[string]string{}
+	var unknownSubnetIDs []string
+	for _, subnet := range subnets {
+		for _, mapping := range clusterSubnets {
+			for name, spec := range mapping {
+				if (name == subnet || spec.ID == subnet) && spec.AZ != "" {
+					subnetAZs[subnet] = spec.AZ
+				}
+			}
+		}
+		if _, ok := subnetAZs[subnet]; !ok {
+			unknownSubnetIDs = append(unknownSubnetIDs, subnet)
+		}
+	}
+	if len(unknownSubnetIDs) == 0 {
+		return subnetAZs, nil
+	}
+
+	output, err := ec2API.DescribeSubnets(&ec2.DescribeSubnetsInput{SubnetIds: aws.StringSlice(unknownSubnetIDs)})
+	if err != nil {
+		return nil, errors.Wrap(err, "describing subnets")
+	}
+	for _, subnet := range output.Subnets {
+		subnetAZs[aws.StringValue(subnet.SubnetId)] = aws.StringValue(subnet.AvailabilityZone)
+	}
+	return subnetAZs, nil
+}
+
 // ExpandInstanceSelectorOptions sets instance types to instances matched by the instance selector criteria
 func (m *NodeGroupService) ExpandInstanceSelectorOptions(nodePools []api.NodePool) error {
 	sess, ok := m.provider.ConfigProvider().(*session.Session)
@@ -104,6 +219,9 @@ func (m *NodeGroupService) ExpandInstanceSelectorOptions(nodePools []api.NodePoo
 			} else {
 				ng.InstancesDistribution.InstanceTypes = instanceTypes
 			}
+			if err := api.ValidateWeightedCapacityCoverage(ng.InstancesDistribution); err != nil {
+				return errors.Wrapf(err, "instance types matched by instance selector criteria for nodegroup %q", baseNG.Name)
+			}
 
 		case *api.ManagedNodeGroup:
 			if len(ng.InstanceTypes) > 0 {
@@ -0,0 +1,93 @@
+package eks_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("ValidateCapacityReservation", func() {
+	var (
+		p           *mockprovider.MockProvider
+		cfg         *api.ClusterConfig
+		ng          *api.NodeGroup
+		reservation *ec2.CapacityReservation
+	)
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg = api.NewClusterConfig()
+		ng = api.NewNodeGroup()
+		ng.Name = "ng-1"
+		ng.InstanceType = "m5.large"
+		ng.AvailabilityZones = []string{"us-west-2a"}
+		ng.CapacityReservation = &api.CapacityReservation{ID: "cr-0123456789abcdef0"}
+		reservation = &ec2.CapacityReservation{
+			CapacityReservationId: aws.String("cr-0123456789abcdef0"),
+			InstanceType:          aws.String("m5.large"),
+			AvailabilityZone:      aws.String("us-west-2a"),
+			State:                 aws.String(ec2.CapacityReservationStateActive),
+		}
+		p.MockEC2().On("DescribeCapacityReservations", mock.MatchedBy(func(input *ec2.DescribeCapacityReservationsInput) bool {
+			return len(input.CapacityReservationIds) == 1 && *input.CapacityReservationIds[0] == "cr-0123456789abcdef0"
+		})).Return(func(*ec2.DescribeCapacityReservationsInput) *ec2.DescribeCapacityReservationsOutput {
+			return &ec2.DescribeCapacityReservationsOutput{CapacityReservations: []*ec2.CapacityReservation{reservation}}
+		}, nil)
+	})
+
+	It("accepts a reservation matching the nodegroup", func() {
+		Expect(eks.ValidateCapacityReservation(p.EC2(), cfg, ng)).To(Succeed())
+	})
+
+	It("rejects a reservation for another instance type", func() {
+		reservation.InstanceType = aws.String("c5.large")
+		Expect(eks.ValidateCapacityReservation(p.EC2(), cfg, ng)).To(MatchError(`capacity reservation "cr-0123456789abcdef0" is for instance type c5.large, but nodegroup "ng-1" uses m5.large`))
+	})
+
+	It("rejects a reservation in another availability zone", func() {
+		ng.AvailabilityZones = []string{"us-west-2a", "us-west-2b"}
+		Expect(eks.ValidateCapacityReservation(p.EC2(), cfg, ng)).To(MatchError(ContainSubstring("nodegroup \"ng-1\" must set availabilityZones to [us-west-2a]")))
+	})
+
+	Context("when the nodegroup sets its subnets", func() {
+		BeforeEach(func() {
+			ng.AvailabilityZones = nil
+			cfg.VPC.Subnets = &api.ClusterSubnets{
+				Private: api.AZSubnetMapping{
+					"private-a": {ID: "subnet-a", AZ: "us-west-2a"},
+					"private-b": {ID: "subnet-b", AZ: "us-west-2b"},
+				},
+			}
+			p.MockEC2().On("DescribeSubnets", &ec2.DescribeSubnetsInput{SubnetIds: aws.StringSlice([]string{"subnet-other"})}).Return(&ec2.DescribeSubnetsOutput{
+				Subnets: []*ec2.Subnet{{SubnetId: aws.String("subnet-other"), AvailabilityZone: aws.String("us-west-2c")}},
+			}, nil)
+		})
+
+		It("accepts subnets in the availability zone of the reservation", func() {
+			ng.Subnets = []string{"private-a", "subnet-a"}
+			Expect(eks.ValidateCapacityReservation(p.EC2(), cfg, ng)).To(Succeed())
+			p.MockEC2().AssertNotCalled(GinkgoT(), "DescribeSubnets", mock.Anything)
+		})
+
+		It("rejects subnets of the VPC config in another availability zone", func() {
+			ng.Subnets = []string{"private-a", "private-b"}
+			Expect(eks.ValidateCapacityReservation(p.EC2(), cfg, ng)).To(MatchError(`capacity reservation "cr-0123456789abcdef0" is in availability zone us-west-2a, but subnet private-b of nodegroup "ng-1" is in us-west-2b`))
+		})
+
+		It("describes the subnets missing from the VPC config", func() {
+			ng.Subnets = []string{"subnet-a", "subnet-other"}
+			Expect(eks.ValidateCapacityReservation(p.EC2(), cfg, ng)).To(MatchError(`capacity reservation "cr-0123456789abcdef0" is in availability zone us-west-2a, but subnet subnet-other of nodegroup "ng-1" is in us-west-2c`))
+		})
+	})
+
+	It("rejects a reservation that is not active", func() {
+		reservation.State = aws.String(ec2.CapacityReservationStateExpired)
+		Expect(eks.ValidateCapacityReservation(p.EC2(), cfg, ng)).To(MatchError(`capacity reservation "cr-0123456789abcdef0" of nodegroup "ng-1" is expired, it must be active`))
+	})
+})
@@ -112,7 +112,7 @@ func newNvidiaDevicePluginTask(
 		kind:            "Nvidia",
 		clusterProvider: clusterProvider,
 		spec:            spec,
-		mkPlugin:        addons.NewNvidiaDevicePlugin,
+		mkPlugin:        addons.NewNvidiaDevicePluginWithMIGStrategy(nvidiaMIGStrategy(spec)),
 		logMessage: `as you are using the EKS-Optimized Accelerated AMI with a GPU-enabled instance type, the Nvidia Kubernetes device plugin was automatically installed.
 	to skip installing it, use --install-nvidia-plugin=false.
 `,
@@ -120,6 +120,23 @@ func newNvidiaDevicePluginTask(
 	return &t
 }
 
+// nvidiaMIGStrategy returns the strategy the Nvidia device plugin exposes the MIG devices of the nodegroups with.
+// The single strategy requires all the GPUs of a node to be partitioned the same way, so the mixed one is used as
+// soon as a nodegroup has several MIG profiles. It is empty when no nodegroup uses MIG
+func nvidiaMIGStrategy(spec *api.ClusterConfig) string {
+	strategy := ""
+	for _, ng := range spec.NodeGroups {
+		if ng.GPUConfig == nil || len(ng.GPUConfig.MIGProfiles) == 0 {
+			continue
+		}
+		if len(ng.GPUConfig.MIGProfiles) > 1 {
+			return "mixed"
+		}
+		strategy = "single"
+	}
+	return strategy
+}
+
 func newNeuronDevicePluginTask(
 	clusterProvider *ClusterProvider,
 	spec *api.ClusterConfig,
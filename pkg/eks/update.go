@@ -163,14 +163,20 @@ func (c *ClusterProvider) EnableKMSEncryption(ctx context.Context, clusterConfig
 	if err != nil {
 		return errors.Wrap(err, "error describing cluster")
 	}
+	// EKS does not allow changing or removing the encryption config of a cluster once it is associated,
+	// so a cluster with any existing encryption config cannot be re-keyed
 	for _, e := range clusterOutput.Cluster.EncryptionConfig {
-		if len(e.Resources) == 1 && *e.Resources[0] == "secrets" {
-			if existingKey := *e.Provider.KeyArn; existingKey != clusterConfig.SecretsEncryption.KeyARN {
-				return errors.Errorf("KMS encryption is already enabled with key %q, changing the key is not supported", existingKey)
-			}
-			logger.Info("KMS encryption is already enabled on the cluster")
-			return nil
+		var existingKey string
+		if e.Provider != nil {
+			existingKey = aws.StringValue(e.Provider.KeyArn)
 		}
+		if existingKey != clusterConfig.SecretsEncryption.KeyARN {
+			return errors.Errorf("cluster %q already has an encryption config with key %q; "+
+				"EKS does not support changing the encryption config of a cluster once it is enabled",
+				clusterConfig.Metadata.Name, existingKey)
+		}
+		logger.Info("KMS encryption is already enabled on the cluster")
+		return nil
 	}
 
 	output, err := c.Provider.EKS().AssociateEncryptionConfigWithContext(ctx, &eks.AssociateEncryptionConfigInput{
@@ -1,6 +1,8 @@
 package eks_test
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go/aws"
 	awseks "github.com/aws/aws-sdk-go/service/eks"
 
@@ -203,4 +205,75 @@ var _ = Describe("EKS API wrapper", func() {
 			Expect(sentClusterLogging[1].Types).To(Equal(aws.StringSlice([]string{"api", "audit", "scheduler"})))
 		})
 	})
+
+	Describe("can enable KMS encryption", func() {
+		const keyARN = "arn:aws:kms:us-west-2:12345:key/new"
+
+		var (
+			p   *mockprovider.MockProvider
+			ctl *ClusterProvider
+			cfg *api.ClusterConfig
+
+			cluster *awseks.Cluster
+		)
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			ctl = &ClusterProvider{
+				Provider: p,
+				Status:   &ProviderStatus{},
+			}
+
+			cfg = api.NewClusterConfig()
+			cfg.Metadata.Name = "testcluster"
+			cfg.SecretsEncryption = &api.SecretsEncryption{KeyARN: keyARN}
+
+			cluster = testutils.NewFakeCluster("testcluster", awseks.ClusterStatusActive)
+			p.MockEKS().On("DescribeCluster", mock.Anything).Return(&awseks.DescribeClusterOutput{Cluster: cluster}, nil)
+			p.MockEKS().On("AssociateEncryptionConfigWithContext", mock.Anything, mock.Anything).Return(&awseks.AssociateEncryptionConfigOutput{
+				Update: &awseks.Update{Id: aws.String("u123")},
+			}, nil)
+			p.MockEKS().On("DescribeUpdate", mock.Anything).Return(&awseks.DescribeUpdateOutput{
+				Update: &awseks.Update{
+					Id:     aws.String("u123"),
+					Status: aws.String(awseks.UpdateStatusSuccessful),
+				},
+			}, nil)
+		})
+
+		It("associates the encryption config and waits for the update", func() {
+			Expect(ctl.EnableKMSEncryption(context.Background(), cfg)).To(Succeed())
+
+			input := p.MockEKS().Calls[1].Arguments.Get(1).(*awseks.AssociateEncryptionConfigInput)
+			Expect(*input.ClusterName).To(Equal("testcluster"))
+			Expect(*input.EncryptionConfig[0].Provider.KeyArn).To(Equal(keyARN))
+			Expect(p.MockEKS().AssertNumberOfCalls(GinkgoT(), "DescribeUpdate", 1)).To(BeTrue())
+		})
+
+		It("refuses to change the key of a cluster that already has an encryption config", func() {
+			cluster.EncryptionConfig = []*awseks.EncryptionConfig{
+				{
+					Resources: aws.StringSlice([]string{"secrets"}),
+					Provider:  &awseks.Provider{KeyArn: aws.String("arn:aws:kms:us-west-2:12345:key/old")},
+				},
+			}
+
+			err := ctl.EnableKMSEncryption(context.Background(), cfg)
+			Expect(err).To(MatchError(`cluster "testcluster" already has an encryption config with key "arn:aws:kms:us-west-2:12345:key/old"; ` +
+				"EKS does not support changing the encryption config of a cluster once it is enabled"))
+			Expect(p.MockEKS().AssertNotCalled(GinkgoT(), "AssociateEncryptionConfigWithContext", mock.Anything, mock.Anything)).To(BeTrue())
+		})
+
+		It("does nothing when encryption is already enabled with the same key", func() {
+			cluster.EncryptionConfig = []*awseks.EncryptionConfig{
+				{
+					Resources: aws.StringSlice([]string{"secrets"}),
+					Provider:  &awseks.Provider{KeyArn: aws.String(keyARN)},
+				},
+			}
+
+			Expect(ctl.EnableKMSEncryption(context.Background(), cfg)).To(Succeed())
+			Expect(p.MockEKS().AssertNotCalled(GinkgoT(), "AssociateEncryptionConfigWithContext", mock.Anything, mock.Anything)).To(BeTrue())
+		})
+	})
 })
@@ -184,6 +184,14 @@ func (m *Service) UpgradeNodeGroup(options UpgradeOptions) error {
 		if err != nil {
 			return err
 		}
+		// goformation drops the properties of the nodegroup it does not support yet
+		for _, path := range []string{builder.ManagedNodeGroupUpdateConfigPath, builder.ManagedNodeGroupNodeRepairConfigPath} {
+			if property := gjson.Get(template, path); property.Exists() {
+				if bytes, err = sjson.SetRawBytes(bytes, path, []byte(property.Raw)); err != nil {
+					return errors.Wrapf(err, "preserving %s of nodegroup", path)
+				}
+			}
+		}
 		if err := m.stackCollection.UpdateNodeGroupStack(options.NodegroupName, string(bytes)); err != nil {
 			return errors.Wrap(err, "error updating nodegroup stack")
 		}
@@ -1,8 +1,14 @@
 package nodebootstrap_test
 
 import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/nodebootstrap"
@@ -124,6 +130,92 @@ var _ = Describe("AmazonLinux2 User Data", func() {
 		})
 	})
 
+	When("KubeletExtraConfig overrides the defaults of eksctl", func() {
+		BeforeEach(func() {
+			ng.InstanceType = "m5.large"
+			ng.KubeletExtraConfig = &api.InlineDocument{
+				"maxPods": 50,
+				"kubeReserved": map[string]string{
+					"cpu":    "300m",
+					"memory": "300Mi",
+				},
+				"featureGates": map[string]bool{
+					"RotateKubeletServerCertificate": true,
+				},
+			}
+			bootstrapper = nodebootstrap.NewAL2Bootstrapper(clusterName, ng)
+		})
+
+		It("merges the settings over the defaults in the kubelet extra args file", func() {
+			userData, err := bootstrapper.UserData()
+			Expect(err).NotTo(HaveOccurred())
+
+			cloudCfg := decode(userData)
+			Expect(cloudCfg.WriteFiles[0].Path).To(Equal("/etc/eksctl/kubelet-extra.json"))
+			var kubeletConfig map[string]interface{}
+			Expect(json.Unmarshal([]byte(cloudCfg.WriteFiles[0].Content), &kubeletConfig)).To(Succeed())
+			Expect(kubeletConfig).To(Equal(map[string]interface{}{
+				"cgroupDriver": "systemd",
+				"maxPods":      float64(50),
+				"kubeReserved": map[string]interface{}{
+					"cpu":    "300m",
+					"memory": "300Mi",
+				},
+				"featureGates": map[string]interface{}{
+					"RotateKubeletServerCertificate": true,
+				},
+			}))
+		})
+	})
+
+	When("maxPodsPerNode is not set", func() {
+		BeforeEach(func() {
+			ng.InstanceType = "m5.large"
+			bootstrapper = nodebootstrap.NewAL2Bootstrapper(clusterName, ng)
+		})
+
+		It("sets maxPods in the kubelet extra args file from the instance type", func() {
+			userData, err := bootstrapper.UserData()
+			Expect(err).NotTo(HaveOccurred())
+
+			cloudCfg := decode(userData)
+			Expect(cloudCfg.WriteFiles[0].Path).To(Equal("/etc/eksctl/kubelet-extra.json"))
+			Expect(cloudCfg.WriteFiles[0].Content).To(Equal("{\"cgroupDriver\":\"systemd\",\"maxPods\":29}"))
+		})
+	})
+
+	When("maxPodsPerNode is not set and the nodegroup uses custom networking", func() {
+		BeforeEach(func() {
+			ng.InstanceType = "m5.large"
+			ng.PodSubnets = []string{"subnet-pods-a"}
+			bootstrapper = nodebootstrap.NewAL2Bootstrapper(clusterName, ng)
+		})
+
+		It("does not count the pods of the primary network interface", func() {
+			userData, err := bootstrapper.UserData()
+			Expect(err).NotTo(HaveOccurred())
+
+			cloudCfg := decode(userData)
+			Expect(cloudCfg.WriteFiles[0].Content).To(Equal("{\"cgroupDriver\":\"systemd\",\"maxPods\":20}"))
+		})
+	})
+
+	When("maxPodsPerNode is set", func() {
+		BeforeEach(func() {
+			ng.InstanceType = "m5.large"
+			ng.MaxPodsPerNode = 10
+			bootstrapper = nodebootstrap.NewAL2Bootstrapper(clusterName, ng)
+		})
+
+		It("sets maxPods in the kubelet extra args file to maxPodsPerNode", func() {
+			userData, err := bootstrapper.UserData()
+			Expect(err).NotTo(HaveOccurred())
+
+			cloudCfg := decode(userData)
+			Expect(cloudCfg.WriteFiles[0].Content).To(Equal("{\"cgroupDriver\":\"systemd\",\"maxPods\":10}"))
+		})
+	})
+
 	When("labels are set on the node config", func() {
 		BeforeEach(func() {
 			ng.Labels = map[string]string{"foo": "bar"}
@@ -143,7 +235,10 @@ var _ = Describe("AmazonLinux2 User Data", func() {
 
 	When("taints are set on the node config", func() {
 		BeforeEach(func() {
-			ng.Taints = map[string]string{"foo": "bar"}
+			ng.Taints = []api.NodeGroupTaint{
+				{Key: "foo", Value: "bar", Effect: "NoSchedule"},
+				{Key: "foo", Value: "bar", Effect: "NoExecute"},
+			}
 			bootstrapper = nodebootstrap.NewAL2Bootstrapper(clusterName, ng)
 		})
 
@@ -153,7 +248,7 @@ var _ = Describe("AmazonLinux2 User Data", func() {
 
 			cloudCfg := decode(userData)
 			Expect(cloudCfg.WriteFiles[2].Path).To(Equal("/etc/eksctl/kubelet.env"))
-			Expect(cloudCfg.WriteFiles[2].Content).To(ContainSubstring("NODE_TAINTS=foo=:bar"))
+			Expect(cloudCfg.WriteFiles[2].Content).To(ContainSubstring("NODE_TAINTS=foo=bar:NoSchedule,foo=bar:NoExecute"))
 			Expect(cloudCfg.WriteFiles[2].Permissions).To(Equal("0644"))
 		})
 	})
@@ -175,6 +270,107 @@ var _ = Describe("AmazonLinux2 User Data", func() {
 		})
 	})
 
+	When("pod subnets are set on the node config", func() {
+		BeforeEach(func() {
+			ng.Name = "ng-1"
+			ng.PodSubnets = []string{"subnet-1"}
+			bootstrapper = nodebootstrap.NewAL2Bootstrapper(clusterName, ng)
+		})
+
+		It("adds the ENIConfig prefix to the env file", func() {
+			userData, err := bootstrapper.UserData()
+			Expect(err).NotTo(HaveOccurred())
+
+			cloudCfg := decode(userData)
+			Expect(cloudCfg.WriteFiles[2].Path).To(Equal("/etc/eksctl/kubelet.env"))
+			Expect(cloudCfg.WriteFiles[2].Content).To(ContainSubstring("ENI_CONFIG_PREFIX=ng-1"))
+		})
+	})
+
+	When("bootstrap retries and timeout are set on the node config", func() {
+		BeforeEach(func() {
+			ng.BootstrapRetries = aws.Int(3)
+			ng.BootstrapTimeout = &metav1.Duration{Duration: 10 * time.Minute}
+			bootstrapper = nodebootstrap.NewAL2Bootstrapper(clusterName, ng)
+		})
+
+		It("adds the retries and timeout to the env file", func() {
+			userData, err := bootstrapper.UserData()
+			Expect(err).NotTo(HaveOccurred())
+
+			cloudCfg := decode(userData)
+			Expect(cloudCfg.WriteFiles[2].Path).To(Equal("/etc/eksctl/kubelet.env"))
+			Expect(cloudCfg.WriteFiles[2].Content).To(ContainSubstring("BOOTSTRAP_RETRIES=3"))
+			Expect(cloudCfg.WriteFiles[2].Content).To(ContainSubstring("BOOTSTRAP_TIMEOUT=600"))
+		})
+	})
+
+	When("MIG profiles are configured", func() {
+		BeforeEach(func() {
+			ng.GPUConfig = &api.NodeGroupGPUConfig{
+				MIGProfiles: map[string]int{
+					"3g.20gb": 1,
+					"1g.5gb":  2,
+				},
+			}
+			bootstrapper = nodebootstrap.NewAL2Bootstrapper(clusterName, ng)
+		})
+
+		It("partitions the GPUs before bootstrapping the node", func() {
+			userData, err := bootstrapper.UserData()
+			Expect(err).NotTo(HaveOccurred())
+
+			cloudCfg := decode(userData)
+			Expect(cloudCfg.Commands[0]).To(ContainElement("nvidia-smi -mig 1"))
+			Expect(cloudCfg.Commands[1]).To(ContainElement("nvidia-smi mig -cgi 1g.5gb,1g.5gb,3g.20gb -C"))
+			Expect(cloudCfg.WriteFiles[0].Path).To(Equal("/etc/eksctl/kubelet-extra.json"))
+		})
+	})
+
+	When("an instance store policy is set", func() {
+		It("combines the instance store volumes into the kubelet root directory for RAID0", func() {
+			ng.InstanceStorePolicy = api.InstanceStorePolicyRAID0
+			ng.PreBootstrapCommands = []string{"echo 'rubarb'"}
+			userData, err := nodebootstrap.NewAL2Bootstrapper(clusterName, ng).UserData()
+			Expect(err).NotTo(HaveOccurred())
+
+			cloudCfg := decode(userData)
+			Expect(cloudCfg.Commands[0]).To(ContainElement("echo 'rubarb'"))
+			Expect(cloudCfg.Commands[1]).To(ContainElement(ContainSubstring("nvme-Amazon_EC2_NVMe_Instance_Storage_")))
+			Expect(cloudCfg.Commands[1]).To(ContainElement(ContainSubstring("mdadm --create /dev/md0")))
+			Expect(cloudCfg.Commands[1]).To(ContainElement(ContainSubstring("/mnt/instance-store/kubelet /var/lib/kubelet none bind")))
+
+			script := cloudCfg.Commands[1].([]interface{})[2].(string)
+			indexOf := func(line string) int {
+				i := strings.Index(script, line)
+				Expect(i).NotTo(Equal(-1), "missing %q", line)
+				return i
+			}
+			// the kubelet root directory must be copied after the instance store is mounted and before
+			// the instance store is bind-mounted over it, otherwise the AMI's kubeconfig is hidden
+			Expect(indexOf("mount /mnt/instance-store\n")).To(BeNumerically("<", indexOf("cp -a /var/lib/kubelet/. /mnt/instance-store/kubelet/")))
+			Expect(indexOf("cp -a /var/lib/kubelet/. /mnt/instance-store/kubelet/")).To(BeNumerically("<", indexOf("mount /var/lib/kubelet")))
+		})
+
+		It("mounts each instance store volume for Mount", func() {
+			ng.InstanceStorePolicy = api.InstanceStorePolicyMount
+			userData, err := nodebootstrap.NewAL2Bootstrapper(clusterName, ng).UserData()
+			Expect(err).NotTo(HaveOccurred())
+
+			cloudCfg := decode(userData)
+			Expect(cloudCfg.Commands[0]).To(ContainElement(ContainSubstring("mkdir -p /mnt/instance-store/$i")))
+			Expect(cloudCfg.Commands[0]).NotTo(ContainElement(ContainSubstring("mdadm")))
+		})
+
+		It("leaves the instance store volumes untouched for None", func() {
+			ng.InstanceStorePolicy = api.InstanceStorePolicyNone
+			userData, err := nodebootstrap.NewAL2Bootstrapper(clusterName, ng).UserData()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(decode(userData).Commands).NotTo(ContainElement(ContainElement(ContainSubstring("instance-store"))))
+		})
+	})
+
 	When("PreBootstrapCommands are set", func() {
 		BeforeEach(func() {
 			ng.PreBootstrapCommands = []string{"echo 'rubarb'"}
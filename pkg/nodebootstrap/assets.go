@@ -1,8 +1,8 @@
 // Code generated by go-bindata. DO NOT EDIT.
 // sources:
-// assets/bootstrap.al2.sh (958B)
-// assets/bootstrap.helper.sh (984B)
-// assets/bootstrap.ubuntu.sh (752B)
+// assets/bootstrap.al2.sh (972B)
+// assets/bootstrap.helper.sh (2.345kB)
+// assets/bootstrap.ubuntu.sh (766B)
 // assets/efa.al2.sh (351B)
 // assets/efa.managed.boothook (484B)
 // assets/install-ssm.al2.sh (159B)
@@ -74,7 +74,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _bootstrapAl2Sh = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x92\xef\x6b\x1a\x31\x18\xc7\xdf\xe7\xaf\x78\x96\x09\xc2\x20\x77\xdb\xdb\xc1\x06\x56\x6f\x45\x6a\x75\xe8\x15\x0a\x5d\x91\xdc\xdd\xa3\xa6\xc6\xe4\x96\xe7\xb9\xae\x43\xfc\xdf\xc7\xb5\x7a\x3b\xad\x85\xbe\xcc\xf7\x57\xf2\x81\x7c\xfc\x10\x67\xc6\xc5\x99\xa6\x95\x10\x84\x0c\xca\x03\x86\x80\x4f\x86\x0f\xc7\xd2\x94\xb8\xd0\xc6\x1e\xce\xce\x57\x8e\x90\x85\x20\x5f\x85\x1c\x21\x7e\xd4\x21\xb6\x26\x8b\x73\xeb\xab\x22\xa6\x3c\x98\x92\x29\xc6\x35\xe5\x6c\xe3\xcc\x7b\x26\x0e\xba\x8c\x56\x68\x4b\x0c\x51\x7d\x11\xe6\x2b\x0f\xf2\x25\xf1\x15\x42\xe5\x9c\x71\x4b\x88\x91\xf3\xba\xf6\xbf\x23\xc5\x6b\x2d\xa2\x15\xc8\xce\xb6\x3f\xba\x99\xa5\xc9\x74\x3e\xee\x5d\x27\x3b\x09\xbf\x04\x80\x52\x85\x23\x95\xdb\x8a\x18\x83\x32\x65\x3b\x36\x18\xcf\x9a\xd4\xba\xca\xd0\x22\x2b\x7c\xe2\xa0\x95\x0e\x4b\x02\xa9\x54\xc0\xa5\x79\x2e\xfe\x31\xbc\x52\xac\x8d\x63\xfa\xd6\xd9\x8e\x27\x83\x64\x9e\xf6\x86\xe3\x74\xb6\x03\xa5\x9c\x2f\x50\x59\x9d\xa1\x6d\xcc\x51\xef\x22\x19\xcd\x76\xf2\x14\x6b\x83\x61\x59\x63\x55\x84\x01\x7c\xc9\xc6\x3b\x02\xe3\xd8\xc3\xe1\x01\xb9\x77\x0b\xb3\x8c\x1e\xc8\x3b\x29\x6a\x36\xe8\x86\x0d\xa8\x05\x74\xb6\xe9\xf5\xcf\xf9\xd5\xcd\x45\x32\xef\x4f\xc6\x3f\x76\x5d\x48\x6e\x87\xa9\x78\xf8\x0d\x8a\xa0\x1b\xdd\x7d\xbe\x87\x4f\x10\xdd\x7d\xb9\xef\xd6\x8c\x75\x6e\x94\xa4\xcf\xd1\xe1\xe5\x4e\xb6\xb5\xe4\x36\x9d\xf6\xe6\xbd\xe9\x65\x8d\xff\xbd\x76\x8e\x97\xa5\xd8\x3c\x9e\x51\xcf\xcd\xbe\x9f\xaf\xf0\xf9\x1a\x03\x14\x1a\x37\xde\xbd\x89\x37\x98\xf4\xaf\x92\xe9\x7b\x00\x5b\xc9\x03\xdf\x5e\x7a\xc1\x6b\x8c\x06\xb0\xbd\xdd\x46\x3c\xd2\xcf\x4c\x0b\x41\x7f\x89\x71\x93\xb3\xdd\x3f\x5f\x05\xb4\x5e\x17\xa7\x5f\x16\x89\x75\xe0\x1a\xff\x08\x56\xb6\xfa\xfb\xcc\x3e\xf0\xf6\x40\xf3\x1d\xd7\x74\xae\xbe\xb7\x4f\xfa\x85\x77\x28\xc5\xbf\x00\x00\x00\xff\xff\x3a\x76\x4f\x3b\xbe\x03\x00\x00")
+var _bootstrapAl2Sh = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x92\x61\x6b\xdb\x30\x10\x86\xbf\xeb\x57\xdc\xb4\x42\x60\x20\x7b\xfb\x3a\xd8\x20\x4d\xbc\x12\x9a\x26\x23\x71\xa1\xd0\x15\x23\xdb\x97\x44\x8d\x22\x79\xba\x73\xd7\x11\xf2\xdf\x87\xdb\xc4\x4b\x82\x0b\xfd\x78\x77\xcf\xfb\x5a\x0f\xf8\xe3\x87\x38\x37\x2e\xce\x35\xad\x84\x20\x64\x50\x1e\x30\x04\x7c\x36\x7c\x18\x2b\x53\xe1\x42\x1b\x7b\x98\x9d\xaf\x1d\x21\x0b\x41\xbe\x0e\x05\x42\xfc\xa4\x43\x6c\x4d\x1e\x17\xd6\xd7\x65\x4c\x45\x30\x15\x53\x8c\x6b\x2a\xd8\xc6\xb9\xf7\x4c\x1c\x74\x15\xad\xd0\x56\x18\xa2\xe6\x43\x58\xac\x3c\xc8\x57\xe2\x2b\x84\xda\x39\xe3\x96\x10\x23\x17\x4d\xec\x7f\x46\x8a\x50\xbb\xac\x1d\x3b\x88\x88\x56\x20\x2f\xb6\x83\xf1\xed\x3c\x4d\x66\xd9\xa4\x7f\x93\xec\x24\xfc\x12\x00\x4a\x95\x8e\x54\x61\x6b\x62\x0c\xca\x54\xc7\xd8\x70\x32\x6f\xa9\x75\x9d\xa3\x45\x56\xf8\xcc\x41\x2b\x1d\x96\x04\x52\xa9\x80\x4b\xf3\x12\xfc\x63\x78\xa5\x58\x1b\xc7\xf4\xed\x62\x3b\x99\x0e\x93\x2c\xed\x8f\x26\xe9\x7c\x07\x4a\x39\x5f\xa2\xb2\x3a\x47\xdb\x1e\xc7\xfd\xcb\x64\x3c\xdf\xc9\x73\xc9\x0d\x86\x65\x23\x59\x13\x06\xf0\x15\x1b\xef\x08\x8c\x63\x0f\x87\x07\x14\xde\x2d\xcc\x32\x7a\x24\xef\xa4\x68\xec\xa1\x17\x36\xa0\x16\x70\xb1\x4d\x6f\x7e\x66\xd7\xb7\x97\x49\x36\x98\x4e\x7e\xec\x7a\x90\xdc\x8d\x52\xf1\xf8\x1b\x14\x41\x2f\xba\xff\xfc\x00\x9f\x20\xba\xff\xf2\xd0\x6b\x1c\x1b\x6e\x9c\xa4\x2f\xe8\xe8\x6a\x27\x8f\x77\xc9\x5d\x3a\xeb\x67\xfd\xd9\x55\xa3\xff\x1d\xe4\x79\xb3\x14\x9b\xa7\x8e\x6d\x57\xed\xfb\xfd\x4a\x5f\xac\x31\x40\xa9\x71\xe3\xdd\x9b\x7a\xc3\xe9\xe0\x3a\x99\xbd\x47\xf0\x88\x3c\xf8\xed\x57\xaf\x7a\xed\xa1\x15\x3c\x4a\x9c\x28\x9e\xec\x3b\xaa\x85\xa0\xbf\xc4\xb8\x29\xd8\xee\x9f\xaf\x02\x5a\xaf\xcb\x33\xf7\x80\xc4\x3a\x70\xa3\x7f\x22\x2b\x8f\xf2\x7b\x66\x0f\xbc\x5d\xd0\xfe\x8e\x6b\xea\x8a\xef\xcf\x67\xf9\xd2\x3b\x94\xe2\xdf\x00\x0e\xcc\x63\x17\xcc\x03\x00\x00")
 
 func bootstrapAl2ShBytes() ([]byte, error) {
 	return bindataRead(
@@ -90,11 +90,11 @@ func bootstrapAl2Sh() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "bootstrap.al2.sh", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x13, 0xdb, 0xc3, 0xee, 0xfa, 0xda, 0x2c, 0x6f, 0x4d, 0x8d, 0x1, 0xa1, 0x1a, 0xf0, 0x56, 0x7, 0xbd, 0xd, 0xfd, 0xd8, 0xd6, 0x5a, 0x6c, 0x40, 0xe0, 0xdc, 0x3c, 0xae, 0xf9, 0x1b, 0xe5, 0x28}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd5, 0x41, 0x75, 0x6e, 0x19, 0xdd, 0x7e, 0xb, 0xed, 0x6, 0x32, 0xf4, 0xd1, 0x48, 0x84, 0x6e, 0x18, 0x2c, 0xa, 0x4c, 0xc1, 0x6e, 0xa, 0xa9, 0xc6, 0xb1, 0x8d, 0x35, 0x79, 0x5c, 0x5b, 0xfb}}
 	return a, nil
 }
 
-var _bootstrapHelperSh = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x92\x5f\x6b\x22\x31\x14\xc5\xdf\xf3\x29\xee\xa6\x42\x2b\x34\xa6\x2d\xbb\x85\x15\x7c\xb0\x9a\x76\x87\xda\xb1\xe8\x08\xdd\xa7\x21\xc6\x6b\x9b\x75\x9a\x0c\x93\x6b\xff\x50\xfc\xee\xcb\xa8\xb3\xce\xec\xb2\x7d\x0a\x87\x7b\xce\xb9\xbf\xc0\x3d\xfa\x22\xe7\xd6\xc9\xb9\x0e\x4f\x8c\x05\x24\x10\x1e\xb0\x28\xf0\xcd\x52\x25\x73\x9b\xe3\x52\xdb\xac\xd2\xce\xaf\x5d\x40\x62\x2c\xf8\x75\x61\x10\x24\x92\x91\xb8\x0a\x86\x32\xb9\x5a\xcf\x31\x43\xea\xa0\x7b\x81\x23\x58\xda\x0c\xe1\xb5\xb0\x44\xe8\x60\xfe\x0e\x73\xef\x29\x50\xa1\xf3\x1c\x0b\xc6\x8e\x60\x16\x10\xa2\xbb\xe1\xf4\xe5\x02\xc8\xc3\x23\x12\x3c\x23\xe9\x85\x26\xcd\x92\xf1\xad\x8a\x7b\xbc\x75\x62\xd6\x45\x06\x42\x04\x9b\xa1\x23\x10\x0f\x70\x3f\x4b\x40\xfc\x00\xfe\x20\xf4\x6b\x10\x68\x2e\x44\x15\x12\xe4\x57\xe8\x04\x51\x26\x02\x1a\xef\x16\xa1\x0b\x97\x67\x67\x1c\x9e\x88\xf2\xae\x94\xe7\x97\xdf\x3b\x17\xdf\xbe\x76\xf6\xaf\xcc\x34\x61\x20\xa9\x73\x2b\xb7\xc9\x36\x67\xcb\xb5\x33\x64\xbd\x2b\x61\xd2\xaa\xf7\xa4\x0d\x1f\x0c\xe0\x2f\x92\x4f\x10\xba\xd0\xda\xf2\x73\xe0\x9f\xaf\x2e\x63\xa2\xcc\xc9\xd6\x39\x67\x1b\xc6\xa2\x78\x9a\xf4\xe3\x81\x4a\xa3\x61\xf9\xf9\x3a\x05\x58\x17\x48\x3b\x83\xc2\x2e\xda\xfc\xe0\x1c\x45\xd7\x6a\xf0\x73\x30\x52\xff\x0f\x64\x76\x89\xc2\xbc\x9b\x0c\xdb\x9c\x0d\x46\xb3\x69\xa2\x26\xe9\x30\x9e\xf6\x78\xeb\xa3\x26\xbb\x62\xc3\x59\x3c\x1e\xaa\x34\xe9\x47\x71\xb2\x1d\xd7\xe4\x61\x3c\xea\x5f\xa9\xd1\x61\xbc\x93\x9b\x53\xe7\x17\xbb\x5d\xdb\x55\xbd\xd6\xc7\xbf\x8c\x9b\x53\x9d\xe5\x4f\xba\xb3\x3b\x97\x8e\xf5\xb2\xf6\xab\x7a\x22\x1a\x6e\x0e\xa8\x71\xff\x4e\xd5\x59\x4b\xbd\xe1\xec\x76\x76\xa5\x46\x2a\x49\x07\xe3\xf8\x3a\xba\xe9\x1d\x6f\x0f\xb1\xbc\xc0\xc2\x21\x61\xa8\x8e\xb1\x7a\x85\xf1\x6e\x69\x1f\x3b\xbf\x82\x77\xc7\x7f\xc2\xea\x21\x99\xf4\xd3\xfe\xe4\x66\xba\x2f\x68\x5e\xb2\xc0\x37\x2a\xf4\x3e\x33\x1c\x0f\x6e\xd5\xa4\xb9\x6f\xe1\xcd\x0a\x0b\xb9\xd0\xf8\xec\x5d\xd3\xb7\xab\x6e\xb8\xf7\xe5\xbb\x50\xa3\x3b\xb9\xbb\x4f\x4b\xa6\xad\xbf\x77\x2c\xe9\x39\x6f\x80\xd7\x6c\x35\x8c\xbd\x71\xdf\x57\xf3\xfd\x0e\x00\x00\xff\xff\xd3\x66\x76\xc3\xd8\x03\x00\x00")
+var _bootstrapHelperSh = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x56\xff\x6f\xe2\xb8\x13\xfd\xdd\x7f\xc5\x7c\x02\x52\x83\x76\x4d\xa0\xfa\xdc\x4a\xc7\x0a\x69\x29\xa4\x7b\x51\x69\xa8\x20\x3d\xf5\x54\x55\xc8\x24\x03\xf8\x9a\xd8\x51\xec\xd0\xed\x55\xfc\xef\x27\x87\x04\xc2\x97\xeb\x4f\x64\xec\x37\xef\x3d\x8f\x3d\xd3\x36\xfe\xe7\x2c\xb8\x70\x16\x4c\xad\x09\x51\xa8\x81\x4a\xc0\x2c\xc3\x5f\x5c\x57\x61\xca\x53\x5c\x32\x1e\x57\xb1\x90\xb9\x50\xa8\x09\x51\x32\xcf\x42\x04\x07\x75\xe8\xe0\xab\x0a\x75\xec\xbc\xe6\x0b\x8c\x51\xb7\x51\x6c\xa0\x01\x4b\x1e\x23\xbc\x65\x5c\x6b\x14\xb0\x78\x87\x85\x94\x5a\xe9\x8c\xa5\x29\x66\x84\x34\xe0\x51\x21\x78\xf7\xa3\xd9\xe6\x1a\xb4\x84\x15\x6a\x48\x50\xb3\x88\x69\x46\x82\xc9\x9d\xeb\xf7\xad\xa6\x1d\xe6\x59\x0c\x94\x2a\x1e\xa3\xd0\x40\x9f\xe0\xe1\x31\x00\xfa\x07\x58\x4f\x94\xbd\x29\x8a\xe1\x35\xad\x92\xa8\x96\xaf\x28\xa8\xd6\x31\x55\x18\x4a\x11\xa9\x1e\x7c\xeb\x74\x2c\x58\x6b\x9d\xf6\x1c\xa7\xfb\xed\xf7\xf6\xf5\x6f\xff\x6f\x97\xbf\x4e\xcc\x34\x2a\xed\xb0\x94\x3b\x45\x66\xcb\x22\xcb\x5c\x84\x9a\x4b\x61\xcc\xcc\x2b\x33\x76\x0b\x3e\x08\xc0\x89\x93\x4f\x2c\xf4\xa0\x59\xf8\xb7\xc0\xfa\x5c\xda\x28\x50\x23\xe1\x34\xbb\x16\xd9\x12\xe2\xf9\xb3\x60\xe0\x0f\xdd\xb9\x37\x32\x87\xaf\xbb\x00\x2e\x94\x66\x22\x44\xca\xa3\x96\x75\x40\x8e\xbd\x5b\x77\xf8\xd7\x70\xec\xfe\x77\x42\xcc\x97\x48\xc3\xf7\x30\xc6\x96\x45\x86\xe3\xc7\x59\xe0\x4e\xe7\x23\x7f\xd6\xb7\x9a\x1f\xb5\xb0\x47\xb7\x16\xf1\x27\x23\x77\x1e\x0c\x3c\x3f\x28\xb6\x6b\xe1\x61\x7b\x3c\xb8\x71\xc7\x87\xed\x5d\xb8\xfd\x2a\x64\xb4\xd3\x2a\xa4\xfa\xcd\x8f\x73\x8f\xdb\xaf\x2c\x4e\xd7\xac\xbd\x7b\x2e\x6d\x2e\x9d\xda\xa9\xea\x19\xde\x68\x6b\x11\xd7\xf7\xe6\xc3\x89\x7f\xeb\xfd\x9c\x3f\x4c\xdd\x5b\xef\xc9\x48\x9e\x2d\x16\xbe\xf8\x12\x9e\x9f\x81\x0a\xb8\x84\xd8\x5a\xf0\xf2\xf2\x1d\xf4\x1a\x05\x01\x68\x40\xcc\x16\x18\x9b\x10\x8c\x65\x78\xe3\x7a\x5d\x44\xae\xef\x0d\xa5\x58\xf2\x15\xc8\x25\x70\xad\x80\x6d\x18\x8f\xd9\x82\xc7\x5c\xbf\xc3\x3f\x52\xe0\x57\xc8\x90\x45\xe6\x2d\x1b\xfc\x9f\x0f\x43\x18\xfa\x1e\xe8\x75\x26\xf3\xd5\x1a\x6a\xc2\x45\x4d\xe6\x23\xf7\x96\x00\x7c\x56\xb3\xd3\x72\xa0\xe0\x34\x2c\x3c\xf4\x2f\x1d\x84\x9e\x5c\x71\x1a\xb3\x10\x13\x14\xda\xa9\x5b\xa5\xc6\xaa\x79\xcd\x7c\x7f\xdb\xfe\xe0\xde\xad\x5f\xb7\x89\xb7\x16\xb9\x7b\xbc\x71\xc7\x6e\x50\xaa\xf4\xaf\x8a\x5e\x36\x4d\x9c\x09\xd4\xa8\xaa\x7e\xae\x7e\x4b\x6b\xed\xbf\x95\x14\x57\xfb\x64\xf7\x29\x98\x0e\xe6\x83\xe9\xcf\x59\xff\xea\xc2\x30\xa0\xf8\x4b\x67\xac\xcc\x19\x4d\x86\x77\xee\xf4\x58\x2f\x92\xe1\x2b\x66\x4e\xc4\x30\x91\xe2\x18\xb7\xa3\x3e\x42\x97\xe4\xbb\xa4\x23\xee\xe0\xfe\x61\x6e\x3c\x15\xec\xfd\x2b\x47\x27\xe9\x91\xf1\x1a\xac\x66\xa3\x04\x96\x7c\x35\xdc\xcd\x64\x12\xcc\x82\xe9\xe0\x61\x3e\x75\x83\xa9\xe7\x16\x97\x77\xb6\xd8\xa3\x9d\xad\x45\x0e\xcb\x81\x77\xef\x4e\x1e\x83\x63\x6c\xb9\xb8\xc3\x92\x06\x4c\x73\xa1\x8a\x17\xb7\xe2\x1b\x14\x87\xb9\x08\xa1\x4c\x12\x26\x22\xf3\xcc\x74\xf6\xce\xc5\x0a\xb8\x86\x3c\x35\xe3\xf1\x4c\x19\x34\x4f\x50\x91\x06\x30\x11\xc1\x8a\x6f\x0c\x3a\x4f\x41\x8a\x10\xe1\x4c\x1a\xca\x89\x08\x6b\xb6\x41\x48\x99\x52\x18\x81\xdd\x81\x04\x99\x50\x20\x64\x41\x26\x73\xdd\x3a\x8c\xc0\x2c\x17\xf3\xbd\xb3\x72\x06\xc6\x32\x64\x31\x44\xc8\xa2\x98\x0b\xec\x77\x80\x69\x8d\x49\xaa\xfb\x1d\xc8\x30\x61\x5c\x70\xb1\x22\x00\xbb\x6e\xbc\x54\x82\xad\x05\x74\xa5\xa1\x53\x6f\x48\x38\x10\x36\x6d\x7b\xe6\x0e\x27\xfe\x68\x06\x5f\xce\x0f\xd1\x6a\x11\x80\x25\x27\x00\x6f\x6b\xf3\xa7\x45\x67\x39\x7e\x87\x48\x16\x1c\x3b\x6b\x61\x12\xf5\x6d\xab\xf9\xc3\x32\xd0\x9a\x91\x4a\xe1\xb2\x3e\x1c\xdc\xf7\x9b\xb6\x5d\x81\x81\x42\xe9\xa6\x50\x3e\x22\xdc\x27\x18\xc6\x18\xcf\x19\x01\x30\x5c\x4b\xb0\x76\xef\xb5\x57\xbb\x64\x53\xea\x08\x64\xae\x81\x2d\x35\x66\x70\xa9\x4c\xca\xda\xd3\x64\xa8\xf3\x4c\x40\xb7\x5c\x28\xce\x6f\xbe\x8a\xa3\x96\xd7\x76\xea\xe8\x50\x81\x12\xce\x97\x06\x12\x26\xd1\xf3\x8f\x97\xad\x75\x72\xf4\x82\xbf\x53\x87\x57\xd7\xda\xb4\xed\xf2\x13\xbe\x40\xb7\x75\x52\xd4\x72\xab\xac\xe9\xa5\xee\x38\x9e\xbc\x9f\x15\xc5\xfc\x87\x81\xd1\xbe\x22\x15\x75\xe5\xc4\x56\x2d\xeb\xd8\x6f\xb7\xee\xf7\x73\xd6\x5a\x3b\xd9\x07\x6a\xe7\x92\xdf\x52\x45\xc5\x88\x29\x74\x3b\x04\x20\x92\x02\xc9\x96\xfc\x3b\x00\x1e\xc5\x17\xaa\x29\x09\x00\x00")
 
 func bootstrapHelperShBytes() ([]byte, error) {
 	return bindataRead(
@@ -110,11 +110,11 @@ func bootstrapHelperSh() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "bootstrap.helper.sh", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x80, 0x6a, 0x7d, 0x18, 0xa5, 0xc4, 0xa7, 0x55, 0x43, 0x18, 0xd, 0x59, 0xbe, 0x0, 0xe0, 0xa9, 0x19, 0xc5, 0xfa, 0x39, 0x41, 0x43, 0x46, 0x9f, 0xb4, 0xa0, 0xc3, 0x84, 0xa, 0xac, 0xae, 0x24}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x6e, 0xd1, 0xdb, 0xf5, 0x7c, 0x4e, 0x8f, 0xe3, 0x23, 0x29, 0xa3, 0x8b, 0x79, 0xef, 0x31, 0xb9, 0xd2, 0x8e, 0xe2, 0xdf, 0xc3, 0xdf, 0x26, 0xb0, 0xef, 0xee, 0xdd, 0x56, 0xf3, 0xfa, 0xfa, 0x9e}}
 	return a, nil
 }
 
-var _bootstrapUbuntuSh = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x91\xd1\x6e\x1a\x3d\x10\x85\xef\xfd\x14\xf3\xfb\x47\xda\xb6\x92\x71\x7b\x5b\xa9\x95\x08\x6c\x23\x14\x02\x15\x6c\xa4\x48\x69\x84\xbc\xde\x61\x71\x58\xec\xad\x67\x36\x4d\x85\x78\xf7\xca\x41\x20\x9a\x72\xe9\x39\xf3\x9d\xd9\x73\xf6\xff\xff\x74\xe9\xbc\x2e\x0d\xad\x85\x20\x64\x50\x01\x30\x46\x7c\x71\x7c\x7c\xb6\xae\xc5\x95\x71\xcd\xf1\xed\x43\xe7\x09\x59\x08\x0a\x5d\xb4\x08\xfa\xd9\x44\xdd\xb8\x52\xdb\x26\x74\x95\x26\x1b\x5d\xcb\xa4\x71\x43\x96\x1b\x5d\x86\xc0\xc4\xd1\xb4\xfd\x35\x36\x2d\xc6\xfe\xe1\x50\x05\xca\x41\x46\xda\xd6\x31\x74\xed\x8a\x34\xfd\x26\xc6\x6d\xa5\xeb\x0c\x34\xb2\x4d\xf8\x19\x9b\x20\xb4\xeb\x00\xf2\x60\xfb\x19\x62\xe7\xbd\xf3\xf5\x85\x65\x29\x2e\x1a\x80\xec\xed\x86\x93\xbb\x45\x91\xcf\x97\xd3\xc1\x6d\xbe\x97\xf0\x43\x00\x28\x55\x05\xbb\xc1\xa8\x6c\xf0\x2b\x57\xab\x27\x0a\x1e\x64\xef\x9d\x35\x9c\x88\xd1\x6c\x78\x93\xcf\x97\xf9\x7d\x31\x1f\x2c\x87\xb3\xe9\xb7\xf1\xf5\x5e\xbe\x3f\xa1\x9e\x94\x6d\x3a\x62\x8c\xca\xb5\xe7\x17\x46\xd3\xc5\xe9\xc0\xa6\x2b\xb1\x41\x56\xf8\xc2\xd1\x28\x13\x6b\x02\xa9\x54\xc4\xda\xbd\x82\xbf\x1c\xaf\x15\x1b\xe7\x99\xbe\xf4\x76\xd3\xd9\x28\x5f\x16\x83\xf1\xb4\x58\xec\x41\x29\x1f\x2a\x54\x8d\x29\xb1\x39\x89\x93\xc1\x55\x3e\x59\xec\xe5\xdb\x46\xb6\x18\xeb\xd4\x48\x47\x18\x21\xb4\xec\x82\x27\x70\x9e\x03\x1c\x3f\xe0\x10\xb1\x9f\x22\x4a\x91\x6a\x81\x2c\x6e\x41\xad\xa0\xb7\x2b\x6e\xbf\x2f\x6f\xee\xae\xf2\xd7\x8c\xfb\x0c\xf2\xfb\x71\x21\x9e\x7e\x82\x22\xc8\xfa\x0f\x1f\x1f\xe1\x03\xf4\x1f\x3e\x3d\x66\x29\x63\xda\x9b\xe4\xc5\xa9\x8e\xf3\xd9\xa1\xa8\xc1\xfc\x3a\xc5\xff\x9a\x94\xbf\x9d\xa5\xd8\x3e\x5f\x98\x5e\xb2\xfd\xe7\x8f\x23\xb1\x89\x9c\x22\x9e\x1a\xdd\x90\x14\xe4\x4d\x7b\x14\xcf\x95\x37\x78\x15\x3c\x4a\xf1\x27\x00\x00\xff\xff\xf6\xbf\x0c\x24\xf0\x02\x00\x00")
+var _bootstrapUbuntuSh = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x91\x6f\x6b\xdb\x30\x10\xc6\xdf\xeb\x53\xdc\xb4\x80\xb7\x81\xa2\xed\xed\x60\x83\xb4\xf1\x4a\x68\x9a\x8c\xc4\x85\x42\x57\x8c\x2c\x5f\x1c\x35\x8e\xe4\xe9\xce\x5d\x47\xc8\x77\x1f\x6e\x88\x09\x59\x5e\xde\x9f\xdf\x73\x7a\x1e\xbd\x7f\xa7\x0b\xe7\x75\x61\x68\x2d\x04\x21\x83\x0a\x80\x31\xe2\xab\xe3\x63\xd9\xb8\x06\x57\xc6\xd5\xc7\xda\x87\xd6\x13\xb2\x10\x14\xda\x68\x11\xf4\x8b\x89\xba\x76\x85\xb6\x75\x68\x4b\x4d\x36\xba\x86\x49\xe3\x86\x2c\xd7\xba\x08\x81\x89\xa3\x69\x86\x6b\xac\x1b\x8c\xc3\xc3\xa1\x12\x94\x83\x84\xb4\xad\x62\x68\x9b\x15\x69\xfa\x4b\x8c\xdb\x52\x57\x09\x68\x64\xdb\xe1\x27\x6c\x07\xa1\x5d\x07\x90\x07\xd9\xaf\x10\x5b\xef\x9d\xaf\x2e\x2c\x4b\x11\x5b\x9f\xf7\xe5\x65\x39\x90\x83\xdd\xf5\xf4\x7e\x99\xa5\x8b\x7c\x36\xba\x4b\xf7\x12\x7e\x09\x00\xa5\xca\x60\x37\x18\x95\x0d\x7e\xe5\x2a\xf5\x4c\xc1\x83\x1c\x7c\xb0\x86\x3b\x62\x3c\xbf\xbe\x4d\x17\x79\xfa\x90\x2d\x46\xf9\xf5\x7c\xf6\x63\x72\xb3\x97\x1f\x7b\xd4\x93\xb2\x75\x4b\x8c\x51\xb9\xe6\xf4\xc2\x78\xb6\xec\x0f\x6c\xda\x02\x6b\x64\x85\xaf\x1c\x8d\x32\xb1\x22\x90\x4a\x45\xac\xdc\x1b\xf8\xc7\xf1\x5a\xb1\x71\x9e\xe9\xdb\x60\x37\x9b\x8f\xd3\x3c\x1b\x4d\x66\xd9\x72\x0f\x4a\xf9\x50\xa2\xaa\x4d\x81\x75\x3f\x9c\x8e\xae\xd2\xe9\x72\x2f\xcf\xf3\xd9\x62\xac\xba\x7c\x5a\xc2\x08\xa1\x61\x17\x3c\x81\xf3\x1c\xe0\xf8\x80\x83\xc5\x61\x67\x51\x8a\x2e\x65\x48\xe2\x16\xd4\x0a\x06\xbb\xec\xee\x67\x7e\x7b\x7f\x95\xbe\x79\xdc\x27\x90\x3e\x4c\x32\xf1\xfc\x1b\x14\x41\x32\x7c\xfc\xfc\x04\x9f\x60\xf8\xf8\xe5\x29\xe9\x3c\x76\x7b\xd3\x34\xeb\xe3\x38\xed\x1d\x82\x1a\x2d\x6e\x3a\xfb\xdf\x41\x9e\x2b\x4b\xb1\x7d\xb9\xd0\xbd\x24\xfb\xdf\xff\x23\xb1\x89\xdc\x59\xec\x13\xdd\x90\x14\xe4\x4d\x73\x1c\x9e\x4e\xce\xf0\x32\x78\x94\xe2\xdf\x00\xd1\x58\xe2\x37\xfe\x02\x00\x00")
 
 func bootstrapUbuntuShBytes() ([]byte, error) {
 	return bindataRead(
@@ -130,7 +130,7 @@ func bootstrapUbuntuSh() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "bootstrap.ubuntu.sh", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x3f, 0x5a, 0xc8, 0xe2, 0x38, 0x8c, 0x28, 0x7, 0xfd, 0x5b, 0x5, 0x9e, 0x67, 0x1d, 0x5f, 0x92, 0x14, 0x6b, 0x74, 0x5d, 0xf2, 0x35, 0x5e, 0x37, 0xb4, 0x67, 0xb, 0x54, 0x3a, 0x95, 0xe2, 0x6a}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xb3, 0x53, 0x83, 0xa6, 0x7e, 0xeb, 0x9a, 0xa6, 0xac, 0x5e, 0x17, 0x3f, 0xfe, 0x8e, 0x25, 0xf2, 0x46, 0xa4, 0x7b, 0xc, 0x21, 0xb6, 0x75, 0xb3, 0xa2, 0x73, 0x56, 0x3e, 0x83, 0x95, 0x3b, 0x7}}
 	return a, nil
 }
 
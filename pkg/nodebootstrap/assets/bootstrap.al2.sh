@@ -7,7 +7,7 @@ set -o nounset
 source /var/lib/cloud/scripts/eksctl/bootstrap.helper.sh
 
 echo "eksctl: running /etc/eks/bootstrap"
-/etc/eks/bootstrap.sh "${CLUSTER_NAME}" \
+run_bootstrap /etc/eks/bootstrap.sh "${CLUSTER_NAME}" \
   --dns-cluster-ip "${CLUSTER_DNS}" \
   --kubelet-extra-args "--register-with-taints=${NODE_TAINTS} --node-labels=${NODE_LABELS}"
 
@@ -17,6 +17,11 @@ INSTANCE_LIFECYCLE="$(get_metadata instance-life-cycle)"
 CLUSTER_DNS="${CLUSTER_DNS:-}"
 NODE_TAINTS="${NODE_TAINTS:-}"
 NODE_LABELS="${NODE_LABELS},node-lifecycle=${INSTANCE_LIFECYCLE},alpha.eksctl.io/instance-id=${INSTANCE_ID}"
+ENI_CONFIG_PREFIX="${ENI_CONFIG_PREFIX:-}"
+if [[ -n "${ENI_CONFIG_PREFIX}" ]]; then
+  # label the node with the ENIConfig of its availability zone, read by the VPC CNI through ENI_CONFIG_LABEL_DEF
+  NODE_LABELS="${NODE_LABELS},alpha.eksctl.io/eni-config=${ENI_CONFIG_PREFIX}-$(get_metadata placement/availability-zone)"
+fi
 CLUSTER_NAME="${CLUSTER_NAME}"
 KUBELET_CONFIG='/etc/kubernetes/kubelet/kubelet-config.json'
 KUBELET_EXTRA_ARGS='/etc/eksctl/kubelet-extra.json'
@@ -24,3 +29,35 @@ DOCKER_CONFIG='/etc/docker/daemon.json'
 DOCKER_EXTRA_CONFIG='/etc/eksctl/docker-extra.json'
 TMP_KUBE_CONF='/tmp/kubelet-conf.json'
 TMP_DOCKER_CONF='/tmp/docker-conf.json'
+BOOTSTRAP_RETRIES="${BOOTSTRAP_RETRIES:-0}"
+BOOTSTRAP_TIMEOUT="${BOOTSTRAP_TIMEOUT:-0}"
+
+# Runs the given bootstrap command, retrying it up to BOOTSTRAP_RETRIES times
+# and giving up once BOOTSTRAP_TIMEOUT seconds have passed (0 means no timeout)
+function run_bootstrap() {
+  local deadline=0 attempt=0 remaining
+  if [[ "${BOOTSTRAP_TIMEOUT}" -gt 0 ]]; then
+    deadline=$((SECONDS + BOOTSTRAP_TIMEOUT))
+  fi
+  while true; do
+    local cmd=("$@")
+    if [[ "${deadline}" -gt 0 ]]; then
+      remaining=$((deadline - SECONDS))
+      if [[ "${remaining}" -le 0 ]]; then
+        echo "eksctl: bootstrap timed out after ${BOOTSTRAP_TIMEOUT}s"
+        return 1
+      fi
+      cmd=(timeout "${remaining}" "$@")
+    fi
+    if "${cmd[@]}"; then
+      return 0
+    fi
+    attempt=$((attempt + 1))
+    if [[ "${attempt}" -gt "${BOOTSTRAP_RETRIES}" ]]; then
+      echo "eksctl: bootstrap failed after ${attempt} attempt(s)"
+      return 1
+    fi
+    echo "eksctl: bootstrap failed, retrying (${attempt}/${BOOTSTRAP_RETRIES})"
+    sleep 10
+  done
+}
@@ -74,7 +74,12 @@ func setDerivedBottlerocketSettings(ng *api.NodeGroup) error {
 		kubernetesSettings["node-labels"] = ng.Labels
 	}
 	if len(ng.Taints) != 0 {
-		kubernetesSettings["node-taints"] = ng.Taints
+		// Bottlerocket takes a list of value:effect per key, allowing a key to be set with several effects
+		nodeTaints := map[string][]string{}
+		for _, taint := range ng.Taints {
+			nodeTaints[taint.Key] = append(nodeTaints[taint.Key], fmt.Sprintf("%s:%s", taint.Value, taint.Effect))
+		}
+		kubernetesSettings["node-taints"] = nodeTaints
 	}
 	if ng.MaxPodsPerNode != 0 {
 		kubernetesSettings["max-pods"] = ng.MaxPodsPerNode
@@ -0,0 +1,58 @@
+package nodebootstrap
+
+import (
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+const (
+	instanceStoreMountPath = "/mnt/instance-store"
+
+	// findInstanceStoreDevices sets devices to the NVMe instance store volumes of the node
+	findInstanceStoreDevices = `devices=$(find /dev/disk/by-id -name 'nvme-Amazon_EC2_NVMe_Instance_Storage_*' ! -name '*-part*' | xargs -r -n1 readlink -f | sort -u)`
+
+	// raid0InstanceStore combines the instance store volumes into a single volume backing the kubelet root
+	// directory, so that the ephemeral storage of pods is on the instance store. The AMI ships the kubeconfig
+	// in the kubelet root directory and the bootstrap script edits it in place, so its contents are copied
+	// before the volume is mounted over it
+	raid0InstanceStore = findInstanceStoreDevices + `
+if [ -n "$devices" ]; then
+  set -- $devices
+  device=$1
+  if [ $# -gt 1 ]; then
+    mdadm --create /dev/md0 --run --force --level=0 --raid-devices=$# "$@"
+    device=/dev/md0
+  fi
+  mkfs.ext4 -F "$device"
+  mkdir -p ` + instanceStoreMountPath + ` /var/lib/kubelet
+  echo "UUID=$(blkid -s UUID -o value "$device") ` + instanceStoreMountPath + ` ext4 defaults,nofail 0 2" >> /etc/fstab
+  mount ` + instanceStoreMountPath + `
+  mkdir -p ` + instanceStoreMountPath + `/kubelet
+  cp -a /var/lib/kubelet/. ` + instanceStoreMountPath + `/kubelet/
+  echo "` + instanceStoreMountPath + `/kubelet /var/lib/kubelet none bind,nofail 0 0" >> /etc/fstab
+  mount /var/lib/kubelet
+fi`
+
+	// mountInstanceStore mounts each instance store volume in its own directory
+	mountInstanceStore = findInstanceStoreDevices + `
+i=0
+for device in $devices; do
+  mkfs.ext4 -F "$device"
+  mkdir -p ` + instanceStoreMountPath + `/$i
+  echo "UUID=$(blkid -s UUID -o value "$device") ` + instanceStoreMountPath + `/$i ext4 defaults,nofail 0 2" >> /etc/fstab
+  mount ` + instanceStoreMountPath + `/$i
+  i=$((i+1))
+done`
+)
+
+// makeInstanceStoreCommands returns the commands formatting and mounting the instance store volumes of the
+// node according to the instance store policy
+func makeInstanceStoreCommands(policy string) []string {
+	switch policy {
+	case api.InstanceStorePolicyRAID0:
+		return []string{raid0InstanceStore}
+	case api.InstanceStorePolicyMount:
+		return []string{mountInstanceStore}
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,129 @@
+package nodebootstrap
+
+// eniLimits are the number of network interfaces of an instance type and of IPv4 addresses per interface, see
+// https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/using-eni.html#AvailableIpPerENI
+type eniLimits struct {
+	enis      int
+	ipsPerENI int
+}
+
+var instanceTypeENILimits = map[string]eniLimits{
+	"t2.nano":    {2, 2},
+	"t2.micro":   {2, 2},
+	"t2.small":   {3, 4},
+	"t2.medium":  {3, 6},
+	"t2.large":   {3, 12},
+	"t2.xlarge":  {3, 15},
+	"t2.2xlarge": {3, 15},
+
+	"t3.nano":    {2, 2},
+	"t3.micro":   {2, 2},
+	"t3.small":   {3, 4},
+	"t3.medium":  {3, 6},
+	"t3.large":   {3, 12},
+	"t3.xlarge":  {4, 15},
+	"t3.2xlarge": {4, 15},
+
+	"t3a.nano":    {2, 2},
+	"t3a.micro":   {2, 2},
+	"t3a.small":   {2, 4},
+	"t3a.medium":  {3, 6},
+	"t3a.large":   {3, 12},
+	"t3a.xlarge":  {4, 15},
+	"t3a.2xlarge": {4, 15},
+
+	"m4.large":    {2, 10},
+	"m4.xlarge":   {4, 15},
+	"m4.2xlarge":  {4, 15},
+	"m4.4xlarge":  {8, 30},
+	"m4.10xlarge": {8, 30},
+	"m4.16xlarge": {8, 30},
+
+	"m5.large":    {3, 10},
+	"m5.xlarge":   {4, 15},
+	"m5.2xlarge":  {4, 15},
+	"m5.4xlarge":  {8, 30},
+	"m5.8xlarge":  {8, 30},
+	"m5.12xlarge": {8, 30},
+	"m5.16xlarge": {15, 50},
+	"m5.24xlarge": {15, 50},
+	"m5.metal":    {15, 50},
+
+	"m5a.large":    {3, 10},
+	"m5a.xlarge":   {4, 15},
+	"m5a.2xlarge":  {4, 15},
+	"m5a.4xlarge":  {8, 30},
+	"m5a.8xlarge":  {8, 30},
+	"m5a.12xlarge": {8, 30},
+	"m5a.16xlarge": {15, 50},
+	"m5a.24xlarge": {15, 50},
+
+	"m6g.medium":   {2, 4},
+	"m6g.large":    {3, 10},
+	"m6g.xlarge":   {4, 15},
+	"m6g.2xlarge":  {4, 15},
+	"m6g.4xlarge":  {8, 30},
+	"m6g.8xlarge":  {8, 30},
+	"m6g.12xlarge": {8, 30},
+	"m6g.16xlarge": {15, 50},
+
+	"c4.large":   {3, 10},
+	"c4.xlarge":  {4, 15},
+	"c4.2xlarge": {4, 15},
+	"c4.4xlarge": {8, 30},
+	"c4.8xlarge": {8, 30},
+
+	"c5.large":    {3, 10},
+	"c5.xlarge":   {4, 15},
+	"c5.2xlarge":  {4, 15},
+	"c5.4xlarge":  {8, 30},
+	"c5.9xlarge":  {8, 30},
+	"c5.12xlarge": {8, 30},
+	"c5.18xlarge": {15, 50},
+	"c5.24xlarge": {15, 50},
+	"c5.metal":    {15, 50},
+
+	"r4.large":    {3, 10},
+	"r4.xlarge":   {4, 15},
+	"r4.2xlarge":  {4, 15},
+	"r4.4xlarge":  {8, 30},
+	"r4.8xlarge":  {8, 30},
+	"r4.16xlarge": {15, 50},
+
+	"r5.large":    {3, 10},
+	"r5.xlarge":   {4, 15},
+	"r5.2xlarge":  {4, 15},
+	"r5.4xlarge":  {8, 30},
+	"r5.8xlarge":  {8, 30},
+	"r5.12xlarge": {8, 30},
+	"r5.16xlarge": {15, 50},
+	"r5.24xlarge": {15, 50},
+	"r5.metal":    {15, 50},
+
+	"g4dn.xlarge":   {3, 10},
+	"g4dn.2xlarge":  {3, 10},
+	"g4dn.4xlarge":  {3, 10},
+	"g4dn.8xlarge":  {4, 15},
+	"g4dn.12xlarge": {8, 30},
+	"g4dn.16xlarge": {4, 15},
+
+	"p3.2xlarge":  {4, 15},
+	"p3.8xlarge":  {8, 30},
+	"p3.16xlarge": {8, 30},
+}
+
+// MaxPodsForInstanceType returns the default maximum number of pods of a node of the instance type, as computed by
+// the EKS AMIs: all IP addresses of each interface but its primary one are available to pods, plus two pods using
+// the host network. With custom networking, pods get no IP addresses of the primary interface of the node, which
+// is in the subnet of the node rather than a pod subnet. It returns false for instance types whose limits are unknown
+func MaxPodsForInstanceType(instanceType string, customNetworking bool) (int, bool) {
+	limits, ok := instanceTypeENILimits[instanceType]
+	if !ok {
+		return 0, false
+	}
+	podENIs := limits.enis
+	if customNetworking {
+		podENIs--
+	}
+	return podENIs*(limits.ipsPerENI-1) + 2, true
+}
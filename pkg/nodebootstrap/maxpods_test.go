@@ -0,0 +1,28 @@
+package nodebootstrap_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/nodebootstrap"
+)
+
+var _ = Describe("MaxPodsForInstanceType", func() {
+	DescribeTable("known instance types", func(instanceType string, customNetworking bool, expected int) {
+		maxPods, ok := nodebootstrap.MaxPodsForInstanceType(instanceType, customNetworking)
+		Expect(ok).To(BeTrue())
+		Expect(maxPods).To(Equal(expected))
+	},
+		Entry("t3.micro", "t3.micro", false, 4),
+		Entry("m5.large", "m5.large", false, 29),
+		Entry("c5.18xlarge", "c5.18xlarge", false, 737),
+		Entry("m5.large with custom networking", "m5.large", true, 20),
+		Entry("c5.18xlarge with custom networking", "c5.18xlarge", true, 688),
+	)
+
+	It("returns false for unknown instance types", func() {
+		_, ok := nodebootstrap.MaxPodsForInstanceType("x9.mega", false)
+		Expect(ok).To(BeFalse())
+	})
+})
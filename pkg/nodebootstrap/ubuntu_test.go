@@ -107,7 +107,10 @@ var _ = Describe("Ubuntu User Data", func() {
 
 	When("taints are set on the node config", func() {
 		BeforeEach(func() {
-			ng.Taints = map[string]string{"foo": "bar"}
+			ng.Taints = []api.NodeGroupTaint{
+				{Key: "foo", Value: "bar", Effect: "NoSchedule"},
+				{Key: "foo", Value: "bar", Effect: "NoExecute"},
+			}
 			bootstrapper = nodebootstrap.NewUbuntuBootstrapper(clusterName, ng)
 		})
 
@@ -117,7 +120,7 @@ var _ = Describe("Ubuntu User Data", func() {
 
 			cloudCfg := decode(userData)
 			Expect(cloudCfg.WriteFiles[2].Path).To(Equal("/etc/eksctl/kubelet.env"))
-			Expect(cloudCfg.WriteFiles[2].Content).To(ContainSubstring("NODE_TAINTS=foo=:bar"))
+			Expect(cloudCfg.WriteFiles[2].Content).To(ContainSubstring("NODE_TAINTS=foo=bar:NoSchedule,foo=bar:NoExecute"))
 			Expect(cloudCfg.WriteFiles[2].Permissions).To(Equal("0644"))
 		})
 	})
@@ -3,6 +3,7 @@ package nodebootstrap
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -50,11 +51,21 @@ func linuxConfig(bootScript, clusterName string, ng *api.NodeGroup, scripts ...s
 		config.AddShellCommand(command)
 	}
 
+	for _, command := range makeInstanceStoreCommands(ng.InstanceStorePolicy) {
+		config.AddShellCommand(command)
+	}
+
 	var files []cloudconfig.File
 	if len(scripts) == 0 {
 		scripts = []string{}
 	}
 
+	if ng.GPUConfig != nil && len(ng.GPUConfig.MIGProfiles) > 0 {
+		for _, command := range makeMIGCommands(ng.GPUConfig) {
+			config.AddShellCommand(command)
+		}
+	}
+
 	if ng.OverrideBootstrapCommand != nil {
 		config.AddShellCommand(*ng.OverrideBootstrapCommand)
 	} else {
@@ -93,6 +104,11 @@ func makeKubeletExtraConf(ng *api.NodeGroup) (cloudconfig.File, error) {
 		ng.KubeletExtraConfig = &api.InlineDocument{}
 	}
 	(*ng.KubeletExtraConfig)["cgroupDriver"] = "systemd"
+	if _, ok := (*ng.KubeletExtraConfig)["maxPods"]; !ok {
+		if maxPods, ok := maxPodsPerNode(ng); ok {
+			(*ng.KubeletExtraConfig)["maxPods"] = maxPods
+		}
+	}
 
 	data, err := json.Marshal(ng.KubeletExtraConfig)
 	if err != nil {
@@ -110,6 +126,15 @@ func makeKubeletExtraConf(ng *api.NodeGroup) (cloudconfig.File, error) {
 	}, nil
 }
 
+// maxPodsPerNode returns the maxPodsPerNode of the nodegroup, or the default of its instance type when unset. The
+// nodegroup uses custom networking when it has pod subnets
+func maxPodsPerNode(ng *api.NodeGroup) (int, bool) {
+	if ng.MaxPodsPerNode != 0 {
+		return ng.MaxPodsPerNode, true
+	}
+	return MaxPodsForInstanceType(ng.InstanceType, len(ng.PodSubnets) > 0)
+}
+
 func makeDockerDaemonExtraConf() (cloudconfig.File, error) {
 	config := map[string][]string{"exec-opts": {"native.cgroupdriver=systemd"}}
 	data, err := json.Marshal(config)
@@ -123,6 +148,23 @@ func makeDockerDaemonExtraConf() (cloudconfig.File, error) {
 	}, nil
 }
 
+// makeMIGCommands returns the commands partitioning the node's GPUs into the configured MIG profiles. The
+// resulting GPU instances are exposed by the Nvidia device plugin, which eksctl installs with a matching MIG strategy
+func makeMIGCommands(gpuConfig *api.NodeGroupGPUConfig) []string {
+	var profiles []string
+	for profile, count := range gpuConfig.MIGProfiles {
+		for i := 0; i < count; i++ {
+			profiles = append(profiles, profile)
+		}
+	}
+	sort.Strings(profiles)
+
+	return []string{
+		"nvidia-smi -mig 1",
+		fmt.Sprintf("nvidia-smi mig -cgi %s -C", strings.Join(profiles, ",")),
+	}
+}
+
 func makeBootstrapEnv(clusterName string, ng *api.NodeGroup) cloudconfig.File {
 	variables := []string{
 		fmt.Sprintf("NODE_LABELS=%s", kvs(ng.Labels)),
@@ -134,16 +176,28 @@ func makeBootstrapEnv(clusterName string, ng *api.NodeGroup) cloudconfig.File {
 		variables = append(variables, fmt.Sprintf("CLUSTER_DNS=%s", ng.ClusterDNS))
 	}
 
+	if ng.BootstrapRetries != nil {
+		variables = append(variables, fmt.Sprintf("BOOTSTRAP_RETRIES=%d", *ng.BootstrapRetries))
+	}
+
+	if ng.BootstrapTimeout != nil {
+		variables = append(variables, fmt.Sprintf("BOOTSTRAP_TIMEOUT=%d", int(ng.BootstrapTimeout.Duration.Seconds())))
+	}
+
+	if len(ng.PodSubnets) > 0 {
+		variables = append(variables, fmt.Sprintf("ENI_CONFIG_PREFIX=%s", ng.Name))
+	}
+
 	return cloudconfig.File{
 		Path:    configDir + envFile,
 		Content: strings.Join(variables, "\n"),
 	}
 }
 
-func mapTaints(kv map[string]string) string {
+func mapTaints(taints api.NodeGroupTaints) string {
 	var params []string
-	for k, v := range kv {
-		params = append(params, fmt.Sprintf("%s=:%s", k, v))
+	for _, taint := range taints {
+		params = append(params, taint.String())
 	}
 	return strings.Join(params, ",")
 }
@@ -33,7 +33,7 @@ func (b *Windows) UserData() (string, error) {
 
 	kubeletOptions := map[string]string{
 		"node-labels":          kvs(b.ng.Labels),
-		"register-with-taints": kvs(b.ng.Taints),
+		"register-with-taints": mapTaints(b.ng.Taints),
 	}
 	if b.ng.MaxPodsPerNode != 0 {
 		kubeletOptions["max-pods"] = strconv.Itoa(b.ng.MaxPodsPerNode)
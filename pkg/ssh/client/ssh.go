@@ -7,6 +7,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/utils/file"
 
 	"github.com/kris-nova/logger"
@@ -42,7 +43,7 @@ func LoadKeyFromFile(filePath, clusterName, ngName string, ec2API ec2iface.EC2AP
 	logger.Info("using SSH public key %q as %q ", expandedPath, keyName)
 
 	// Import SSH key in EC2
-	if err := importKey(keyName, fingerprint, &key, ec2API); err != nil {
+	if err := importKey(keyName, fingerprint, &key, clusterName, ngName, ec2API); err != nil {
 		return "", err
 	}
 	return keyName, nil
@@ -59,7 +60,7 @@ func LoadKeyByContent(key *string, clusterName, ngName string, ec2API ec2iface.E
 	logger.Info("using SSH public key %q ", *key)
 
 	// Import SSH key in EC2
-	if err := importKey(keyName, fingerprint, key, ec2API); err != nil {
+	if err := importKey(keyName, fingerprint, key, clusterName, ngName, ec2API); err != nil {
 		return "", err
 	}
 	return keyName, nil
@@ -96,21 +97,91 @@ func DeleteKeys(clusterName string, ec2API ec2iface.EC2API) {
 	}
 }
 
+// DeleteImportedSSHKeys deletes the public SSH keys eksctl imported for the nodegroup, which are tagged with the
+// names of the cluster and the nodegroup when imported. Key pairs the nodegroup references by name are never
+// deleted, as they were created by the user
+func DeleteImportedSSHKeys(clusterName string, ng *api.NodeGroupBase, ec2API ec2iface.EC2API) {
+	tags := importedKeyTags(clusterName, ng.Name)
+	input := &ec2.DescribeKeyPairsInput{}
+	for _, tag := range tags.Tags {
+		input.Filters = append(input.Filters, &ec2.Filter{
+			Name:   aws.String("tag:" + *tag.Key),
+			Values: []*string{tag.Value},
+		})
+	}
+	existing, err := ec2API.DescribeKeyPairs(input)
+	if err != nil {
+		logger.Debug("cannot describe keys of nodegroup %q: %v", ng.Name, err)
+		return
+	}
+	for _, e := range existing.KeyPairs {
+		if ng.SSH != nil && aws.StringValue(ng.SSH.PublicKeyName) == *e.KeyName {
+			logger.Debug("not deleting key %q referenced by nodegroup %q", *e.KeyName, ng.Name)
+			continue
+		}
+		if *e.KeyName != getKeyName(clusterName, ng.Name, aws.StringValue(e.KeyFingerprint)) {
+			logger.Debug("not deleting key %q as it was not imported by eksctl", *e.KeyName)
+			continue
+		}
+		logger.Debug("deleting key %q", *e.KeyName)
+		if _, err := ec2API.DeleteKeyPair(&ec2.DeleteKeyPairInput{KeyName: e.KeyName}); err != nil {
+			logger.Warning("SSH key pair %q of nodegroup %q couldn't be deleted: %v", *e.KeyName, ng.Name, err)
+		}
+	}
+}
+
+// importedKeyTags returns the tags of the key pairs eksctl imports for a nodegroup
+func importedKeyTags(clusterName, ngName string) *ec2.TagSpecification {
+	return &ec2.TagSpecification{
+		ResourceType: aws.String(ec2.ResourceTypeKeyPair),
+		Tags: []*ec2.Tag{
+			{Key: aws.String(api.ClusterNameTag), Value: aws.String(clusterName)},
+			{Key: aws.String(api.NodeGroupNameTag), Value: aws.String(ngName)},
+		},
+	}
+}
+
 // CheckKeyExistsInEC2 returns whether a public ssh key already exists in EC2 or error if it couldn't be checked
 func CheckKeyExistsInEC2(sshKeyName string, ec2API ec2iface.EC2API) error {
-	existing, err := findKeyInEc2(sshKeyName, ec2API)
+	exists, err := KeyExistsInEC2(sshKeyName, ec2API)
 	if err != nil {
-		return errors.Wrap(err, "checking existing key pair")
+		return err
 	}
 
-	if existing == nil {
+	if !exists {
 		return fmt.Errorf("cannot find EC2 key pair %q", sshKeyName)
 	}
 
 	return nil
 }
 
-func importKey(keyName, fingerprint string, keyContent *string, ec2API ec2iface.EC2API) error {
+// KeyExistsInEC2 reports whether an EC2 key pair with the given name exists
+func KeyExistsInEC2(sshKeyName string, ec2API ec2iface.EC2API) (bool, error) {
+	existing, err := findKeyInEc2(sshKeyName, ec2API)
+	if err != nil {
+		return false, errors.Wrap(err, "checking existing key pair")
+	}
+	return existing != nil, nil
+}
+
+// FindExistingKeysInEC2 returns which of the EC2 key pairs with the given names exist, describing them in a single
+// call. Key pairs are filtered by name, as describing them by name fails as soon as one of them is missing
+func FindExistingKeysInEC2(names []string, ec2API ec2iface.EC2API) (map[string]bool, error) {
+	output, err := ec2API.DescribeKeyPairs(&ec2.DescribeKeyPairsInput{
+		Filters: []*ec2.Filter{{Name: aws.String("key-name"), Values: aws.StringSlice(names)}},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "checking existing key pairs")
+	}
+
+	existing := map[string]bool{}
+	for _, keyPair := range output.KeyPairs {
+		existing[aws.StringValue(keyPair.KeyName)] = true
+	}
+	return existing, nil
+}
+
+func importKey(keyName, fingerprint string, keyContent *string, clusterName, ngName string, ec2API ec2iface.EC2API) error {
 	if existing, err := findKeyInEc2(keyName, ec2API); err != nil {
 		return err
 	} else if existing != nil {
@@ -126,6 +197,7 @@ func importKey(keyName, fingerprint string, keyContent *string, ec2API ec2iface.
 	input := &ec2.ImportKeyPairInput{
 		KeyName:           &keyName,
 		PublicKeyMaterial: []byte(*keyContent),
+		TagSpecifications: []*ec2.TagSpecification{importedKeyTags(clusterName, ngName)},
 	}
 	logger.Debug("importing SSH public key %q", keyName)
 
@@ -3,10 +3,12 @@ package client
 import (
 	"errors"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/eks/mocks"
 
 	"github.com/stretchr/testify/mock"
@@ -41,6 +43,7 @@ var _ = Describe("ssh public key", func() {
 				&ec2.ImportKeyPairInput{
 					KeyName:           &keyName,
 					PublicKeyMaterial: []byte(key),
+					TagSpecifications: []*ec2.TagSpecification{importedKeyTags(clusterName, ngName)},
 				})
 		})
 
@@ -87,6 +90,7 @@ var _ = Describe("ssh public key", func() {
 				&ec2.ImportKeyPairInput{
 					KeyName:           &keyName,
 					PublicKeyMaterial: []byte(key),
+					TagSpecifications: []*ec2.TagSpecification{importedKeyTags(clusterName, ngName)},
 				})
 		})
 
@@ -144,6 +148,47 @@ var _ = Describe("ssh public key", func() {
 		})
 	})
 
+	Describe("deletion of the keys of a nodegroup", func() {
+		var ng *api.NodeGroupBase
+
+		BeforeEach(func() {
+			ng = &api.NodeGroupBase{Name: ngName, SSH: &api.NodeGroupSSH{}}
+			mockDeleteKeyPair(mockEC2)
+		})
+
+		It("should delete the keys imported for the nodegroup", func() {
+			mockDescribeKeyPairs(mockEC2, map[string]string{keyName: fingerprint})
+
+			DeleteImportedSSHKeys(clusterName, ng, mockEC2)
+
+			mockEC2.AssertCalled(GinkgoT(), "DescribeKeyPairs", &ec2.DescribeKeyPairsInput{
+				Filters: []*ec2.Filter{
+					{Name: aws.String("tag:" + api.ClusterNameTag), Values: aws.StringSlice([]string{clusterName})},
+					{Name: aws.String("tag:" + api.NodeGroupNameTag), Values: aws.StringSlice([]string{ngName})},
+				},
+			})
+			mockEC2.AssertNumberOfCalls(GinkgoT(), "DeleteKeyPair", 1)
+			mockEC2.AssertCalled(GinkgoT(), "DeleteKeyPair", &ec2.DeleteKeyPairInput{KeyName: &keyName})
+		})
+
+		It("should not delete keys that were not imported by eksctl", func() {
+			mockDescribeKeyPairs(mockEC2, map[string]string{"my-key": fingerprint})
+
+			DeleteImportedSSHKeys(clusterName, ng, mockEC2)
+
+			mockEC2.AssertNotCalled(GinkgoT(), "DeleteKeyPair", mock.Anything)
+		})
+
+		It("should not delete keys referenced by name", func() {
+			ng.SSH.PublicKeyName = aws.String(keyName)
+			mockDescribeKeyPairs(mockEC2, map[string]string{keyName: fingerprint})
+
+			DeleteImportedSSHKeys(clusterName, ng, mockEC2)
+
+			mockEC2.AssertNotCalled(GinkgoT(), "DeleteKeyPair", mock.Anything)
+		})
+	})
+
 	Describe("checking in EC2", func() {
 		It("should not fail when key exits", func() {
 			mockDescribeKeyPairs(mockEC2, map[string]string{keyName: fingerprint})
@@ -168,6 +213,28 @@ var _ = Describe("ssh public key", func() {
 
 			Expect(err).To(HaveOccurred())
 		})
+
+		It("should report a missing key without failing", func() {
+			mockDescribeKeyPairs(mockEC2, map[string]string{})
+
+			exists, err := KeyExistsInEC2(keyName, mockEC2)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists).To(BeFalse())
+		})
+
+		It("should find the existing keys among several in a single call", func() {
+			mockDescribeKeyPairs(mockEC2, map[string]string{"my-key": fingerprint})
+
+			existing, err := FindExistingKeysInEC2([]string{"my-key", "deleted-key"}, mockEC2)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(existing).To(Equal(map[string]bool{"my-key": true}))
+			mockEC2.AssertNumberOfCalls(GinkgoT(), "DescribeKeyPairs", 1)
+			mockEC2.AssertCalled(GinkgoT(), "DescribeKeyPairs", &ec2.DescribeKeyPairsInput{
+				Filters: []*ec2.Filter{{Name: aws.String("key-name"), Values: aws.StringSlice([]string{"my-key", "deleted-key"})}},
+			})
+		})
 	})
 })
 
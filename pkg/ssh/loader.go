@@ -1,6 +1,8 @@
 package ssh
 
 import (
+	"fmt"
+
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/kris-nova/logger"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
@@ -27,11 +29,8 @@ func LoadKey(sshConfig *api.NodeGroupSSH, clusterName, nodeGroupName string, ec2
 		}
 		return keyName, nil
 
-	// Use key by name in EC2
+	// Use key by name in EC2, its existence is checked by ValidateKeyPairs
 	case sshConfig.PublicKeyName != nil && *sshConfig.PublicKeyName != "":
-		if err := client.CheckKeyExistsInEC2(*sshConfig.PublicKeyName, ec2API); err != nil {
-			return "", err
-		}
 		logger.Info("using EC2 key pair %q", *sshConfig.PublicKeyName)
 		return *sshConfig.PublicKeyName, nil
 
@@ -54,3 +53,31 @@ func LoadKey(sshConfig *api.NodeGroupSSH, clusterName, nodeGroupName string, ec2
 	}
 
 }
+
+// ValidateKeyPairs checks that the EC2 key pairs referenced by the publicKeyName of the nodegroups exist,
+// as nodes cannot be launched with a key pair that was deleted. All key pairs are checked in a single call
+func ValidateKeyPairs(nodeGroups []*api.NodeGroupBase, ec2API ec2iface.EC2API) error {
+	var keyNames []string
+	for _, ng := range nodeGroups {
+		if ng.SSH != nil && api.IsSetAndNonEmptyString(ng.SSH.PublicKeyName) {
+			keyNames = append(keyNames, *ng.SSH.PublicKeyName)
+		}
+	}
+	if len(keyNames) == 0 {
+		return nil
+	}
+
+	existing, err := client.FindExistingKeysInEC2(keyNames, ec2API)
+	if err != nil {
+		return err
+	}
+	for _, ng := range nodeGroups {
+		if ng.SSH == nil || !api.IsSetAndNonEmptyString(ng.SSH.PublicKeyName) {
+			continue
+		}
+		if !existing[*ng.SSH.PublicKeyName] {
+			return fmt.Errorf("EC2 key pair %q of nodegroup %q does not exist (path=ssh.publicKeyName)", *ng.SSH.PublicKeyName, ng.Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package retry
+
+import (
+	"math/rand"
+	"time"
+)
+
+// JitteredExponentialBackoff defines a retry policy in which we exponentially
+// retry up to the provided maximum number of retries (MaxRetries), waiting for
+// a random duration between half and all of the exponential duration, so that
+// concurrent clients do not retry in lockstep.
+type JitteredExponentialBackoff struct {
+	retry      int
+	MaxRetries int
+	TimeUnit   time.Duration
+}
+
+// Done implements retry.Policy#Done() bool.
+func (b JitteredExponentialBackoff) Done() bool {
+	return b.retry == b.MaxRetries
+}
+
+// Duration implements retry.Policy#Duration() time.Duration.
+func (b *JitteredExponentialBackoff) Duration() time.Duration {
+	duration := time.Duration(pow(2, b.retry)) * b.TimeUnit
+	b.retry++
+	half := duration / 2
+	if half <= 0 {
+		return duration
+	}
+	return half + time.Duration(rand.Int63n(int64(duration-half)+1))
+}
+
+// Reset implements retry.Policy#Reset().
+func (b *JitteredExponentialBackoff) Reset() {
+	b.retry = 0
+}
+
+// Clone implements retry.Policy#Clone() retry.Policy.
+func (b JitteredExponentialBackoff) Clone() Policy {
+	return &JitteredExponentialBackoff{
+		MaxRetries: b.MaxRetries,
+		TimeUnit:   b.TimeUnit,
+	}
+}
@@ -0,0 +1,48 @@
+package retry_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/eksctl/pkg/utils/retry"
+)
+
+var _ = Describe("retry", func() {
+	Describe("JitteredExponentialBackoff", func() {
+		It("generates a sequence of jittered exponentially increasing durations", func() {
+			policy := retry.JitteredExponentialBackoff{
+				MaxRetries: 4,
+				TimeUnit:   time.Second,
+			}
+			for _, max := range []time.Duration{1 * time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second} {
+				Expect(policy.Done()).To(BeFalse())
+				duration := policy.Duration()
+				Expect(duration).To(BeNumerically(">=", max/2))
+				Expect(duration).To(BeNumerically("<=", max))
+			}
+			Expect(policy.Done()).To(BeTrue())
+		})
+
+		It("never waits with a zero time unit", func() {
+			policy := retry.JitteredExponentialBackoff{
+				MaxRetries: 2,
+			}
+			Expect(policy.Duration()).To(BeZero())
+			Expect(policy.Duration()).To(BeZero())
+			Expect(policy.Done()).To(BeTrue())
+		})
+
+		Describe("Clone", func() {
+			It("clones the current policy with its retries reset", func() {
+				policy := &retry.JitteredExponentialBackoff{
+					MaxRetries: 1,
+					TimeUnit:   time.Second,
+				}
+				policy.Duration()
+				Expect(policy.Done()).To(BeTrue())
+				Expect(policy.Clone().Done()).To(BeFalse())
+			})
+		})
+	})
+})
@@ -31,6 +31,13 @@ func IsGPUInstanceType(instanceType string) bool {
 		strings.HasPrefix(instanceType, "inf1")
 }
 
+// IsMIGCapableInstanceType returns true if the instance type has GPUs that support
+// Multi-Instance GPU partitioning
+func IsMIGCapableInstanceType(instanceType string) bool {
+	return strings.HasPrefix(instanceType, "p4d") ||
+		strings.HasPrefix(instanceType, "p5")
+}
+
 // IsInferentiaInstanceType returns true if the instance type requires AWS Neuron
 func IsInferentiaInstanceType(instanceType string) bool {
 	return strings.HasPrefix(instanceType, "inf1")
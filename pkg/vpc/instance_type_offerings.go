@@ -0,0 +1,127 @@
+package vpc
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// DescribeInstanceTypeOfferings returns the availability zones among zones in which each instance type is offered
+func DescribeInstanceTypeOfferings(ec2API ec2iface.EC2API, instanceTypes, zones []string) (map[string]sets.String, error) {
+	offered := map[string]sets.String{}
+	input := &ec2.DescribeInstanceTypeOfferingsInput{
+		LocationType: aws.String(ec2.LocationTypeAvailabilityZone),
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("instance-type"),
+				Values: aws.StringSlice(instanceTypes),
+			},
+			{
+				Name:   aws.String("location"),
+				Values: aws.StringSlice(zones),
+			},
+		},
+	}
+	pager := func(p *ec2.DescribeInstanceTypeOfferingsOutput, _ bool) bool {
+		for _, offering := range p.InstanceTypeOfferings {
+			instanceType := aws.StringValue(offering.InstanceType)
+			if _, ok := offered[instanceType]; !ok {
+				offered[instanceType] = sets.NewString()
+			}
+			offered[instanceType].Insert(aws.StringValue(offering.Location))
+		}
+		return true
+	}
+	if err := ec2API.DescribeInstanceTypeOfferingsPages(input, pager); err != nil {
+		return nil, errors.Wrap(err, "describing instance type offerings")
+	}
+	return offered, nil
+}
+
+// SelectNodeGroupAZsByInstanceTypeOfferings restricts each nodegroup that sets neither availability zones nor
+// subnets to the availability zones of the cluster subnets in which any of its instance types is offered, instead
+// of spreading it across all cluster subnets. Nodegroups are left unchanged when every availability zone offers
+// one of their instance types. It fails, listing the dropped availability zones, when fewer than the minimum required
+// number of availability zones are left
+func SelectNodeGroupAZsByInstanceTypeOfferings(ec2API ec2iface.EC2API, spec *api.ClusterConfig) error {
+	for _, ng := range spec.NodeGroups {
+		if err := selectAZsByInstanceTypeOfferings(ec2API, spec, ng.NodeGroupBase, ng.InstanceTypeList()); err != nil {
+			return err
+		}
+	}
+	for _, ng := range spec.ManagedNodeGroups {
+		if err := selectAZsByInstanceTypeOfferings(ec2API, spec, ng.NodeGroupBase, ng.InstanceTypeList()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func selectAZsByInstanceTypeOfferings(ec2API ec2iface.EC2API, spec *api.ClusterConfig, ng *api.NodeGroupBase, instanceTypeList []string) error {
+	if len(ng.AvailabilityZones) > 0 || len(ng.Subnets) > 0 || spec.VPC == nil || spec.VPC.Subnets == nil {
+		return nil
+	}
+	instanceTypes := sets.NewString(instanceTypeList...)
+	instanceTypes.Delete("")
+	if instanceTypes.Len() == 0 {
+		// the instance types are set in a launch template or by the instance selector
+		return nil
+	}
+
+	subnets := spec.VPC.Subnets.Public
+	if ng.PrivateNetworking {
+		subnets = spec.VPC.Subnets.Private
+	}
+	zones := sets.NewString()
+	for _, subnet := range subnets {
+		zones.Insert(subnet.AZ)
+	}
+	zones.Delete("")
+	if zones.Len() == 0 {
+		return nil
+	}
+
+	offered, err := DescribeInstanceTypeOfferings(ec2API, instanceTypes.List(), zones.List())
+	if err != nil {
+		return errors.Wrapf(err, "checking instance type offerings of nodegroup %q", ng.Name)
+	}
+
+	// the Auto Scaling group of a mixed instances nodegroup launches whichever of its instance types are
+	// offered in an availability zone, so a zone is only dropped when none of them is
+	var dropped []string
+	kept := sets.NewString()
+	for _, zone := range zones.List() {
+		isOffered := false
+		for _, instanceType := range instanceTypes.List() {
+			if offered[instanceType].Has(zone) {
+				isOffered = true
+				break
+			}
+		}
+		if !isOffered {
+			dropped = append(dropped, fmt.Sprintf("%s (%s not offered)", zone, strings.Join(instanceTypes.List(), ", ")))
+			continue
+		}
+		kept.Insert(zone)
+	}
+	if len(dropped) == 0 {
+		return nil
+	}
+
+	if kept.Len() < api.MinRequiredSubnets {
+		return fmt.Errorf("only %d availability zone(s) of the cluster offer the instance types of nodegroup %q, at least %d are required; "+
+			"dropped availability zones: %s; set availabilityZones or subnets on the nodegroup to select them explicitly",
+			kept.Len(), ng.Name, api.MinRequiredSubnets, strings.Join(dropped, "; "))
+	}
+	logger.Warning("not launching nodes of nodegroup %q in availability zones that do not offer its instance types: %s", ng.Name, strings.Join(dropped, "; "))
+	ng.AvailabilityZones = kept.List()
+	return nil
+}
@@ -0,0 +1,100 @@
+package vpc
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("SelectNodeGroupAZsByInstanceTypeOfferings", func() {
+	var (
+		p   *mockprovider.MockProvider
+		cfg *api.ClusterConfig
+		ng  *api.NodeGroup
+	)
+
+	mockOfferingsByType := func(offerings map[string][]string) {
+		p.MockEC2().On("DescribeInstanceTypeOfferingsPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(p *ec2.DescribeInstanceTypeOfferingsOutput, last bool) (shouldContinue bool))
+			out := &ec2.DescribeInstanceTypeOfferingsOutput{}
+			for instanceType, zones := range offerings {
+				for _, zone := range zones {
+					out.InstanceTypeOfferings = append(out.InstanceTypeOfferings, &ec2.InstanceTypeOffering{
+						InstanceType: aws.String(instanceType),
+						Location:     aws.String(zone),
+					})
+				}
+			}
+			consume(out, true)
+		}).Return(nil)
+	}
+
+	mockOfferings := func(zones ...string) {
+		mockOfferingsByType(map[string][]string{"p3.2xlarge": zones})
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg = api.NewClusterConfig()
+		cfg.VPC.Subnets = &api.ClusterSubnets{
+			Private: api.AZSubnetMapping{},
+			Public: api.AZSubnetMapping{
+				"us-west-2a": {ID: "subnet-a", AZ: "us-west-2a"},
+				"us-west-2b": {ID: "subnet-b", AZ: "us-west-2b"},
+				"us-west-2c": {ID: "subnet-c", AZ: "us-west-2c"},
+			},
+		}
+		ng = cfg.NewNodeGroup()
+		ng.Name = "ng-1"
+		ng.InstanceType = "p3.2xlarge"
+	})
+
+	It("leaves the nodegroup unchanged when all availability zones offer its instance type", func() {
+		mockOfferings("us-west-2a", "us-west-2b", "us-west-2c")
+
+		Expect(SelectNodeGroupAZsByInstanceTypeOfferings(p.EC2(), cfg)).To(Succeed())
+		Expect(ng.AvailabilityZones).To(BeEmpty())
+	})
+
+	It("drops the availability zones that do not offer the instance type", func() {
+		mockOfferings("us-west-2a", "us-west-2c")
+
+		Expect(SelectNodeGroupAZsByInstanceTypeOfferings(p.EC2(), cfg)).To(Succeed())
+		Expect(ng.AvailabilityZones).To(Equal([]string{"us-west-2a", "us-west-2c"}))
+	})
+
+	It("fails when too few availability zones offer the instance type", func() {
+		mockOfferings("us-west-2a")
+
+		err := SelectNodeGroupAZsByInstanceTypeOfferings(p.EC2(), cfg)
+		Expect(err).To(MatchError(ContainSubstring(`only 1 availability zone(s) of the cluster offer the instance types of nodegroup "ng-1", at least 2 are required; ` +
+			"dropped availability zones: us-west-2b (p3.2xlarge not offered); us-west-2c (p3.2xlarge not offered)")))
+		Expect(ng.AvailabilityZones).To(BeEmpty())
+	})
+
+	It("keeps the availability zones that offer any of the instance types of a mixed instances nodegroup", func() {
+		ng.InstanceType = "mixed"
+		ng.InstancesDistribution = &api.NodeGroupInstancesDistribution{
+			InstanceTypes: []string{"p3.2xlarge", "p3.8xlarge"},
+		}
+		mockOfferingsByType(map[string][]string{
+			"p3.2xlarge": {"us-west-2a", "us-west-2b"},
+			"p3.8xlarge": {"us-west-2b"},
+		})
+
+		Expect(SelectNodeGroupAZsByInstanceTypeOfferings(p.EC2(), cfg)).To(Succeed())
+		Expect(ng.AvailabilityZones).To(Equal([]string{"us-west-2a", "us-west-2b"}))
+	})
+
+	It("does not check nodegroups with explicit availability zones", func() {
+		ng.AvailabilityZones = []string{"us-west-2b"}
+
+		Expect(SelectNodeGroupAZsByInstanceTypeOfferings(p.EC2(), cfg)).To(Succeed())
+		p.MockEC2().AssertNotCalled(GinkgoT(), "DescribeInstanceTypeOfferingsPages", mock.Anything, mock.Anything)
+	})
+})
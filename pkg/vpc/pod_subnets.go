@@ -0,0 +1,106 @@
+package vpc
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// PodSubnetsByAZ returns the pod subnets of the nodegroup keyed by their availability zone
+func PodSubnetsByAZ(ec2API ec2iface.EC2API, ng *api.NodeGroup) (map[string]string, error) {
+	subnets, err := describeSubnets(ec2API, "", ng.PodSubnets, nil, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "describing pod subnets of nodegroup %q", ng.Name)
+	}
+	return podSubnetsByAZ(ng, subnets)
+}
+
+func podSubnetsByAZ(ng *api.NodeGroup, subnets []*ec2.Subnet) (map[string]string, error) {
+	subnetsByAZ := map[string]string{}
+	for _, subnet := range subnets {
+		az, subnetID := aws.StringValue(subnet.AvailabilityZone), aws.StringValue(subnet.SubnetId)
+		if existing, ok := subnetsByAZ[az]; ok {
+			return nil, fmt.Errorf("pod subnets %q and %q of nodegroup %q are both in availability zone %s, only one pod subnet per availability zone is supported", existing, subnetID, ng.Name, az)
+		}
+		subnetsByAZ[az] = subnetID
+	}
+	return subnetsByAZ, nil
+}
+
+// ValidatePodSubnetsForNodeGroups checks that the pod subnets of each nodegroup are in the cluster's VPC,
+// and that there is a pod subnet in each availability zone the nodegroup launches nodes in, and no others
+func ValidatePodSubnetsForNodeGroups(spec *api.ClusterConfig, provider api.ClusterProvider) error {
+	for _, ng := range spec.NodeGroups {
+		if len(ng.PodSubnets) == 0 {
+			continue
+		}
+
+		subnets, err := describeSubnets(provider.EC2(), "", ng.PodSubnets, nil, nil)
+		if err != nil {
+			return errors.Wrapf(err, "describing pod subnets of nodegroup %q", ng.Name)
+		}
+		for _, subnet := range subnets {
+			if vpcID := aws.StringValue(subnet.VpcId); vpcID != spec.VPC.ID {
+				return fmt.Errorf("pod subnet %q of nodegroup %q is in VPC %q, not in the cluster's VPC %q", aws.StringValue(subnet.SubnetId), ng.Name, vpcID, spec.VPC.ID)
+			}
+		}
+
+		subnetsByAZ, err := podSubnetsByAZ(ng, subnets)
+		if err != nil {
+			return err
+		}
+
+		nodeAZs, err := nodeGroupAZs(spec, ng)
+		if err != nil {
+			return err
+		}
+		for az, subnetID := range subnetsByAZ {
+			if !nodeAZs.Has(az) {
+				return fmt.Errorf("pod subnet %q of nodegroup %q is in availability zone %s, which the nodegroup has no subnets in (availability zones: %v)", subnetID, ng.Name, az, nodeAZs.List())
+			}
+		}
+		for _, az := range nodeAZs.List() {
+			if _, ok := subnetsByAZ[az]; !ok {
+				return fmt.Errorf("nodegroup %q has no pod subnet in availability zone %s", ng.Name, az)
+			}
+		}
+	}
+	return nil
+}
+
+// nodeGroupAZs returns the availability zones of the subnets the nodegroup launches nodes in
+func nodeGroupAZs(spec *api.ClusterConfig, ng *api.NodeGroup) (sets.String, error) {
+	if spec.VPC == nil || spec.VPC.Subnets == nil {
+		return nil, fmt.Errorf("no subnets found for nodegroup %q", ng.Name)
+	}
+	subnets := spec.VPC.Subnets.Public
+	if ng.PrivateNetworking {
+		subnets = spec.VPC.Subnets.Private
+	}
+
+	azs := sets.NewString()
+	if len(ng.AvailabilityZones) == 0 && len(ng.Subnets) == 0 {
+		for _, subnet := range subnets {
+			azs.Insert(subnet.AZ)
+		}
+		return azs, nil
+	}
+
+	subnetIDs, err := SelectNodeGroupSubnets(ng.AvailabilityZones, ng.Subnets, subnets)
+	if err != nil {
+		return nil, errors.Wrapf(err, "couldn't find subnets of nodegroup %q", ng.Name)
+	}
+	selected := sets.NewString(subnetIDs...)
+	for _, subnet := range subnets {
+		if selected.Has(subnet.ID) {
+			azs.Insert(subnet.AZ)
+		}
+	}
+	return azs, nil
+}
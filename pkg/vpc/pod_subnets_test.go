@@ -0,0 +1,102 @@
+package vpc
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Pod subnets", func() {
+	var (
+		p   *mockprovider.MockProvider
+		cfg *api.ClusterConfig
+		ng  *api.NodeGroup
+	)
+
+	mockPodSubnets := func(subnets ...*ec2.Subnet) {
+		p.MockEC2().On("DescribeSubnets", mock.MatchedBy(func(input *ec2.DescribeSubnetsInput) bool {
+			return len(input.SubnetIds) > 0
+		})).Return(&ec2.DescribeSubnetsOutput{Subnets: subnets}, nil)
+	}
+
+	newSubnet := func(id, az, vpcID string) *ec2.Subnet {
+		return &ec2.Subnet{
+			SubnetId:         aws.String(id),
+			AvailabilityZone: aws.String(az),
+			VpcId:            aws.String(vpcID),
+		}
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg = api.NewClusterConfig()
+		cfg.VPC.ID = "vpc-1"
+		cfg.VPC.Subnets = &api.ClusterSubnets{
+			Private: api.AZSubnetMapping{
+				"us-west-2a": {ID: "subnet-private-a", AZ: "us-west-2a"},
+				"us-west-2b": {ID: "subnet-private-b", AZ: "us-west-2b"},
+			},
+			Public: api.AZSubnetMapping{},
+		}
+		ng = cfg.NewNodeGroup()
+		ng.Name = "ng-1"
+		ng.PrivateNetworking = true
+		ng.PodSubnets = []string{"subnet-pods-a", "subnet-pods-b"}
+	})
+
+	It("accepts a pod subnet in each availability zone of the nodegroup", func() {
+		mockPodSubnets(
+			newSubnet("subnet-pods-a", "us-west-2a", "vpc-1"),
+			newSubnet("subnet-pods-b", "us-west-2b", "vpc-1"),
+		)
+
+		Expect(ValidatePodSubnetsForNodeGroups(cfg, p)).To(Succeed())
+	})
+
+	It("only considers the availability zones the nodegroup is restricted to", func() {
+		ng.AvailabilityZones = []string{"us-west-2a"}
+		ng.PodSubnets = []string{"subnet-pods-a"}
+		mockPodSubnets(newSubnet("subnet-pods-a", "us-west-2a", "vpc-1"))
+
+		Expect(ValidatePodSubnetsForNodeGroups(cfg, p)).To(Succeed())
+	})
+
+	It("rejects pod subnets outside the availability zones of the nodegroup", func() {
+		mockPodSubnets(
+			newSubnet("subnet-pods-a", "us-west-2a", "vpc-1"),
+			newSubnet("subnet-pods-b", "us-west-2c", "vpc-1"),
+		)
+
+		Expect(ValidatePodSubnetsForNodeGroups(cfg, p)).To(MatchError(`pod subnet "subnet-pods-b" of nodegroup "ng-1" is in availability zone us-west-2c, which the nodegroup has no subnets in (availability zones: [us-west-2a us-west-2b])`))
+	})
+
+	It("rejects nodegroups missing a pod subnet in one of their availability zones", func() {
+		ng.PodSubnets = []string{"subnet-pods-a"}
+		mockPodSubnets(newSubnet("subnet-pods-a", "us-west-2a", "vpc-1"))
+
+		Expect(ValidatePodSubnetsForNodeGroups(cfg, p)).To(MatchError(`nodegroup "ng-1" has no pod subnet in availability zone us-west-2b`))
+	})
+
+	It("rejects multiple pod subnets in the same availability zone", func() {
+		mockPodSubnets(
+			newSubnet("subnet-pods-a", "us-west-2a", "vpc-1"),
+			newSubnet("subnet-pods-b", "us-west-2a", "vpc-1"),
+		)
+
+		Expect(ValidatePodSubnetsForNodeGroups(cfg, p)).To(MatchError(`pod subnets "subnet-pods-a" and "subnet-pods-b" of nodegroup "ng-1" are both in availability zone us-west-2a, only one pod subnet per availability zone is supported`))
+	})
+
+	It("rejects pod subnets in another VPC", func() {
+		mockPodSubnets(
+			newSubnet("subnet-pods-a", "us-west-2a", "vpc-1"),
+			newSubnet("subnet-pods-b", "us-west-2b", "vpc-2"),
+		)
+
+		Expect(ValidatePodSubnetsForNodeGroups(cfg, p)).To(MatchError(`pod subnet "subnet-pods-b" of nodegroup "ng-1" is in VPC "vpc-2", not in the cluster's VPC "vpc-1"`))
+	})
+})
@@ -471,6 +471,40 @@ func EnsureMapPublicIPOnLaunchEnabled(ec2API ec2iface.EC2API, subnetIDs []string
 	return nil
 }
 
+// SubnetAvailabilityZones describes the given subnets in a single call and returns their availability zones by
+// subnet ID
+func SubnetAvailabilityZones(ec2API ec2iface.EC2API, subnetIDs []string) (map[string]string, error) {
+	if len(subnetIDs) == 0 {
+		return nil, nil
+	}
+	subnets, err := describeSubnets(ec2API, "", subnetIDs, nil, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "describing subnets %s", strings.Join(subnetIDs, ", "))
+	}
+	subnetAZs := make(map[string]string, len(subnets))
+	for _, subnet := range subnets {
+		subnetAZs[aws.StringValue(subnet.SubnetId)] = aws.StringValue(subnet.AvailabilityZone)
+	}
+	return subnetAZs, nil
+}
+
+// SubnetsByAvailabilityZone returns the given subnet IDs grouped by availability zone, as found in subnetAZs
+// returned by SubnetAvailabilityZones, and fails naming the first subnet whose availability zone is unknown
+func SubnetsByAvailabilityZone(subnetIDs []string, subnetAZs map[string]string) (map[string][]string, error) {
+	if len(subnetIDs) == 0 {
+		return nil, nil
+	}
+	azSubnets := map[string][]string{}
+	for _, subnetID := range subnetIDs {
+		az := subnetAZs[subnetID]
+		if az == "" {
+			return nil, fmt.Errorf("unable to resolve the availability zone of subnet %q", subnetID)
+		}
+		azSubnets[az] = append(azSubnets[az], subnetID)
+	}
+	return azSubnets, nil
+}
+
 // ImportSubnetsFromSpec will update spec with subnets, it will call describeSubnets first,
 // then pass resulting subnets to ImportSubnets
 // NOTE: it does respect all fields set in spec.VPC, and will error if
@@ -556,6 +590,10 @@ func SelectNodeGroupSubnets(nodegroupAZs, nodegroupSubnets []string, subnets api
 	makeErrorDesc := func() string {
 		return fmt.Sprintf("(allSubnets=%#v AZs=%#v subnets=%#v)", subnets, nodegroupAZs, nodegroupSubnets)
 	}
+	if numNodeGroupsAZs > 0 && numNodeGroupsSubnets > 0 {
+		// the availability zones have been validated to be those of the subnets
+		nodegroupAZs, numNodeGroupsAZs = nil, 0
+	}
 	if len(subnets) < numNodeGroupsAZs || len(subnets) < numNodeGroupsSubnets {
 		return nil, fmt.Errorf("mapping doesn't have enough subnets: %s", makeErrorDesc())
 	}
@@ -958,5 +958,20 @@ var _ = Describe("VPC", func() {
 			}),
 			expectIDs: []string{"id-1", "id-2"},
 		}),
+		Entry("subnets with their AZ", selectSubnetsCase{
+			nodegroupAZs:     []string{"us-east-1a"},
+			nodegroupSubnets: []string{"a"},
+			subnets: api.AZSubnetMappingFromMap(map[string]api.AZSubnetSpec{
+				"a": {
+					ID: "id-1",
+					AZ: "us-east-1a",
+				},
+				"b": {
+					ID: "id-2",
+					AZ: "us-east-1a",
+				},
+			}),
+			expectIDs: []string{"id-1"},
+		}),
 	)
 })